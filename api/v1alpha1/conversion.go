@@ -435,6 +435,10 @@ func Convert_v1alpha1_ScalewayMachineStatus_To_v1alpha2_ScalewayMachineStatus(in
 	return nil
 }
 
+func Convert_v1alpha2_AdditionalVolume_To_v1alpha1_AdditionalVolume(in *infrav1.AdditionalVolume, out *AdditionalVolume, s apimachineryconversion.Scope) error {
+	return autoConvert_v1alpha2_AdditionalVolume_To_v1alpha1_AdditionalVolume(in, out, s)
+}
+
 func Convert_v1alpha2_ScalewayMachineStatus_To_v1alpha1_ScalewayMachineStatus(in *infrav1.ScalewayMachineStatus, out *ScalewayMachineStatus, s apimachineryconversion.Scope) error {
 	if err := autoConvert_v1alpha2_ScalewayMachineStatus_To_v1alpha1_ScalewayMachineStatus(in, out, s); err != nil {
 		return err
@@ -445,6 +449,11 @@ func Convert_v1alpha2_ScalewayMachineStatus_To_v1alpha1_ScalewayMachineStatus(in
 	return nil
 }
 
+func Convert_v1alpha2_ScalewayMachineTemplate_To_v1alpha1_ScalewayMachineTemplate(in *infrav1.ScalewayMachineTemplate, out *ScalewayMachineTemplate, s apimachineryconversion.Scope) error {
+	// Status does not exist in v1alpha1.
+	return autoConvert_v1alpha2_ScalewayMachineTemplate_To_v1alpha1_ScalewayMachineTemplate(in, out, s)
+}
+
 func Convert_v1alpha1_ScalewayManagedClusterSpec_To_v1alpha2_ScalewayManagedClusterSpec(in *ScalewayManagedClusterSpec, out *infrav1.ScalewayManagedClusterSpec, s apimachineryconversion.Scope) error {
 	if err := autoConvert_v1alpha1_ScalewayManagedClusterSpec_To_v1alpha2_ScalewayManagedClusterSpec(in, out, s); err != nil {
 		return err
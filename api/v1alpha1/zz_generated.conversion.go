@@ -28,11 +28,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.AdditionalVolume)(nil), (*AdditionalVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_AdditionalVolume_To_v1alpha1_AdditionalVolume(a.(*v1alpha2.AdditionalVolume), b.(*AdditionalVolume), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ScalewayCluster)(nil), (*v1alpha2.ScalewayCluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ScalewayCluster_To_v1alpha2_ScalewayCluster(a.(*ScalewayCluster), b.(*v1alpha2.ScalewayCluster), scope)
 	}); err != nil {
@@ -118,11 +113,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha2.ScalewayMachineTemplate)(nil), (*ScalewayMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_ScalewayMachineTemplate_To_v1alpha1_ScalewayMachineTemplate(a.(*v1alpha2.ScalewayMachineTemplate), b.(*ScalewayMachineTemplate), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ScalewayMachineTemplateList)(nil), (*v1alpha2.ScalewayMachineTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ScalewayMachineTemplateList_To_v1alpha2_ScalewayMachineTemplateList(a.(*ScalewayMachineTemplateList), b.(*v1alpha2.ScalewayMachineTemplateList), scope)
 	}); err != nil {
@@ -278,6 +268,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha2.AdditionalVolume)(nil), (*AdditionalVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AdditionalVolume_To_v1alpha1_AdditionalVolume(a.(*v1alpha2.AdditionalVolume), b.(*AdditionalVolume), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha2.Image)(nil), (*ImageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_Image_To_v1alpha1_ImageSpec(a.(*v1alpha2.Image), b.(*ImageSpec), scope)
 	}); err != nil {
@@ -308,6 +303,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha2.ScalewayMachineTemplate)(nil), (*ScalewayMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ScalewayMachineTemplate_To_v1alpha1_ScalewayMachineTemplate(a.(*v1alpha2.ScalewayMachineTemplate), b.(*ScalewayMachineTemplate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha2.ScalewayManagedClusterSpec)(nil), (*ScalewayManagedClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ScalewayManagedClusterSpec_To_v1alpha1_ScalewayManagedClusterSpec(a.(*v1alpha2.ScalewayManagedClusterSpec), b.(*ScalewayManagedClusterSpec), scope)
 	}); err != nil {
@@ -384,14 +384,11 @@ func autoConvert_v1alpha2_AdditionalVolume_To_v1alpha1_AdditionalVolume(in *v1al
 	if err := v1.Convert_int64_To_Pointer_int64(&in.IOPS, &out.IOPS, s); err != nil {
 		return err
 	}
+	// WARNING: in.Snapshot requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeletionPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha2_AdditionalVolume_To_v1alpha1_AdditionalVolume is an autogenerated conversion function.
-func Convert_v1alpha2_AdditionalVolume_To_v1alpha1_AdditionalVolume(in *v1alpha2.AdditionalVolume, out *AdditionalVolume, s conversion.Scope) error {
-	return autoConvert_v1alpha2_AdditionalVolume_To_v1alpha1_AdditionalVolume(in, out, s)
-}
-
 func autoConvert_v1alpha1_PrivateNetworkSpec_To_v1alpha2_PrivateNetworkSpec(in *PrivateNetworkSpec, out *v1alpha2.PrivateNetworkSpec, s conversion.Scope) error {
 	// WARNING: in.PrivateNetworkParams requires manual conversion: does not exist in peer-type
 	if err := v1.Convert_bool_To_Pointer_bool(&in.Enabled, &out.Enabled, s); err != nil {
@@ -498,6 +495,7 @@ func autoConvert_v1alpha2_ScalewayClusterSpec_To_v1alpha1_ScalewayClusterSpec(in
 	out.ProjectID = string(in.ProjectID)
 	out.Region = string(in.Region)
 	out.ScalewaySecretName = in.ScalewaySecretName
+	// WARNING: in.IdentityRef requires manual conversion: does not exist in peer-type
 	out.FailureDomains = *(*[]string)(unsafe.Pointer(&in.FailureDomains))
 	// WARNING: in.Network requires manual conversion: inconvertible types (github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2.ScalewayClusterNetwork vs *github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha1.NetworkSpec)
 	if err := Convert_v1beta2_APIEndpoint_To_v1beta1_APIEndpoint(&in.ControlPlaneEndpoint, &out.ControlPlaneEndpoint, s); err != nil {
@@ -748,6 +746,7 @@ func autoConvert_v1alpha2_ScalewayMachineSpec_To_v1alpha1_ScalewayMachineSpec(in
 		return err
 	}
 	out.CommercialType = in.CommercialType
+	// WARNING: in.FallbackCommercialTypes requires manual conversion: does not exist in peer-type
 	if err := Convert_v1alpha2_Image_To_v1alpha1_ImageSpec(&in.Image, &out.Image, s); err != nil {
 		return err
 	}
@@ -764,8 +763,16 @@ func autoConvert_v1alpha2_ScalewayMachineSpec_To_v1alpha1_ScalewayMachineSpec(in
 		out.AdditionalVolumes = nil
 	}
 	// WARNING: in.PublicNetwork requires manual conversion: inconvertible types (github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2.PublicNetwork vs *github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha1.PublicNetworkSpec)
+	// WARNING: in.PrivateNetwork requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalPrivateNetworks requires manual conversion: does not exist in peer-type
 	// WARNING: in.PlacementGroup requires manual conversion: inconvertible types (github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2.IDOrName vs *github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha1.PlacementGroupSpec)
+	// WARNING: in.ManagedPlacementGroup requires manual conversion: does not exist in peer-type
 	// WARNING: in.SecurityGroup requires manual conversion: inconvertible types (github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2.IDOrName vs *github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha1.SecurityGroupSpec)
+	// WARNING: in.BootstrapData requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalTags requires manual conversion: does not exist in peer-type
+	// WARNING: in.UnhealthyServerPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeletionStrategy requires manual conversion: does not exist in peer-type
+	// WARNING: in.Protected requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -779,6 +786,16 @@ func autoConvert_v1alpha2_ScalewayMachineStatus_To_v1alpha1_ScalewayMachineStatu
 	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
 	// WARNING: in.Initialization requires manual conversion: does not exist in peer-type
 	out.Addresses = *(*[]v1beta1.MachineAddress)(unsafe.Pointer(&in.Addresses))
+	// WARNING: in.PublicIPs requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrivateNetworks requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalVolumes requires manual conversion: does not exist in peer-type
+	// WARNING: in.ServerID requires manual conversion: does not exist in peer-type
+	// WARNING: in.Zone requires manual conversion: does not exist in peer-type
+	// WARNING: in.State requires manual conversion: does not exist in peer-type
+	// WARNING: in.CommercialType requires manual conversion: does not exist in peer-type
+	// WARNING: in.Image requires manual conversion: does not exist in peer-type
+	// WARNING: in.RootVolume requires manual conversion: does not exist in peer-type
+	// WARNING: in.Deletion requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -800,14 +817,10 @@ func autoConvert_v1alpha2_ScalewayMachineTemplate_To_v1alpha1_ScalewayMachineTem
 	if err := Convert_v1alpha2_ScalewayMachineTemplateSpec_To_v1alpha1_ScalewayMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	// WARNING: in.Status requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha2_ScalewayMachineTemplate_To_v1alpha1_ScalewayMachineTemplate is an autogenerated conversion function.
-func Convert_v1alpha2_ScalewayMachineTemplate_To_v1alpha1_ScalewayMachineTemplate(in *v1alpha2.ScalewayMachineTemplate, out *ScalewayMachineTemplate, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ScalewayMachineTemplate_To_v1alpha1_ScalewayMachineTemplate(in, out, s)
-}
-
 func autoConvert_v1alpha1_ScalewayMachineTemplateList_To_v1alpha2_ScalewayMachineTemplateList(in *ScalewayMachineTemplateList, out *v1alpha2.ScalewayMachineTemplateList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -993,6 +1006,7 @@ func autoConvert_v1alpha2_ScalewayManagedClusterSpec_To_v1alpha1_ScalewayManaged
 	out.Region = string(in.Region)
 	out.ProjectID = string(in.ProjectID)
 	out.ScalewaySecretName = in.ScalewaySecretName
+	// WARNING: in.IdentityRef requires manual conversion: does not exist in peer-type
 	// WARNING: in.Network requires manual conversion: inconvertible types (github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2.ScalewayManagedClusterNetwork vs *github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha1.ManagedNetworkSpec)
 	if err := Convert_v1beta2_APIEndpoint_To_v1beta1_APIEndpoint(&in.ControlPlaneEndpoint, &out.ControlPlaneEndpoint, s); err != nil {
 		return err
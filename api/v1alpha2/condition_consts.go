@@ -33,6 +33,9 @@ const (
 	// PrivateNetworkAttachmentFailedReason surfaces when the attachment of resources to the private network failed.
 	PrivateNetworkAttachmentFailedReason = "PrivateNetworkAttachmentFailed"
 
+	// InvalidBootstrapDataTemplateReason surfaces when the bootstrap data template cannot be rendered.
+	InvalidBootstrapDataTemplateReason = "InvalidBootstrapDataTemplate"
+
 	// InternalErrorReason surfaces unexpected errors reporting by controllers.
 	// In most cases, it will be required to look at controllers logs to properly triage those issues.
 	InternalErrorReason = "InternalError"
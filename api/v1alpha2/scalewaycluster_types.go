@@ -37,6 +37,10 @@ const (
 	// ScalewayClusterLoadBalancersReadyReason surfaces when the load balancers are provisioned and ready.
 	ScalewayClusterLoadBalancersReadyReason = ReadyReason
 
+	// ScalewayClusterLoadBalancersExternallyManagedReason surfaces when the control plane endpoint
+	// is served by a load balancer that is not managed by the provider.
+	ScalewayClusterLoadBalancersExternallyManagedReason = "ExternallyManaged"
+
 	// ScalewayClusterLoadBalancersNotReadyReason surfaces when one or multiple load balancers are not ready.
 	ScalewayClusterLoadBalancersNotReadyReason = NotReadyReason
 
@@ -59,13 +63,34 @@ const (
 	ScalewayClusterLoadBalancerACLReconciliationFailedReason = "LoadBalancerACLReconciliationFailed"
 )
 
+// ScalewayCluster's SecurityGroupsReady condition and corresponding reasons.
+const (
+	// ScalewayClusterSecurityGroupsReadyCondition indicates whether the managed Security Groups are ready.
+	ScalewayClusterSecurityGroupsReadyCondition = "SecurityGroupsReady"
+
+	// ScalewayClusterNoSecurityGroupsReason surfaces when managed Security Groups are not enabled.
+	// In this case, the condition is set to True as there is nothing to configure.
+	ScalewayClusterNoSecurityGroupsReason = "NoSecurityGroups"
+
+	// ScalewayClusterSecurityGroupsReadyReason surfaces when the managed Security Groups are ready.
+	ScalewayClusterSecurityGroupsReadyReason = ReadyReason
+
+	// ScalewayClusterSecurityGroupsReconciliationFailedReason surfaces when the managed Security Groups reconciliation failed.
+	ScalewayClusterSecurityGroupsReconciliationFailedReason = ReconciliationFailedReason
+)
+
 // ScalewayClusterSpec defines the desired state of ScalewayCluster.
+// +kubebuilder:validation:XValidation:rule="has(self.scalewaySecretName) != has(self.identityRef)",message="exactly one of scalewaySecretName or identityRef must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.identityRef) == has(oldSelf.identityRef)",message="identityRef cannot be added or removed"
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.controlPlaneEndpoint) || has(self.controlPlaneEndpoint)", message="controlPlaneEndpoint is required once set"
 // +kubebuilder:validation:XValidation:rule="(has(self.network) && has(self.network.controlPlaneDNS)) == (has(oldSelf.network) && has(oldSelf.network.controlPlaneDNS))",message="controlPlaneDNS cannot be added or removed"
 // +kubebuilder:validation:XValidation:rule="(has(self.network) && has(self.network.privateNetwork)) == (has(oldSelf.network) && has(oldSelf.network.privateNetwork))",message="privateNetwork cannot be added or removed"
+// +kubebuilder:validation:XValidation:rule="(has(self.network) && has(self.network.securityGroups)) == (has(oldSelf.network) && has(oldSelf.network.securityGroups))",message="securityGroups cannot be added or removed"
 // +kubebuilder:validation:XValidation:rule="(has(self.network) && has(self.network.controlPlaneLoadBalancer) && has(self.network.controlPlaneLoadBalancer.private)) == (has(oldSelf.network) && has(oldSelf.network.controlPlaneLoadBalancer) && has(oldSelf.network.controlPlaneLoadBalancer.private))",message="private cannot be added or removed"
 // +kubebuilder:validation:XValidation:rule="(has(self.network) && has(self.network.controlPlaneLoadBalancer) && has(self.network.controlPlaneLoadBalancer.ip)) == (has(oldSelf.network) && has(oldSelf.network.controlPlaneLoadBalancer) && has(oldSelf.network.controlPlaneLoadBalancer.ip))",message="ip cannot be added or removed"
 // +kubebuilder:validation:XValidation:rule="(has(self.network) && has(self.network.controlPlaneLoadBalancer) && has(self.network.controlPlaneLoadBalancer.zone)) == (has(oldSelf.network) && has(oldSelf.network.controlPlaneLoadBalancer) && has(oldSelf.network.controlPlaneLoadBalancer.zone))",message="zone cannot be added or removed"
+// +kubebuilder:validation:XValidation:rule="(has(self.network) && has(self.network.controlPlaneLoadBalancer) && has(self.network.controlPlaneLoadBalancer.externallyManaged)) == (has(oldSelf.network) && has(oldSelf.network.controlPlaneLoadBalancer) && has(oldSelf.network.controlPlaneLoadBalancer.externallyManaged))",message="externallyManaged cannot be added or removed"
+// +kubebuilder:validation:XValidation:rule="!has(self.network) || !has(self.network.controlPlaneLoadBalancer) || !has(self.network.controlPlaneLoadBalancer.externallyManaged) || !self.network.controlPlaneLoadBalancer.externallyManaged || has(self.controlPlaneEndpoint) && has(self.controlPlaneEndpoint.host) && has(self.controlPlaneEndpoint.port)",message="controlPlaneEndpoint is required when the control plane load balancer is externally managed"
 // +kubebuilder:validation:XValidation:rule="(has(self.network) && has(self.network.controlPlaneLoadBalancer) && has(self.network.controlPlaneLoadBalancer.privateIP)) == (has(oldSelf.network) && has(oldSelf.network.controlPlaneLoadBalancer) && has(oldSelf.network.controlPlaneLoadBalancer.privateIP))",message="privateIP cannot be added or removed"
 type ScalewayClusterSpec struct {
 	// projectID is the ID of a Scaleway project where the cluster will be created.
@@ -81,11 +106,18 @@ type ScalewayClusterSpec struct {
 	// scalewaySecretName is the name of the secret that contains the Scaleway client parameters.
 	// The following keys are required: SCW_ACCESS_KEY, SCW_SECRET_KEY.
 	// The following key is optional: SCW_API_URL.
-	// +required
+	// Exactly one of scalewaySecretName or identityRef must be set.
+	// +optional
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=253
 	ScalewaySecretName string `json:"scalewaySecretName,omitempty"`
 
+	// identityRef references a ScalewayClusterIdentity that contains the Scaleway
+	// client parameters. The namespace of the ScalewayCluster must be allowed by the identity.
+	// Exactly one of scalewaySecretName or identityRef must be set.
+	// +optional
+	IdentityRef ScalewayClusterIdentityReference `json:"identityRef,omitempty,omitzero"`
+
 	// failureDomains is a list of failure domains where the control-plane nodes will be created.
 	// Failure domains correspond to Scaleway zones inside the cluster region (e.g. fr-par-1).
 	// +optional
@@ -110,6 +142,8 @@ type ScalewayClusterSpec struct {
 // +kubebuilder:validation:XValidation:rule="!has(self.publicGateways) || has(self.privateNetwork) && self.privateNetwork.enabled",message="privateNetwork is required when publicGateways is set"
 // +kubebuilder:validation:XValidation:rule="!has(self.controlPlaneLoadBalancer) || !has(self.controlPlaneLoadBalancer.private) || !self.controlPlaneLoadBalancer.private || has(self.privateNetwork) && self.privateNetwork.enabled",message="privateNetwork is required when private LoadBalancer is enabled"
 // +kubebuilder:validation:XValidation:rule="!has(self.controlPlaneDNS) || has(self.controlPlaneDNS) && has(self.controlPlaneDNS.domain) || has(self.controlPlaneDNS) && !has(self.controlPlaneDNS.domain) && has(self.controlPlaneLoadBalancer) && has(self.controlPlaneLoadBalancer.private) && self.controlPlaneLoadBalancer.private",message=".controlPlaneDNS.domain must be set unless control plane load balancer is private"
+// +kubebuilder:validation:XValidation:rule="!has(self.securityGroups) || !self.securityGroups.enabled || has(self.privateNetwork) && self.privateNetwork.enabled",message="privateNetwork is required when securityGroups are enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.controlPlaneLoadBalancer) || !has(self.controlPlaneLoadBalancer.externallyManaged) || !self.controlPlaneLoadBalancer.externallyManaged || !has(self.controlPlaneExtraLoadBalancers) && !has(self.controlPlaneDNS)",message="controlPlaneExtraLoadBalancers and controlPlaneDNS cannot be set when the control plane load balancer is externally managed"
 type ScalewayClusterNetwork struct {
 	// controlPlaneLoadBalancer defines settings for the load balancer of the control plane.
 	// +optional
@@ -142,6 +176,14 @@ type ScalewayClusterNetwork struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=6
 	PublicGateways []PublicGateway `json:"publicGateways,omitempty"`
+
+	// securityGroups allows to manage the Security Groups of the Instance
+	// servers of the cluster. When enabled, a control-plane and a worker Security
+	// Group are created in every zone of the cluster region and attached to
+	// machines based on their role. A Security Group set on a ScalewayMachine
+	// takes precedence over the managed Security Groups.
+	// +optional
+	SecurityGroups SecurityGroupsSpec `json:"securityGroups,omitempty,omitzero"`
 }
 
 // LoadBalancer defines load balancer parameters.
@@ -194,6 +236,15 @@ type ControlPlaneLoadBalancer struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	Private *bool `json:"private,omitempty"`
 
+	// externallyManaged disables the creation of Scaleway load balancers for the
+	// control plane when it's set to true. The control plane endpoint is then
+	// supplied by the user in spec.controlPlaneEndpoint and served by a load
+	// balancer that is not managed by the provider (e.g. an L4 appliance or kube-vip).
+	// Other settings of the control plane load balancer are ignored.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	ExternallyManaged *bool `json:"externallyManaged,omitempty"`
+
 	// additionalPorts to expose on the control plane load balancer.
 	// +optional
 	// +listType=map
@@ -201,6 +252,16 @@ type ControlPlaneLoadBalancer struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=10
 	AdditionalPorts []LoadBalancerPort `json:"additionalPorts,omitempty"`
+
+	// healthCheck configures the health check of the kube-apiserver backend of the
+	// load balancers. By default, a TCP health check is used.
+	// +optional
+	HealthCheck LoadBalancerHealthCheck `json:"healthCheck,omitempty,omitzero"`
+
+	// backend configures the kube-apiserver backend of the load balancers. The PROXY
+	// protocol is not supported by the kube-apiserver, proxyProtocol can only be None.
+	// +optional
+	Backend LoadBalancerBackend `json:"backend,omitempty,omitzero"`
 }
 
 // ControlPlaneDNS defines the DNS configuration of the control plane endpoint.
@@ -243,6 +304,68 @@ type PrivateNetworkSpec struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
+// SecurityGroupsSpec defines the Security Groups managed for the cluster.
+// Inbound traffic is dropped by default, the following traffic is allowed:
+//   - control-plane: kube-apiserver (and additional ports of the control plane
+//     load balancer) from the load balancers, etcd and kubelet from the Private Network.
+//   - worker: kubelet from the Private Network, NodePort range from nodePortAllowedRanges.
+type SecurityGroupsSpec struct {
+	// enabled allows to create Security Groups for the machines of the cluster when it's set to true.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// nodePortAllowedRanges is a list of IP ranges that are allowed to access
+	// the NodePort range (30000-32767) of worker nodes. When unset, all IP
+	// ranges are allowed.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=30
+	NodePortAllowedRanges []CIDR `json:"nodePortAllowedRanges,omitempty"`
+
+	// controlPlaneRules is a list of additional inbound rules for the
+	// control-plane Security Group.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=50
+	ControlPlaneRules []SecurityGroupRule `json:"controlPlaneRules,omitempty"`
+
+	// workerRules is a list of additional inbound rules for the worker Security Group.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=50
+	WorkerRules []SecurityGroupRule `json:"workerRules,omitempty"`
+}
+
+// SecurityGroupRule defines an inbound rule that allows traffic.
+// +kubebuilder:validation:XValidation:rule="!has(self.portTo) || has(self.portFrom) && self.portFrom <= self.portTo",message="portTo requires portFrom and must be greater than or equal to portFrom"
+// +kubebuilder:validation:XValidation:rule="!has(self.portFrom) || self.protocol in ['TCP', 'UDP']",message="ports can only be set with TCP or UDP protocol"
+type SecurityGroupRule struct {
+	// protocol of the traffic to allow.
+	// +required
+	// +kubebuilder:validation:Enum=TCP;UDP;ICMP;ANY
+	Protocol string `json:"protocol,omitempty"`
+
+	// portFrom is the first port of the range to allow. When unset, all ports are allowed.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	PortFrom int32 `json:"portFrom,omitempty"`
+
+	// portTo is the last port of the range to allow. When unset, only portFrom is allowed.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	PortTo int32 `json:"portTo,omitempty"`
+
+	// ipRange is the source IP range of the traffic to allow.
+	// +required
+	IPRange CIDR `json:"ipRange,omitempty"`
+}
+
 // LoadBalancerPort defines a port to expose on the control plane load balancer.
 type LoadBalancerPort struct {
 	// port is the port number that will be exposed on the load balancer.
@@ -256,6 +379,162 @@ type LoadBalancerPort struct {
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=65535
 	TargetPort int32 `json:"targetPort,omitempty"`
+
+	// healthCheck configures the health check of the backend of this port. By default,
+	// a TCP health check is used.
+	// +optional
+	HealthCheck LoadBalancerHealthCheck `json:"healthCheck,omitempty,omitzero"`
+
+	// backend configures the backend of this port.
+	// +optional
+	Backend LoadBalancerBackend `json:"backend,omitempty,omitzero"`
+}
+
+// LoadBalancerBackend defines the settings of a load balancer backend.
+// +kubebuilder:validation:MinProperties=1
+type LoadBalancerBackend struct {
+	// proxyProtocol is the version of the PROXY protocol used to inform the control
+	// plane nodes of the IP of the clients. The PROXY protocol must be supported by
+	// the software listening on the target port. Defaults to None.
+	// +optional
+	ProxyProtocol LoadBalancerProxyProtocol `json:"proxyProtocol,omitempty"`
+
+	// forwardAlgorithm is the algorithm used to select the control plane node that
+	// receives a new connection. Defaults to RoundRobin.
+	// +optional
+	ForwardAlgorithm LoadBalancerForwardAlgorithm `json:"forwardAlgorithm,omitempty"`
+
+	// onMarkedDownAction is the action taken on the connections to a control plane
+	// node when it is marked as down by the health check. Defaults to None.
+	// +optional
+	OnMarkedDownAction LoadBalancerOnMarkedDownAction `json:"onMarkedDownAction,omitempty"`
+
+	// serverTimeoutSeconds is the maximum time a control plane node has to process
+	// a request. When unset, the default of the load balancer is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+	ServerTimeoutSeconds int32 `json:"serverTimeoutSeconds,omitempty"`
+
+	// connectTimeoutSeconds is the maximum time to establish a connection to a
+	// control plane node. When unset, the default of the load balancer is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+	ConnectTimeoutSeconds int32 `json:"connectTimeoutSeconds,omitempty"`
+
+	// tunnelTimeoutSeconds is the maximum inactivity time of a tunnel, such as a
+	// WebSocket or a long-running watch. When unset, the default of the load balancer is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+	TunnelTimeoutSeconds int32 `json:"tunnelTimeoutSeconds,omitempty"`
+}
+
+// LoadBalancerProxyProtocol is the version of the PROXY protocol of a load balancer backend.
+// +kubebuilder:validation:Enum=None;V1;V2
+type LoadBalancerProxyProtocol string
+
+const (
+	// LoadBalancerProxyProtocolNone disables the PROXY protocol.
+	LoadBalancerProxyProtocolNone LoadBalancerProxyProtocol = "None"
+	// LoadBalancerProxyProtocolV1 enables the version 1 of the PROXY protocol.
+	LoadBalancerProxyProtocolV1 LoadBalancerProxyProtocol = "V1"
+	// LoadBalancerProxyProtocolV2 enables the version 2 of the PROXY protocol.
+	LoadBalancerProxyProtocolV2 LoadBalancerProxyProtocol = "V2"
+)
+
+// LoadBalancerForwardAlgorithm is the load balancing algorithm of a load balancer backend.
+// +kubebuilder:validation:Enum=RoundRobin;LeastConn;First
+type LoadBalancerForwardAlgorithm string
+
+const (
+	// LoadBalancerForwardAlgorithmRoundRobin selects the control plane nodes in turn.
+	LoadBalancerForwardAlgorithmRoundRobin LoadBalancerForwardAlgorithm = "RoundRobin"
+	// LoadBalancerForwardAlgorithmLeastConn selects the control plane node with the
+	// least number of connections.
+	LoadBalancerForwardAlgorithmLeastConn LoadBalancerForwardAlgorithm = "LeastConn"
+	// LoadBalancerForwardAlgorithmFirst selects the first control plane node that
+	// has available connection slots.
+	LoadBalancerForwardAlgorithmFirst LoadBalancerForwardAlgorithm = "First"
+)
+
+// LoadBalancerOnMarkedDownAction is the action taken when a server of a load balancer
+// backend is marked as down.
+// +kubebuilder:validation:Enum=None;ShutdownSessions
+type LoadBalancerOnMarkedDownAction string
+
+const (
+	// LoadBalancerOnMarkedDownActionNone keeps the existing connections.
+	LoadBalancerOnMarkedDownActionNone LoadBalancerOnMarkedDownAction = "None"
+	// LoadBalancerOnMarkedDownActionShutdownSessions closes the existing connections.
+	LoadBalancerOnMarkedDownActionShutdownSessions LoadBalancerOnMarkedDownAction = "ShutdownSessions"
+)
+
+// LoadBalancerHealthCheckType is the type of the health check of a load balancer backend.
+// +kubebuilder:validation:Enum=TCP;HTTPS
+type LoadBalancerHealthCheckType string
+
+const (
+	// LoadBalancerHealthCheckTypeTCP checks that a TCP connection can be opened
+	// on the target port.
+	LoadBalancerHealthCheckTypeTCP LoadBalancerHealthCheckType = "TCP"
+	// LoadBalancerHealthCheckTypeHTTPS sends an HTTPS request to the target port
+	// and checks the response code.
+	LoadBalancerHealthCheckTypeHTTPS LoadBalancerHealthCheckType = "HTTPS"
+)
+
+// LoadBalancerHealthCheck defines the health check of a load balancer backend.
+// The health check is performed on the target port of the backend.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:XValidation:rule="!has(self.https) || (has(self.type) && self.type == 'HTTPS')",message="https can only be set when type is HTTPS"
+type LoadBalancerHealthCheck struct {
+	// type of the health check. Defaults to TCP.
+	// +optional
+	Type LoadBalancerHealthCheckType `json:"type,omitempty"`
+
+	// https configures the HTTPS health check.
+	// +optional
+	HTTPS HTTPSHealthCheck `json:"https,omitempty,omitzero"`
+
+	// intervalSeconds is the time to wait between two consecutive health checks.
+	// When unset, the default of the load balancer is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+
+	// timeoutSeconds is the maximum time a backend server has to reply to the
+	// health check. When unset, the default of the load balancer is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// maxRetries is the number of consecutive unsuccessful health checks after which
+	// a backend server is considered dead. Defaults to 5.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	MaxRetries int32 `json:"maxRetries,omitempty"`
+}
+
+// HTTPSHealthCheck configures an HTTPS health check.
+// +kubebuilder:validation:MinProperties=1
+type HTTPSHealthCheck struct {
+	// path is the HTTP path of the health check request. Defaults to "/".
+	// +optional
+	// +kubebuilder:validation:Pattern=^/
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Path string `json:"path,omitempty"`
+
+	// code is the HTTP response code expected for the health check to be
+	// considered successful. Defaults to 200.
+	// +optional
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	Code int32 `json:"code,omitempty"`
 }
 
 // Name returns a unique name for the LoadBalancerPort, which is used as an identifier in the load balancer configuration.
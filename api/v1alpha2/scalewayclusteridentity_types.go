@@ -0,0 +1,97 @@
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScalewayClusterIdentityFinalizer is the finalizer that prevents deletion of a
+// ScalewayClusterIdentity while it is used by clusters.
+const ScalewayClusterIdentityFinalizer = "scalewayclusteridentity.infrastructure.cluster.x-k8s.io/sci-protection"
+
+// ScalewayClusterIdentitySpec defines the desired state of ScalewayClusterIdentity.
+type ScalewayClusterIdentitySpec struct {
+	// secretRef references the secret that contains the Scaleway client parameters.
+	// The following keys are required: SCW_ACCESS_KEY, SCW_SECRET_KEY.
+	// The following key is optional: SCW_API_URL.
+	// +required
+	SecretRef ScalewayClusterIdentitySecretReference `json:"secretRef,omitzero"`
+
+	// allowedNamespaces is used to identify the namespaces from which clusters
+	// are allowed to use the identity. Namespaces can be selected either with a list
+	// of namespaces or with a label selector. An empty allowedNamespaces object
+	// allows all namespaces. If not set, no namespace is allowed.
+	// +optional
+	AllowedNamespaces *AllowedNamespaces `json:"allowedNamespaces,omitempty"`
+}
+
+// ScalewayClusterIdentitySecretReference references a secret in a specific namespace.
+type ScalewayClusterIdentitySecretReference struct {
+	// name of the secret.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+
+	// namespace of the secret.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// AllowedNamespaces defines the namespaces from which clusters are allowed to
+// use a ScalewayClusterIdentity.
+type AllowedNamespaces struct {
+	// list is a list of namespaces that are allowed to use the identity.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=63
+	List []string `json:"list,omitempty"`
+
+	// selector is a label selector of the namespaces that are allowed to use the identity.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// ScalewayClusterIdentityReference references a ScalewayClusterIdentity.
+type ScalewayClusterIdentityReference struct {
+	// name of the ScalewayClusterIdentity.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=scalewayclusteridentities,scope=Cluster,categories=cluster-api,shortName=sci
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretRef.name",description="Name of the secret"
+// +kubebuilder:printcolumn:name="SecretNamespace",type="string",JSONPath=".spec.secretRef.namespace",description="Namespace of the secret"
+
+// ScalewayClusterIdentity is the Schema for the scalewayclusteridentities API
+type ScalewayClusterIdentity struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of ScalewayClusterIdentity
+	// +required
+	Spec ScalewayClusterIdentitySpec `json:"spec,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalewayClusterIdentityList contains a list of ScalewayClusterIdentity
+type ScalewayClusterIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ScalewayClusterIdentity `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScalewayClusterIdentity{}, &ScalewayClusterIdentityList{})
+}
@@ -0,0 +1,153 @@
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// ScalewayElasticMetalMachineFinalizer is the finalizer that prevents deletion of a ScalewayElasticMetalMachine.
+const ScalewayElasticMetalMachineFinalizer = "scalewayelasticmetalmachine.infrastructure.cluster.x-k8s.io/semm-protection"
+
+// ScalewayElasticMetalMachineReadyCondition reports if the ScalewayElasticMetalMachine is ready.
+const ScalewayElasticMetalMachineReadyCondition = clusterv1.ReadyCondition
+
+// ScalewayElasticMetalMachine's ServerReady condition and corresponding reasons.
+const (
+	// ScalewayElasticMetalMachineServerReadyCondition indicates whether the Elastic Metal server is ready.
+	ScalewayElasticMetalMachineServerReadyCondition = "ServerReady"
+
+	// ScalewayElasticMetalMachineServerReadyReason surfaces when the Elastic Metal server is ready.
+	ScalewayElasticMetalMachineServerReadyReason = ReadyReason
+
+	// ScalewayElasticMetalMachineServerReconciliationFailedReason surfaces when there is a failure in reconciling the Elastic Metal server.
+	ScalewayElasticMetalMachineServerReconciliationFailedReason = ReconciliationFailedReason
+
+	// ScalewayElasticMetalMachineServerInvalidBootstrapDataTemplateReason surfaces when the bootstrap data template cannot be rendered.
+	ScalewayElasticMetalMachineServerInvalidBootstrapDataTemplateReason = InvalidBootstrapDataTemplateReason
+)
+
+// ScalewayElasticMetalMachineSpec defines the desired state of ScalewayElasticMetalMachine.
+type ScalewayElasticMetalMachineSpec struct {
+	// providerID must match the provider ID as seen on the node object corresponding to this machine.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	ProviderID string `json:"providerID,omitempty"`
+
+	// offer is the name of the Elastic Metal offer (e.g. EM-A116X-SSD).
+	// The server is billed hourly.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=50
+	Offer string `json:"offer,omitempty"`
+
+	// os defines the operating system to install on the server.
+	// The operating system must support cloud-init.
+	// +required
+	OS ElasticMetalOS `json:"os,omitempty,omitzero"`
+
+	// sshKeyIDs is a list of IDs of Scaleway SSH keys that are authorized on the server.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=50
+	SSHKeyIDs []UUID `json:"sshKeyIDs,omitempty"`
+}
+
+// ElasticMetalOS contains an ID or a Name and Version of an Elastic Metal operating system.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:XValidation:rule="has(self.id) != has(self.name)",message="exactly one of id or name must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.version) || has(self.name)",message="version can only be set with name"
+type ElasticMetalOS struct {
+	// id of the operating system.
+	// +optional
+	ID UUID `json:"id,omitempty"`
+
+	// name of the operating system (e.g. Ubuntu).
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=100
+	Name string `json:"name,omitempty"`
+
+	// version of the operating system (e.g. "Ubuntu 24.04 LTS (Noble Numbat)").
+	// Required if multiple versions of the operating system are available.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=100
+	Version string `json:"version,omitempty"`
+}
+
+// ScalewayElasticMetalMachineStatus defines the observed state of ScalewayElasticMetalMachine.
+// +kubebuilder:validation:MinProperties=1
+type ScalewayElasticMetalMachineStatus struct {
+	// conditions represent the current state of the ScalewayElasticMetalMachine resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// initialization provides observations of the ScalewayElasticMetalMachine initialization process.
+	// NOTE: Fields in this struct are part of the Cluster API contract and are used to orchestrate initial Machine provisioning.
+	// +optional
+	Initialization ScalewayMachineInitializationStatus `json:"initialization,omitempty,omitzero"`
+
+	// addresses contains the associated addresses for the machine.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=32
+	Addresses []clusterv1.MachineAddress `json:"addresses,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=scalewayelasticmetalmachines,scope=Namespaced,categories=cluster-api,shortName=semm
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Offer",type="string",JSONPath=".spec.offer",description="Elastic Metal offer"
+// +kubebuilder:printcolumn:name="ProviderID",type="string",JSONPath=".spec.providerID",description="Node provider ID"
+// +kubebuilder:printcolumn:name="Provisioned",type="boolean",JSONPath=".status.initialization.provisioned",description="Provisioned is true when the machine infrastructure is fully provisioned"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="ScalewayElasticMetalMachine pass all readiness checks"
+
+// ScalewayElasticMetalMachine is the Schema for the scalewayelasticmetalmachines API
+// +kubebuilder:validation:XValidation:rule="self.metadata.name.size() <= 63",message="name must be between 1 and 63 characters"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')",message="name must be a valid DNS label"
+type ScalewayElasticMetalMachine struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of ScalewayElasticMetalMachine
+	// +required
+	Spec ScalewayElasticMetalMachineSpec `json:"spec,omitzero"`
+
+	// status defines the observed state of ScalewayElasticMetalMachine
+	// +optional
+	Status ScalewayElasticMetalMachineStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalewayElasticMetalMachineList contains a list of ScalewayElasticMetalMachine
+type ScalewayElasticMetalMachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ScalewayElasticMetalMachine `json:"items"`
+}
+
+// GetConditions returns the list of conditions for an ScalewayElasticMetalMachine API object.
+func (s *ScalewayElasticMetalMachine) GetConditions() []metav1.Condition {
+	return s.Status.Conditions
+}
+
+// SetConditions will set the given conditions on an ScalewayElasticMetalMachine object.
+func (s *ScalewayElasticMetalMachine) SetConditions(conditions []metav1.Condition) {
+	s.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&ScalewayElasticMetalMachine{}, &ScalewayElasticMetalMachineList{})
+}
@@ -0,0 +1,54 @@
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// ScalewayElasticMetalMachineTemplateSpec defines the desired state of ScalewayElasticMetalMachineTemplate
+type ScalewayElasticMetalMachineTemplateSpec struct {
+	// template is a ScalewayElasticMetalMachine template resource.
+	// +required
+	Template ScalewayElasticMetalMachineTemplateResource `json:"template,omitempty,omitzero"`
+}
+
+type ScalewayElasticMetalMachineTemplateResource struct {
+	// metadata is a Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	ObjectMeta clusterv1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the desired state of ScalewayElasticMetalMachine
+	// +required
+	Spec ScalewayElasticMetalMachineSpec `json:"spec,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=scalewayelasticmetalmachinetemplates,scope=Namespaced,categories=cluster-api,shortName=semmt
+// +kubebuilder:storageversion
+
+// ScalewayElasticMetalMachineTemplate is the Schema for the scalewayelasticmetalmachinetemplates API
+type ScalewayElasticMetalMachineTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of ScalewayElasticMetalMachineTemplate
+	// +required
+	Spec ScalewayElasticMetalMachineTemplateSpec `json:"spec,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalewayElasticMetalMachineTemplateList contains a list of ScalewayElasticMetalMachineTemplate
+type ScalewayElasticMetalMachineTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ScalewayElasticMetalMachineTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScalewayElasticMetalMachineTemplate{}, &ScalewayElasticMetalMachineTemplateList{})
+}
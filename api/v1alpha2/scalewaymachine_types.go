@@ -8,6 +8,10 @@ import (
 // ScalewayMachineFinalizer is the finalizer that prevents deletion of a ScalewayMachine.
 const ScalewayMachineFinalizer = "scalewaymachine.infrastructure.cluster.x-k8s.io/sm-protection"
 
+// ScalewayMachineFileSystemResizedAnnotation is set on a ScalewayMachine to acknowledge
+// that the filesystems of its resized volumes were grown on the node.
+const ScalewayMachineFileSystemResizedAnnotation = "scalewaymachine.infrastructure.cluster.x-k8s.io/filesystem-resized"
+
 // ScalewayMachineReadyCondition reports if the ScalewayMachine is ready.
 const ScalewayMachineReadyCondition = clusterv1.ReadyCondition
 
@@ -21,9 +25,88 @@ const (
 
 	// ScalewayMachineInstanceReconciliationFailedReason surfaces when there is a failure in reconciling the Scaleway instance.
 	ScalewayMachineInstanceReconciliationFailedReason = ReconciliationFailedReason
+
+	// ScalewayMachineInstanceInvalidBootstrapDataTemplateReason surfaces when the bootstrap data template cannot be rendered.
+	ScalewayMachineInstanceInvalidBootstrapDataTemplateReason = InvalidBootstrapDataTemplateReason
+
+	// ScalewayMachineInstanceBootstrapDataTooLargeReason surfaces when the bootstrap data does not fit in the user data of the instance.
+	ScalewayMachineInstanceBootstrapDataTooLargeReason = "BootstrapDataTooLarge"
+
+	// ScalewayMachineInstanceOutOfStockReason surfaces when the commercial type and all
+	// fallback commercial types are out of stock in the zone of the instance.
+	ScalewayMachineInstanceOutOfStockReason = "OutOfStock"
+)
+
+// ScalewayMachine's ServerHealthy condition and corresponding reasons.
+const (
+	// ScalewayMachineServerHealthyCondition indicates whether the Scaleway instance is still
+	// running once the node has joined the cluster.
+	ScalewayMachineServerHealthyCondition = "ServerHealthy"
+
+	// ScalewayMachineServerRunningReason surfaces when the Scaleway instance is running.
+	ScalewayMachineServerRunningReason = "ServerRunning"
+
+	// ScalewayMachineServerStoppedReason surfaces when the Scaleway instance is stopped or
+	// stopped in place.
+	ScalewayMachineServerStoppedReason = "ServerStopped"
+
+	// ScalewayMachineServerChangingStateReason surfaces when the Scaleway instance is starting
+	// or stopping. The status of the condition is Unknown until the instance reaches a stable state.
+	ScalewayMachineServerChangingStateReason = "ServerChangingState"
+
+	// ScalewayMachineServerLockedReason surfaces when the Scaleway instance is locked by Scaleway.
+	ScalewayMachineServerLockedReason = "ServerLocked"
+
+	// ScalewayMachineServerMissingReason surfaces when the Scaleway instance can no longer be found.
+	ScalewayMachineServerMissingReason = "ServerMissing"
+)
+
+// ScalewayMachine's FileSystemResizePending condition and corresponding reasons.
+const (
+	// ScalewayMachineFileSystemResizePendingCondition surfaces when block volumes of the Scaleway
+	// instance were grown and their filesystems must be grown on the node.
+	ScalewayMachineFileSystemResizePendingCondition = "FileSystemResizePending"
+
+	// ScalewayMachineVolumesResizedReason surfaces when block volumes of the Scaleway instance were grown.
+	ScalewayMachineVolumesResizedReason = "VolumesResized"
+)
+
+// ScalewayMachine's Deleting condition and corresponding reasons.
+const (
+	// ScalewayMachineDeletingCondition surfaces details about the deletion of the Scaleway instance.
+	ScalewayMachineDeletingCondition = clusterv1.DeletingCondition
+
+	// ScalewayMachineDeletingShuttingDownReason surfaces when the Scaleway instance is being
+	// stopped in place before it is powered off.
+	ScalewayMachineDeletingShuttingDownReason = "ShuttingDown"
+
+	// ScalewayMachineDeletingForcingPoweroffReason surfaces when the Scaleway instance is
+	// powered off because it was not stopped in place within the graceful shutdown timeout.
+	ScalewayMachineDeletingForcingPoweroffReason = "ForcingPoweroff"
+
+	// ScalewayMachineDeletingPoweringOffReason surfaces when the Scaleway instance is being powered off.
+	ScalewayMachineDeletingPoweringOffReason = "PoweringOff"
+
+	// ScalewayMachineDeletingSnapshottingRootVolumeReason surfaces when a snapshot of the
+	// root volume of the Scaleway instance is being created.
+	ScalewayMachineDeletingSnapshottingRootVolumeReason = "SnapshottingRootVolume"
+
+	// ScalewayMachineDeletingDeletingServerReason surfaces when the Scaleway instance and
+	// its volumes are being deleted.
+	ScalewayMachineDeletingDeletingServerReason = "DeletingServer"
+
+	// ScalewayMachineDeletingArchivedReason surfaces when the Scaleway instance is stopped
+	// and kept instead of being deleted.
+	ScalewayMachineDeletingArchivedReason = "Archived"
+
+	// ScalewayMachineDeletingServerProtectedReason surfaces when the Scaleway instance is
+	// protected and is kept until the Machine that owns the ScalewayMachine is deleted.
+	ScalewayMachineDeletingServerProtectedReason = "ServerProtected"
 )
 
 // ScalewayMachineSpec defines the desired state of ScalewayMachine.
+// +kubebuilder:validation:XValidation:rule="!has(self.placementGroup) || !has(self.managedPlacementGroup)",message="placementGroup and managedPlacementGroup are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.deletionStrategy) || !has(self.deletionStrategy.snapshotRootVolume) || !self.deletionStrategy.snapshotRootVolume || !has(self.rootVolume) || !has(self.rootVolume.type) || self.rootVolume.type == 'block'",message="snapshotRootVolume can only be set for block root volumes"
 type ScalewayMachineSpec struct {
 	// providerID must match the provider ID as seen on the node object corresponding to this machine.
 	// +optional
@@ -37,6 +120,18 @@ type ScalewayMachineSpec struct {
 	// +kubebuilder:validation:MaxLength=20
 	CommercialType string `json:"commercialType,omitempty"`
 
+	// fallbackCommercialTypes is an ordered list of commercial types to use when
+	// the commercial type is out of stock in the zone of the instance. If the machine
+	// has no failure domain, the other zones of the region are also tried. The availability
+	// of commercial types is only checked before the instance is created.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=5
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=20
+	FallbackCommercialTypes []string `json:"fallbackCommercialTypes,omitempty"`
+
 	// image defines an image ID, Name or Label to use to create the instance.
 	// +required
 	Image Image `json:"image,omitempty,omitzero"`
@@ -46,7 +141,8 @@ type ScalewayMachineSpec struct {
 	RootVolume RootVolume `json:"rootVolume,omitempty,omitzero"`
 
 	// additionalVolumes to be created and attached to the instance before it's first started.
-	// These volumes are deleted during instance deletion.
+	// These volumes are deleted during instance deletion. The size and IOPS of block
+	// volumes can be increased on an existing instance.
 	// +optional
 	// +listType=atomic
 	// +kubebuilder:validation:MinItems=1
@@ -57,15 +153,145 @@ type ScalewayMachineSpec struct {
 	// +optional
 	PublicNetwork PublicNetwork `json:"publicNetwork,omitempty,omitzero"`
 
+	// privateNetwork configures the private IPv4 of the instance in the Private Network
+	// of the cluster. By default, the private IPv4 is assigned by DHCP.
+	// +optional
+	PrivateNetwork MachinePrivateNetwork `json:"privateNetwork,omitempty,omitzero"`
+
+	// additionalPrivateNetworks are Private Networks the instance is attached to, in
+	// addition to the Private Network of the cluster. A private NIC is created in each
+	// of these Private Networks and its IPv4 is assigned by DHCP.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=7
+	AdditionalPrivateNetworks []PrivateNetworkReference `json:"additionalPrivateNetworks,omitempty"`
+
 	// placementGroup allows attaching a Placement Group to the instance.
 	// +optional
 	PlacementGroup IDOrName `json:"placementGroup,omitempty,omitzero"`
 
+	// managedPlacementGroup allows the provider to create and manage the Placement Groups
+	// of the instance. Instances of the same MachineDeployment, MachinePool or control plane
+	// share the same Placement Groups. A new Placement Group is created when
+	// the existing ones are full, and empty Placement Groups are deleted.
+	// +optional
+	ManagedPlacementGroup ManagedPlacementGroup `json:"managedPlacementGroup,omitempty,omitzero"`
+
 	// securityGroup allows attaching a Security Group to the instance.
 	// +optional
 	SecurityGroup IDOrName `json:"securityGroup,omitempty,omitzero"`
+
+	// bootstrapData configures how the bootstrap data is stored in the user data of the instance.
+	// +optional
+	BootstrapData BootstrapData `json:"bootstrapData,omitempty,omitzero"`
+
+	// additionalTags that will be added to the default tags of the instance,
+	// its flexible IPs, its root volume and its additional volumes.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=30
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=128
+	AdditionalTags []string `json:"additionalTags,omitempty"`
+
+	// unhealthyServerPolicy defines how an unhealthy instance is reported once the node
+	// has joined the cluster (e.g. when the instance is stopped, locked or missing).
+	// With Report, only the ServerHealthy condition is updated. With Fail, the ServerHealthy
+	// condition is also part of the Ready condition, which Cluster API mirrors to the
+	// InfrastructureReady condition of the Machine. A MachineHealthCheck can target this
+	// condition with unhealthyMachineConditions. Defaults to Report.
+	// +optional
+	// +kubebuilder:validation:Enum=Report;Fail
+	UnhealthyServerPolicy string `json:"unhealthyServerPolicy,omitempty"`
+
+	// deletionStrategy configures how the instance is stopped and what happens to it
+	// when the ScalewayMachine is deleted.
+	// +optional
+	DeletionStrategy DeletionStrategy `json:"deletionStrategy,omitempty,omitzero"`
+
+	// protected enables the protection of the instance when it is created, so that it
+	// cannot be deleted from the Scaleway console or CLI. The protection is only lifted
+	// by the provider when the Machine that owns the ScalewayMachine is deleted.
+	// It cannot be enabled in a ScalewayMachinePool.
+	// Defaults to true for control-plane machines and false otherwise.
+	// +optional
+	Protected *bool `json:"protected,omitempty"`
 }
 
+// DeletionStrategy policies.
+const (
+	// DeletionPolicyDelete deletes the instance and its volumes.
+	DeletionPolicyDelete = "Delete"
+
+	// DeletionPolicyArchive stops the instance and keeps it with its volumes.
+	DeletionPolicyArchive = "Archive"
+)
+
+// DeletionStrategy configures how the instance is deleted.
+// +kubebuilder:validation:MinProperties=1
+type DeletionStrategy struct {
+	// policy defines what happens to the instance when the ScalewayMachine is deleted.
+	// With Delete, the instance and its volumes are deleted. With Archive, the instance
+	// is stopped and kept with its volumes, it is detached from the resources of the
+	// cluster and its tags are replaced with the caps-archived=true tag. It is no longer
+	// managed by the provider and must be deleted manually. Archive cannot be used in a
+	// ScalewayMachinePool. Defaults to Delete.
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Archive
+	Policy string `json:"policy,omitempty"`
+
+	// gracefulShutdownTimeoutSeconds is the time given to the instance to stop in place
+	// before it is forcibly powered off. The Scaleway Instance API cannot ask the operating
+	// system of the instance to shut down (no ACPI shutdown), stopping in place is done
+	// by the hypervisor. By default, the instance is powered off right away. It cannot be
+	// used in a ScalewayMachinePool.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	GracefulShutdownTimeoutSeconds int32 `json:"gracefulShutdownTimeoutSeconds,omitempty"`
+
+	// snapshotRootVolume creates a snapshot of the root volume once the instance is
+	// stopped, before it is deleted or archived. The snapshot is kept when the instance
+	// is deleted. This is only applicable for block root volumes. It cannot be used
+	// in a ScalewayMachinePool.
+	// +optional
+	SnapshotRootVolume *bool `json:"snapshotRootVolume,omitempty"`
+}
+
+// BootstrapData configures how the bootstrap data is stored in the user data of the instance.
+// +kubebuilder:validation:MinProperties=1
+type BootstrapData struct {
+	// encoding of the cloud-init bootstrap data. With Auto, the bootstrap data is
+	// compressed with gzip only if it exceeds the user data size limit. With Plain,
+	// the bootstrap data is never compressed. With Gzip, the bootstrap data is always
+	// compressed. Ignition bootstrap data is never compressed. Defaults to Auto.
+	// +optional
+	// +kubebuilder:default=Auto
+	Encoding BootstrapDataEncoding `json:"encoding,omitempty"`
+
+	// split allows splitting the encoded cloud-init bootstrap data across
+	// multiple user data keys when it still exceeds the user data size limit.
+	// A small loader is then stored in the cloud-init key, it requires curl and
+	// cloud-init 18.4 or later in the image.
+	// +optional
+	Split *bool `json:"split,omitempty"`
+}
+
+// BootstrapDataEncoding is the encoding of the cloud-init bootstrap data.
+// +kubebuilder:validation:Enum=Auto;Plain;Gzip
+type BootstrapDataEncoding string
+
+const (
+	// BootstrapDataEncodingAuto compresses the bootstrap data only if it exceeds the user data size limit.
+	BootstrapDataEncodingAuto BootstrapDataEncoding = "Auto"
+	// BootstrapDataEncodingPlain never compresses the bootstrap data.
+	BootstrapDataEncodingPlain BootstrapDataEncoding = "Plain"
+	// BootstrapDataEncodingGzip always compresses the bootstrap data.
+	BootstrapDataEncodingGzip BootstrapDataEncoding = "Gzip"
+)
+
 // Image contains an ID, Name or Label to use to create the instance.
 // +kubebuilder:validation:MinProperties=1
 // +kubebuilder:validation:MaxProperties=1
@@ -94,11 +320,28 @@ type IDOrName struct {
 	Name string `json:"name,omitempty"`
 }
 
+// ManagedPlacementGroup defines the policy of the Placement Groups managed by the provider.
+type ManagedPlacementGroup struct {
+	// policyType of the Placement Groups. Use low_latency to group instances
+	// or max_availability to spread them.
+	// +required
+	// +kubebuilder:validation:Enum=low_latency;max_availability
+	PolicyType string `json:"policyType,omitempty"`
+
+	// policyMode of the Placement Groups. With enforced, the instance creation
+	// fails if the policy cannot be respected. Defaults to optional.
+	// +optional
+	// +kubebuilder:default="optional"
+	// +kubebuilder:validation:Enum=optional;enforced
+	PolicyMode string `json:"policyMode,omitempty"`
+}
+
 // RootVolume defines the characteristics of the system (root) volume.
 // +kubebuilder:validation:MinProperties=1
 // +kubebuilder:validation:XValidation:rule="!has(self.iops) || has(self.type) && self.type == 'block'",message="iops can only be set for block volumes"
 type RootVolume struct {
-	// size of the root volume in GB. Defaults to 20 GB.
+	// size of the root volume in GB. Defaults to 20 GB. The size of a block root
+	// volume can be increased on an existing instance.
 	// +optional
 	// +kubebuilder:default=20
 	// +kubebuilder:validation:Minimum=8
@@ -121,8 +364,11 @@ type RootVolume struct {
 // AdditionalVolume defines the characteristics of an additional volume.
 // +kubebuilder:validation:MinProperties=1
 // +kubebuilder:validation:XValidation:rule="!has(self.iops) || has(self.type) && self.type == 'block'",message="iops can only be set for block volumes"
+// +kubebuilder:validation:XValidation:rule="!has(self.snapshot) || has(self.type) && self.type == 'block'",message="snapshot can only be set for block volumes"
+// +kubebuilder:validation:XValidation:rule="!has(self.deletionPolicy) || self.deletionPolicy == 'Delete' || has(self.type) && self.type == 'block'",message="deletionPolicy can only be Retain or Snapshot for block volumes"
 type AdditionalVolume struct {
-	// size of the volume in GB.
+	// size of the volume in GB. When the volume is created from a snapshot,
+	// the size of the snapshot is used by default.
 	// +optional
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=10000
@@ -140,11 +386,50 @@ type AdditionalVolume struct {
 	// +optional
 	// +kubebuilder:validation:Minimum=5000
 	IOPS int64 `json:"iops,omitempty"`
+
+	// snapshot is an existing block snapshot the volume is created from. This is
+	// only applicable for block volumes.
+	// +optional
+	Snapshot IDOrName `json:"snapshot,omitempty,omitzero"`
+
+	// deletionPolicy defines what happens to the volume when the instance is deleted.
+	// With Delete, the volume is deleted. With Retain, the volume is detached and kept,
+	// its machine tags are replaced with the caps-retained=true tag.
+	// With Snapshot, a snapshot of the volume is created before the volume is deleted.
+	// Retain and Snapshot are only applicable for block volumes. Defaults to Delete.
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Retain;Snapshot
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
 }
 
+// AdditionalVolume deletion policies.
+const (
+	// VolumeDeletionPolicyDelete deletes the volume with the instance.
+	VolumeDeletionPolicyDelete = "Delete"
+
+	// VolumeDeletionPolicyRetain detaches the volume and keeps it.
+	VolumeDeletionPolicyRetain = "Retain"
+
+	// VolumeDeletionPolicySnapshot creates a snapshot of the volume before it is deleted.
+	VolumeDeletionPolicySnapshot = "Snapshot"
+)
+
 // PublicNetwork allows enabling the attachment of public IPs to the instance.
 // +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:XValidation:rule="!has(self.ipv4) || !has(self.enableIPv4) || self.enableIPv4",message="enableIPv4 cannot be false when ipv4 is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.ipv6) || !has(self.enableIPv6) || self.enableIPv6",message="enableIPv6 cannot be false when ipv6 is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.ipType) || self.ipType != 'NAT' || (!has(self.ipv6) && (!has(self.enableIPv6) || !self.enableIPv6))",message="NAT IPs only support IPv4"
 type PublicNetwork struct {
+	// ipType is the type of the public IPs of the server. Routed IPs are attached
+	// directly to the server while NAT IPs are translated to the private address of
+	// the server and only support IPv4. New servers use routed IPs unless ipType is
+	// NAT. The NAT IPs of an existing server are migrated to routed IPs only when
+	// ipType is Routed, they are left unchanged when ipType is not set. Routed IPs
+	// cannot be migrated to NAT IPs, ipType can only be set to NAT on an existing
+	// server that uses NAT IPs.
+	// +optional
+	IPType PublicIPType `json:"ipType,omitempty"`
+
 	// enableIPv4 defines whether server should have an IPv4 created and attached.
 	// +optional
 	EnableIPv4 *bool `json:"enableIPv4,omitempty"`
@@ -152,6 +437,103 @@ type PublicNetwork struct {
 	// enableIPv6 defines whether server should have an IPv6 created and attached.
 	// +optional
 	EnableIPv6 *bool `json:"enableIPv6,omitempty"`
+
+	// ipv4 references an existing flexible IPv4 to attach to the server instead of
+	// creating a new one. The IP is detached, but not deleted, when the server is deleted.
+	// Setting this field enables the public IPv4.
+	// +optional
+	IPv4 FlexibleIPReference `json:"ipv4,omitempty,omitzero"`
+
+	// ipv6 references an existing flexible IPv6 to attach to the server instead of
+	// creating a new one. The IP is detached, but not deleted, when the server is deleted.
+	// Setting this field enables the public IPv6.
+	// +optional
+	IPv6 FlexibleIPReference `json:"ipv6,omitempty,omitzero"`
+}
+
+// PublicIPType is the type of the public IPs of a server.
+// +kubebuilder:validation:Enum=Routed;NAT
+type PublicIPType string
+
+const (
+	// PublicIPTypeRouted is the type of routed IPs.
+	PublicIPTypeRouted PublicIPType = "Routed"
+	// PublicIPTypeNAT is the type of NAT IPs.
+	PublicIPTypeNAT PublicIPType = "NAT"
+)
+
+// MachinePrivateNetwork configures the private IPv4 of the instance. The IPv4
+// is reserved in IPAM before the private NIC of the instance is created.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
+type MachinePrivateNetwork struct {
+	// ipamIPID is the ID of an IPv4 that is already reserved in IPAM. This IP is
+	// not released when the instance is deleted.
+	// +optional
+	IPAMIPID UUID `json:"ipamIPID,omitempty"`
+
+	// address is a static IPv4 of the Private Network. It is reserved in IPAM by
+	// the provider and released when the instance is deleted.
+	// +optional
+	Address IPv4 `json:"address,omitempty"`
+
+	// addressRange is a range of IPv4 of the Private Network. The provider reserves
+	// the first free IPv4 of the range in IPAM and releases it when the instance is deleted.
+	// +optional
+	AddressRange CIDR `json:"addressRange,omitempty"`
+}
+
+// PrivateNetworkReference references an existing Private Network by ID or tags.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
+type PrivateNetworkReference struct {
+	// id of the Private Network.
+	// +optional
+	ID UUID `json:"id,omitempty"`
+
+	// tags of the Private Network. Exactly one Private Network of the project
+	// must have all these tags.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=128
+	Tags []string `json:"tags,omitempty"`
+}
+
+// FlexibleIPReference references an existing flexible IP by ID, address or tags.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
+type FlexibleIPReference struct {
+	// id of the flexible IP.
+	// +optional
+	ID UUID `json:"id,omitempty"`
+
+	// address of the flexible IP.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="isIP(self)",message="value must be a valid IP address"
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=39
+	Address string `json:"address,omitempty"`
+
+	// tags select a pool of flexible IPs. The first IP of the pool that has all
+	// these tags and that is not attached to a server is used.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=128
+	Tags []string `json:"tags,omitempty"`
+}
+
+// IsDefined returns true if the FlexibleIPReference is set.
+func (r *FlexibleIPReference) IsDefined() bool {
+	if r == nil {
+		return false
+	}
+	return r.ID != "" || r.Address != "" || len(r.Tags) > 0
 }
 
 // ScalewayMachineStatus defines the observed state of ScalewayMachine.
@@ -177,6 +559,138 @@ type ScalewayMachineStatus struct {
 	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=32
 	Addresses []clusterv1.MachineAddress `json:"addresses,omitempty"`
+
+	// publicIPs contains the public addresses of the machine and their type.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=2
+	PublicIPs []MachinePublicIPStatus `json:"publicIPs,omitempty"`
+
+	// privateNetworks contains the private addresses of the machine in each
+	// Private Network it is attached to.
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+	// +kubebuilder:validation:MaxItems=8
+	PrivateNetworks []MachinePrivateNetworkStatus `json:"privateNetworks,omitempty"`
+
+	// additionalVolumes contains the IDs of the additional volumes of the machine.
+	// Scratch volumes are not reported.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=15
+	AdditionalVolumes []AdditionalVolumeStatus `json:"additionalVolumes,omitempty"`
+
+	// serverID is the ID of the Instance server of the machine.
+	// +optional
+	ServerID UUID `json:"serverID,omitempty"`
+
+	// zone of the Instance server. It may differ from the failure domain of the
+	// Machine when the server was created in another zone of the region.
+	// +optional
+	Zone ScalewayZone `json:"zone,omitempty"`
+
+	// state is the current state of the Instance server (e.g. running, stopped).
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32
+	State string `json:"state,omitempty"`
+
+	// commercialType of the Instance server. It may differ from the commercial
+	// type of the spec when a fallback commercial type was used.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=20
+	CommercialType string `json:"commercialType,omitempty"`
+
+	// image is the image the Instance server was created from.
+	// +optional
+	Image ImageStatus `json:"image,omitempty,omitzero"`
+
+	// rootVolume is the system (root) volume of the Instance server.
+	// +optional
+	RootVolume RootVolumeStatus `json:"rootVolume,omitempty,omitzero"`
+
+	// deletion contains information about the deletion of the instance.
+	// +optional
+	Deletion ScalewayMachineDeletionStatus `json:"deletion,omitempty,omitzero"`
+}
+
+// ScalewayMachineDeletionStatus contains information about the deletion of the instance.
+// +kubebuilder:validation:MinProperties=1
+type ScalewayMachineDeletionStatus struct {
+	// gracefulShutdownStartTime is the time when the graceful shutdown of the instance started.
+	// +optional
+	GracefulShutdownStartTime metav1.Time `json:"gracefulShutdownStartTime,omitempty,omitzero"`
+}
+
+// ImageStatus contains the ID and name of an image.
+// +kubebuilder:validation:MinProperties=1
+type ImageStatus struct {
+	// id of the image.
+	// +optional
+	ID UUID `json:"id,omitempty"`
+
+	// name of the image.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name,omitempty"`
+}
+
+// RootVolumeStatus contains the ID and type of the root volume.
+// +kubebuilder:validation:MinProperties=1
+type RootVolumeStatus struct {
+	// id of the root volume.
+	// +optional
+	ID UUID `json:"id,omitempty"`
+
+	// type of the root volume. Can be local or block.
+	// +optional
+	// +kubebuilder:validation:Enum=local;block
+	Type string `json:"type,omitempty"`
+}
+
+// AdditionalVolumeStatus contains the ID of an additional volume.
+type AdditionalVolumeStatus struct {
+	// name of the volume.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	Name string `json:"name,omitempty"`
+
+	// id of the volume.
+	// +required
+	ID UUID `json:"id,omitempty"`
+}
+
+// MachinePublicIPStatus contains a public address of the machine.
+type MachinePublicIPStatus struct {
+	// address is the public IPv4 or IPv6 of the machine.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=39
+	Address string `json:"address,omitempty"`
+
+	// type is the type of the public IP.
+	// +required
+	Type PublicIPType `json:"type,omitempty"`
+}
+
+// MachinePrivateNetworkStatus contains the private addresses of the machine in a Private Network.
+type MachinePrivateNetworkStatus struct {
+	// id of the Private Network.
+	// +required
+	ID UUID `json:"id,omitempty"`
+
+	// addresses of the machine in the Private Network.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=39
+	Addresses []string `json:"addresses,omitempty"`
 }
 
 // ScalewayMachineInitializationStatus provides observations of the ScalewayMachine initialization process.
@@ -196,6 +710,10 @@ type ScalewayMachineInitializationStatus struct {
 // +kubebuilder:printcolumn:name="ProviderID",type="string",JSONPath=".spec.providerID",description="Node provider ID"
 // +kubebuilder:printcolumn:name="Provisioned",type="boolean",JSONPath=".status.initialization.provisioned",description="Provisioned is true when the machine infrastructure is fully provisioned"
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="ScalewayMachine pass all readiness checks"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state",description="State of the Instance server"
+// +kubebuilder:printcolumn:name="Zone",type="string",JSONPath=".status.zone",description="Zone of the Instance server",priority=1
+// +kubebuilder:printcolumn:name="ServerID",type="string",JSONPath=".status.serverID",description="ID of the Instance server",priority=1
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".status.image.name",description="Image of the Instance server",priority=1
 
 // ScalewayMachine is the Schema for the scalewaymachines API
 // +kubebuilder:validation:XValidation:rule="self.metadata.name.size() <= 63",message="name must be between 1 and 63 characters"
@@ -0,0 +1,158 @@
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// ScalewayMachinePoolFinalizer is the finalizer that prevents deletion of a ScalewayMachinePool.
+const ScalewayMachinePoolFinalizer = "scalewaymachinepool.infrastructure.cluster.x-k8s.io/smp-protection"
+
+// ScalewayMachinePoolReadyCondition reports if the ScalewayMachinePool is ready.
+const ScalewayMachinePoolReadyCondition = clusterv1.ReadyCondition
+
+// ScalewayMachinePool's InstancesReady condition and corresponding reasons.
+const (
+	// ScalewayMachinePoolInstancesReadyCondition indicates whether the Scaleway instances of the pool are ready.
+	ScalewayMachinePoolInstancesReadyCondition = "InstancesReady"
+
+	// ScalewayMachinePoolInstancesReadyReason surfaces when all the Scaleway instances of the pool are ready.
+	ScalewayMachinePoolInstancesReadyReason = ReadyReason
+
+	// ScalewayMachinePoolInstancesReconciliationFailedReason surfaces when there is a failure in reconciling the Scaleway instances.
+	ScalewayMachinePoolInstancesReconciliationFailedReason = ReconciliationFailedReason
+
+	// ScalewayMachinePoolInstancesScalingReason surfaces when instances are being created or deleted
+	// to match the desired number of replicas.
+	ScalewayMachinePoolInstancesScalingReason = "Scaling"
+
+	// ScalewayMachinePoolInstancesRollingUpdateReason surfaces when outdated instances are being replaced.
+	ScalewayMachinePoolInstancesRollingUpdateReason = "RollingUpdate"
+)
+
+// ScalewayMachinePoolSpec defines the desired state of ScalewayMachinePool.
+type ScalewayMachinePoolSpec struct {
+	// template defines the Instance servers that are created by the pool.
+	// Updating the template triggers a rolling replacement of the servers.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="!has(self.providerID)",message="providerID cannot be set in template"
+	Template ScalewayMachineSpec `json:"template,omitzero"`
+
+	// strategy defines how the servers of the pool are replaced when the template changes.
+	// +optional
+	Strategy ScalewayMachinePoolStrategy `json:"strategy,omitempty,omitzero"`
+
+	// providerIDList are the identification IDs of machine instances provided by the provider.
+	// This field must match the provider IDs as seen on the node objects corresponding to a machine pool's machine instances.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=10000
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=512
+	ProviderIDList []string `json:"providerIDList,omitempty"`
+}
+
+// ScalewayMachinePoolStrategy defines the rolling replacement strategy of a ScalewayMachinePool.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:XValidation:rule="(has(self.maxSurge) ? self.maxSurge : 1) + (has(self.maxUnavailable) ? self.maxUnavailable : 0) > 0",message="maxSurge and maxUnavailable cannot both be 0"
+type ScalewayMachinePoolStrategy struct {
+	// maxSurge is the maximum number of servers that can be created above the
+	// desired number of replicas during a rolling replacement. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxSurge *int32 `json:"maxSurge,omitempty"`
+
+	// maxUnavailable is the maximum number of servers that can be unavailable
+	// during a rolling replacement. Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+}
+
+// ScalewayMachinePoolStatus defines the observed state of ScalewayMachinePool.
+// +kubebuilder:validation:MinProperties=1
+type ScalewayMachinePoolStatus struct {
+	// conditions represent the current state of the ScalewayMachinePool resource.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ready is true when the provider resource is ready.
+	// Deprecated: this field is kept for now as CAPI still needs it.
+	// The .initialization.provisioned field should be used instead.
+	// +optional
+	Ready *bool `json:"ready,omitempty"`
+
+	// initialization provides observations of the ScalewayMachinePool initialization process.
+	// NOTE: Fields in this struct are part of the Cluster API contract and are used to orchestrate initial MachinePool provisioning.
+	// +optional
+	Initialization ScalewayMachinePoolInitializationStatus `json:"initialization,omitempty,omitzero"`
+
+	// replicas is the most recently observed number of replicas.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// ScalewayMachinePoolInitializationStatus provides observations of the ScalewayMachinePool initialization process.
+// +kubebuilder:validation:MinProperties=1
+type ScalewayMachinePoolInitializationStatus struct {
+	// provisioned is true when the infrastructure provider reports that the MachinePool's infrastructure is fully provisioned.
+	// +optional
+	Provisioned *bool `json:"provisioned,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=scalewaymachinepools,scope=Namespaced,categories=cluster-api,shortName=smp
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="CommercialType",type="string",JSONPath=".spec.template.commercialType",description="Commercial type of the instances"
+// +kubebuilder:printcolumn:name="Provisioned",type="boolean",JSONPath=".status.initialization.provisioned",description="Provisioned is true when the machinepool infrastructure is fully provisioned"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="ScalewayMachinePool pass all readiness checks"
+// +kubebuilder:printcolumn:name="Replicas",type="string",JSONPath=".status.replicas"
+
+// ScalewayMachinePool is the Schema for the scalewaymachinepools API
+// +kubebuilder:validation:XValidation:rule="self.metadata.name.size() <= 57",message="name must be between 1 and 57 characters"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')",message="name must be a valid DNS label"
+type ScalewayMachinePool struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of ScalewayMachinePool
+	// +required
+	Spec ScalewayMachinePoolSpec `json:"spec,omitzero"`
+
+	// status defines the observed state of ScalewayMachinePool
+	// +optional
+	Status ScalewayMachinePoolStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalewayMachinePoolList contains a list of ScalewayMachinePool
+type ScalewayMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ScalewayMachinePool `json:"items"`
+}
+
+// GetConditions returns the list of conditions for an ScalewayMachinePool API object.
+func (s *ScalewayMachinePool) GetConditions() []metav1.Condition {
+	return s.Status.Conditions
+}
+
+// SetConditions will set the given conditions on an ScalewayMachinePool object.
+func (s *ScalewayMachinePool) SetConditions(conditions []metav1.Condition) {
+	s.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&ScalewayMachinePool{}, &ScalewayMachinePoolList{})
+}
@@ -1,6 +1,7 @@
 package v1alpha2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
@@ -23,7 +24,48 @@ type ScalewayMachineTemplateResource struct {
 	Spec ScalewayMachineSpec `json:"spec,omitempty,omitzero"`
 }
 
+// ScalewayMachineTemplateStatus defines the observed state of ScalewayMachineTemplate.
+// It is used by the cluster-autoscaler to scale node groups from zero.
+// +kubebuilder:validation:MinProperties=1
+type ScalewayMachineTemplateStatus struct {
+	// capacity defines the resource capacity of the machines created from this template.
+	// It is resolved from the commercial type of the template.
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty"` //nolint:kubeapilinter // required by the cluster-autoscaler contract.
+
+	// nodeInfo contains information about the nodes created from this template.
+	// +optional
+	NodeInfo NodeInfo `json:"nodeInfo,omitempty,omitzero"`
+}
+
+// NodeInfo contains information about a node.
+// +kubebuilder:validation:MinProperties=1
+type NodeInfo struct {
+	// architecture is the CPU architecture of the node.
+	// +optional
+	Architecture Architecture `json:"architecture,omitempty"`
+
+	// operatingSystem is the operating system of the node.
+	// +optional
+	// +kubebuilder:validation:Enum=linux
+	OperatingSystem string `json:"operatingSystem,omitempty"`
+}
+
+// Architecture is the CPU architecture of a node.
+// +kubebuilder:validation:Enum=amd64;arm64;arm
+type Architecture string
+
+const (
+	// ArchitectureAmd64 is the amd64 (x86_64) architecture.
+	ArchitectureAmd64 Architecture = "amd64"
+	// ArchitectureArm64 is the arm64 architecture.
+	ArchitectureArm64 Architecture = "arm64"
+	// ArchitectureArm is the arm architecture.
+	ArchitectureArm Architecture = "arm"
+)
+
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 // +kubebuilder:resource:path=scalewaymachinetemplates,scope=Namespaced,categories=cluster-api,shortName=smt
 // +kubebuilder:storageversion
 
@@ -38,6 +80,10 @@ type ScalewayMachineTemplate struct {
 	// spec defines the desired state of ScalewayMachineTemplate
 	// +required
 	Spec ScalewayMachineTemplateSpec `json:"spec,omitzero"`
+
+	// status defines the observed state of ScalewayMachineTemplate
+	// +optional
+	Status ScalewayMachineTemplateStatus `json:"status,omitzero"`
 }
 
 // +kubebuilder:object:root=true
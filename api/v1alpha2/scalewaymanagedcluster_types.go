@@ -12,6 +12,8 @@ const ScalewayManagedClusterFinalizer = "scalewaymanagedcluster.infrastructure.c
 const ScalewayManagedClusterReadyCondition = clusterv1.ReadyCondition
 
 // ScalewayManagedClusterSpec defines the desired state of ScalewayManagedCluster.
+// +kubebuilder:validation:XValidation:rule="has(self.scalewaySecretName) != has(self.identityRef)",message="exactly one of scalewaySecretName or identityRef must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.identityRef) == has(oldSelf.identityRef)",message="identityRef cannot be added or removed"
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.controlPlaneEndpoint) || has(self.controlPlaneEndpoint)", message="controlPlaneEndpoint is required once set"
 // +kubebuilder:validation:XValidation:rule="(has(self.network) && has(self.network.privateNetwork)) == (has(oldSelf.network) && has(oldSelf.network.privateNetwork))",message="privateNetwork cannot be added or removed"
 type ScalewayManagedClusterSpec struct {
@@ -28,11 +30,18 @@ type ScalewayManagedClusterSpec struct {
 	// scalewaySecretName is the name of the secret that contains the Scaleway client parameters.
 	// The following keys are required: SCW_ACCESS_KEY, SCW_SECRET_KEY.
 	// The following key is optional: SCW_API_URL.
-	// +required
+	// Exactly one of scalewaySecretName or identityRef must be set.
+	// +optional
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=253
 	ScalewaySecretName string `json:"scalewaySecretName,omitempty"`
 
+	// identityRef references a ScalewayClusterIdentity that contains the Scaleway
+	// client parameters. The namespace of the ScalewayManagedCluster must be allowed by the identity.
+	// Exactly one of scalewaySecretName or identityRef must be set.
+	// +optional
+	IdentityRef ScalewayClusterIdentityReference `json:"identityRef,omitempty,omitzero"`
+
 	// network defines the network configuration of the managed cluster.
 	// +optional
 	Network ScalewayManagedClusterNetwork `json:"network,omitempty,omitzero"`
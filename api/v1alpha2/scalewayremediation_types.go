@@ -0,0 +1,117 @@
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScalewayRemediation strategies.
+const (
+	// RemediationStrategyReboot reboots the server.
+	RemediationStrategyReboot = "Reboot"
+
+	// RemediationStrategyPowerCycle stops the server in place and then powers it on.
+	RemediationStrategyPowerCycle = "PowerCycle"
+)
+
+// ScalewayRemediation phases.
+const (
+	// RemediationPhaseRunning is the phase of a remediation that is rebooting the server.
+	RemediationPhaseRunning = "Running"
+
+	// RemediationPhasePoweringOff is the phase of a PowerCycle remediation that waits
+	// for the server to be stopped before powering it on.
+	RemediationPhasePoweringOff = "PoweringOff"
+
+	// RemediationPhaseWaiting is the phase of a remediation that waits for the Machine
+	// to become healthy after the server was rebooted.
+	RemediationPhaseWaiting = "Waiting"
+
+	// RemediationPhaseDeleting is the phase of a remediation that exhausted its retries
+	// and deleted the Machine.
+	RemediationPhaseDeleting = "Deleting"
+)
+
+// ScalewayRemediationSpec defines the desired state of ScalewayRemediation.
+type ScalewayRemediationSpec struct {
+	// strategy of the remediation. With Reboot, the server is rebooted. With PowerCycle,
+	// the server is stopped in place and then powered on. Defaults to Reboot.
+	// +optional
+	// +kubebuilder:default="Reboot"
+	// +kubebuilder:validation:Enum=Reboot;PowerCycle
+	Strategy string `json:"strategy,omitempty"`
+
+	// retryLimit is the maximum number of times the server is rebooted before the
+	// Machine is deleted. Defaults to 1.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	RetryLimit int32 `json:"retryLimit,omitempty"`
+
+	// timeoutSeconds is the time to wait for the Machine to become healthy after each
+	// reboot of the server. Defaults to 300 seconds.
+	// +optional
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=3600
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// ScalewayRemediationStatus defines the observed state of ScalewayRemediation.
+// +kubebuilder:validation:MinProperties=1
+type ScalewayRemediationStatus struct {
+	// phase of the remediation. Can be Running, PoweringOff, Waiting or Deleting.
+	// +optional
+	// +kubebuilder:validation:Enum=Running;PoweringOff;Waiting;Deleting
+	Phase string `json:"phase,omitempty"`
+
+	// retryCount is the number of remediation attempts, including the attempt in progress.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RetryCount int32 `json:"retryCount,omitempty"`
+
+	// lastRemediated is the last time an action was requested on the server.
+	// +optional
+	LastRemediated metav1.Time `json:"lastRemediated,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=scalewayremediations,scope=Namespaced,categories=cluster-api,shortName=srem
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Strategy",type="string",JSONPath=".spec.strategy",description="Remediation strategy"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Remediation phase"
+// +kubebuilder:printcolumn:name="Retries",type="integer",JSONPath=".status.retryCount",description="Number of times the server was rebooted"
+// +kubebuilder:printcolumn:name="Last Remediated",type="date",JSONPath=".status.lastRemediated",description="Last time the server was rebooted"
+
+// ScalewayRemediation is the Schema for the scalewayremediations API.
+// It is created by a MachineHealthCheck from a ScalewayRemediationTemplate
+// to remediate an unhealthy Machine.
+type ScalewayRemediation struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of ScalewayRemediation
+	// +required
+	Spec ScalewayRemediationSpec `json:"spec,omitzero"`
+
+	// status defines the observed state of ScalewayRemediation
+	// +optional
+	Status ScalewayRemediationStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalewayRemediationList contains a list of ScalewayRemediation
+type ScalewayRemediationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ScalewayRemediation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScalewayRemediation{}, &ScalewayRemediationList{})
+}
@@ -0,0 +1,55 @@
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// ScalewayRemediationTemplateSpec defines the desired state of ScalewayRemediationTemplate
+type ScalewayRemediationTemplateSpec struct {
+	// template is a ScalewayRemediation template resource.
+	// +required
+	Template ScalewayRemediationTemplateResource `json:"template,omitempty,omitzero"`
+}
+
+type ScalewayRemediationTemplateResource struct {
+	// metadata is a Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	ObjectMeta clusterv1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the desired state of ScalewayRemediation
+	// +required
+	Spec ScalewayRemediationSpec `json:"spec,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=scalewayremediationtemplates,scope=Namespaced,categories=cluster-api,shortName=sremt
+// +kubebuilder:storageversion
+
+// ScalewayRemediationTemplate is the Schema for the scalewayremediationtemplates API.
+// It is referenced by the remediation of a MachineHealthCheck.
+type ScalewayRemediationTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of ScalewayRemediationTemplate
+	// +required
+	Spec ScalewayRemediationTemplateSpec `json:"spec,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalewayRemediationTemplateList contains a list of ScalewayRemediationTemplate
+type ScalewayRemediationTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ScalewayRemediationTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScalewayRemediationTemplate{}, &ScalewayRemediationTemplateList{})
+}
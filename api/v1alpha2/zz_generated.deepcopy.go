@@ -5,6 +5,7 @@
 package v1alpha2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -33,6 +34,7 @@ func (in *ACL) DeepCopy() *ACL {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdditionalVolume) DeepCopyInto(out *AdditionalVolume) {
 	*out = *in
+	out.Snapshot = in.Snapshot
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalVolume.
@@ -45,6 +47,46 @@ func (in *AdditionalVolume) DeepCopy() *AdditionalVolume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalVolumeStatus) DeepCopyInto(out *AdditionalVolumeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalVolumeStatus.
+func (in *AdditionalVolumeStatus) DeepCopy() *AdditionalVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedNamespaces) DeepCopyInto(out *AllowedNamespaces) {
+	*out = *in
+	if in.List != nil {
+		in, out := &in.List, &out.List
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedNamespaces.
+func (in *AllowedNamespaces) DeepCopy() *AllowedNamespaces {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedNamespaces)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutoUpgrade) DeepCopyInto(out *AutoUpgrade) {
 	*out = *in
@@ -101,6 +143,26 @@ func (in *Autoscaler) DeepCopy() *Autoscaler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapData) DeepCopyInto(out *BootstrapData) {
+	*out = *in
+	if in.Split != nil {
+		in, out := &in.Split, &out.Split
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapData.
+func (in *BootstrapData) DeepCopy() *BootstrapData {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapData)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlaneDNS) DeepCopyInto(out *ControlPlaneDNS) {
 	*out = *in
@@ -130,11 +192,18 @@ func (in *ControlPlaneLoadBalancer) DeepCopyInto(out *ControlPlaneLoadBalancer)
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ExternallyManaged != nil {
+		in, out := &in.ExternallyManaged, &out.ExternallyManaged
+		*out = new(bool)
+		**out = **in
+	}
 	if in.AdditionalPorts != nil {
 		in, out := &in.AdditionalPorts, &out.AdditionalPorts
 		*out = make([]LoadBalancerPort, len(*in))
 		copy(*out, *in)
 	}
+	out.HealthCheck = in.HealthCheck
+	out.Backend = in.Backend
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneLoadBalancer.
@@ -147,6 +216,76 @@ func (in *ControlPlaneLoadBalancer) DeepCopy() *ControlPlaneLoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionStrategy) DeepCopyInto(out *DeletionStrategy) {
+	*out = *in
+	if in.SnapshotRootVolume != nil {
+		in, out := &in.SnapshotRootVolume, &out.SnapshotRootVolume
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionStrategy.
+func (in *DeletionStrategy) DeepCopy() *DeletionStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticMetalOS) DeepCopyInto(out *ElasticMetalOS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticMetalOS.
+func (in *ElasticMetalOS) DeepCopy() *ElasticMetalOS {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticMetalOS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlexibleIPReference) DeepCopyInto(out *FlexibleIPReference) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlexibleIPReference.
+func (in *FlexibleIPReference) DeepCopy() *FlexibleIPReference {
+	if in == nil {
+		return nil
+	}
+	out := new(FlexibleIPReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSHealthCheck) DeepCopyInto(out *HTTPSHealthCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSHealthCheck.
+func (in *HTTPSHealthCheck) DeepCopy() *HTTPSHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IDOrName) DeepCopyInto(out *IDOrName) {
 	*out = *in
@@ -178,6 +317,21 @@ func (in *Image) DeepCopy() *Image {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageStatus) DeepCopyInto(out *ImageStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageStatus.
+func (in *ImageStatus) DeepCopy() *ImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
 	*out = *in
@@ -193,9 +347,42 @@ func (in *LoadBalancer) DeepCopy() *LoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerBackend) DeepCopyInto(out *LoadBalancerBackend) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerBackend.
+func (in *LoadBalancerBackend) DeepCopy() *LoadBalancerBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerHealthCheck) DeepCopyInto(out *LoadBalancerHealthCheck) {
+	*out = *in
+	out.HTTPS = in.HTTPS
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerHealthCheck.
+func (in *LoadBalancerHealthCheck) DeepCopy() *LoadBalancerHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerPort) DeepCopyInto(out *LoadBalancerPort) {
 	*out = *in
+	out.HealthCheck = in.HealthCheck
+	out.Backend = in.Backend
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerPort.
@@ -208,6 +395,56 @@ func (in *LoadBalancerPort) DeepCopy() *LoadBalancerPort {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePrivateNetwork) DeepCopyInto(out *MachinePrivateNetwork) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePrivateNetwork.
+func (in *MachinePrivateNetwork) DeepCopy() *MachinePrivateNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePrivateNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePrivateNetworkStatus) DeepCopyInto(out *MachinePrivateNetworkStatus) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePrivateNetworkStatus.
+func (in *MachinePrivateNetworkStatus) DeepCopy() *MachinePrivateNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePrivateNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePublicIPStatus) DeepCopyInto(out *MachinePublicIPStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePublicIPStatus.
+func (in *MachinePublicIPStatus) DeepCopy() *MachinePublicIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePublicIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
 	*out = *in
@@ -228,6 +465,36 @@ func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedPlacementGroup) DeepCopyInto(out *ManagedPlacementGroup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPlacementGroup.
+func (in *ManagedPlacementGroup) DeepCopy() *ManagedPlacementGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedPlacementGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeInfo) DeepCopyInto(out *NodeInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeInfo.
+func (in *NodeInfo) DeepCopy() *NodeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OnDelete) DeepCopyInto(out *OnDelete) {
 	*out = *in
@@ -288,6 +555,26 @@ func (in *PrivateNetwork) DeepCopy() *PrivateNetwork {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateNetworkReference) DeepCopyInto(out *PrivateNetworkReference) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateNetworkReference.
+func (in *PrivateNetworkReference) DeepCopy() *PrivateNetworkReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateNetworkReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateNetworkSpec) DeepCopyInto(out *PrivateNetworkSpec) {
 	*out = *in
@@ -337,6 +624,8 @@ func (in *PublicNetwork) DeepCopyInto(out *PublicNetwork) {
 		*out = new(bool)
 		**out = **in
 	}
+	in.IPv4.DeepCopyInto(&out.IPv4)
+	in.IPv6.DeepCopyInto(&out.IPv6)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicNetwork.
@@ -364,6 +653,21 @@ func (in *RootVolume) DeepCopy() *RootVolume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RootVolumeStatus) DeepCopyInto(out *RootVolumeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RootVolumeStatus.
+func (in *RootVolumeStatus) DeepCopy() *RootVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RootVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalewayCluster) DeepCopyInto(out *ScalewayCluster) {
 	*out = *in
@@ -392,51 +696,57 @@ func (in *ScalewayCluster) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScalewayClusterInitializationStatus) DeepCopyInto(out *ScalewayClusterInitializationStatus) {
+func (in *ScalewayClusterIdentity) DeepCopyInto(out *ScalewayClusterIdentity) {
 	*out = *in
-	if in.Provisioned != nil {
-		in, out := &in.Provisioned, &out.Provisioned
-		*out = new(bool)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayClusterInitializationStatus.
-func (in *ScalewayClusterInitializationStatus) DeepCopy() *ScalewayClusterInitializationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayClusterIdentity.
+func (in *ScalewayClusterIdentity) DeepCopy() *ScalewayClusterIdentity {
 	if in == nil {
 		return nil
 	}
-	out := new(ScalewayClusterInitializationStatus)
+	out := new(ScalewayClusterIdentity)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalewayClusterIdentity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScalewayClusterList) DeepCopyInto(out *ScalewayClusterList) {
+func (in *ScalewayClusterIdentityList) DeepCopyInto(out *ScalewayClusterIdentityList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ScalewayCluster, len(*in))
+		*out = make([]ScalewayClusterIdentity, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayClusterList.
-func (in *ScalewayClusterList) DeepCopy() *ScalewayClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayClusterIdentityList.
+func (in *ScalewayClusterIdentityList) DeepCopy() *ScalewayClusterIdentityList {
 	if in == nil {
 		return nil
 	}
-	out := new(ScalewayClusterList)
+	out := new(ScalewayClusterIdentityList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ScalewayClusterList) DeepCopyObject() runtime.Object {
+func (in *ScalewayClusterIdentityList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -444,37 +754,141 @@ func (in *ScalewayClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScalewayClusterNetwork) DeepCopyInto(out *ScalewayClusterNetwork) {
+func (in *ScalewayClusterIdentityReference) DeepCopyInto(out *ScalewayClusterIdentityReference) {
 	*out = *in
-	in.ControlPlaneLoadBalancer.DeepCopyInto(&out.ControlPlaneLoadBalancer)
-	if in.ControlPlaneExtraLoadBalancers != nil {
-		in, out := &in.ControlPlaneExtraLoadBalancers, &out.ControlPlaneExtraLoadBalancers
-		*out = make([]LoadBalancer, len(*in))
-		copy(*out, *in)
-	}
-	out.ControlPlaneDNS = in.ControlPlaneDNS
-	in.PrivateNetwork.DeepCopyInto(&out.PrivateNetwork)
-	if in.PublicGateways != nil {
-		in, out := &in.PublicGateways, &out.PublicGateways
-		*out = make([]PublicGateway, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayClusterNetwork.
-func (in *ScalewayClusterNetwork) DeepCopy() *ScalewayClusterNetwork {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayClusterIdentityReference.
+func (in *ScalewayClusterIdentityReference) DeepCopy() *ScalewayClusterIdentityReference {
 	if in == nil {
 		return nil
 	}
-	out := new(ScalewayClusterNetwork)
+	out := new(ScalewayClusterIdentityReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScalewayClusterNetworkStatus) DeepCopyInto(out *ScalewayClusterNetworkStatus) {
+func (in *ScalewayClusterIdentitySecretReference) DeepCopyInto(out *ScalewayClusterIdentitySecretReference) {
 	*out = *in
-	if in.PublicGatewayIDs != nil {
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayClusterIdentitySecretReference.
+func (in *ScalewayClusterIdentitySecretReference) DeepCopy() *ScalewayClusterIdentitySecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayClusterIdentitySecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayClusterIdentitySpec) DeepCopyInto(out *ScalewayClusterIdentitySpec) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = new(AllowedNamespaces)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayClusterIdentitySpec.
+func (in *ScalewayClusterIdentitySpec) DeepCopy() *ScalewayClusterIdentitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayClusterIdentitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayClusterInitializationStatus) DeepCopyInto(out *ScalewayClusterInitializationStatus) {
+	*out = *in
+	if in.Provisioned != nil {
+		in, out := &in.Provisioned, &out.Provisioned
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayClusterInitializationStatus.
+func (in *ScalewayClusterInitializationStatus) DeepCopy() *ScalewayClusterInitializationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayClusterInitializationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayClusterList) DeepCopyInto(out *ScalewayClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScalewayCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayClusterList.
+func (in *ScalewayClusterList) DeepCopy() *ScalewayClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalewayClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayClusterNetwork) DeepCopyInto(out *ScalewayClusterNetwork) {
+	*out = *in
+	in.ControlPlaneLoadBalancer.DeepCopyInto(&out.ControlPlaneLoadBalancer)
+	if in.ControlPlaneExtraLoadBalancers != nil {
+		in, out := &in.ControlPlaneExtraLoadBalancers, &out.ControlPlaneExtraLoadBalancers
+		*out = make([]LoadBalancer, len(*in))
+		copy(*out, *in)
+	}
+	out.ControlPlaneDNS = in.ControlPlaneDNS
+	in.PrivateNetwork.DeepCopyInto(&out.PrivateNetwork)
+	if in.PublicGateways != nil {
+		in, out := &in.PublicGateways, &out.PublicGateways
+		*out = make([]PublicGateway, len(*in))
+		copy(*out, *in)
+	}
+	in.SecurityGroups.DeepCopyInto(&out.SecurityGroups)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayClusterNetwork.
+func (in *ScalewayClusterNetwork) DeepCopy() *ScalewayClusterNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayClusterNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayClusterNetworkStatus) DeepCopyInto(out *ScalewayClusterNetworkStatus) {
+	*out = *in
+	if in.PublicGatewayIDs != nil {
 		in, out := &in.PublicGatewayIDs, &out.PublicGatewayIDs
 		*out = make([]UUID, len(*in))
 		copy(*out, *in)
@@ -499,6 +913,7 @@ func (in *ScalewayClusterNetworkStatus) DeepCopy() *ScalewayClusterNetworkStatus
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalewayClusterSpec) DeepCopyInto(out *ScalewayClusterSpec) {
 	*out = *in
+	out.IdentityRef = in.IdentityRef
 	if in.FailureDomains != nil {
 		in, out := &in.FailureDomains, &out.FailureDomains
 		*out = make([]ScalewayZone, len(*in))
@@ -640,6 +1055,205 @@ func (in *ScalewayClusterTemplateSpec) DeepCopy() *ScalewayClusterTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayElasticMetalMachine) DeepCopyInto(out *ScalewayElasticMetalMachine) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayElasticMetalMachine.
+func (in *ScalewayElasticMetalMachine) DeepCopy() *ScalewayElasticMetalMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayElasticMetalMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalewayElasticMetalMachine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayElasticMetalMachineList) DeepCopyInto(out *ScalewayElasticMetalMachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScalewayElasticMetalMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayElasticMetalMachineList.
+func (in *ScalewayElasticMetalMachineList) DeepCopy() *ScalewayElasticMetalMachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayElasticMetalMachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalewayElasticMetalMachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayElasticMetalMachineSpec) DeepCopyInto(out *ScalewayElasticMetalMachineSpec) {
+	*out = *in
+	out.OS = in.OS
+	if in.SSHKeyIDs != nil {
+		in, out := &in.SSHKeyIDs, &out.SSHKeyIDs
+		*out = make([]UUID, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayElasticMetalMachineSpec.
+func (in *ScalewayElasticMetalMachineSpec) DeepCopy() *ScalewayElasticMetalMachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayElasticMetalMachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayElasticMetalMachineStatus) DeepCopyInto(out *ScalewayElasticMetalMachineStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Initialization.DeepCopyInto(&out.Initialization)
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]v1beta2.MachineAddress, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayElasticMetalMachineStatus.
+func (in *ScalewayElasticMetalMachineStatus) DeepCopy() *ScalewayElasticMetalMachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayElasticMetalMachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayElasticMetalMachineTemplate) DeepCopyInto(out *ScalewayElasticMetalMachineTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayElasticMetalMachineTemplate.
+func (in *ScalewayElasticMetalMachineTemplate) DeepCopy() *ScalewayElasticMetalMachineTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayElasticMetalMachineTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalewayElasticMetalMachineTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayElasticMetalMachineTemplateList) DeepCopyInto(out *ScalewayElasticMetalMachineTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScalewayElasticMetalMachineTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayElasticMetalMachineTemplateList.
+func (in *ScalewayElasticMetalMachineTemplateList) DeepCopy() *ScalewayElasticMetalMachineTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayElasticMetalMachineTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalewayElasticMetalMachineTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayElasticMetalMachineTemplateResource) DeepCopyInto(out *ScalewayElasticMetalMachineTemplateResource) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayElasticMetalMachineTemplateResource.
+func (in *ScalewayElasticMetalMachineTemplateResource) DeepCopy() *ScalewayElasticMetalMachineTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayElasticMetalMachineTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayElasticMetalMachineTemplateSpec) DeepCopyInto(out *ScalewayElasticMetalMachineTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayElasticMetalMachineTemplateSpec.
+func (in *ScalewayElasticMetalMachineTemplateSpec) DeepCopy() *ScalewayElasticMetalMachineTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayElasticMetalMachineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalewayMachine) DeepCopyInto(out *ScalewayMachine) {
 	*out = *in
@@ -667,6 +1281,22 @@ func (in *ScalewayMachine) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayMachineDeletionStatus) DeepCopyInto(out *ScalewayMachineDeletionStatus) {
+	*out = *in
+	in.GracefulShutdownStartTime.DeepCopyInto(&out.GracefulShutdownStartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachineDeletionStatus.
+func (in *ScalewayMachineDeletionStatus) DeepCopy() *ScalewayMachineDeletionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayMachineDeletionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalewayMachineInitializationStatus) DeepCopyInto(out *ScalewayMachineInitializationStatus) {
 	*out = *in
@@ -677,51 +1307,215 @@ func (in *ScalewayMachineInitializationStatus) DeepCopyInto(out *ScalewayMachine
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachineInitializationStatus.
-func (in *ScalewayMachineInitializationStatus) DeepCopy() *ScalewayMachineInitializationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachineInitializationStatus.
+func (in *ScalewayMachineInitializationStatus) DeepCopy() *ScalewayMachineInitializationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayMachineInitializationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayMachineList) DeepCopyInto(out *ScalewayMachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScalewayMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachineList.
+func (in *ScalewayMachineList) DeepCopy() *ScalewayMachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayMachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalewayMachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayMachinePool) DeepCopyInto(out *ScalewayMachinePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachinePool.
+func (in *ScalewayMachinePool) DeepCopy() *ScalewayMachinePool {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayMachinePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalewayMachinePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayMachinePoolInitializationStatus) DeepCopyInto(out *ScalewayMachinePoolInitializationStatus) {
+	*out = *in
+	if in.Provisioned != nil {
+		in, out := &in.Provisioned, &out.Provisioned
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachinePoolInitializationStatus.
+func (in *ScalewayMachinePoolInitializationStatus) DeepCopy() *ScalewayMachinePoolInitializationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayMachinePoolInitializationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayMachinePoolList) DeepCopyInto(out *ScalewayMachinePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScalewayMachinePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachinePoolList.
+func (in *ScalewayMachinePoolList) DeepCopy() *ScalewayMachinePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayMachinePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalewayMachinePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayMachinePoolSpec) DeepCopyInto(out *ScalewayMachinePoolSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	in.Strategy.DeepCopyInto(&out.Strategy)
+	if in.ProviderIDList != nil {
+		in, out := &in.ProviderIDList, &out.ProviderIDList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachinePoolSpec.
+func (in *ScalewayMachinePoolSpec) DeepCopy() *ScalewayMachinePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayMachinePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayMachinePoolStatus) DeepCopyInto(out *ScalewayMachinePoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ready != nil {
+		in, out := &in.Ready, &out.Ready
+		*out = new(bool)
+		**out = **in
+	}
+	in.Initialization.DeepCopyInto(&out.Initialization)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachinePoolStatus.
+func (in *ScalewayMachinePoolStatus) DeepCopy() *ScalewayMachinePoolStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ScalewayMachineInitializationStatus)
+	out := new(ScalewayMachinePoolStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScalewayMachineList) DeepCopyInto(out *ScalewayMachineList) {
+func (in *ScalewayMachinePoolStrategy) DeepCopyInto(out *ScalewayMachinePoolStrategy) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ScalewayMachine, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachineList.
-func (in *ScalewayMachineList) DeepCopy() *ScalewayMachineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachinePoolStrategy.
+func (in *ScalewayMachinePoolStrategy) DeepCopy() *ScalewayMachinePoolStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(ScalewayMachineList)
+	out := new(ScalewayMachinePoolStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ScalewayMachineList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalewayMachineSpec) DeepCopyInto(out *ScalewayMachineSpec) {
 	*out = *in
+	if in.FallbackCommercialTypes != nil {
+		in, out := &in.FallbackCommercialTypes, &out.FallbackCommercialTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	out.Image = in.Image
 	out.RootVolume = in.RootVolume
 	if in.AdditionalVolumes != nil {
@@ -730,8 +1524,29 @@ func (in *ScalewayMachineSpec) DeepCopyInto(out *ScalewayMachineSpec) {
 		copy(*out, *in)
 	}
 	in.PublicNetwork.DeepCopyInto(&out.PublicNetwork)
+	out.PrivateNetwork = in.PrivateNetwork
+	if in.AdditionalPrivateNetworks != nil {
+		in, out := &in.AdditionalPrivateNetworks, &out.AdditionalPrivateNetworks
+		*out = make([]PrivateNetworkReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.PlacementGroup = in.PlacementGroup
+	out.ManagedPlacementGroup = in.ManagedPlacementGroup
 	out.SecurityGroup = in.SecurityGroup
+	in.BootstrapData.DeepCopyInto(&out.BootstrapData)
+	if in.AdditionalTags != nil {
+		in, out := &in.AdditionalTags, &out.AdditionalTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.DeletionStrategy.DeepCopyInto(&out.DeletionStrategy)
+	if in.Protected != nil {
+		in, out := &in.Protected, &out.Protected
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachineSpec.
@@ -760,6 +1575,26 @@ func (in *ScalewayMachineStatus) DeepCopyInto(out *ScalewayMachineStatus) {
 		*out = make([]v1beta2.MachineAddress, len(*in))
 		copy(*out, *in)
 	}
+	if in.PublicIPs != nil {
+		in, out := &in.PublicIPs, &out.PublicIPs
+		*out = make([]MachinePublicIPStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateNetworks != nil {
+		in, out := &in.PrivateNetworks, &out.PrivateNetworks
+		*out = make([]MachinePrivateNetworkStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalVolumes != nil {
+		in, out := &in.AdditionalVolumes, &out.AdditionalVolumes
+		*out = make([]AdditionalVolumeStatus, len(*in))
+		copy(*out, *in)
+	}
+	out.Image = in.Image
+	out.RootVolume = in.RootVolume
+	in.Deletion.DeepCopyInto(&out.Deletion)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachineStatus.
@@ -778,6 +1613,7 @@ func (in *ScalewayMachineTemplate) DeepCopyInto(out *ScalewayMachineTemplate) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachineTemplate.
@@ -863,6 +1699,29 @@ func (in *ScalewayMachineTemplateSpec) DeepCopy() *ScalewayMachineTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayMachineTemplateStatus) DeepCopyInto(out *ScalewayMachineTemplateStatus) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	out.NodeInfo = in.NodeInfo
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachineTemplateStatus.
+func (in *ScalewayMachineTemplateStatus) DeepCopy() *ScalewayMachineTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayMachineTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalewayManagedCluster) DeepCopyInto(out *ScalewayManagedCluster) {
 	*out = *in
@@ -981,6 +1840,7 @@ func (in *ScalewayManagedClusterNetworkStatus) DeepCopy() *ScalewayManagedCluste
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalewayManagedClusterSpec) DeepCopyInto(out *ScalewayManagedClusterSpec) {
 	*out = *in
+	out.IdentityRef = in.IdentityRef
 	in.Network.DeepCopyInto(&out.Network)
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 }
@@ -1332,6 +2192,187 @@ func (in *ScalewayManagedMachinePoolStatus) DeepCopy() *ScalewayManagedMachinePo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayRemediation) DeepCopyInto(out *ScalewayRemediation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayRemediation.
+func (in *ScalewayRemediation) DeepCopy() *ScalewayRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalewayRemediation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayRemediationList) DeepCopyInto(out *ScalewayRemediationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScalewayRemediation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayRemediationList.
+func (in *ScalewayRemediationList) DeepCopy() *ScalewayRemediationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayRemediationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalewayRemediationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayRemediationSpec) DeepCopyInto(out *ScalewayRemediationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayRemediationSpec.
+func (in *ScalewayRemediationSpec) DeepCopy() *ScalewayRemediationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayRemediationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayRemediationStatus) DeepCopyInto(out *ScalewayRemediationStatus) {
+	*out = *in
+	in.LastRemediated.DeepCopyInto(&out.LastRemediated)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayRemediationStatus.
+func (in *ScalewayRemediationStatus) DeepCopy() *ScalewayRemediationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayRemediationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayRemediationTemplate) DeepCopyInto(out *ScalewayRemediationTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayRemediationTemplate.
+func (in *ScalewayRemediationTemplate) DeepCopy() *ScalewayRemediationTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayRemediationTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalewayRemediationTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayRemediationTemplateList) DeepCopyInto(out *ScalewayRemediationTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScalewayRemediationTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayRemediationTemplateList.
+func (in *ScalewayRemediationTemplateList) DeepCopy() *ScalewayRemediationTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayRemediationTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalewayRemediationTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayRemediationTemplateResource) DeepCopyInto(out *ScalewayRemediationTemplateResource) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayRemediationTemplateResource.
+func (in *ScalewayRemediationTemplateResource) DeepCopy() *ScalewayRemediationTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayRemediationTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalewayRemediationTemplateSpec) DeepCopyInto(out *ScalewayRemediationTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayRemediationTemplateSpec.
+func (in *ScalewayRemediationTemplateSpec) DeepCopy() *ScalewayRemediationTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalewayRemediationTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Scaling) DeepCopyInto(out *Scaling) {
 	*out = *in
@@ -1362,6 +2403,56 @@ func (in *Scaling) DeepCopy() *Scaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRule.
+func (in *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupsSpec) DeepCopyInto(out *SecurityGroupsSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NodePortAllowedRanges != nil {
+		in, out := &in.NodePortAllowedRanges, &out.NodePortAllowedRanges
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.ControlPlaneRules != nil {
+		in, out := &in.ControlPlaneRules, &out.ControlPlaneRules
+		*out = make([]SecurityGroupRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkerRules != nil {
+		in, out := &in.WorkerRules, &out.WorkerRules
+		*out = make([]SecurityGroupRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupsSpec.
+func (in *SecurityGroupsSpec) DeepCopy() *SecurityGroupsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpgradePolicy) DeepCopyInto(out *UpgradePolicy) {
 	*out = *in
@@ -51,10 +51,13 @@ func init() {
 
 // ADD CRD RBAC for CRD Migrator.
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
-// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions;customresourcedefinitions/status,verbs=update;patch,resourceNames=scalewayclusters.infrastructure.cluster.x-k8s.io;scalewayclustertemplates.infrastructure.cluster.x-k8s.io;scalewaymachines.infrastructure.cluster.x-k8s.io;scalewaymachinetemplates.infrastructure.cluster.x-k8s.io;scalewaymanagedclusters.infrastructure.cluster.x-k8s.io;scalewaymanagedcontrolplanes.infrastructure.cluster.x-k8s.io;scalewaymanagedmachinepools.infrastructure.cluster.x-k8s.io
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions;customresourcedefinitions/status,verbs=update;patch,resourceNames=scalewayclusteridentities.infrastructure.cluster.x-k8s.io;scalewayclusters.infrastructure.cluster.x-k8s.io;scalewayclustertemplates.infrastructure.cluster.x-k8s.io;scalewayelasticmetalmachines.infrastructure.cluster.x-k8s.io;scalewayelasticmetalmachinetemplates.infrastructure.cluster.x-k8s.io;scalewaymachines.infrastructure.cluster.x-k8s.io;scalewaymachinepools.infrastructure.cluster.x-k8s.io;scalewaymachinetemplates.infrastructure.cluster.x-k8s.io;scalewaymanagedclusters.infrastructure.cluster.x-k8s.io;scalewaymanagedcontrolplanes.infrastructure.cluster.x-k8s.io;scalewaymanagedmachinepools.infrastructure.cluster.x-k8s.io;scalewayremediations.infrastructure.cluster.x-k8s.io;scalewayremediationtemplates.infrastructure.cluster.x-k8s.io
 // ADD CR RBAC for CRD Migrator.
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayclusteridentities,verbs=get;list;watch;patch;update
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayclustertemplates,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayelasticmetalmachinetemplates,verbs=get;list;watch;patch;update
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewaymachinetemplates,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayremediationtemplates,verbs=get;list;watch;patch;update
 
 // nolint:gocyclo
 func main() {
@@ -220,6 +223,26 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "ScalewayManagedMachinePool")
 		os.Exit(1)
 	}
+	if err := controller.NewScalewayMachinePoolReconciler(mgr.GetClient()).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ScalewayMachinePool")
+		os.Exit(1)
+	}
+	if err := controller.NewScalewayClusterIdentityReconciler(mgr.GetClient()).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ScalewayClusterIdentity")
+		os.Exit(1)
+	}
+	if err := controller.NewScalewayMachineTemplateReconciler(mgr.GetClient()).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ScalewayMachineTemplate")
+		os.Exit(1)
+	}
+	if err := controller.NewScalewayElasticMetalMachineReconciler(mgr.GetClient()).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ScalewayElasticMetalMachine")
+		os.Exit(1)
+	}
+	if err := controller.NewScalewayRemediationReconciler(mgr.GetClient()).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ScalewayRemediation")
+		os.Exit(1)
+	}
 	// nolint:goconst
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
 		if err := webhookv1.SetupScalewayClusterWebhookWithManager(mgr); err != nil {
@@ -249,6 +272,13 @@ func main() {
 		}
 	}
 	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := webhookv1.SetupScalewayMachinePoolWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ScalewayMachinePool")
+			os.Exit(1)
+		}
+	}
+	// nolint:goconst
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
 		if err := webhookv1.SetupScalewayManagedClusterWebhookWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "ScalewayManagedCluster")
@@ -269,6 +299,20 @@ func main() {
 			os.Exit(1)
 		}
 	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := webhookv1.SetupScalewayElasticMetalMachineWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ScalewayElasticMetalMachine")
+			os.Exit(1)
+		}
+	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := webhookv1.SetupScalewayElasticMetalMachineTemplateWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ScalewayElasticMetalMachineTemplate")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	crdMigratorSkipPhases := []crdmigrator.Phase{}
@@ -282,13 +326,19 @@ func main() {
 		// Note: The kubebuilder RBAC markers above has to be kept in sync
 		// with the CRDs that should be migrated by this provider.
 		Config: map[client.Object]crdmigrator.ByObjectConfig{
-			&infrav1.ScalewayCluster{}:             {UseCache: true},
-			&infrav1.ScalewayClusterTemplate{}:     {UseCache: false},
-			&infrav1.ScalewayMachine{}:             {UseCache: true},
-			&infrav1.ScalewayClusterTemplate{}:     {UseCache: false},
-			&infrav1.ScalewayManagedCluster{}:      {UseCache: true},
-			&infrav1.ScalewayManagedControlPlane{}: {UseCache: true},
-			&infrav1.ScalewayManagedMachinePool{}:  {UseCache: true},
+			&infrav1.ScalewayCluster{}:                     {UseCache: true},
+			&infrav1.ScalewayClusterIdentity{}:             {UseCache: true},
+			&infrav1.ScalewayClusterTemplate{}:             {UseCache: false},
+			&infrav1.ScalewayElasticMetalMachine{}:         {UseCache: true},
+			&infrav1.ScalewayElasticMetalMachineTemplate{}: {UseCache: false},
+			&infrav1.ScalewayMachine{}:                     {UseCache: true},
+			&infrav1.ScalewayMachinePool{}:                 {UseCache: true},
+			&infrav1.ScalewayClusterTemplate{}:             {UseCache: false},
+			&infrav1.ScalewayManagedCluster{}:              {UseCache: true},
+			&infrav1.ScalewayManagedControlPlane{}:         {UseCache: true},
+			&infrav1.ScalewayManagedMachinePool{}:          {UseCache: true},
+			&infrav1.ScalewayRemediation{}:                 {UseCache: true},
+			&infrav1.ScalewayRemediationTemplate{}:         {UseCache: false},
 		},
 		// The CRDMigrator is run with only concurrency 1 to ensure we don't overwhelm
 		// the apiserver by patching a lot of CRs concurrently.
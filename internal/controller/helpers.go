@@ -18,12 +18,33 @@ import (
 
 var (
 	scalewaySecretOwnerAPIVersion = infrav1.GroupVersion.String()
-	scalewaySecretOwnerKinds      = []string{"ScalewayCluster", "ScalewayManagedCluster"}
+	scalewaySecretOwnerKinds      = []string{"ScalewayCluster", "ScalewayManagedCluster", "ScalewayClusterIdentity"}
 )
 
 // claimScalewaySecret adds an object as owner of a secret. It also adds a finalizer
-// (if not present already) to prevent the removal of the secret.
+// (if not present already) to prevent the removal of the secret. It does nothing
+// if secretName is empty, which is the case when a ScalewayClusterIdentity is used.
 func claimScalewaySecret(ctx context.Context, c client.Client, owner client.Object, secretName string) error {
+	if secretName == "" {
+		return nil
+	}
+
+	return claimSecret(ctx, c, owner, client.ObjectKey{Name: secretName, Namespace: owner.GetNamespace()})
+}
+
+// releaseScalewaySecret removes an object as owner of a secret. It also removes
+// the finalizer it there is no owner anymore. It does nothing if secretName is empty.
+func releaseScalewaySecret(ctx context.Context, c client.Client, owner client.Object, secretName string) error {
+	if secretName == "" {
+		return nil
+	}
+
+	return releaseSecret(ctx, c, owner, client.ObjectKey{Name: secretName, Namespace: owner.GetNamespace()})
+}
+
+// claimSecret adds an object as owner of the secret with the provided key and
+// adds the secret finalizer.
+func claimSecret(ctx context.Context, c client.Client, owner client.Object, key client.ObjectKey) error {
 	gvk, err := apiutil.GVKForObject(owner, c.Scheme())
 	if err != nil {
 		return fmt.Errorf("failed to get GVK for owner: %w", err)
@@ -34,10 +55,7 @@ func claimScalewaySecret(ctx context.Context, c client.Client, owner client.Obje
 	}
 
 	secret := &corev1.Secret{}
-	if err := c.Get(ctx, client.ObjectKey{
-		Name:      secretName,
-		Namespace: owner.GetNamespace(),
-	}, secret); err != nil {
+	if err := c.Get(ctx, key, secret); err != nil {
 		return err
 	}
 
@@ -55,9 +73,9 @@ func claimScalewaySecret(ctx context.Context, c client.Client, owner client.Obje
 	return secretHelper.Patch(ctx, secret)
 }
 
-// releaseScalewaySecret removes an object as owner of a secret. It also removes
-// the finalizer it there is no owner anymore.
-func releaseScalewaySecret(ctx context.Context, c client.Client, owner client.Object, secretName string) error {
+// releaseSecret removes an object as owner of the secret with the provided key.
+// It also removes the secret finalizer if there is no owner anymore.
+func releaseSecret(ctx context.Context, c client.Client, owner client.Object, key client.ObjectKey) error {
 	gvk, err := apiutil.GVKForObject(owner, c.Scheme())
 	if err != nil {
 		return fmt.Errorf("failed to get GVK for owner: %w", err)
@@ -68,10 +86,7 @@ func releaseScalewaySecret(ctx context.Context, c client.Client, owner client.Ob
 	}
 
 	secret := &corev1.Secret{}
-	if err := c.Get(ctx, client.ObjectKey{
-		Name:      secretName,
-		Namespace: owner.GetNamespace(),
-	}, secret); err != nil {
+	if err := c.Get(ctx, key, secret); err != nil {
 		if apierrors.IsNotFound(err) {
 			return nil
 		}
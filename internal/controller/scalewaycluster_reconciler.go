@@ -11,6 +11,7 @@ import (
 	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
 	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/domain"
 	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/lb"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/securitygroup"
 	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/vpc"
 	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/vpcgw"
 )
@@ -31,6 +32,7 @@ func newScalewayClusterService(s *scope.Cluster) *scalewayClusterService {
 			vpc.New(s),
 			vpcgw.New(s),
 			lb.New(s),
+			securitygroup.New(s),
 			domain.New(s),
 		},
 	}
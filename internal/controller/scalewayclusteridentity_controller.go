@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+)
+
+// ScalewayClusterIdentityReconciler reconciles a ScalewayClusterIdentity object.
+// It prevents the deletion of an identity, and of its secret, while the identity
+// is used by ScalewayCluster or ScalewayManagedCluster objects.
+type ScalewayClusterIdentityReconciler struct {
+	client.Client
+}
+
+// NewScalewayClusterIdentityReconciler returns a new ScalewayClusterIdentityReconciler.
+func NewScalewayClusterIdentityReconciler(c client.Client) *ScalewayClusterIdentityReconciler {
+	return &ScalewayClusterIdentityReconciler{
+		Client: c,
+	}
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayclusteridentities,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayclusteridentities/finalizers,verbs=update
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayclusters;scalewaymanagedclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;update;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *ScalewayClusterIdentityReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, retErr error) {
+	log := logf.FromContext(ctx)
+
+	identity := &infrav1.ScalewayClusterIdentity{}
+	if err := r.Get(ctx, req.NamespacedName, identity); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	patchHelper, err := patch.NewHelper(identity, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create patch helper: %w", err)
+	}
+
+	// Always patch the identity when exiting this function so the finalizer is persisted.
+	defer func() {
+		if err := patchHelper.Patch(ctx, identity); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+
+	secretKey := client.ObjectKey{
+		Name:      identity.Spec.SecretRef.Name,
+		Namespace: identity.Spec.SecretRef.Namespace,
+	}
+
+	if identity.DeletionTimestamp.IsZero() {
+		controllerutil.AddFinalizer(identity, infrav1.ScalewayClusterIdentityFinalizer)
+
+		if err := claimSecret(ctx, r.Client, identity, secretKey); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Info("Secret of ScalewayClusterIdentity is not available yet")
+				return ctrl.Result{RequeueAfter: DefaultRetryTime}, nil
+			}
+
+			return ctrl.Result{}, fmt.Errorf("unable to claim secret: %w", err)
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// The identity is reconciled again when the clusters that use it are deleted.
+	users, err := r.identityUsers(ctx, identity.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if len(users) > 0 {
+		log.Info("ScalewayClusterIdentity is still used, waiting for its clusters to be deleted", "clusters", users)
+		return ctrl.Result{}, nil
+	}
+
+	if err := releaseSecret(ctx, r.Client, identity, secretKey); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to release secret: %w", err)
+	}
+
+	controllerutil.RemoveFinalizer(identity, infrav1.ScalewayClusterIdentityFinalizer)
+
+	return ctrl.Result{}, nil
+}
+
+// identityUsers returns the namespaced names of the ScalewayCluster and
+// ScalewayManagedCluster objects that reference the identity.
+func (r *ScalewayClusterIdentityReconciler) identityUsers(ctx context.Context, name string) ([]string, error) {
+	var users []string
+
+	scalewayClusters := &infrav1.ScalewayClusterList{}
+	if err := r.List(ctx, scalewayClusters); err != nil {
+		return nil, fmt.Errorf("failed to list ScalewayClusters: %w", err)
+	}
+
+	for _, sc := range scalewayClusters.Items {
+		if sc.Spec.IdentityRef.Name == name {
+			users = append(users, client.ObjectKeyFromObject(&sc).String())
+		}
+	}
+
+	managedClusters := &infrav1.ScalewayManagedClusterList{}
+	if err := r.List(ctx, managedClusters); err != nil {
+		return nil, fmt.Errorf("failed to list ScalewayManagedClusters: %w", err)
+	}
+
+	for _, smc := range managedClusters.Items {
+		if smc.Spec.IdentityRef.Name == name {
+			users = append(users, client.ObjectKeyFromObject(&smc).String())
+		}
+	}
+
+	return users, nil
+}
+
+// clusterToScalewayClusterIdentity maps a ScalewayCluster or a ScalewayManagedCluster
+// to the ScalewayClusterIdentity it references.
+func clusterToScalewayClusterIdentity(_ context.Context, o client.Object) []reconcile.Request {
+	var name string
+
+	switch c := o.(type) {
+	case *infrav1.ScalewayCluster:
+		name = c.Spec.IdentityRef.Name
+	case *infrav1.ScalewayManagedCluster:
+		name = c.Spec.IdentityRef.Name
+	}
+
+	if name == "" {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: name}}}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ScalewayClusterIdentityReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.ScalewayClusterIdentity{}).
+		Named("scalewayclusteridentity").
+		// Watch the clusters to release the identity once they are deleted.
+		Watches(
+			&infrav1.ScalewayCluster{},
+			handler.EnqueueRequestsFromMapFunc(clusterToScalewayClusterIdentity),
+		).
+		Watches(
+			&infrav1.ScalewayManagedCluster{},
+			handler.EnqueueRequestsFromMapFunc(clusterToScalewayClusterIdentity),
+		).
+		Complete(r)
+}
@@ -0,0 +1,277 @@
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+)
+
+var _ = Describe("ScalewayClusterIdentity Controller", func() {
+	Context("When reconciling a resource", func() {
+		const resourceName = "test-resource"
+
+		typeNamespacedName := types.NamespacedName{
+			Name: resourceName,
+		}
+		scalewayclusteridentity := &infrav1.ScalewayClusterIdentity{}
+
+		BeforeEach(func(ctx SpecContext) {
+			By("creating the custom resource for the Kind ScalewayClusterIdentity")
+			err := k8sClient.Get(ctx, typeNamespacedName, scalewayclusteridentity)
+			if err != nil && errors.IsNotFound(err) {
+				resource := &infrav1.ScalewayClusterIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: resourceName,
+					},
+					Spec: infrav1.ScalewayClusterIdentitySpec{
+						SecretRef: infrav1.ScalewayClusterIdentitySecretReference{
+							Name:      "my-secret",
+							Namespace: "default",
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			}
+		})
+
+		AfterEach(func(ctx SpecContext) {
+			resource := &infrav1.ScalewayClusterIdentity{}
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Cleanup the specific resource instance ScalewayClusterIdentity")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+		It("should successfully reconcile the resource", func(ctx SpecContext) {
+			By("Reconciling the created resource")
+			controllerReconciler := NewScalewayClusterIdentityReconciler(k8sClient)
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
+
+var (
+	identityNamespacedName = types.NamespacedName{
+		Name: "identity",
+	}
+	identitySecretNamespacedName = types.NamespacedName{
+		Namespace: "caps-credentials",
+		Name:      "scaleway-secret",
+	}
+)
+
+func TestScalewayClusterIdentityReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	identitySecret := func(finalizers ...string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       identitySecretNamespacedName.Name,
+				Namespace:  identitySecretNamespacedName.Namespace,
+				Finalizers: finalizers,
+			},
+			Data: map[string][]byte{
+				scw.ScwAccessKeyEnv: []byte("SCWXXXXXXXXXXXXXXXXX"),
+				scw.ScwSecretKeyEnv: []byte("11111111-1111-1111-1111-111111111111"),
+			},
+		}
+	}
+
+	identity := func(deleted bool) *infrav1.ScalewayClusterIdentity {
+		sci := &infrav1.ScalewayClusterIdentity{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: identityNamespacedName.Name,
+			},
+			Spec: infrav1.ScalewayClusterIdentitySpec{
+				SecretRef: infrav1.ScalewayClusterIdentitySecretReference{
+					Name:      identitySecretNamespacedName.Name,
+					Namespace: identitySecretNamespacedName.Namespace,
+				},
+				AllowedNamespaces: &infrav1.AllowedNamespaces{},
+			},
+		}
+
+		if deleted {
+			sci.Finalizers = []string{infrav1.ScalewayClusterIdentityFinalizer}
+			sci.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		}
+
+		return sci
+	}
+
+	type args struct {
+		ctx context.Context
+		req ctrl.Request
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    ctrl.Result
+		wantErr bool
+		objects []client.Object
+		asserts func(g *WithT, c client.Client)
+	}{
+		{
+			name: "adds finalizer and claims secret",
+			args: args{
+				ctx: context.TODO(),
+				req: ctrl.Request{NamespacedName: identityNamespacedName},
+			},
+			want: ctrl.Result{},
+			objects: []client.Object{
+				identity(false),
+				identitySecret(),
+			},
+			asserts: func(g *WithT, c client.Client) {
+				sci := &infrav1.ScalewayClusterIdentity{}
+				g.Expect(c.Get(context.TODO(), identityNamespacedName, sci)).To(Succeed())
+				g.Expect(sci.Finalizers).To(ConsistOf(infrav1.ScalewayClusterIdentityFinalizer))
+
+				secret := &corev1.Secret{}
+				g.Expect(c.Get(context.TODO(), identitySecretNamespacedName, secret)).To(Succeed())
+				g.Expect(secret.Finalizers).To(ConsistOf(SecretFinalizer))
+				g.Expect(secret.OwnerReferences).To(HaveLen(1))
+				g.Expect(secret.OwnerReferences[0].Kind).To(Equal("ScalewayClusterIdentity"))
+				g.Expect(secret.OwnerReferences[0].Name).To(Equal(identityNamespacedName.Name))
+			},
+		},
+		{
+			name: "secret does not exist yet",
+			args: args{
+				ctx: context.TODO(),
+				req: ctrl.Request{NamespacedName: identityNamespacedName},
+			},
+			want: ctrl.Result{RequeueAfter: DefaultRetryTime},
+			objects: []client.Object{
+				identity(false),
+			},
+			asserts: func(g *WithT, c client.Client) {
+				sci := &infrav1.ScalewayClusterIdentity{}
+				g.Expect(c.Get(context.TODO(), identityNamespacedName, sci)).To(Succeed())
+				g.Expect(sci.Finalizers).To(ConsistOf(infrav1.ScalewayClusterIdentityFinalizer))
+			},
+		},
+		{
+			name: "deletion is blocked while identity is used",
+			args: args{
+				ctx: context.TODO(),
+				req: ctrl.Request{NamespacedName: identityNamespacedName},
+			},
+			want: ctrl.Result{},
+			objects: []client.Object{
+				identity(true),
+				identitySecret(SecretFinalizer),
+				&infrav1.ScalewayCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      scalewayClusterNamespacedName.Name,
+						Namespace: scalewayClusterNamespacedName.Namespace,
+					},
+					Spec: infrav1.ScalewayClusterSpec{
+						IdentityRef: infrav1.ScalewayClusterIdentityReference{
+							Name: identityNamespacedName.Name,
+						},
+					},
+				},
+			},
+			asserts: func(g *WithT, c client.Client) {
+				sci := &infrav1.ScalewayClusterIdentity{}
+				g.Expect(c.Get(context.TODO(), identityNamespacedName, sci)).To(Succeed())
+				g.Expect(sci.Finalizers).To(ConsistOf(infrav1.ScalewayClusterIdentityFinalizer))
+
+				secret := &corev1.Secret{}
+				g.Expect(c.Get(context.TODO(), identitySecretNamespacedName, secret)).To(Succeed())
+				g.Expect(secret.Finalizers).To(ConsistOf(SecretFinalizer))
+			},
+		},
+		{
+			name: "releases secret and removes finalizer when identity is unused",
+			args: args{
+				ctx: context.TODO(),
+				req: ctrl.Request{NamespacedName: identityNamespacedName},
+			},
+			want: ctrl.Result{},
+			objects: []client.Object{
+				identity(true),
+				identitySecret(SecretFinalizer),
+				&infrav1.ScalewayManagedCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other",
+						Namespace: "caps",
+					},
+					Spec: infrav1.ScalewayManagedClusterSpec{
+						IdentityRef: infrav1.ScalewayClusterIdentityReference{
+							Name: "other-identity",
+						},
+					},
+				},
+			},
+			asserts: func(g *WithT, c client.Client) {
+				sci := &infrav1.ScalewayClusterIdentity{}
+				g.Expect(errors.IsNotFound(c.Get(context.TODO(), identityNamespacedName, sci))).To(BeTrue())
+
+				secret := &corev1.Secret{}
+				g.Expect(c.Get(context.TODO(), identitySecretNamespacedName, secret)).To(Succeed())
+				g.Expect(secret.Finalizers).To(BeEmpty())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			sb := runtime.NewSchemeBuilder(
+				corev1.AddToScheme,
+				clusterv1.AddToScheme,
+				infrav1.AddToScheme,
+			)
+			s := runtime.NewScheme()
+
+			g.Expect(sb.AddToScheme(s)).To(Succeed())
+
+			runtimeObjects := make([]runtime.Object, 0, len(tt.objects))
+			for _, obj := range tt.objects {
+				runtimeObjects = append(runtimeObjects, obj)
+			}
+
+			c := fake.NewClientBuilder().
+				WithScheme(s).
+				WithRuntimeObjects(runtimeObjects...).
+				Build()
+
+			r := NewScalewayClusterIdentityReconciler(c)
+
+			got, err := r.Reconcile(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ScalewayClusterIdentityReconciler.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScalewayClusterIdentityReconciler.Reconcile() = %v, want %v", got, tt.want)
+			}
+
+			tt.asserts(g, c)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
+)
+
+// ScalewayElasticMetalMachineReconciler reconciles a ScalewayElasticMetalMachine object
+type ScalewayElasticMetalMachineReconciler struct {
+	client.Client
+
+	createScalewayElasticMetalMachineService scalewayElasticMetalMachineServiceCreator
+}
+
+// scalewayElasticMetalMachineServiceCreator is a function that creates a new scalewayElasticMetalMachineService reconciler.
+type scalewayElasticMetalMachineServiceCreator func(machineScope *scope.ElasticMetalMachine) *scalewayElasticMetalMachineService
+
+// NewScalewayElasticMetalMachineReconciler returns a new ScalewayElasticMetalMachineReconciler.
+func NewScalewayElasticMetalMachineReconciler(c client.Client) *ScalewayElasticMetalMachineReconciler {
+	return &ScalewayElasticMetalMachineReconciler{
+		Client:                                   c,
+		createScalewayElasticMetalMachineService: newScalewayElasticMetalMachineService,
+	}
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayelasticmetalmachines,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayelasticmetalmachines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayelasticmetalmachines/finalizers,verbs=update
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *ScalewayElasticMetalMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, retErr error) {
+	log := logf.FromContext(ctx)
+
+	scalewayElasticMetalMachine := &infrav1.ScalewayElasticMetalMachine{}
+	err := r.Get(ctx, req.NamespacedName, scalewayElasticMetalMachine)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Fetch the Machine.
+	machine, err := util.GetOwnerMachine(ctx, r.Client, scalewayElasticMetalMachine.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if machine == nil {
+		log.Info("Machine Controller has not yet set OwnerRef")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("machine", machine.Name)
+
+	// Fetch the Cluster.
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machine.ObjectMeta)
+	if err != nil {
+		log.Info("Machine is missing cluster label or cluster does not exist")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("cluster", cluster.Name)
+
+	log = log.WithValues("ScalewayCluster", cluster.Spec.InfrastructureRef.Name)
+	scalewayCluster := &infrav1.ScalewayCluster{}
+	if err := r.Client.Get(ctx, client.ObjectKey{
+		Namespace: scalewayElasticMetalMachine.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}, scalewayCluster); err != nil {
+		log.Info("ScalewayCluster is not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	// Create the cluster scope
+	clusterScope, err := scope.NewCluster(ctx, &scope.ClusterParams{
+		Client:          r.Client,
+		Cluster:         cluster,
+		ScalewayCluster: scalewayCluster,
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Create the machine scope
+	machineScope, err := scope.NewElasticMetalMachine(&scope.ElasticMetalMachineParams{
+		Client:                      r.Client,
+		ClusterScope:                clusterScope,
+		Machine:                     machine,
+		ScalewayElasticMetalMachine: scalewayElasticMetalMachine,
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
+	}
+
+	// Always close the scope when exiting this function so we can persist any ScalewayElasticMetalMachine changes.
+	defer func() {
+		if err := machineScope.Close(ctx); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+
+	if annotations.IsPaused(cluster, scalewayElasticMetalMachine) {
+		log.Info("ScalewayElasticMetalMachine or linked Cluster is marked as paused. Won't reconcile normally")
+		return ctrl.Result{}, nil
+	}
+
+	// Handle deleted machines
+	if !scalewayElasticMetalMachine.ObjectMeta.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, machineScope)
+	}
+
+	// Handle non-deleted machines
+	return r.reconcileNormal(ctx, machineScope, clusterScope)
+}
+
+func (r *ScalewayElasticMetalMachineReconciler) reconcileNormal(ctx context.Context, machineScope *scope.ElasticMetalMachine, clusterScope *scope.Cluster) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	log.Info("Reconciling ScalewayElasticMetalMachine")
+
+	scalewayElasticMetalMachine := machineScope.ScalewayElasticMetalMachine
+
+	// Register our finalizer immediately to avoid orphaning Scaleway resources on delete
+	if controllerutil.AddFinalizer(scalewayElasticMetalMachine, infrav1.ScalewayElasticMetalMachineFinalizer) {
+		if err := machineScope.PatchObject(ctx); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Make sure the Cluster Infrastructure is ready.
+	if !ptr.Deref(clusterScope.Cluster.Status.Initialization.InfrastructureProvisioned, false) {
+		log.Info("Cluster infrastructure is not ready yet")
+		return ctrl.Result{RequeueAfter: time.Second}, nil
+	}
+
+	// Make sure bootstrap data is available and populated.
+	if machineScope.Machine.Spec.Bootstrap.DataSecretName == nil {
+		log.Info("Bootstrap data secret reference is not yet available")
+		return ctrl.Result{RequeueAfter: time.Second}, nil
+	}
+
+	if err := r.createScalewayElasticMetalMachineService(machineScope).Reconcile(ctx); err != nil {
+		// Handle terminal & transient errors
+		var reconcileError *scaleway.ReconcileError
+		if errors.As(err, &reconcileError) && reconcileError.RequeueAfter() != 0 {
+			log.Info(fmt.Sprintf("Transient failure to reconcile ScalewayElasticMetalMachine, retrying: %s", reconcileError.Error()))
+			return ctrl.Result{RequeueAfter: reconcileError.RequeueAfter()}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile machine services: %w", err)
+	}
+
+	scalewayElasticMetalMachine.Status.Initialization.Provisioned = ptr.To(true)
+
+	return ctrl.Result{}, nil
+}
+
+func (r *ScalewayElasticMetalMachineReconciler) reconcileDelete(ctx context.Context, machineScope *scope.ElasticMetalMachine) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	log.Info("Reconciling ScalewayElasticMetalMachine delete")
+
+	if err := r.createScalewayElasticMetalMachineService(machineScope).Delete(ctx); err != nil {
+		// Handle transient errors
+		var reconcileError *scaleway.ReconcileError
+		if errors.As(err, &reconcileError) && reconcileError.RequeueAfter() != 0 {
+			log.Info(fmt.Sprintf("Transient failure to reconcile ScalewayElasticMetalMachine, retrying: %s", reconcileError.Error()))
+			return ctrl.Result{RequeueAfter: reconcileError.RequeueAfter()}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to delete machine services: %w", err)
+	}
+
+	// Machine is deleted so remove the finalizer.
+	controllerutil.RemoveFinalizer(machineScope.ScalewayElasticMetalMachine, infrav1.ScalewayElasticMetalMachineFinalizer)
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ScalewayElasticMetalMachineReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.ScalewayElasticMetalMachine{}).
+		// Watch for changes to Machine and enqueue requests for ScalewayElasticMetalMachine
+		// when the NodeRef becomes available in order to remove cloud-init user data.
+		Watches(
+			&clusterv1.Machine{},
+			handler.EnqueueRequestsFromMapFunc(util.MachineToInfrastructureMapFunc(infrav1.GroupVersion.WithKind("ScalewayElasticMetalMachine"))),
+			builder.WithPredicates(machineUpdateNodeRefAvailable()),
+		).
+		Named("scalewayelasticmetalmachine").
+		Complete(r)
+}
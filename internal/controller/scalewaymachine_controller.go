@@ -7,6 +7,7 @@ import (
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util"
@@ -26,10 +27,15 @@ import (
 	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
 )
 
+// serverHealthCheckPeriod is the period at which the server state is checked
+// once the node has joined the cluster.
+const serverHealthCheckPeriod = time.Minute
+
 // ScalewayMachineReconciler reconciles a ScalewayMachine object
 type ScalewayMachineReconciler struct {
 	client.Client
 
+	recorder                     events.EventRecorder
 	createScalewayMachineService scalewayMachineServiceCreator
 }
 
@@ -48,6 +54,7 @@ func NewScalewayMachineReconciler(c client.Client) *ScalewayMachineReconciler {
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewaymachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewaymachines/finalizers,verbs=update
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -111,6 +118,7 @@ func (r *ScalewayMachineReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		ClusterScope:    clusterScope,
 		Machine:         machine,
 		ScalewayMachine: scalewayMachine,
+		Recorder:        r.recorder,
 	})
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
@@ -183,6 +191,11 @@ func (r *ScalewayMachineReconciler) reconcileNormal(ctx context.Context, machine
 
 	scalewayMachine.Status.Initialization.Provisioned = ptr.To(true)
 
+	// Keep polling the server state once the node has joined the cluster.
+	if machineScope.HasJoinedCluster() {
+		return ctrl.Result{RequeueAfter: serverHealthCheckPeriod}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
@@ -210,6 +223,8 @@ func (r *ScalewayMachineReconciler) reconcileDelete(ctx context.Context, machine
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ScalewayMachineReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorder("scalewaymachine-controller")
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1.ScalewayMachine{}).
 		// Watch for changes to Machine and enqueue requests for ScalewayMachine
@@ -0,0 +1,226 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
+)
+
+// ScalewayMachinePoolReconciler reconciles a ScalewayMachinePool object
+type ScalewayMachinePoolReconciler struct {
+	client.Client
+
+	createScalewayMachinePoolService scalewayMachinePoolServiceCreator
+}
+
+// scalewayMachinePoolServiceCreator is a function that creates a new scalewayMachinePoolService reconciler.
+type scalewayMachinePoolServiceCreator func(machinePoolScope *scope.MachinePool) *scalewayMachinePoolService
+
+// NewScalewayMachinePoolReconciler returns a new ScalewayMachinePoolReconciler.
+func NewScalewayMachinePoolReconciler(c client.Client) *ScalewayMachinePoolReconciler {
+	return &ScalewayMachinePoolReconciler{
+		Client:                           c,
+		createScalewayMachinePoolService: newScalewayMachinePoolService,
+	}
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewaymachinepools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewaymachinepools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewaymachinepools/finalizers,verbs=update
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools;machinepools/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *ScalewayMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, retErr error) {
+	log := logf.FromContext(ctx)
+
+	scalewayMachinePool := &infrav1.ScalewayMachinePool{}
+	if err := r.Get(ctx, req.NamespacedName, scalewayMachinePool); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Fetch the MachinePool.
+	machinePool, err := getOwnerMachinePool(ctx, r.Client, scalewayMachinePool.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if machinePool == nil {
+		log.Info("MachinePool Controller has not yet set OwnerRef")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("machinePool", machinePool.Name)
+
+	// Fetch the Cluster.
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machinePool.ObjectMeta)
+	if err != nil {
+		log.Info("MachinePool is missing cluster label or cluster does not exist")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("cluster", cluster.Name)
+
+	log = log.WithValues("ScalewayCluster", cluster.Spec.InfrastructureRef.Name)
+	scalewayCluster := &infrav1.ScalewayCluster{}
+	if err := r.Client.Get(ctx, client.ObjectKey{
+		Namespace: scalewayMachinePool.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}, scalewayCluster); err != nil {
+		log.Info("ScalewayCluster is not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	// Create the cluster scope
+	clusterScope, err := scope.NewCluster(ctx, &scope.ClusterParams{
+		Client:          r.Client,
+		Cluster:         cluster,
+		ScalewayCluster: scalewayCluster,
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Create the machine pool scope
+	machinePoolScope, err := scope.NewMachinePool(&scope.MachinePoolParams{
+		Client:              r.Client,
+		ClusterScope:        clusterScope,
+		MachinePool:         machinePool,
+		ScalewayMachinePool: scalewayMachinePool,
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
+	}
+
+	// Always close the scope when exiting this function so we can persist any ScalewayMachinePool changes.
+	defer func() {
+		if err := machinePoolScope.Close(ctx); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+
+	if annotations.IsPaused(cluster, scalewayMachinePool) {
+		log.Info("ScalewayMachinePool or linked Cluster is marked as paused. Won't reconcile normally")
+		return ctrl.Result{}, nil
+	}
+
+	// Handle deleted machine pools
+	if !scalewayMachinePool.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, machinePoolScope)
+	}
+
+	// Handle non-deleted machine pools
+	return r.reconcileNormal(ctx, machinePoolScope)
+}
+
+func (r *ScalewayMachinePoolReconciler) reconcileNormal(ctx context.Context, machinePoolScope *scope.MachinePool) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	log.Info("Reconciling ScalewayMachinePool")
+
+	scalewayMachinePool := machinePoolScope.ScalewayMachinePool
+
+	// Register our finalizer immediately to avoid orphaning Scaleway resources on delete
+	if controllerutil.AddFinalizer(scalewayMachinePool, infrav1.ScalewayMachinePoolFinalizer) {
+		if err := machinePoolScope.PatchObject(ctx); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Make sure the Cluster Infrastructure is ready.
+	if !ptr.Deref(machinePoolScope.Cluster.Cluster.Status.Initialization.InfrastructureProvisioned, false) {
+		log.Info("Cluster infrastructure is not ready yet")
+		return ctrl.Result{RequeueAfter: time.Second}, nil
+	}
+
+	// Make sure bootstrap data is available and populated.
+	if !machinePoolScope.HasBootstrapData() {
+		log.Info("Bootstrap data secret reference is not yet available")
+		return ctrl.Result{RequeueAfter: time.Second}, nil
+	}
+
+	if err := r.createScalewayMachinePoolService(machinePoolScope).Reconcile(ctx); err != nil {
+		// Handle terminal & transient errors
+		var reconcileError *scaleway.ReconcileError
+		if errors.As(err, &reconcileError) && reconcileError.RequeueAfter() != 0 {
+			log.Info(fmt.Sprintf("Transient failure to reconcile ScalewayMachinePool, retrying: %s", reconcileError.Error()))
+			return ctrl.Result{RequeueAfter: reconcileError.RequeueAfter()}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile machine pool services: %w", err)
+	}
+
+	scalewayMachinePool.Status.Initialization.Provisioned = ptr.To(true)
+	scalewayMachinePool.Status.Ready = ptr.To(true)
+
+	return ctrl.Result{}, nil
+}
+
+func (r *ScalewayMachinePoolReconciler) reconcileDelete(ctx context.Context, machinePoolScope *scope.MachinePool) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	log.Info("Reconciling ScalewayMachinePool delete")
+
+	if err := r.createScalewayMachinePoolService(machinePoolScope).Delete(ctx); err != nil {
+		// Handle transient errors
+		var reconcileError *scaleway.ReconcileError
+		if errors.As(err, &reconcileError) && reconcileError.RequeueAfter() != 0 {
+			log.Info(fmt.Sprintf("Transient failure to reconcile ScalewayMachinePool, retrying: %s", reconcileError.Error()))
+			return ctrl.Result{RequeueAfter: reconcileError.RequeueAfter()}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to delete machine pool services: %w", err)
+	}
+
+	// Machine pool is deleted so remove the finalizer.
+	controllerutil.RemoveFinalizer(machinePoolScope.ScalewayMachinePool, infrav1.ScalewayMachinePoolFinalizer)
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ScalewayMachinePoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	scalewayMachinePoolMapper, err := util.ClusterToTypedObjectsMapper(r.Client, &infrav1.ScalewayMachinePoolList{}, mgr.GetScheme())
+	if err != nil {
+		return fmt.Errorf("failed to create mapper for Cluster to ScalewayMachinePools: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.ScalewayMachinePool{}).
+		Named("scalewaymachinepool").
+		// Watch for changes to MachinePool (replicas, failure domains, bootstrap
+		// data and node references) and enqueue requests for ScalewayMachinePool.
+		Watches(
+			&clusterv1.MachinePool{},
+			handler.EnqueueRequestsFromMapFunc(machinePoolToInfrastructureMapFunc(infrav1.GroupVersion.WithKind("ScalewayMachinePool"))),
+		).
+		// Add a watch on clusterv1.Cluster object for pause/unpause & ready notifications.
+		Watches(
+			&clusterv1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(scalewayMachinePoolMapper),
+			builder.WithPredicates(predicates.ClusterPausedTransitionsOrInfrastructureProvisioned(mgr.GetScheme(), mgr.GetLogger())),
+		).
+		Complete(r)
+}
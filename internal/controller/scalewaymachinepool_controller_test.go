@@ -0,0 +1,290 @@
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+)
+
+var _ = Describe("ScalewayMachinePool Controller", func() {
+	Context("When reconciling a resource", func() {
+		const resourceName = "test-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		scalewaymachinepool := &infrav1.ScalewayMachinePool{}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind ScalewayMachinePool")
+			err := k8sClient.Get(ctx, typeNamespacedName, scalewaymachinepool)
+			if err != nil && errors.IsNotFound(err) {
+				resource := &infrav1.ScalewayMachinePool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resourceName,
+						Namespace: "default",
+					},
+					Spec: infrav1.ScalewayMachinePoolSpec{
+						Template: infrav1.ScalewayMachineSpec{
+							CommercialType: "PRO2-S",
+							Image: infrav1.Image{
+								Label: "ubuntu_focal",
+							},
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			resource := &infrav1.ScalewayMachinePool{}
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Cleanup the specific resource instance ScalewayMachinePool")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+		It("should successfully reconcile the resource", func() {
+			By("Reconciling the created resource")
+			controllerReconciler := &ScalewayMachinePoolReconciler{
+				Client:                           k8sClient,
+				createScalewayMachinePoolService: newScalewayMachinePoolService,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
+
+var scalewayMachinePoolNamespacedName = types.NamespacedName{
+	Namespace: "caps",
+	Name:      "scalewaymachinepool",
+}
+
+func TestScalewayMachinePoolReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		createScalewayMachinePoolService scalewayMachinePoolServiceCreator
+	}
+	type args struct {
+		ctx context.Context
+		req ctrl.Request
+	}
+
+	newObjects := func(scalewayMachinePool *infrav1.ScalewayMachinePool) []client.Object {
+		return []client.Object{
+			&infrav1.ScalewayCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      scalewayClusterNamespacedName.Name,
+					Namespace: scalewayClusterNamespacedName.Namespace,
+				},
+				Spec: infrav1.ScalewayClusterSpec{
+					Region:             "fr-par",
+					ScalewaySecretName: secretNamespacedName.Name,
+					ProjectID:          "11111111-1111-1111-1111-111111111111",
+				},
+			},
+			&clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      clusterNamespacedName.Name,
+					Namespace: clusterNamespacedName.Namespace,
+				},
+				Spec: clusterv1.ClusterSpec{
+					InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+						Name: scalewayClusterNamespacedName.Name,
+					},
+				},
+				Status: clusterv1.ClusterStatus{
+					Initialization: clusterv1.ClusterInitializationStatus{
+						InfrastructureProvisioned: ptr.To(true),
+					},
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      secretNamespacedName.Name,
+					Namespace: secretNamespacedName.Namespace,
+				},
+				Data: map[string][]byte{
+					scw.ScwAccessKeyEnv: []byte("SCWXXXXXXXXXXXXXXXXX"),
+					scw.ScwSecretKeyEnv: []byte("11111111-1111-1111-1111-111111111111"),
+				},
+			},
+			scalewayMachinePool,
+			&clusterv1.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      machinePoolNamespacedName.Name,
+					Namespace: machinePoolNamespacedName.Namespace,
+					Labels: map[string]string{
+						clusterv1.ClusterNameLabel: clusterNamespacedName.Name,
+					},
+				},
+				Spec: clusterv1.MachinePoolSpec{
+					ClusterName: clusterNamespacedName.Name,
+					Template: clusterv1.MachineTemplateSpec{
+						Spec: clusterv1.MachineSpec{
+							Bootstrap: clusterv1.Bootstrap{
+								DataSecretName: ptr.To("bootstrap"),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    ctrl.Result
+		wantErr bool
+		objects []client.Object
+		asserts func(g *WithT, c client.Client)
+	}{
+		{
+			name: "should reconcile normally",
+			fields: fields{
+				createScalewayMachinePoolService: func(machinePoolScope *scope.MachinePool) *scalewayMachinePoolService {
+					return &scalewayMachinePoolService{
+						scope:     machinePoolScope,
+						Reconcile: func(ctx context.Context) error { return nil },
+						Delete:    func(ctx context.Context) error { return nil },
+					}
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: reconcile.Request{
+					NamespacedName: scalewayMachinePoolNamespacedName,
+				},
+			},
+			objects: newObjects(&infrav1.ScalewayMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      scalewayMachinePoolNamespacedName.Name,
+					Namespace: scalewayMachinePoolNamespacedName.Namespace,
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							Name:       machinePoolNamespacedName.Name,
+							Kind:       "MachinePool",
+							APIVersion: clusterv1.GroupVersion.String(),
+						},
+					},
+				},
+			}),
+			asserts: func(g *WithT, c client.Client) {
+				// ScalewayMachinePool checks
+				smp := &infrav1.ScalewayMachinePool{}
+				g.Expect(c.Get(context.TODO(), scalewayMachinePoolNamespacedName, smp)).To(Succeed())
+				g.Expect(smp.Status.Initialization.Provisioned).To(Equal(ptr.To(true)))
+				g.Expect(smp.Status.Ready).To(Equal(ptr.To(true)))
+				g.Expect(smp.Finalizers).To(ContainElement(infrav1.ScalewayMachinePoolFinalizer))
+			},
+		},
+		{
+			name: "should reconcile deletion",
+			fields: fields{
+				createScalewayMachinePoolService: func(machinePoolScope *scope.MachinePool) *scalewayMachinePoolService {
+					return &scalewayMachinePoolService{
+						scope:     machinePoolScope,
+						Reconcile: func(ctx context.Context) error { return nil },
+						Delete:    func(ctx context.Context) error { return nil },
+					}
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: reconcile.Request{
+					NamespacedName: scalewayMachinePoolNamespacedName,
+				},
+			},
+			objects: newObjects(&infrav1.ScalewayMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      scalewayMachinePoolNamespacedName.Name,
+					Namespace: scalewayMachinePoolNamespacedName.Namespace,
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							Name:       machinePoolNamespacedName.Name,
+							Kind:       "MachinePool",
+							APIVersion: clusterv1.GroupVersion.String(),
+						},
+					},
+					Finalizers:        []string{infrav1.ScalewayMachinePoolFinalizer},
+					DeletionTimestamp: &metav1.Time{Time: time.Now()},
+				},
+			}),
+			asserts: func(g *WithT, c client.Client) {
+				// ScalewayMachinePool should not exist anymore if the finalizer was correctly removed.
+				smp := &infrav1.ScalewayMachinePool{}
+				g.Expect(c.Get(context.TODO(), scalewayMachinePoolNamespacedName, smp)).NotTo(Succeed())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			sb := runtime.NewSchemeBuilder(
+				corev1.AddToScheme,
+				clusterv1.AddToScheme,
+				infrav1.AddToScheme,
+			)
+			s := runtime.NewScheme()
+
+			g.Expect(sb.AddToScheme(s)).To(Succeed())
+
+			runtimeObjects := make([]runtime.Object, 0, len(tt.objects))
+			for _, obj := range tt.objects {
+				runtimeObjects = append(runtimeObjects, obj)
+			}
+
+			c := fake.NewClientBuilder().
+				WithScheme(s).
+				WithRuntimeObjects(runtimeObjects...).
+				WithStatusSubresource(tt.objects...).
+				Build()
+
+			r := &ScalewayMachinePoolReconciler{
+				Client:                           c,
+				createScalewayMachinePoolService: tt.fields.createScalewayMachinePoolService,
+			}
+			got, err := r.Reconcile(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ScalewayMachinePoolReconciler.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScalewayMachinePoolReconciler.Reconcile() = %v, want %v", got, tt.want)
+			}
+
+			tt.asserts(g, c)
+		})
+	}
+}
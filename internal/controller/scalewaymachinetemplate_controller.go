@@ -0,0 +1,162 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
+)
+
+// ScalewayMachineTemplateReconciler reconciles a ScalewayMachineTemplate object
+type ScalewayMachineTemplateReconciler struct {
+	client.Client
+
+	createScalewayMachineTemplateService scalewayMachineTemplateServiceCreator
+}
+
+// scalewayMachineTemplateServiceCreator is a function that creates a new scalewayMachineTemplateService reconciler.
+type scalewayMachineTemplateServiceCreator func(machineTemplateScope *scope.MachineTemplate) *scalewayMachineTemplateService
+
+// NewScalewayMachineTemplateReconciler returns a new ScalewayMachineTemplateReconciler.
+func NewScalewayMachineTemplateReconciler(c client.Client) *ScalewayMachineTemplateReconciler {
+	return &ScalewayMachineTemplateReconciler{
+		Client:                               c,
+		createScalewayMachineTemplateService: newScalewayMachineTemplateService,
+	}
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewaymachinetemplates,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewaymachinetemplates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *ScalewayMachineTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, retErr error) {
+	log := logf.FromContext(ctx)
+
+	scalewayMachineTemplate := &infrav1.ScalewayMachineTemplate{}
+	if err := r.Get(ctx, req.NamespacedName, scalewayMachineTemplate); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Nothing to do if the template is being deleted.
+	if !scalewayMachineTemplate.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// Fetch the Cluster. The template is owned by the Cluster once it is used
+	// by a MachineDeployment, it may also have the cluster name label.
+	cluster, err := util.GetOwnerCluster(ctx, r.Client, scalewayMachineTemplate.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if cluster == nil {
+		cluster, err = util.GetClusterFromMetadata(ctx, r.Client, scalewayMachineTemplate.ObjectMeta)
+		if err != nil {
+			log.Info("ScalewayMachineTemplate is not linked to a Cluster yet")
+			return ctrl.Result{}, nil
+		}
+	}
+
+	log = log.WithValues("cluster", cluster.Name)
+
+	if annotations.IsPaused(cluster, scalewayMachineTemplate) {
+		log.Info("ScalewayMachineTemplate or linked Cluster is marked as paused. Won't reconcile normally")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("ScalewayCluster", cluster.Spec.InfrastructureRef.Name)
+	scalewayCluster := &infrav1.ScalewayCluster{}
+	if err := r.Client.Get(ctx, client.ObjectKey{
+		Namespace: scalewayMachineTemplate.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}, scalewayCluster); err != nil {
+		log.Info("ScalewayCluster is not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	// Create the cluster scope
+	clusterScope, err := scope.NewCluster(ctx, &scope.ClusterParams{
+		Client:          r.Client,
+		Cluster:         cluster,
+		ScalewayCluster: scalewayCluster,
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Create the machine template scope
+	machineTemplateScope, err := scope.NewMachineTemplate(&scope.MachineTemplateParams{
+		Client:                  r.Client,
+		ClusterScope:            clusterScope,
+		ScalewayMachineTemplate: scalewayMachineTemplate,
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
+	}
+
+	// Always close the scope when exiting this function so we can persist any ScalewayMachineTemplate changes.
+	defer func() {
+		if err := machineTemplateScope.Close(ctx); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+
+	return r.reconcileNormal(ctx, machineTemplateScope)
+}
+
+func (r *ScalewayMachineTemplateReconciler) reconcileNormal(ctx context.Context, machineTemplateScope *scope.MachineTemplate) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	log.Info("Reconciling ScalewayMachineTemplate")
+
+	if err := r.createScalewayMachineTemplateService(machineTemplateScope).Reconcile(ctx); err != nil {
+		// Handle terminal & transient errors
+		var reconcileError *scaleway.ReconcileError
+		if errors.As(err, &reconcileError) && reconcileError.RequeueAfter() != 0 {
+			log.Info(fmt.Sprintf("Transient failure to reconcile ScalewayMachineTemplate, retrying: %s", reconcileError.Error()))
+			return ctrl.Result{RequeueAfter: reconcileError.RequeueAfter()}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile machine template services: %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ScalewayMachineTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	scalewayMachineTemplateMapper, err := util.ClusterToTypedObjectsMapper(r.Client, &infrav1.ScalewayMachineTemplateList{}, mgr.GetScheme())
+	if err != nil {
+		return fmt.Errorf("failed to create mapper for Cluster to ScalewayMachineTemplates: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.ScalewayMachineTemplate{}).
+		Named("scalewaymachinetemplate").
+		// Add a watch on clusterv1.Cluster object for pause/unpause & ready notifications.
+		Watches(
+			&clusterv1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(scalewayMachineTemplateMapper),
+			builder.WithPredicates(predicates.ClusterPausedTransitionsOrInfrastructureProvisioned(mgr.GetScheme(), mgr.GetLogger())),
+		).
+		Complete(r)
+}
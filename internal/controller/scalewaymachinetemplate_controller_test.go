@@ -0,0 +1,310 @@
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+)
+
+var _ = Describe("ScalewayMachineTemplate Controller", func() {
+	Context("When reconciling a resource", func() {
+		const resourceName = "test-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		scalewaymachinetemplate := &infrav1.ScalewayMachineTemplate{}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind ScalewayMachineTemplate")
+			err := k8sClient.Get(ctx, typeNamespacedName, scalewaymachinetemplate)
+			if err != nil && errors.IsNotFound(err) {
+				resource := &infrav1.ScalewayMachineTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resourceName,
+						Namespace: "default",
+					},
+					Spec: infrav1.ScalewayMachineTemplateSpec{
+						Template: infrav1.ScalewayMachineTemplateResource{
+							Spec: infrav1.ScalewayMachineSpec{
+								CommercialType: "PRO2-S",
+								Image: infrav1.Image{
+									Label: "ubuntu_focal",
+								},
+							},
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			resource := &infrav1.ScalewayMachineTemplate{}
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Cleanup the specific resource instance ScalewayMachineTemplate")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+		It("should successfully reconcile the resource", func() {
+			By("Reconciling the created resource")
+			controllerReconciler := &ScalewayMachineTemplateReconciler{
+				Client:                               k8sClient,
+				createScalewayMachineTemplateService: newScalewayMachineTemplateService,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
+
+var scalewayMachineTemplateNamespacedName = types.NamespacedName{
+	Namespace: "caps",
+	Name:      "scalewaymachinetemplate",
+}
+
+func TestScalewayMachineTemplateReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		createScalewayMachineTemplateService scalewayMachineTemplateServiceCreator
+	}
+	type args struct {
+		ctx context.Context
+		req ctrl.Request
+	}
+
+	newObjects := func(scalewayMachineTemplate *infrav1.ScalewayMachineTemplate) []client.Object {
+		return []client.Object{
+			&infrav1.ScalewayCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      scalewayClusterNamespacedName.Name,
+					Namespace: scalewayClusterNamespacedName.Namespace,
+				},
+				Spec: infrav1.ScalewayClusterSpec{
+					Region:             "fr-par",
+					ScalewaySecretName: secretNamespacedName.Name,
+					ProjectID:          "11111111-1111-1111-1111-111111111111",
+				},
+			},
+			&clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      clusterNamespacedName.Name,
+					Namespace: clusterNamespacedName.Namespace,
+				},
+				Spec: clusterv1.ClusterSpec{
+					InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+						Name: scalewayClusterNamespacedName.Name,
+					},
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      secretNamespacedName.Name,
+					Namespace: secretNamespacedName.Namespace,
+				},
+				Data: map[string][]byte{
+					scw.ScwAccessKeyEnv: []byte("SCWXXXXXXXXXXXXXXXXX"),
+					scw.ScwSecretKeyEnv: []byte("11111111-1111-1111-1111-111111111111"),
+				},
+			},
+			scalewayMachineTemplate,
+		}
+	}
+
+	setCapacity := func(machineTemplateScope *scope.MachineTemplate) *scalewayMachineTemplateService {
+		return &scalewayMachineTemplateService{
+			scope: machineTemplateScope,
+			Reconcile: func(ctx context.Context) error {
+				machineTemplateScope.ScalewayMachineTemplate.Status.Capacity = corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("2"),
+				}
+				return nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    ctrl.Result
+		wantErr bool
+		objects []client.Object
+		asserts func(g *WithT, c client.Client)
+	}{
+		{
+			name: "should reconcile template owned by cluster",
+			fields: fields{
+				createScalewayMachineTemplateService: setCapacity,
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: reconcile.Request{
+					NamespacedName: scalewayMachineTemplateNamespacedName,
+				},
+			},
+			objects: newObjects(&infrav1.ScalewayMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      scalewayMachineTemplateNamespacedName.Name,
+					Namespace: scalewayMachineTemplateNamespacedName.Namespace,
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							Name:       clusterNamespacedName.Name,
+							Kind:       "Cluster",
+							APIVersion: clusterv1.GroupVersion.String(),
+						},
+					},
+				},
+			}),
+			asserts: func(g *WithT, c client.Client) {
+				smt := &infrav1.ScalewayMachineTemplate{}
+				g.Expect(c.Get(context.TODO(), scalewayMachineTemplateNamespacedName, smt)).To(Succeed())
+				g.Expect(smt.Status.Capacity.Cpu().Equal(resource.MustParse("2"))).To(BeTrue())
+			},
+		},
+		{
+			name: "should reconcile template with cluster label",
+			fields: fields{
+				createScalewayMachineTemplateService: setCapacity,
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: reconcile.Request{
+					NamespacedName: scalewayMachineTemplateNamespacedName,
+				},
+			},
+			objects: newObjects(&infrav1.ScalewayMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      scalewayMachineTemplateNamespacedName.Name,
+					Namespace: scalewayMachineTemplateNamespacedName.Namespace,
+					Labels: map[string]string{
+						clusterv1.ClusterNameLabel: clusterNamespacedName.Name,
+					},
+				},
+			}),
+			asserts: func(g *WithT, c client.Client) {
+				smt := &infrav1.ScalewayMachineTemplate{}
+				g.Expect(c.Get(context.TODO(), scalewayMachineTemplateNamespacedName, smt)).To(Succeed())
+				g.Expect(smt.Status.Capacity.Cpu().Equal(resource.MustParse("2"))).To(BeTrue())
+			},
+		},
+		{
+			name: "should skip template not linked to a cluster",
+			fields: fields{
+				createScalewayMachineTemplateService: setCapacity,
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: reconcile.Request{
+					NamespacedName: scalewayMachineTemplateNamespacedName,
+				},
+			},
+			objects: newObjects(&infrav1.ScalewayMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      scalewayMachineTemplateNamespacedName.Name,
+					Namespace: scalewayMachineTemplateNamespacedName.Namespace,
+				},
+			}),
+			asserts: func(g *WithT, c client.Client) {
+				smt := &infrav1.ScalewayMachineTemplate{}
+				g.Expect(c.Get(context.TODO(), scalewayMachineTemplateNamespacedName, smt)).To(Succeed())
+				g.Expect(smt.Status.Capacity).To(BeEmpty())
+			},
+		},
+		{
+			name: "should not reconcile paused template",
+			fields: fields{
+				createScalewayMachineTemplateService: setCapacity,
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: reconcile.Request{
+					NamespacedName: scalewayMachineTemplateNamespacedName,
+				},
+			},
+			objects: newObjects(&infrav1.ScalewayMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      scalewayMachineTemplateNamespacedName.Name,
+					Namespace: scalewayMachineTemplateNamespacedName.Namespace,
+					Labels: map[string]string{
+						clusterv1.ClusterNameLabel: clusterNamespacedName.Name,
+					},
+					Annotations: map[string]string{
+						clusterv1.PausedAnnotation: "true",
+					},
+				},
+			}),
+			asserts: func(g *WithT, c client.Client) {
+				smt := &infrav1.ScalewayMachineTemplate{}
+				g.Expect(c.Get(context.TODO(), scalewayMachineTemplateNamespacedName, smt)).To(Succeed())
+				g.Expect(smt.Status.Capacity).To(BeEmpty())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			sb := runtime.NewSchemeBuilder(
+				corev1.AddToScheme,
+				clusterv1.AddToScheme,
+				infrav1.AddToScheme,
+			)
+			s := runtime.NewScheme()
+
+			g.Expect(sb.AddToScheme(s)).To(Succeed())
+
+			runtimeObjects := make([]runtime.Object, 0, len(tt.objects))
+			for _, obj := range tt.objects {
+				runtimeObjects = append(runtimeObjects, obj)
+			}
+
+			c := fake.NewClientBuilder().
+				WithScheme(s).
+				WithRuntimeObjects(runtimeObjects...).
+				WithStatusSubresource(tt.objects...).
+				Build()
+
+			r := &ScalewayMachineTemplateReconciler{
+				Client:                               c,
+				createScalewayMachineTemplateService: tt.fields.createScalewayMachineTemplateService,
+			}
+			got, err := r.Reconcile(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ScalewayMachineTemplateReconciler.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScalewayMachineTemplateReconciler.Reconcile() = %v, want %v", got, tt.want)
+			}
+
+			tt.asserts(g, c)
+		})
+	}
+}
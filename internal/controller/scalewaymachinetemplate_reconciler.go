@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/machinetemplate"
+)
+
+type scalewayMachineTemplateService struct {
+	scope *scope.MachineTemplate
+	// services is the list of services that are reconciled by this controller.
+	// The order of the services is important as it determines the order in which the services are reconciled.
+	services  []scaleway.ServiceReconciler
+	Reconcile func(context.Context) error
+}
+
+func newScalewayMachineTemplateService(s *scope.MachineTemplate) *scalewayMachineTemplateService {
+	smts := &scalewayMachineTemplateService{
+		scope: s,
+		services: []scaleway.ServiceReconciler{
+			machinetemplate.New(s),
+		},
+	}
+
+	smts.Reconcile = smts.reconcile
+
+	return smts
+}
+
+// Reconcile reconciles all the services in a predetermined order.
+func (s *scalewayMachineTemplateService) reconcile(ctx context.Context) error {
+	for _, service := range s.services {
+		if err := service.Reconcile(ctx); err != nil {
+			return fmt.Errorf("failed to reconcile ScalewayMachineTemplate service %s: %w", service.Name(), err)
+		}
+	}
+
+	return nil
+}
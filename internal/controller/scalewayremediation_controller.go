@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
+)
+
+// ScalewayRemediationReconciler reconciles a ScalewayRemediation object
+type ScalewayRemediationReconciler struct {
+	client.Client
+	recorder                         events.EventRecorder
+	createScalewayRemediationService scalewayRemediationServiceCreator
+}
+
+// scalewayRemediationServiceCreator is a function that creates a new scalewayRemediationService reconciler.
+type scalewayRemediationServiceCreator func(remediationScope *scope.Remediation) *scalewayRemediationService
+
+// NewScalewayRemediationReconciler returns a new ScalewayRemediationReconciler.
+func NewScalewayRemediationReconciler(c client.Client) *ScalewayRemediationReconciler {
+	return &ScalewayRemediationReconciler{
+		Client:                           c,
+		createScalewayRemediationService: newScalewayRemediationService,
+	}
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayremediations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayremediations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayremediationtemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *ScalewayRemediationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, retErr error) {
+	log := logf.FromContext(ctx)
+
+	scalewayRemediation := &infrav1.ScalewayRemediation{}
+	if err := r.Get(ctx, req.NamespacedName, scalewayRemediation); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Nothing to do if the remediation is being deleted, the MachineHealthCheck
+	// deletes it once the Machine is healthy again.
+	if !scalewayRemediation.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	// Fetch the Machine, the MachineHealthCheck sets it as owner of the remediation.
+	machine, err := util.GetOwnerMachine(ctx, r.Client, scalewayRemediation.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if machine == nil {
+		log.Info("ScalewayRemediation is not owned by a Machine")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("machine", machine.Name)
+
+	// Fetch the Cluster.
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machine.ObjectMeta)
+	if err != nil {
+		log.Info("Machine is missing cluster label or cluster does not exist")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("cluster", cluster.Name)
+
+	if annotations.IsPaused(cluster, scalewayRemediation) {
+		log.Info("ScalewayRemediation or linked Cluster is marked as paused. Won't reconcile normally")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("ScalewayCluster", cluster.Spec.InfrastructureRef.Name)
+	scalewayCluster := &infrav1.ScalewayCluster{}
+	if err := r.Client.Get(ctx, client.ObjectKey{
+		Namespace: scalewayRemediation.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}, scalewayCluster); err != nil {
+		log.Info("ScalewayCluster is not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	// Fetch the ScalewayMachine, other kinds of machines can only be deleted.
+	var scalewayMachine *infrav1.ScalewayMachine
+	if machine.Spec.InfrastructureRef.Kind == "ScalewayMachine" {
+		scalewayMachine = &infrav1.ScalewayMachine{}
+		if err := r.Client.Get(ctx, client.ObjectKey{
+			Namespace: machine.Namespace,
+			Name:      machine.Spec.InfrastructureRef.Name,
+		}, scalewayMachine); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return ctrl.Result{}, err
+			}
+
+			scalewayMachine = nil
+		}
+	}
+
+	// Create the cluster scope
+	clusterScope, err := scope.NewCluster(ctx, &scope.ClusterParams{
+		Client:          r.Client,
+		Cluster:         cluster,
+		ScalewayCluster: scalewayCluster,
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Create the remediation scope
+	remediationScope, err := scope.NewRemediation(&scope.RemediationParams{
+		Client:              r.Client,
+		ClusterScope:        clusterScope,
+		Machine:             machine,
+		ScalewayMachine:     scalewayMachine,
+		ScalewayRemediation: scalewayRemediation,
+		Recorder:            r.recorder,
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
+	}
+
+	// Always close the scope when exiting this function so we can persist any ScalewayRemediation changes.
+	defer func() {
+		if err := remediationScope.Close(ctx); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+
+	return r.reconcileNormal(ctx, remediationScope)
+}
+
+func (r *ScalewayRemediationReconciler) reconcileNormal(ctx context.Context, remediationScope *scope.Remediation) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	log.Info("Reconciling ScalewayRemediation")
+
+	if err := r.createScalewayRemediationService(remediationScope).Reconcile(ctx); err != nil {
+		// Handle terminal & transient errors
+		var reconcileError *scaleway.ReconcileError
+		if errors.As(err, &reconcileError) && reconcileError.RequeueAfter() != 0 {
+			log.Info(fmt.Sprintf("Transient failure to reconcile ScalewayRemediation, retrying: %s", reconcileError.Error()))
+			return ctrl.Result{RequeueAfter: reconcileError.RequeueAfter()}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile remediation services: %w", err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ScalewayRemediationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorder("scalewayremediation-controller")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.ScalewayRemediation{}).
+		Named("scalewayremediation").
+		Complete(r)
+}
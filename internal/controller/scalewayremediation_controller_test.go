@@ -0,0 +1,270 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
+)
+
+var _ = Describe("ScalewayRemediation Controller", func() {
+	Context("When reconciling a resource", func() {
+		const resourceName = "test-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		scalewayremediation := &infrav1.ScalewayRemediation{}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind ScalewayRemediation")
+			err := k8sClient.Get(ctx, typeNamespacedName, scalewayremediation)
+			if err != nil && apierrors.IsNotFound(err) {
+				resource := &infrav1.ScalewayRemediation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resourceName,
+						Namespace: "default",
+					},
+				}
+				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			resource := &infrav1.ScalewayRemediation{}
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Cleanup the specific resource instance ScalewayRemediation")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+		It("should successfully reconcile the resource", func() {
+			By("Reconciling the created resource")
+			controllerReconciler := &ScalewayRemediationReconciler{
+				Client:                           k8sClient,
+				createScalewayRemediationService: newScalewayRemediationService,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
+
+var scalewayRemediationNamespacedName = types.NamespacedName{
+	Namespace: "caps",
+	Name:      "machine",
+}
+
+func TestScalewayRemediationReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	objects := func() []client.Object {
+		return []client.Object{
+			&infrav1.ScalewayCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      scalewayClusterNamespacedName.Name,
+					Namespace: scalewayClusterNamespacedName.Namespace,
+				},
+				Spec: infrav1.ScalewayClusterSpec{
+					Region:             "fr-par",
+					ScalewaySecretName: secretNamespacedName.Name,
+					ProjectID:          "11111111-1111-1111-1111-111111111111",
+				},
+			},
+			&clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      clusterNamespacedName.Name,
+					Namespace: clusterNamespacedName.Namespace,
+				},
+				Spec: clusterv1.ClusterSpec{
+					InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+						Name: scalewayClusterNamespacedName.Name,
+					},
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      secretNamespacedName.Name,
+					Namespace: secretNamespacedName.Namespace,
+				},
+				Data: map[string][]byte{
+					scw.ScwAccessKeyEnv: []byte("SCWXXXXXXXXXXXXXXXXX"),
+					scw.ScwSecretKeyEnv: []byte("11111111-1111-1111-1111-111111111111"),
+				},
+			},
+			&infrav1.ScalewayMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      scalewayMachineNamespacedName.Name,
+					Namespace: scalewayMachineNamespacedName.Namespace,
+				},
+				Spec: infrav1.ScalewayMachineSpec{
+					ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+				},
+			},
+			&clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      machineNamespacedName.Name,
+					Namespace: machineNamespacedName.Namespace,
+					Labels: map[string]string{
+						clusterv1.ClusterNameLabel: clusterNamespacedName.Name,
+					},
+				},
+				Spec: clusterv1.MachineSpec{
+					InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+						Kind: "ScalewayMachine",
+						Name: scalewayMachineNamespacedName.Name,
+					},
+				},
+			},
+			&infrav1.ScalewayRemediation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      scalewayRemediationNamespacedName.Name,
+					Namespace: scalewayRemediationNamespacedName.Namespace,
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							Name:       machineNamespacedName.Name,
+							Kind:       "Machine",
+							APIVersion: clusterv1.GroupVersion.String(),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	type fields struct {
+		createScalewayRemediationService scalewayRemediationServiceCreator
+	}
+	type args struct {
+		ctx context.Context
+		req ctrl.Request
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    ctrl.Result
+		wantErr bool
+		objects []client.Object
+		asserts func(g *WithT, c client.Client)
+	}{
+		{
+			name: "should reconcile normally",
+			fields: fields{
+				createScalewayRemediationService: func(remediationScope *scope.Remediation) *scalewayRemediationService {
+					return &scalewayRemediationService{
+						scope: remediationScope,
+						Reconcile: func(ctx context.Context) error {
+							if remediationScope.ScalewayMachine == nil {
+								return errors.New("ScalewayMachine not found")
+							}
+
+							remediationScope.ScalewayRemediation.Status.Phase = infrav1.RemediationPhaseRunning
+							return nil
+						},
+					}
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: reconcile.Request{
+					NamespacedName: scalewayRemediationNamespacedName,
+				},
+			},
+			objects: objects(),
+			asserts: func(g *WithT, c client.Client) {
+				sr := &infrav1.ScalewayRemediation{}
+				g.Expect(c.Get(context.TODO(), scalewayRemediationNamespacedName, sr)).To(Succeed())
+				g.Expect(sr.Status.Phase).To(Equal(infrav1.RemediationPhaseRunning))
+			},
+		},
+		{
+			name: "should requeue while waiting for the machine to become healthy",
+			fields: fields{
+				createScalewayRemediationService: func(remediationScope *scope.Remediation) *scalewayRemediationService {
+					return &scalewayRemediationService{
+						scope: remediationScope,
+						Reconcile: func(ctx context.Context) error {
+							return scaleway.WithTransientError(errors.New("waiting for machine to become healthy"), time.Minute)
+						},
+					}
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+				req: reconcile.Request{
+					NamespacedName: scalewayRemediationNamespacedName,
+				},
+			},
+			want:    ctrl.Result{RequeueAfter: time.Minute},
+			objects: objects(),
+			asserts: func(g *WithT, c client.Client) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			sb := runtime.NewSchemeBuilder(
+				corev1.AddToScheme,
+				clusterv1.AddToScheme,
+				infrav1.AddToScheme,
+			)
+			s := runtime.NewScheme()
+
+			g.Expect(sb.AddToScheme(s)).To(Succeed())
+
+			runtimeObjects := make([]runtime.Object, 0, len(tt.objects))
+			for _, obj := range tt.objects {
+				runtimeObjects = append(runtimeObjects, obj)
+			}
+
+			c := fake.NewClientBuilder().
+				WithScheme(s).
+				WithRuntimeObjects(runtimeObjects...).
+				WithStatusSubresource(tt.objects...).
+				Build()
+
+			r := &ScalewayRemediationReconciler{
+				Client:                           c,
+				createScalewayRemediationService: tt.fields.createScalewayRemediationService,
+			}
+			got, err := r.Reconcile(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ScalewayRemediationReconciler.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScalewayRemediationReconciler.Reconcile() = %v, want %v", got, tt.want)
+			}
+
+			tt.asserts(g, c)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/remediation"
+)
+
+type scalewayRemediationService struct {
+	scope *scope.Remediation
+	// services is the list of services that are reconciled by this controller.
+	// The order of the services is important as it determines the order in which the services are reconciled.
+	services  []scaleway.ServiceReconciler
+	Reconcile func(context.Context) error
+}
+
+func newScalewayRemediationService(s *scope.Remediation) *scalewayRemediationService {
+	srs := &scalewayRemediationService{
+		scope: s,
+		services: []scaleway.ServiceReconciler{
+			remediation.New(s),
+		},
+	}
+
+	srs.Reconcile = srs.reconcile
+
+	return srs
+}
+
+// Reconcile reconciles all the services in a predetermined order.
+func (s *scalewayRemediationService) reconcile(ctx context.Context) error {
+	for _, service := range s.services {
+		if err := service.Reconcile(ctx); err != nil {
+			return fmt.Errorf("failed to reconcile ScalewayRemediation service %s: %w", service.Name(), err)
+		}
+	}
+
+	return nil
+}
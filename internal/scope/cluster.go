@@ -67,6 +67,7 @@ func (c *Cluster) PatchObject(ctx context.Context) error {
 		infrav1.PublicGatewaysReadyCondition,
 		infrav1.ScalewayClusterLoadBalancersReadyCondition,
 		infrav1.ScalewayClusterDomainReadyCondition,
+		infrav1.ScalewayClusterSecurityGroupsReadyCondition,
 	}
 
 	if err := conditions.SetSummaryCondition(c.ScalewayCluster, c.ScalewayCluster, infrav1.ScalewayClusterReadyCondition, conditions.ForConditionTypes(summaryConditions)); err != nil {
@@ -133,6 +134,12 @@ func (c *Cluster) PrivateNetworkID() (string, error) {
 	return string(c.ScalewayCluster.Status.Network.PrivateNetworkID), nil
 }
 
+// HasManagedSecurityGroups returns true if the Security Groups of the machines
+// are managed by the cluster.
+func (c *Cluster) HasManagedSecurityGroups() bool {
+	return ptr.Deref(c.ScalewayCluster.Spec.Network.SecurityGroups.Enabled, false)
+}
+
 // ControlPlaneLoadBalancerPort returns the port to use for the control plane
 // loadbalancer frontend.
 func (c *Cluster) ControlPlaneLoadBalancerPort() int32 {
@@ -237,6 +244,12 @@ func (c *Cluster) ControlPlaneLoadBalancerPrivate() bool {
 	return c.HasPrivateNetwork() && ptr.Deref(c.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.Private, false)
 }
 
+// ControlPlaneLoadBalancerExternallyManaged returns true if the control plane
+// endpoint is served by a load balancer that is not managed by the provider.
+func (c *Cluster) ControlPlaneLoadBalancerExternallyManaged() bool {
+	return ptr.Deref(c.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.ExternallyManaged, false)
+}
+
 // IsVPCStatusSet if the VPC fields are set in the status.
 func (c *Cluster) IsVPCStatusSet() bool {
 	return c.ScalewayCluster.Status.Network.PrivateNetworkID != "" &&
@@ -0,0 +1,131 @@
+package scope
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+)
+
+// ElasticMetalMachine is an ElasticMetalMachine scope.
+type ElasticMetalMachine struct {
+	Client      client.Client
+	patchHelper *patch.Helper
+
+	*Cluster
+
+	Machine                     *clusterv1.Machine
+	ScalewayElasticMetalMachine *infrav1.ScalewayElasticMetalMachine
+}
+
+// ElasticMetalMachineParams contains mandatory params for creating the ElasticMetalMachine scope.
+type ElasticMetalMachineParams struct {
+	Client                      client.Client
+	ClusterScope                *Cluster
+	Machine                     *clusterv1.Machine
+	ScalewayElasticMetalMachine *infrav1.ScalewayElasticMetalMachine
+}
+
+// NewElasticMetalMachine creates a new ElasticMetalMachine scope.
+func NewElasticMetalMachine(params *ElasticMetalMachineParams) (*ElasticMetalMachine, error) {
+	helper, err := patch.NewHelper(params.ScalewayElasticMetalMachine, params.Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create patch helper for ScalewayElasticMetalMachine: %w", err)
+	}
+
+	return &ElasticMetalMachine{
+		Client:                      params.Client,
+		patchHelper:                 helper,
+		Cluster:                     params.ClusterScope,
+		Machine:                     params.Machine,
+		ScalewayElasticMetalMachine: params.ScalewayElasticMetalMachine,
+	}, nil
+}
+
+// PatchObject patches the ScalewayElasticMetalMachine object.
+func (m *ElasticMetalMachine) PatchObject(ctx context.Context) error {
+	summaryConditions := []string{
+		infrav1.ScalewayElasticMetalMachineServerReadyCondition,
+	}
+
+	if err := conditions.SetSummaryCondition(
+		m.ScalewayElasticMetalMachine,
+		m.ScalewayElasticMetalMachine,
+		infrav1.ScalewayElasticMetalMachineReadyCondition,
+		conditions.ForConditionTypes(summaryConditions),
+	); err != nil {
+		return err
+	}
+
+	return m.patchHelper.Patch(ctx, m.ScalewayElasticMetalMachine, patch.WithOwnedConditions{
+		Conditions: append(summaryConditions, infrav1.ScalewayElasticMetalMachineReadyCondition),
+	})
+}
+
+// Close closes the ElasticMetalMachine scope by patching the ScalewayElasticMetalMachine object.
+func (m *ElasticMetalMachine) Close(ctx context.Context) error {
+	return m.PatchObject(ctx)
+}
+
+// ResourceName returns the name that resources created for the machine should have.
+func (m *ElasticMetalMachine) ResourceName() string {
+	return m.ScalewayElasticMetalMachine.Name
+}
+
+// ResourceTags returns the tags that resources created for the machine should have.
+func (m *ElasticMetalMachine) ResourceTags() []string {
+	return append(m.Cluster.ResourceTags(), fmt.Sprintf("caps-scalewayelasticmetalmachine=%s", m.ScalewayElasticMetalMachine.Name))
+}
+
+// Zone returns the zone of the machine.
+func (m *ElasticMetalMachine) Zone() (scw.Zone, error) {
+	return m.ScalewayClient.GetZoneOrDefault(m.Machine.Spec.FailureDomain)
+}
+
+// SSHKeyIDs returns the IDs of the SSH keys to authorize on the server.
+func (m *ElasticMetalMachine) SSHKeyIDs() []string {
+	ids := make([]string, 0, len(m.ScalewayElasticMetalMachine.Spec.SSHKeyIDs))
+
+	for _, id := range m.ScalewayElasticMetalMachine.Spec.SSHKeyIDs {
+		ids = append(ids, string(id))
+	}
+
+	return ids
+}
+
+// SetProviderID sets the ProviderID of the ScalewayElasticMetalMachine if it is not already set.
+func (m *ElasticMetalMachine) SetProviderID(providerID string) {
+	if m.ScalewayElasticMetalMachine.Spec.ProviderID == "" {
+		m.ScalewayElasticMetalMachine.Spec.ProviderID = providerID
+	}
+}
+
+// SetAddresses sets the addresses of the ScalewayElasticMetalMachine.
+// It replaces the existing addresses with the provided ones.
+func (m *ElasticMetalMachine) SetAddresses(addresses []clusterv1.MachineAddress) {
+	m.ScalewayElasticMetalMachine.Status.Addresses = addresses
+}
+
+// GetBootstrapData retrieves the bootstrap data from the secret specified in the Machine.
+// It returns the bootstrap data and its format (e.g. cloud-config or ignition).
+// It returns an error if the secret is not found or if the value key is missing.
+func (m *ElasticMetalMachine) GetBootstrapData(ctx context.Context) ([]byte, string, error) {
+	return getBootstrapData(ctx, m.Client, m.Machine)
+}
+
+// HasJoinedCluster returns true if the machine has joined the cluster.
+func (m *ElasticMetalMachine) HasJoinedCluster() bool {
+	return m.Machine.Status.NodeRef.IsDefined()
+}
+
+// IsControlPlane returns true if the machine is a control plane machine.
+func (m *ElasticMetalMachine) IsControlPlane() bool {
+	return util.IsControlPlaneMachine(m.Machine)
+}
@@ -2,13 +2,18 @@ package scope
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"golang.org/x/crypto/blake2b"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
@@ -17,16 +22,51 @@ import (
 
 const base36set = "0123456789abcdefghijklmnopqrstuvwxyz"
 
+// instanceProviderIDPrefix is the prefix of the provider ID of Instance servers.
+const instanceProviderIDPrefix = "scaleway://instance/"
+
+// parseInstanceProviderID returns the zone and ID of the Instance server of a
+// provider ID. It returns false if the provider ID is not a valid Instance provider ID.
+func parseInstanceProviderID(providerID string) (scw.Zone, string, bool) {
+	if !strings.HasPrefix(providerID, instanceProviderIDPrefix) {
+		return "", "", false
+	}
+
+	zone, serverID, ok := strings.Cut(strings.TrimPrefix(providerID, instanceProviderIDPrefix), "/")
+	if !ok || zone == "" || serverID == "" {
+		return "", "", false
+	}
+
+	return scw.Zone(zone), serverID, true
+}
+
 func nameWithSuffixes(name string, suffixes ...string) string {
 	return strings.Join(append([]string{name}, suffixes...), "-")
 }
 
-func newScalewayClient(ctx context.Context, c client.Client, region, projectID string, secretRef client.ObjectKey) (*scwClient.Client, error) {
+func newScalewayClient(
+	ctx context.Context,
+	c client.Client,
+	region, projectID, namespace, secretName string,
+	identityRef infrav1.ScalewayClusterIdentityReference,
+) (*scwClient.Client, error) {
 	r, err := scw.ParseRegion(region)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse region %q: %w", r, err)
 	}
 
+	secretRef := client.ObjectKey{
+		Namespace: namespace,
+		Name:      secretName,
+	}
+
+	if identityRef.Name != "" {
+		secretRef, err = scalewayClusterIdentitySecretRef(ctx, c, namespace, identityRef.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	secret := &corev1.Secret{}
 	if err := c.Get(ctx, secretRef, secret); err != nil {
 		return nil, fmt.Errorf("failed to get ScalewaySecret: %w", err)
@@ -40,18 +80,71 @@ func newScalewayClient(ctx context.Context, c client.Client, region, projectID s
 	return sc, nil
 }
 
+// scalewayClusterIdentitySecretRef returns the reference of the secret of a
+// ScalewayClusterIdentity, after making sure the identity can be used from the
+// provided namespace.
+func scalewayClusterIdentitySecretRef(ctx context.Context, c client.Client, namespace, identityName string) (client.ObjectKey, error) {
+	identity := &infrav1.ScalewayClusterIdentity{}
+	if err := c.Get(ctx, client.ObjectKey{Name: identityName}, identity); err != nil {
+		return client.ObjectKey{}, fmt.Errorf("failed to get ScalewayClusterIdentity %s: %w", identityName, err)
+	}
+
+	allowed, err := isNamespaceAllowed(ctx, c, identity.Spec.AllowedNamespaces, namespace)
+	if err != nil {
+		return client.ObjectKey{}, err
+	}
+
+	if !allowed {
+		return client.ObjectKey{}, fmt.Errorf("namespace %s is not allowed to use ScalewayClusterIdentity %s", namespace, identityName)
+	}
+
+	return client.ObjectKey{
+		Namespace: identity.Spec.SecretRef.Namespace,
+		Name:      identity.Spec.SecretRef.Name,
+	}, nil
+}
+
+// isNamespaceAllowed returns true if the namespace is allowed by allowedNamespaces.
+// A nil allowedNamespaces allows no namespace, an empty one allows all namespaces.
+func isNamespaceAllowed(ctx context.Context, c client.Client, allowedNamespaces *infrav1.AllowedNamespaces, namespace string) (bool, error) {
+	switch {
+	case allowedNamespaces == nil:
+		return false, nil
+	case len(allowedNamespaces.List) == 0 && allowedNamespaces.Selector == nil:
+		return true, nil
+	case slices.Contains(allowedNamespaces.List, namespace):
+		return true, nil
+	case allowedNamespaces.Selector == nil:
+		return false, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(allowedNamespaces.Selector)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse namespace selector: %w", err)
+	}
+
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		return false, fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+	}
+
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
+
 func newScalewayClientForScalewayCluster(ctx context.Context, c client.Client, sc *infrav1.ScalewayCluster) (*scwClient.Client, error) {
-	return newScalewayClient(ctx, c, string(sc.Spec.Region), string(sc.Spec.ProjectID), types.NamespacedName{
-		Namespace: sc.Namespace,
-		Name:      sc.Spec.ScalewaySecretName,
-	})
+	return newScalewayClient(
+		ctx, c,
+		string(sc.Spec.Region), string(sc.Spec.ProjectID),
+		sc.Namespace, sc.Spec.ScalewaySecretName, sc.Spec.IdentityRef,
+	)
 }
 
 func newScalewayClientForScalewayManagedCluster(ctx context.Context, c client.Client, smc *infrav1.ScalewayManagedCluster) (*scwClient.Client, error) {
-	return newScalewayClient(ctx, c, string(smc.Spec.Region), string(smc.Spec.ProjectID), types.NamespacedName{
-		Namespace: smc.Namespace,
-		Name:      smc.Spec.ScalewaySecretName,
-	})
+	return newScalewayClient(
+		ctx, c,
+		string(smc.Spec.Region), string(smc.Spec.ProjectID),
+		smc.Namespace, smc.Spec.ScalewaySecretName, smc.Spec.IdentityRef,
+	)
 }
 
 // base36TruncatedHash returns a consistent hash using blake2b
@@ -82,3 +175,25 @@ func base36Truncate(bytes []byte) string {
 
 	return chars.String()
 }
+
+// getBootstrapData retrieves the bootstrap data from the secret referenced by the machine.
+// It returns an error if the secret is not found or if the value key is missing.
+func getBootstrapData(ctx context.Context, c client.Client, machine *clusterv1.Machine) ([]byte, string, error) {
+	if machine.Spec.Bootstrap.DataSecretName == nil {
+		return nil, "", errors.New("missing bootstrap secret name in machine")
+	}
+
+	key := types.NamespacedName{Namespace: machine.GetNamespace(), Name: *machine.Spec.Bootstrap.DataSecretName}
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, key, secret); err != nil {
+		return nil, "", err
+	}
+
+	value, ok := secret.Data["value"]
+	if !ok {
+		return nil, "", errors.New("error retrieving bootstrap data: secret value key is missing")
+	}
+
+	// The format key is optional, bootstrap providers that do not set it emit cloud-config.
+	return value, string(secret.Data["format"]), nil
+}
@@ -1,6 +1,19 @@
 package scope
 
-import "testing"
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+)
 
 func Test_base36TruncatedHash(t *testing.T) {
 	t.Parallel()
@@ -37,3 +50,168 @@ func Test_base36TruncatedHash(t *testing.T) {
 		})
 	}
 }
+
+func Test_scalewayClusterIdentitySecretRef(t *testing.T) {
+	t.Parallel()
+	newIdentity := func(allowedNamespaces *infrav1.AllowedNamespaces) *infrav1.ScalewayClusterIdentity {
+		return &infrav1.ScalewayClusterIdentity{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "identity",
+			},
+			Spec: infrav1.ScalewayClusterIdentitySpec{
+				SecretRef: infrav1.ScalewayClusterIdentitySecretReference{
+					Name:      "secret",
+					Namespace: "caps-credentials",
+				},
+				AllowedNamespaces: allowedNamespaces,
+			},
+		}
+	}
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default",
+			Labels: map[string]string{
+				"team": "platform",
+			},
+		},
+	}
+	secretRef := client.ObjectKey{Namespace: "caps-credentials", Name: "secret"}
+
+	type args struct {
+		namespace    string
+		identityName string
+	}
+	tests := []struct {
+		name    string
+		objects []client.Object
+		args    args
+		want    client.ObjectKey
+		wantErr bool
+	}{
+		{
+			name:    "identity not found",
+			objects: []client.Object{namespace},
+			args:    args{namespace: "default", identityName: "identity"},
+			wantErr: true,
+		},
+		{
+			name:    "no allowed namespaces",
+			objects: []client.Object{namespace, newIdentity(nil)},
+			args:    args{namespace: "default", identityName: "identity"},
+			wantErr: true,
+		},
+		{
+			name:    "all namespaces allowed",
+			objects: []client.Object{namespace, newIdentity(&infrav1.AllowedNamespaces{})},
+			args:    args{namespace: "default", identityName: "identity"},
+			want:    secretRef,
+		},
+		{
+			name: "namespace in list",
+			objects: []client.Object{namespace, newIdentity(&infrav1.AllowedNamespaces{
+				List: []string{"other", "default"},
+			})},
+			args: args{namespace: "default", identityName: "identity"},
+			want: secretRef,
+		},
+		{
+			name: "namespace not in list",
+			objects: []client.Object{namespace, newIdentity(&infrav1.AllowedNamespaces{
+				List: []string{"other"},
+			})},
+			args:    args{namespace: "default", identityName: "identity"},
+			wantErr: true,
+		},
+		{
+			name: "namespace matches selector",
+			objects: []client.Object{namespace, newIdentity(&infrav1.AllowedNamespaces{
+				List: []string{"other"},
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"team": "platform"},
+				},
+			})},
+			args: args{namespace: "default", identityName: "identity"},
+			want: secretRef,
+		},
+		{
+			name: "namespace does not match selector",
+			objects: []client.Object{namespace, newIdentity(&infrav1.AllowedNamespaces{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"team": "other"},
+				},
+			})},
+			args:    args{namespace: "default", identityName: "identity"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := runtime.NewScheme()
+			if err := corev1.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			if err := infrav1.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+
+			c := fake.NewClientBuilder().WithScheme(s).WithObjects(tt.objects...).Build()
+
+			got, err := scalewayClusterIdentitySecretRef(context.TODO(), c, tt.args.namespace, tt.args.identityName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("scalewayClusterIdentitySecretRef() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("scalewayClusterIdentitySecretRef() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseInstanceProviderID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		providerID string
+		wantZone   scw.Zone
+		wantID     string
+		wantOK     bool
+	}{
+		{
+			name:       "valid provider ID",
+			providerID: "scaleway://instance/fr-par-2/11111111-1111-1111-1111-111111111111",
+			wantZone:   scw.ZoneFrPar2,
+			wantID:     "11111111-1111-1111-1111-111111111111",
+			wantOK:     true,
+		},
+		{
+			name:       "empty provider ID",
+			providerID: "",
+		},
+		{
+			name:       "missing server ID",
+			providerID: "scaleway://instance/fr-par-2/",
+		},
+		{
+			name:       "other provider",
+			providerID: "aws:///us-east-1a/i-0123456789",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			zone, id, ok := parseInstanceProviderID(tt.providerID)
+			if ok != tt.wantOK {
+				t.Errorf("parseInstanceProviderID() ok = %v, want %v", ok, tt.wantOK)
+				return
+			}
+			if zone != tt.wantZone {
+				t.Errorf("parseInstanceProviderID() zone = %v, want %v", zone, tt.wantZone)
+			}
+			if id != tt.wantID {
+				t.Errorf("parseInstanceProviderID() id = %v, want %v", id, tt.wantID)
+			}
+		})
+	}
+}
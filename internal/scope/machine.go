@@ -4,11 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util"
@@ -37,6 +38,13 @@ type Machine struct {
 
 	Machine         *clusterv1.Machine
 	ScalewayMachine *infrav1.ScalewayMachine
+
+	// tags overrides the tags of the resources created for the machine.
+	// It is only set for machines of a ScalewayMachinePool.
+	tags []string
+
+	// recorder records events on the ScalewayMachine, it may be nil.
+	recorder events.EventRecorder
 }
 
 // MachineParams contains mandatory params for creating the Machine scope.
@@ -45,6 +53,8 @@ type MachineParams struct {
 	ClusterScope    *Cluster
 	Machine         *clusterv1.Machine
 	ScalewayMachine *infrav1.ScalewayMachine
+	// Recorder is optional, no event is recorded if it is nil.
+	Recorder events.EventRecorder
 }
 
 // NewMachine creates a new Machine scope.
@@ -60,21 +70,46 @@ func NewMachine(params *MachineParams) (*Machine, error) {
 		Cluster:         params.ClusterScope,
 		Machine:         params.Machine,
 		ScalewayMachine: params.ScalewayMachine,
+		recorder:        params.Recorder,
 	}, nil
 }
 
+// Eventf records an event on the ScalewayMachine.
+func (m *Machine) Eventf(eventtype, reason, action, note string, args ...any) {
+	if m.recorder == nil {
+		return
+	}
+
+	m.recorder.Eventf(m.ScalewayMachine, nil, eventtype, reason, action, note, args...)
+}
+
 // PatchObject patches the ScalewayMachine object.
 func (m *Machine) PatchObject(ctx context.Context) error {
 	summaryConditions := []string{
 		infrav1.ScalewayMachineInstanceReadyCondition,
 	}
 
-	if err := conditions.SetSummaryCondition(m.ScalewayMachine, m.ScalewayMachine, infrav1.ScalewayMachineReadyCondition, conditions.ForConditionTypes(summaryConditions)); err != nil {
+	// The ServerHealthy condition is only set once the node has joined the cluster.
+	if m.FailOnUnhealthyServer() {
+		summaryConditions = append(summaryConditions, infrav1.ScalewayMachineServerHealthyCondition)
+	}
+
+	if err := conditions.SetSummaryCondition(
+		m.ScalewayMachine, m.ScalewayMachine, infrav1.ScalewayMachineReadyCondition,
+		conditions.ForConditionTypes(summaryConditions),
+		conditions.IgnoreTypesIfMissing{infrav1.ScalewayMachineServerHealthyCondition},
+	); err != nil {
 		return err
 	}
 
 	return m.patchHelper.Patch(ctx, m.ScalewayMachine, patch.WithOwnedConditions{
-		Conditions: append(summaryConditions, infrav1.ScalewayMachineReadyCondition),
+		Conditions: []string{
+			infrav1.ScalewayMachineInstanceReadyCondition,
+			infrav1.ScalewayMachineServerHealthyCondition,
+			infrav1.ScalewayMachineFileSystemResizePendingCondition,
+			infrav1.ScalewayMachineDeletingCondition,
+			infrav1.ScalewayMachineReadyCondition,
+		},
 	})
 }
 
@@ -90,14 +125,77 @@ func (m *Machine) ResourceName() string {
 
 // ResourceTags returns the tags that resources created for the machine should have.
 func (m *Machine) ResourceTags() []string {
+	if m.tags != nil {
+		return slices.Clone(m.tags)
+	}
+
 	return append(m.Cluster.ResourceTags(), fmt.Sprintf("caps-scalewaymachine=%s", m.ScalewayMachine.Name))
 }
 
-// Zone returns the zone of the machine.
+// DesiredTags returns the tags that resources of the machine should have, including
+// the additional tags of the ScalewayMachine. Resources must be looked up with ResourceTags.
+func (m *Machine) DesiredTags() []string {
+	tags := m.ResourceTags()
+
+	for _, tag := range m.ScalewayMachine.Spec.AdditionalTags {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+// Zone returns the zone of the machine: the zone of its server once it is known,
+// otherwise the failure domain of the Machine or the default zone. The zone of the
+// server is read from the status or, if the status was lost, from the provider ID
+// that is set as soon as the server is created.
 func (m *Machine) Zone() (scw.Zone, error) {
+	if zone := m.serverZone(); zone != "" {
+		return m.ScalewayClient.GetZoneOrDefault(string(zone))
+	}
+
 	return m.ScalewayClient.GetZoneOrDefault(m.Machine.Spec.FailureDomain)
 }
 
+// Zones returns the zones where the server of the machine can be found or created,
+// in order of preference. When the Machine has no failure domain and the zone of
+// the server is not known, the server can be in any zone of the region, starting
+// with the default zone.
+func (m *Machine) Zones() ([]scw.Zone, error) {
+	zone, err := m.Zone()
+	if err != nil {
+		return nil, err
+	}
+
+	zones := []scw.Zone{zone}
+
+	if m.serverZone() != "" || m.Machine.Spec.FailureDomain != "" {
+		return zones, nil
+	}
+
+	for _, regionZone := range m.ScalewayClient.GetControlPlaneZones() {
+		if !slices.Contains(zones, regionZone) {
+			zones = append(zones, regionZone)
+		}
+	}
+
+	return zones, nil
+}
+
+// serverZone returns the zone of the server of the machine if it is known.
+func (m *Machine) serverZone() scw.Zone {
+	if m.ScalewayMachine.Status.Zone != "" {
+		return scw.Zone(m.ScalewayMachine.Status.Zone)
+	}
+
+	if zone, _, ok := parseInstanceProviderID(m.ScalewayMachine.Spec.ProviderID); ok {
+		return zone
+	}
+
+	return ""
+}
+
 // RootVolumeSize returns the size of the root volume for the machine.
 func (m *Machine) RootVolumeSize() scw.Size {
 	size := defaultRootVolumeSize
@@ -142,12 +240,21 @@ func (m *Machine) HasPublicIPv4() bool {
 		return true
 	}
 
-	return ptr.Deref(m.ScalewayMachine.Spec.PublicNetwork.EnableIPv4, false)
+	return ptr.Deref(m.ScalewayMachine.Spec.PublicNetwork.EnableIPv4, false) ||
+		m.ScalewayMachine.Spec.PublicNetwork.IPv4.IsDefined()
 }
 
 // HasPublicIPv6 returns true if the machine should have a Public IPv6 address.
 func (m *Machine) HasPublicIPv6() bool {
-	return ptr.Deref(m.ScalewayMachine.Spec.PublicNetwork.EnableIPv6, false)
+	return ptr.Deref(m.ScalewayMachine.Spec.PublicNetwork.EnableIPv6, false) ||
+		m.ScalewayMachine.Spec.PublicNetwork.IPv6.IsDefined()
+}
+
+// PublicIPType returns the type of the public IPs requested for the machine. It
+// is empty when no type is requested: new servers use routed IPs and the type of
+// the public IPs of existing servers is left unchanged.
+func (m *Machine) PublicIPType() infrav1.PublicIPType {
+	return m.ScalewayMachine.Spec.PublicNetwork.IPType
 }
 
 // SetProviderID sets the ProviderID of the ScalewayMachine if it is not already set.
@@ -163,25 +270,93 @@ func (m *Machine) SetAddresses(addresses []clusterv1.MachineAddress) {
 	m.ScalewayMachine.Status.Addresses = addresses
 }
 
-// GetBootstrapData retrieves the bootstrap data from the secret specified in the ScalewayMachine.
-// It returns an error if the secret is not found or if the value key is missing.
-func (m *Machine) GetBootstrapData(ctx context.Context) ([]byte, error) {
-	if m.Machine.Spec.Bootstrap.DataSecretName == nil {
-		return nil, errors.New("missing bootstrap secret name in machine")
-	}
+// SetPrivateNetworks sets the private addresses of the ScalewayMachine in each Private Network.
+// It replaces the existing Private Networks with the provided ones.
+func (m *Machine) SetPrivateNetworks(privateNetworks []infrav1.MachinePrivateNetworkStatus) {
+	m.ScalewayMachine.Status.PrivateNetworks = privateNetworks
+}
 
-	key := types.NamespacedName{Namespace: m.Machine.GetNamespace(), Name: *m.Machine.Spec.Bootstrap.DataSecretName}
-	secret := &corev1.Secret{}
-	if err := m.Client.Get(ctx, key, secret); err != nil {
-		return nil, err
+// SetPublicIPs sets the public addresses of the ScalewayMachine.
+// It replaces the existing public addresses with the provided ones.
+func (m *Machine) SetPublicIPs(publicIPs []infrav1.MachinePublicIPStatus) {
+	m.ScalewayMachine.Status.PublicIPs = publicIPs
+}
+
+// SetServerStatus sets the status fields that describe the server of the ScalewayMachine.
+func (m *Machine) SetServerStatus(server *instance.Server) {
+	status := &m.ScalewayMachine.Status
+	status.ServerID = infrav1.UUID(server.ID)
+	status.Zone = infrav1.ScalewayZone(server.Zone)
+	status.State = string(server.State)
+	status.CommercialType = server.CommercialType
+	status.Image = infrav1.ImageStatus{}
+	status.RootVolume = infrav1.RootVolumeStatus{}
+
+	if server.Image != nil {
+		status.Image = infrav1.ImageStatus{
+			ID:   infrav1.UUID(server.Image.ID),
+			Name: server.Image.Name,
+		}
 	}
 
-	value, ok := secret.Data["value"]
-	if !ok {
-		return nil, errors.New("error retrieving bootstrap data: secret value key is missing")
+	for _, vol := range server.Volumes {
+		if !vol.Boot {
+			continue
+		}
+
+		status.RootVolume.ID = infrav1.UUID(vol.ID)
+
+		for volumeType, instanceVolumeType := range volumeTypeToInstanceVolumeType {
+			if string(instanceVolumeType) == string(vol.VolumeType) {
+				status.RootVolume.Type = volumeType
+			}
+		}
+
+		break
 	}
+}
+
+// SetAdditionalVolumes sets the IDs of the additional volumes of the ScalewayMachine.
+// It replaces the existing additional volumes with the provided ones.
+func (m *Machine) SetAdditionalVolumes(volumes []infrav1.AdditionalVolumeStatus) {
+	m.ScalewayMachine.Status.AdditionalVolumes = volumes
+}
 
-	return value, nil
+// GetBootstrapData retrieves the bootstrap data from the secret specified in the ScalewayMachine.
+// It returns the bootstrap data and its format (e.g. cloud-config or ignition).
+// It returns an error if the secret is not found or if the value key is missing.
+func (m *Machine) GetBootstrapData(ctx context.Context) ([]byte, string, error) {
+	return getBootstrapData(ctx, m.Client, m.Machine)
+}
+
+// FailOnUnhealthyServer returns true if an unhealthy server must be reflected
+// in the Ready condition of the ScalewayMachine.
+func (m *Machine) FailOnUnhealthyServer() bool {
+	return m.ScalewayMachine.Spec.UnhealthyServerPolicy == "Fail"
+}
+
+// ArchiveOnDeletion returns true if the server must be stopped and kept instead
+// of being deleted.
+func (m *Machine) ArchiveOnDeletion() bool {
+	return m.ScalewayMachine.Spec.DeletionStrategy.Policy == infrav1.DeletionPolicyArchive
+}
+
+// GracefulShutdownTimeout returns the time given to the server to shut down gracefully
+// before it is powered off. It returns 0 if the server must be powered off right away.
+func (m *Machine) GracefulShutdownTimeout() time.Duration {
+	return time.Duration(m.ScalewayMachine.Spec.DeletionStrategy.GracefulShutdownTimeoutSeconds) * time.Second
+}
+
+// SnapshotRootVolume returns true if a snapshot of the root volume must be created
+// before the server is deleted or archived.
+func (m *Machine) SnapshotRootVolume() bool {
+	return ptr.Deref(m.ScalewayMachine.Spec.DeletionStrategy.SnapshotRootVolume, false)
+}
+
+// IsProtected returns true if the server must be protected against deletion.
+// Control-plane servers are protected by default.
+func (m *Machine) IsProtected() bool {
+	return ptr.Deref(m.ScalewayMachine.Spec.Protected, m.IsControlPlane())
 }
 
 // HasJoinedCluster returns true if the machine has joined the cluster.
@@ -194,3 +369,24 @@ func (m *Machine) HasJoinedCluster() bool {
 func (m *Machine) IsControlPlane() bool {
 	return util.IsControlPlaneMachine(m.Machine)
 }
+
+// HasManagedPlacementGroup returns true if the Placement Groups of the machine
+// are managed by the provider.
+func (m *Machine) HasManagedPlacementGroup() bool {
+	return m.ScalewayMachine.Spec.ManagedPlacementGroup.PolicyType != ""
+}
+
+// ManagedPlacementGroupName returns the name of the group of machines that
+// share the same managed Placement Groups.
+func (m *Machine) ManagedPlacementGroupName() (string, error) {
+	switch {
+	case m.IsControlPlane():
+		return "control-plane", nil
+	case m.Machine.Labels[clusterv1.MachineDeploymentNameLabel] != "":
+		return "md-" + m.Machine.Labels[clusterv1.MachineDeploymentNameLabel], nil
+	case m.Machine.Labels[clusterv1.MachinePoolNameLabel] != "":
+		return "mp-" + m.Machine.Labels[clusterv1.MachinePoolNameLabel], nil
+	}
+
+	return "", errors.New("machine must be part of a MachineDeployment, a MachinePool or the control plane to use a managed placement group")
+}
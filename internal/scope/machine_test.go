@@ -387,3 +387,152 @@ func TestMachine_HasJoinedCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestMachine_IsProtected(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		Machine         *clusterv1.Machine
+		ScalewayMachine *infrav1.ScalewayMachine
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   bool
+	}{
+		{
+			name: "worker machine",
+			fields: fields{
+				Machine:         &clusterv1.Machine{},
+				ScalewayMachine: &infrav1.ScalewayMachine{},
+			},
+			want: false,
+		},
+		{
+			name: "control-plane machine",
+			fields: fields{
+				Machine: &clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+					},
+				},
+				ScalewayMachine: &infrav1.ScalewayMachine{},
+			},
+			want: true,
+		},
+		{
+			name: "control-plane machine without protection",
+			fields: fields{
+				Machine: &clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+					},
+				},
+				ScalewayMachine: &infrav1.ScalewayMachine{
+					Spec: infrav1.ScalewayMachineSpec{
+						Protected: ptr.To(false),
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "protected worker machine",
+			fields: fields{
+				Machine: &clusterv1.Machine{},
+				ScalewayMachine: &infrav1.ScalewayMachine{
+					Spec: infrav1.ScalewayMachineSpec{
+						Protected: ptr.To(true),
+					},
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := &Machine{
+				Machine:         tt.fields.Machine,
+				ScalewayMachine: tt.fields.ScalewayMachine,
+			}
+			if got := m.IsProtected(); got != tt.want {
+				t.Errorf("Machine.IsProtected() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMachine_SetServerStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		server *instance.Server
+		want   infrav1.ScalewayMachineStatus
+	}{
+		{
+			name: "server with local root volume",
+			server: &instance.Server{
+				ID:             "11111111-1111-1111-1111-111111111111",
+				Zone:           scw.ZoneFrPar1,
+				State:          instance.ServerStateRunning,
+				CommercialType: "PRO2-S",
+				Image: &instance.Image{
+					ID:   "22222222-2222-2222-2222-222222222222",
+					Name: "ubuntu_noble",
+				},
+				Volumes: map[string]*instance.VolumeServer{
+					"0": {
+						ID:         "33333333-3333-3333-3333-333333333333",
+						Boot:       true,
+						VolumeType: instance.VolumeServerVolumeTypeLSSD,
+					},
+					"1": {
+						ID:         "44444444-4444-4444-4444-444444444444",
+						VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+					},
+				},
+			},
+			want: infrav1.ScalewayMachineStatus{
+				ServerID:       "11111111-1111-1111-1111-111111111111",
+				Zone:           "fr-par-1",
+				State:          "running",
+				CommercialType: "PRO2-S",
+				Image: infrav1.ImageStatus{
+					ID:   "22222222-2222-2222-2222-222222222222",
+					Name: "ubuntu_noble",
+				},
+				RootVolume: infrav1.RootVolumeStatus{
+					ID:   "33333333-3333-3333-3333-333333333333",
+					Type: "local",
+				},
+			},
+		},
+		{
+			name: "server without image and volumes",
+			server: &instance.Server{
+				ID:             "11111111-1111-1111-1111-111111111111",
+				Zone:           scw.ZoneFrPar1,
+				State:          instance.ServerStateStopped,
+				CommercialType: "DEV1-S",
+			},
+			want: infrav1.ScalewayMachineStatus{
+				ServerID:       "11111111-1111-1111-1111-111111111111",
+				Zone:           "fr-par-1",
+				State:          "stopped",
+				CommercialType: "DEV1-S",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := &Machine{
+				ScalewayMachine: &infrav1.ScalewayMachine{},
+			}
+			m.SetServerStatus(tt.server)
+			if got := m.ScalewayMachine.Status; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Machine.SetServerStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package scope
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+)
+
+const (
+	// machinePoolMachineTagPrefix is the prefix of the tag that contains the
+	// name of a ScalewayMachinePool machine.
+	machinePoolMachineTagPrefix = "caps-scalewaymachinepoolmachine="
+	// machinePoolTemplateHashTagPrefix is the prefix of the tag that contains the
+	// hash of the template that was used to create a ScalewayMachinePool machine.
+	machinePoolTemplateHashTagPrefix = "caps-templatehash="
+	// machinePoolTemplateHashLength is the length of the template hash.
+	machinePoolTemplateHashLength = 10
+
+	defaultMachinePoolMaxSurge       = 1
+	defaultMachinePoolMaxUnavailable = 0
+)
+
+// MachinePool is a MachinePool scope.
+type MachinePool struct {
+	Client      client.Client
+	patchHelper *patch.Helper
+
+	*Cluster
+
+	MachinePool         *clusterv1.MachinePool
+	ScalewayMachinePool *infrav1.ScalewayMachinePool
+}
+
+// MachinePoolParams contains mandatory params for creating the MachinePool scope.
+type MachinePoolParams struct {
+	Client              client.Client
+	ClusterScope        *Cluster
+	MachinePool         *clusterv1.MachinePool
+	ScalewayMachinePool *infrav1.ScalewayMachinePool
+}
+
+// NewMachinePool creates a new MachinePool scope.
+func NewMachinePool(params *MachinePoolParams) (*MachinePool, error) {
+	helper, err := patch.NewHelper(params.ScalewayMachinePool, params.Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create patch helper for ScalewayMachinePool: %w", err)
+	}
+
+	return &MachinePool{
+		Client:              params.Client,
+		patchHelper:         helper,
+		Cluster:             params.ClusterScope,
+		MachinePool:         params.MachinePool,
+		ScalewayMachinePool: params.ScalewayMachinePool,
+	}, nil
+}
+
+// PatchObject patches the ScalewayMachinePool object.
+func (m *MachinePool) PatchObject(ctx context.Context) error {
+	summaryConditions := []string{
+		infrav1.ScalewayMachinePoolInstancesReadyCondition,
+	}
+
+	if err := conditions.SetSummaryCondition(m.ScalewayMachinePool, m.ScalewayMachinePool, infrav1.ScalewayMachinePoolReadyCondition, conditions.ForConditionTypes(summaryConditions)); err != nil {
+		return err
+	}
+
+	return m.patchHelper.Patch(ctx, m.ScalewayMachinePool, patch.WithOwnedConditions{
+		Conditions: append(summaryConditions, infrav1.ScalewayMachinePoolReadyCondition),
+	})
+}
+
+// Close closes the MachinePool scope by patching the ScalewayMachinePool object.
+func (m *MachinePool) Close(ctx context.Context) error {
+	return m.PatchObject(ctx)
+}
+
+// ResourceName returns the name/prefix that resources created for the machine pool should have.
+// It is possible to provide additional suffixes that will be appended to the name with a leading "-".
+func (m *MachinePool) ResourceName(suffixes ...string) string {
+	return nameWithSuffixes(m.ScalewayMachinePool.Name, suffixes...)
+}
+
+// ResourceTags returns the tags that all resources created for the machine pool have.
+func (m *MachinePool) ResourceTags() []string {
+	return m.Cluster.ResourceTags(fmt.Sprintf("caps-scalewaymachinepool=%s", m.ScalewayMachinePool.Name))
+}
+
+// Zones returns the zones where the machines of the pool can be created.
+// If the MachinePool has no failure domains, the default zone is returned.
+func (m *MachinePool) Zones() ([]scw.Zone, error) {
+	if len(m.MachinePool.Spec.FailureDomains) == 0 {
+		zone, err := m.ScalewayClient.GetZoneOrDefault("")
+		if err != nil {
+			return nil, err
+		}
+
+		return []scw.Zone{zone}, nil
+	}
+
+	zones := make([]scw.Zone, 0, len(m.MachinePool.Spec.FailureDomains))
+
+	for _, fd := range m.MachinePool.Spec.FailureDomains {
+		zone, err := m.ScalewayClient.GetZoneOrDefault(fd)
+		if err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(zones, zone) {
+			zones = append(zones, zone)
+		}
+	}
+
+	return zones, nil
+}
+
+// Replicas returns the desired number of machines in the pool.
+func (m *MachinePool) Replicas() int {
+	return int(ptr.Deref(m.MachinePool.Spec.Replicas, 1))
+}
+
+// MaxSurge returns the maximum number of machines that can be created above
+// the desired number of replicas during a rolling replacement.
+func (m *MachinePool) MaxSurge() int {
+	return int(ptr.Deref(m.ScalewayMachinePool.Spec.Strategy.MaxSurge, defaultMachinePoolMaxSurge))
+}
+
+// MaxUnavailable returns the maximum number of machines that can be unavailable
+// during a rolling replacement.
+func (m *MachinePool) MaxUnavailable() int {
+	return int(ptr.Deref(m.ScalewayMachinePool.Spec.Strategy.MaxUnavailable, defaultMachinePoolMaxUnavailable))
+}
+
+// TemplateHash returns a hash of the machine template of the pool. Machines
+// with a different template hash are outdated and must be replaced. Fields that
+// are reconciled in place on existing machines are not part of the hash.
+func (m *MachinePool) TemplateHash() (string, error) {
+	template := m.ScalewayMachinePool.Spec.Template.DeepCopy()
+	template.AdditionalTags = nil
+	template.UnhealthyServerPolicy = ""
+	template.DeletionStrategy = infrav1.DeletionStrategy{}
+	template.PublicNetwork.IPType = ""
+
+	// Block volumes are grown and their IOPS updated in place.
+	if template.RootVolume.Type == "" || template.RootVolume.Type == "block" {
+		template.RootVolume.Size = 0
+		template.RootVolume.IOPS = 0
+	}
+
+	for i := range template.AdditionalVolumes {
+		if template.AdditionalVolumes[i].Type == "block" {
+			template.AdditionalVolumes[i].Size = 0
+			template.AdditionalVolumes[i].IOPS = 0
+		}
+	}
+
+	b, err := json.Marshal(struct {
+		Template infrav1.ScalewayMachineSpec `json:"template"`
+		Version  string                      `json:"version"`
+	}{
+		Template: *template,
+		Version:  m.MachinePool.Spec.Template.Spec.Version,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal machine template: %w", err)
+	}
+
+	return base36TruncatedHash(string(b), machinePoolTemplateHashLength)
+}
+
+// ParseMachineTags extracts the name and template hash of a machine from the
+// tags of its server. It returns false if the tags do not belong to a machine of the pool.
+func (m *MachinePool) ParseMachineTags(tags []string) (name, templateHash string, ok bool) {
+	for _, tag := range tags {
+		if v, found := strings.CutPrefix(tag, machinePoolMachineTagPrefix); found {
+			name = v
+		}
+
+		if v, found := strings.CutPrefix(tag, machinePoolTemplateHashTagPrefix); found {
+			templateHash = v
+		}
+	}
+
+	return name, templateHash, name != "" && templateHash != ""
+}
+
+// HasNode returns true if a node with the provided name is referenced by the MachinePool.
+func (m *MachinePool) HasNode(nodeName string) bool {
+	return slices.ContainsFunc(m.MachinePool.Status.NodeRefs, func(ref corev1.ObjectReference) bool {
+		return ref.Name == nodeName
+	})
+}
+
+// HasBootstrapData returns true if the bootstrap data secret of the MachinePool is available.
+func (m *MachinePool) HasBootstrapData() bool {
+	return m.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName != nil
+}
+
+// NewMachine returns a Machine scope for a machine of the pool. The machine
+// has no ScalewayMachine object, the returned scope must not be closed.
+func (m *MachinePool) NewMachine(name string, zone scw.Zone, templateHash string, joined bool) *Machine {
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: m.ScalewayMachinePool.Namespace,
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel:     m.Cluster.Cluster.Name,
+				clusterv1.MachinePoolNameLabel: m.MachinePool.Name,
+			},
+		},
+		Spec: clusterv1.MachineSpec{
+			ClusterName:   m.MachinePool.Spec.ClusterName,
+			Bootstrap:     m.MachinePool.Spec.Template.Spec.Bootstrap,
+			FailureDomain: string(zone),
+		},
+	}
+
+	if joined {
+		machine.Status.NodeRef = clusterv1.MachineNodeReference{Name: name}
+	}
+
+	return &Machine{
+		Client:  m.Client,
+		Cluster: m.Cluster,
+		Machine: machine,
+		ScalewayMachine: &infrav1.ScalewayMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: m.ScalewayMachinePool.Namespace,
+			},
+			Spec: *m.ScalewayMachinePool.Spec.Template.DeepCopy(),
+		},
+		tags: append(
+			m.ResourceTags(),
+			machinePoolTemplateHashTagPrefix+templateHash,
+			machinePoolMachineTagPrefix+name,
+		),
+	}
+}
+
+// SetProviderIDs sets the ProviderIDList of the ScalewayMachinePool.
+func (m *MachinePool) SetProviderIDs(providerIDs []string) {
+	slices.Sort(providerIDs)
+	m.ScalewayMachinePool.Spec.ProviderIDList = providerIDs
+}
+
+// SetStatusReplicas sets the number of replicas of the ScalewayMachinePool.
+func (m *MachinePool) SetStatusReplicas(replicas int) {
+	m.ScalewayMachinePool.Status.Replicas = ptr.To(int32(replicas))
+}
@@ -0,0 +1,121 @@
+package scope
+
+import (
+	"testing"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+)
+
+func TestMachinePool_TemplateHash(t *testing.T) {
+	t.Parallel()
+
+	newMachinePool := func(version string, mutate func(spec *infrav1.ScalewayMachineSpec)) *MachinePool {
+		m := &MachinePool{
+			MachinePool: &clusterv1.MachinePool{
+				Spec: clusterv1.MachinePoolSpec{
+					Template: clusterv1.MachineTemplateSpec{
+						Spec: clusterv1.MachineSpec{
+							Version: version,
+						},
+					},
+				},
+			},
+			ScalewayMachinePool: &infrav1.ScalewayMachinePool{
+				Spec: infrav1.ScalewayMachinePoolSpec{
+					Template: infrav1.ScalewayMachineSpec{
+						CommercialType: "DEV1-S",
+						Image: infrav1.Image{
+							IDOrName: infrav1.IDOrName{
+								Name: "cluster-api-rockylinux-9-v1.34.3",
+							},
+						},
+						RootVolume: infrav1.RootVolume{
+							Type: "block",
+						},
+						AdditionalTags: []string{"team=platform"},
+					},
+				},
+			},
+		}
+
+		if mutate != nil {
+			mutate(&m.ScalewayMachinePool.Spec.Template)
+		}
+
+		return m
+	}
+
+	tests := []struct {
+		name     string
+		version  string
+		mutate   func(spec *infrav1.ScalewayMachineSpec)
+		wantSame bool
+	}{
+		{
+			name:     "same template",
+			version:  "v1.34.3",
+			wantSame: true,
+		},
+		{
+			name:    "additionalTags changed",
+			version: "v1.34.3",
+			mutate: func(spec *infrav1.ScalewayMachineSpec) {
+				spec.AdditionalTags = []string{"team=platform", "env=prod"}
+			},
+			wantSame: true,
+		},
+		{
+			name:    "block root volume grown",
+			version: "v1.34.3",
+			mutate: func(spec *infrav1.ScalewayMachineSpec) {
+				spec.RootVolume = infrav1.RootVolume{Size: 50, Type: "block", IOPS: 15000}
+			},
+			wantSame: true,
+		},
+		{
+			name:    "local root volume changed",
+			version: "v1.34.3",
+			mutate: func(spec *infrav1.ScalewayMachineSpec) {
+				spec.RootVolume = infrav1.RootVolume{Size: 50, Type: "local"}
+			},
+			wantSame: false,
+		},
+		{
+			name:    "commercialType changed",
+			version: "v1.34.3",
+			mutate: func(spec *infrav1.ScalewayMachineSpec) {
+				spec.CommercialType = "DEV1-M"
+			},
+			wantSame: false,
+		},
+		{
+			name:     "version changed",
+			version:  "v1.35.0",
+			wantSame: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			want, err := newMachinePool("v1.34.3", nil).TemplateHash()
+			if err != nil {
+				t.Fatalf("MachinePool.TemplateHash() error = %v", err)
+			}
+
+			got, err := newMachinePool(tt.version, tt.mutate).TemplateHash()
+			if err != nil {
+				t.Fatalf("MachinePool.TemplateHash() error = %v", err)
+			}
+
+			if len(got) != machinePoolTemplateHashLength {
+				t.Errorf("MachinePool.TemplateHash() = %v, want length %d", got, machinePoolTemplateHashLength)
+			}
+
+			if (got == want) != tt.wantSame {
+				t.Errorf("MachinePool.TemplateHash() = %v, base hash = %v, wantSame %v", got, want, tt.wantSame)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package scope
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+)
+
+// MachineTemplate is a MachineTemplate scope.
+type MachineTemplate struct {
+	patchHelper *patch.Helper
+
+	*Cluster
+
+	ScalewayMachineTemplate *infrav1.ScalewayMachineTemplate
+}
+
+// MachineTemplateParams contains mandatory params for creating the MachineTemplate scope.
+type MachineTemplateParams struct {
+	Client                  client.Client
+	ClusterScope            *Cluster
+	ScalewayMachineTemplate *infrav1.ScalewayMachineTemplate
+}
+
+// NewMachineTemplate creates a new MachineTemplate scope.
+func NewMachineTemplate(params *MachineTemplateParams) (*MachineTemplate, error) {
+	helper, err := patch.NewHelper(params.ScalewayMachineTemplate, params.Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create patch helper for ScalewayMachineTemplate: %w", err)
+	}
+
+	return &MachineTemplate{
+		patchHelper:             helper,
+		Cluster:                 params.ClusterScope,
+		ScalewayMachineTemplate: params.ScalewayMachineTemplate,
+	}, nil
+}
+
+// PatchObject patches the ScalewayMachineTemplate object.
+func (m *MachineTemplate) PatchObject(ctx context.Context) error {
+	return m.patchHelper.Patch(ctx, m.ScalewayMachineTemplate)
+}
+
+// Close closes the MachineTemplate scope by patching the ScalewayMachineTemplate object.
+func (m *MachineTemplate) Close(ctx context.Context) error {
+	return m.PatchObject(ctx)
+}
+
+// CommercialType returns the commercial type of the machines created from the template.
+func (m *MachineTemplate) CommercialType() string {
+	return m.ScalewayMachineTemplate.Spec.Template.Spec.CommercialType
+}
@@ -0,0 +1,132 @@
+package scope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"k8s.io/client-go/tools/events"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+)
+
+const (
+	defaultRemediationRetryLimit     = 1
+	defaultRemediationTimeoutSeconds = 300
+)
+
+// Remediation is a Remediation scope.
+type Remediation struct {
+	Client      client.Client
+	patchHelper *patch.Helper
+
+	*Cluster
+
+	Machine *clusterv1.Machine
+	// ScalewayMachine is nil if the Machine is not backed by a ScalewayMachine.
+	ScalewayMachine     *infrav1.ScalewayMachine
+	ScalewayRemediation *infrav1.ScalewayRemediation
+
+	// recorder records events on the ScalewayRemediation, it may be nil.
+	recorder events.EventRecorder
+}
+
+// RemediationParams contains mandatory params for creating the Remediation scope.
+type RemediationParams struct {
+	Client              client.Client
+	ClusterScope        *Cluster
+	Machine             *clusterv1.Machine
+	ScalewayMachine     *infrav1.ScalewayMachine
+	ScalewayRemediation *infrav1.ScalewayRemediation
+	// Recorder is optional, no event is recorded if it is nil.
+	Recorder events.EventRecorder
+}
+
+// NewRemediation creates a new Remediation scope.
+func NewRemediation(params *RemediationParams) (*Remediation, error) {
+	helper, err := patch.NewHelper(params.ScalewayRemediation, params.Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create patch helper for ScalewayRemediation: %w", err)
+	}
+
+	return &Remediation{
+		Client:              params.Client,
+		patchHelper:         helper,
+		Cluster:             params.ClusterScope,
+		Machine:             params.Machine,
+		ScalewayMachine:     params.ScalewayMachine,
+		ScalewayRemediation: params.ScalewayRemediation,
+		recorder:            params.Recorder,
+	}, nil
+}
+
+// Eventf records an event on the ScalewayRemediation.
+func (r *Remediation) Eventf(eventtype, reason, action, note string, args ...any) {
+	if r.recorder == nil {
+		return
+	}
+
+	r.recorder.Eventf(r.ScalewayRemediation, r.Machine, eventtype, reason, action, note, args...)
+}
+
+// PatchObject patches the ScalewayRemediation object.
+func (r *Remediation) PatchObject(ctx context.Context) error {
+	return r.patchHelper.Patch(ctx, r.ScalewayRemediation)
+}
+
+// Close closes the Remediation scope by patching the ScalewayRemediation object.
+func (r *Remediation) Close(ctx context.Context) error {
+	return r.PatchObject(ctx)
+}
+
+// Strategy returns the remediation strategy.
+func (r *Remediation) Strategy() string {
+	if r.ScalewayRemediation.Spec.Strategy == "" {
+		return infrav1.RemediationStrategyReboot
+	}
+
+	return r.ScalewayRemediation.Spec.Strategy
+}
+
+// RetryLimit returns the maximum number of times the server is rebooted.
+func (r *Remediation) RetryLimit() int32 {
+	if r.ScalewayRemediation.Spec.RetryLimit == 0 {
+		return defaultRemediationRetryLimit
+	}
+
+	return r.ScalewayRemediation.Spec.RetryLimit
+}
+
+// Timeout returns the time to wait for the Machine to become healthy after a reboot.
+func (r *Remediation) Timeout() time.Duration {
+	if r.ScalewayRemediation.Spec.TimeoutSeconds == 0 {
+		return defaultRemediationTimeoutSeconds * time.Second
+	}
+
+	return time.Duration(r.ScalewayRemediation.Spec.TimeoutSeconds) * time.Second
+}
+
+// Server returns the zone and ID of the Instance server of the Machine.
+// It returns an error if the Machine is not backed by a provisioned ScalewayMachine.
+func (r *Remediation) Server() (scw.Zone, string, error) {
+	if r.ScalewayMachine == nil {
+		return "", "", errors.New("machine is not backed by a ScalewayMachine")
+	}
+
+	providerID := r.ScalewayMachine.Spec.ProviderID
+	if providerID == "" {
+		return "", "", errors.New("providerID is not set on ScalewayMachine")
+	}
+
+	zone, serverID, ok := parseInstanceProviderID(providerID)
+	if !ok {
+		return "", "", fmt.Errorf("invalid providerID %q", providerID)
+	}
+
+	return zone, serverID, nil
+}
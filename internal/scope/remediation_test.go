@@ -0,0 +1,78 @@
+package scope
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+)
+
+func TestRemediation_Server(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		scalewayMachine *infrav1.ScalewayMachine
+		wantZone        scw.Zone
+		wantServerID    string
+		wantErr         bool
+	}{
+		{
+			name: "instance provider ID",
+			scalewayMachine: &infrav1.ScalewayMachine{
+				Spec: infrav1.ScalewayMachineSpec{
+					ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+				},
+			},
+			wantZone:     scw.ZoneFrPar1,
+			wantServerID: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			name:            "no ScalewayMachine",
+			scalewayMachine: nil,
+			wantErr:         true,
+		},
+		{
+			name:            "no provider ID",
+			scalewayMachine: &infrav1.ScalewayMachine{},
+			wantErr:         true,
+		},
+		{
+			name: "elastic metal provider ID",
+			scalewayMachine: &infrav1.ScalewayMachine{
+				Spec: infrav1.ScalewayMachineSpec{
+					ProviderID: "scaleway://baremetal/fr-par-1/11111111-1111-1111-1111-111111111111",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing server ID",
+			scalewayMachine: &infrav1.ScalewayMachine{
+				Spec: infrav1.ScalewayMachineSpec{
+					ProviderID: "scaleway://instance/fr-par-1",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := &Remediation{
+				ScalewayMachine: tt.scalewayMachine,
+			}
+			zone, serverID, err := r.Server()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Remediation.Server() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if zone != tt.wantZone {
+				t.Errorf("Remediation.Server() zone = %v, want %v", zone, tt.wantZone)
+			}
+			if serverID != tt.wantServerID {
+				t.Errorf("Remediation.Server() serverID = %v, want %v", serverID, tt.wantServerID)
+			}
+		})
+	}
+}
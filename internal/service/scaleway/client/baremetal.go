@@ -0,0 +1,291 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	baremetal "github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	baremetalpn "github.com/scaleway/scaleway-sdk-go/api/baremetal/v3"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+type BaremetalAPI interface {
+	zonesGetter
+
+	ListServers(req *baremetal.ListServersRequest, opts ...scw.RequestOption) (*baremetal.ListServersResponse, error)
+	CreateServer(req *baremetal.CreateServerRequest, opts ...scw.RequestOption) (*baremetal.Server, error)
+	UpdateServer(req *baremetal.UpdateServerRequest, opts ...scw.RequestOption) (*baremetal.Server, error)
+	InstallServer(req *baremetal.InstallServerRequest, opts ...scw.RequestOption) (*baremetal.Server, error)
+	DeleteServer(req *baremetal.DeleteServerRequest, opts ...scw.RequestOption) (*baremetal.Server, error)
+	ListOffers(req *baremetal.ListOffersRequest, opts ...scw.RequestOption) (*baremetal.ListOffersResponse, error)
+	ListOS(req *baremetal.ListOSRequest, opts ...scw.RequestOption) (*baremetal.ListOSResponse, error)
+	GetOS(req *baremetal.GetOSRequest, opts ...scw.RequestOption) (*baremetal.OS, error)
+}
+
+type BaremetalPrivateNetworkAPI interface {
+	ListServerPrivateNetworks(req *baremetalpn.PrivateNetworkAPIListServerPrivateNetworksRequest, opts ...scw.RequestOption) (*baremetalpn.ListServerPrivateNetworksResponse, error)
+	AddServerPrivateNetwork(req *baremetalpn.PrivateNetworkAPIAddServerPrivateNetworkRequest, opts ...scw.RequestOption) (*baremetalpn.ServerPrivateNetwork, error)
+}
+
+type Baremetal interface {
+	FindBaremetalServer(ctx context.Context, zone scw.Zone, tags []string) (*baremetal.Server, error)
+	CreateBaremetalServer(ctx context.Context, zone scw.Zone, name, offerID string, optionIDs, tags []string) (*baremetal.Server, error)
+	UpdateBaremetalServerUserData(ctx context.Context, zone scw.Zone, serverID string, userData []byte) error
+	InstallBaremetalServer(ctx context.Context, zone scw.Zone, serverID, osID, hostname string, sshKeyIDs []string) error
+	DeleteBaremetalServer(ctx context.Context, zone scw.Zone, serverID string) error
+	FindBaremetalOffer(ctx context.Context, zone scw.Zone, name string) (*baremetal.Offer, error)
+	FindBaremetalOS(ctx context.Context, zone scw.Zone, offerID, name, version string) (*baremetal.OS, error)
+	GetBaremetalOS(ctx context.Context, zone scw.Zone, osID string) (*baremetal.OS, error)
+	FindBaremetalServerPrivateNetwork(ctx context.Context, zone scw.Zone, serverID, privateNetworkID string) (*baremetalpn.ServerPrivateNetwork, error)
+	AddBaremetalServerPrivateNetwork(ctx context.Context, zone scw.Zone, serverID, privateNetworkID string) (*baremetalpn.ServerPrivateNetwork, error)
+}
+
+// FindBaremetalServer finds an existing Elastic Metal server by tags.
+// It returns ErrNoItemFound if no matching server is found.
+func (c *Client) FindBaremetalServer(ctx context.Context, zone scw.Zone, tags []string) (*baremetal.Server, error) {
+	if err := c.validateZone(c.baremetal, zone); err != nil {
+		return nil, err
+	}
+
+	if err := validateTags(tags); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.baremetal.ListServers(&baremetal.ListServersRequest{
+		Zone:      zone,
+		Tags:      tags,
+		ProjectID: &c.projectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("ListServers", err)
+	}
+
+	// Filter out all servers that have the wrong tags.
+	servers := slices.DeleteFunc(resp.Servers, func(server *baremetal.Server) bool {
+		return !matchTags(server.Tags, tags)
+	})
+
+	switch len(servers) {
+	case 0:
+		return nil, ErrNoItemFound
+	case 1:
+		return servers[0], nil
+	default:
+		return nil, fmt.Errorf("%w: found %d baremetal servers with tags %s", ErrTooManyItemsFound, len(servers), tags)
+	}
+}
+
+// CreateBaremetalServer orders a new Elastic Metal server without installing an OS.
+func (c *Client) CreateBaremetalServer(
+	ctx context.Context,
+	zone scw.Zone,
+	name, offerID string,
+	optionIDs, tags []string,
+) (*baremetal.Server, error) {
+	if err := c.validateZone(c.baremetal, zone); err != nil {
+		return nil, err
+	}
+
+	server, err := c.baremetal.CreateServer(&baremetal.CreateServerRequest{
+		Zone:        zone,
+		OfferID:     offerID,
+		ProjectID:   &c.projectID,
+		Name:        name,
+		Description: createdByDescription,
+		Tags:        append(tags, createdByTag),
+		OptionIDs:   optionIDs,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, newCallError("CreateServer", err)
+	}
+
+	return server, nil
+}
+
+// UpdateBaremetalServerUserData sets the cloud-init user data of an Elastic Metal server.
+func (c *Client) UpdateBaremetalServerUserData(ctx context.Context, zone scw.Zone, serverID string, userData []byte) error {
+	if err := c.validateZone(c.baremetal, zone); err != nil {
+		return err
+	}
+
+	if _, err := c.baremetal.UpdateServer(&baremetal.UpdateServerRequest{
+		Zone:     zone,
+		ServerID: serverID,
+		UserData: &userData,
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("UpdateServer", err)
+	}
+
+	return nil
+}
+
+// InstallBaremetalServer installs an OS on an Elastic Metal server.
+func (c *Client) InstallBaremetalServer(ctx context.Context, zone scw.Zone, serverID, osID, hostname string, sshKeyIDs []string) error {
+	if err := c.validateZone(c.baremetal, zone); err != nil {
+		return err
+	}
+
+	if _, err := c.baremetal.InstallServer(&baremetal.InstallServerRequest{
+		Zone:      zone,
+		ServerID:  serverID,
+		OsID:      osID,
+		Hostname:  hostname,
+		SSHKeyIDs: sshKeyIDs,
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("InstallServer", err)
+	}
+
+	return nil
+}
+
+// DeleteBaremetalServer deletes an Elastic Metal server. The server is wiped
+// and released by Scaleway.
+func (c *Client) DeleteBaremetalServer(ctx context.Context, zone scw.Zone, serverID string) error {
+	if err := c.validateZone(c.baremetal, zone); err != nil {
+		return err
+	}
+
+	if _, err := c.baremetal.DeleteServer(&baremetal.DeleteServerRequest{
+		Zone:     zone,
+		ServerID: serverID,
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("DeleteServer", err)
+	}
+
+	return nil
+}
+
+// FindBaremetalOffer finds an Elastic Metal offer by name.
+// It returns ErrNoItemFound if no matching offer is found.
+func (c *Client) FindBaremetalOffer(ctx context.Context, zone scw.Zone, name string) (*baremetal.Offer, error) {
+	if err := c.validateZone(c.baremetal, zone); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.baremetal.ListOffers(&baremetal.ListOffersRequest{
+		Zone:               zone,
+		Name:               &name,
+		SubscriptionPeriod: baremetal.OfferSubscriptionPeriodHourly,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("ListOffers", err)
+	}
+
+	// Filter out all offers that have the wrong name.
+	offers := slices.DeleteFunc(resp.Offers, func(offer *baremetal.Offer) bool {
+		return offer.Name != name
+	})
+
+	switch len(offers) {
+	case 0:
+		return nil, ErrNoItemFound
+	case 1:
+		return offers[0], nil
+	default:
+		return nil, fmt.Errorf("%w: found %d offers with name %s", ErrTooManyItemsFound, len(offers), name)
+	}
+}
+
+// FindBaremetalOS finds an Elastic Metal OS compatible with the offer by name
+// and version. If version is empty, the name must match a single OS.
+// It returns ErrNoItemFound if no matching OS is found.
+func (c *Client) FindBaremetalOS(ctx context.Context, zone scw.Zone, offerID, name, version string) (*baremetal.OS, error) {
+	if err := c.validateZone(c.baremetal, zone); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.baremetal.ListOS(&baremetal.ListOSRequest{
+		Zone:    zone,
+		OfferID: &offerID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("ListOS", err)
+	}
+
+	// Filter out all OS that have the wrong name or version.
+	oses := slices.DeleteFunc(resp.Os, func(os *baremetal.OS) bool {
+		return os.Name != name || (version != "" && os.Version != version)
+	})
+
+	switch len(oses) {
+	case 0:
+		return nil, ErrNoItemFound
+	case 1:
+		return oses[0], nil
+	default:
+		return nil, fmt.Errorf("%w: found %d OS with name %s and version %q", ErrTooManyItemsFound, len(oses), name, version)
+	}
+}
+
+// GetBaremetalOS gets an Elastic Metal OS by ID.
+func (c *Client) GetBaremetalOS(ctx context.Context, zone scw.Zone, osID string) (*baremetal.OS, error) {
+	if err := c.validateZone(c.baremetal, zone); err != nil {
+		return nil, err
+	}
+
+	os, err := c.baremetal.GetOS(&baremetal.GetOSRequest{
+		Zone: zone,
+		OsID: osID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, newCallError("GetOS", err)
+	}
+
+	return os, nil
+}
+
+// FindBaremetalServerPrivateNetwork finds the attachment of an Elastic Metal
+// server to a Private Network.
+// It returns ErrNoItemFound if the server is not attached to the Private Network.
+func (c *Client) FindBaremetalServerPrivateNetwork(
+	ctx context.Context,
+	zone scw.Zone,
+	serverID, privateNetworkID string,
+) (*baremetalpn.ServerPrivateNetwork, error) {
+	if err := c.validateZone(c.baremetal, zone); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.baremetalPrivateNetwork.ListServerPrivateNetworks(&baremetalpn.PrivateNetworkAPIListServerPrivateNetworksRequest{
+		Zone:             zone,
+		ServerID:         &serverID,
+		PrivateNetworkID: &privateNetworkID,
+		ProjectID:        &c.projectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("ListServerPrivateNetworks", err)
+	}
+
+	switch len(resp.ServerPrivateNetworks) {
+	case 0:
+		return nil, ErrNoItemFound
+	case 1:
+		return resp.ServerPrivateNetworks[0], nil
+	default:
+		return nil, fmt.Errorf("%w: found %d private networks for server %s", ErrTooManyItemsFound, len(resp.ServerPrivateNetworks), serverID)
+	}
+}
+
+// AddBaremetalServerPrivateNetwork attaches an Elastic Metal server to a Private
+// Network. The IP of the server is automatically allocated by IPAM.
+func (c *Client) AddBaremetalServerPrivateNetwork(
+	ctx context.Context,
+	zone scw.Zone,
+	serverID, privateNetworkID string,
+) (*baremetalpn.ServerPrivateNetwork, error) {
+	if err := c.validateZone(c.baremetal, zone); err != nil {
+		return nil, err
+	}
+
+	spn, err := c.baremetalPrivateNetwork.AddServerPrivateNetwork(&baremetalpn.PrivateNetworkAPIAddServerPrivateNetworkRequest{
+		Zone:             zone,
+		ServerID:         serverID,
+		PrivateNetworkID: privateNetworkID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, newCallError("AddServerPrivateNetwork", err)
+	}
+
+	return spn, nil
+}
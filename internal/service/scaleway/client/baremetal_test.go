@@ -0,0 +1,349 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+
+	baremetal "github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client/mock_client"
+)
+
+const offerID = "11111111-1111-1111-1111-111111111111"
+
+func TestClient_FindBaremetalServer(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx  context.Context
+		zone scw.Zone
+		tags []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *baremetal.Server
+		wantErr bool
+		expect  func(d *mock_client.MockBaremetalAPIMockRecorder)
+	}{
+		{
+			name: "no server found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				tags: []string{"tag1", "tag2"},
+			},
+			wantErr: true,
+			expect: func(d *mock_client.MockBaremetalAPIMockRecorder) {
+				d.ListServers(&baremetal.ListServersRequest{
+					Zone:      scw.ZoneFrPar1,
+					Tags:      []string{"tag1", "tag2"},
+					ProjectID: ptr.To(projectID),
+				}, gomock.Any()).Return(&baremetal.ListServersResponse{}, nil)
+			},
+		},
+		{
+			name: "server found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				tags: []string{"tag1", "tag2"},
+			},
+			expect: func(d *mock_client.MockBaremetalAPIMockRecorder) {
+				d.ListServers(&baremetal.ListServersRequest{
+					Zone:      scw.ZoneFrPar1,
+					Tags:      []string{"tag1", "tag2"},
+					ProjectID: ptr.To(projectID),
+				}, gomock.Any()).Return(&baremetal.ListServersResponse{
+					TotalCount: 2,
+					Servers: []*baremetal.Server{
+						{
+							Name: "server",
+							Tags: []string{"misc", "tag1", "tag2"},
+						},
+						{
+							Name: "other",
+							Tags: []string{"tag1"},
+						},
+					},
+				}, nil)
+			},
+			want: &baremetal.Server{
+				Name: "server",
+				Tags: []string{"misc", "tag1", "tag2"},
+			},
+		},
+		{
+			name: "duplicate servers found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				tags: []string{"tag1", "tag2"},
+			},
+			wantErr: true,
+			expect: func(d *mock_client.MockBaremetalAPIMockRecorder) {
+				d.ListServers(&baremetal.ListServersRequest{
+					Zone:      scw.ZoneFrPar1,
+					Tags:      []string{"tag1", "tag2"},
+					ProjectID: ptr.To(projectID),
+				}, gomock.Any()).Return(&baremetal.ListServersResponse{
+					TotalCount: 2,
+					Servers: []*baremetal.Server{
+						{
+							Name: "server",
+							Tags: []string{"tag1", "tag2"},
+						},
+						{
+							Name: "server1",
+							Tags: []string{"tag1", "tag2"},
+						},
+					},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			baremetalMock := mock_client.NewMockBaremetalAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			baremetalMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(baremetalMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				baremetal: baremetalMock,
+			}
+			got, err := c.FindBaremetalServer(tt.args.ctx, tt.args.zone, tt.args.tags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindBaremetalServer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindBaremetalServer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_FindBaremetalOffer(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		region scw.Region
+	}
+	type args struct {
+		ctx  context.Context
+		zone scw.Zone
+		name string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *baremetal.Offer
+		wantErr bool
+		expect  func(d *mock_client.MockBaremetalAPIMockRecorder)
+	}{
+		{
+			name: "offer found",
+			fields: fields{
+				region: scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				name: "EM-A116X-SSD",
+			},
+			expect: func(d *mock_client.MockBaremetalAPIMockRecorder) {
+				d.ListOffers(&baremetal.ListOffersRequest{
+					Zone:               scw.ZoneFrPar1,
+					Name:               ptr.To("EM-A116X-SSD"),
+					SubscriptionPeriod: baremetal.OfferSubscriptionPeriodHourly,
+				}, gomock.Any()).Return(&baremetal.ListOffersResponse{
+					TotalCount: 2,
+					Offers: []*baremetal.Offer{
+						{ID: offerID, Name: "EM-A116X-SSD"},
+						{ID: "22222222-2222-2222-2222-222222222222", Name: "EM-A116X-SSD-2"},
+					},
+				}, nil)
+			},
+			want: &baremetal.Offer{ID: offerID, Name: "EM-A116X-SSD"},
+		},
+		{
+			name: "no offer found",
+			fields: fields{
+				region: scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				name: "EM-A116X-SSD",
+			},
+			wantErr: true,
+			expect: func(d *mock_client.MockBaremetalAPIMockRecorder) {
+				d.ListOffers(&baremetal.ListOffersRequest{
+					Zone:               scw.ZoneFrPar1,
+					Name:               ptr.To("EM-A116X-SSD"),
+					SubscriptionPeriod: baremetal.OfferSubscriptionPeriodHourly,
+				}, gomock.Any()).Return(&baremetal.ListOffersResponse{}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			baremetalMock := mock_client.NewMockBaremetalAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			baremetalMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(baremetalMock.EXPECT())
+
+			c := &Client{
+				region:    tt.fields.region,
+				baremetal: baremetalMock,
+			}
+			got, err := c.FindBaremetalOffer(tt.args.ctx, tt.args.zone, tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindBaremetalOffer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindBaremetalOffer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_FindBaremetalOS(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		region scw.Region
+	}
+	type args struct {
+		ctx     context.Context
+		zone    scw.Zone
+		offerID string
+		name    string
+		version string
+	}
+	oses := []*baremetal.OS{
+		{ID: "11111111-1111-1111-1111-111111111111", Name: "Ubuntu", Version: "22.04 LTS (Jammy Jellyfish)"},
+		{ID: "22222222-2222-2222-2222-222222222222", Name: "Ubuntu", Version: "24.04 LTS (Noble Numbat)"},
+		{ID: "33333333-3333-3333-3333-333333333333", Name: "Debian", Version: "12 (Bookworm)"},
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *baremetal.OS
+		wantErr bool
+	}{
+		{
+			name: "find by name and version",
+			fields: fields{
+				region: scw.RegionFrPar,
+			},
+			args: args{
+				ctx:     context.TODO(),
+				zone:    scw.ZoneFrPar1,
+				offerID: offerID,
+				name:    "Ubuntu",
+				version: "24.04 LTS (Noble Numbat)",
+			},
+			want: oses[1],
+		},
+		{
+			name: "find by name",
+			fields: fields{
+				region: scw.RegionFrPar,
+			},
+			args: args{
+				ctx:     context.TODO(),
+				zone:    scw.ZoneFrPar1,
+				offerID: offerID,
+				name:    "Debian",
+			},
+			want: oses[2],
+		},
+		{
+			name: "multiple versions found",
+			fields: fields{
+				region: scw.RegionFrPar,
+			},
+			args: args{
+				ctx:     context.TODO(),
+				zone:    scw.ZoneFrPar1,
+				offerID: offerID,
+				name:    "Ubuntu",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			baremetalMock := mock_client.NewMockBaremetalAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			baremetalMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			baremetalMock.EXPECT().ListOS(&baremetal.ListOSRequest{
+				Zone:    tt.args.zone,
+				OfferID: ptr.To(tt.args.offerID),
+			}, gomock.Any()).Return(&baremetal.ListOSResponse{
+				TotalCount: uint32(len(oses)),
+				Os:         slices.Clone(oses),
+			}, nil)
+
+			c := &Client{
+				region:    tt.fields.region,
+				baremetal: baremetalMock,
+			}
+			got, err := c.FindBaremetalOS(tt.args.ctx, tt.args.zone, tt.args.offerID, tt.args.name, tt.args.version)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindBaremetalOS() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindBaremetalOS() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
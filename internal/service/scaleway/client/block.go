@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"slices"
 
 	"github.com/scaleway/scaleway-sdk-go/api/block/v1"
@@ -12,17 +13,33 @@ type BlockAPI interface {
 	zonesGetter
 
 	CreateVolume(req *block.CreateVolumeRequest, opts ...scw.RequestOption) (*block.Volume, error)
+	GetVolume(req *block.GetVolumeRequest, opts ...scw.RequestOption) (*block.Volume, error)
 	UpdateVolume(req *block.UpdateVolumeRequest, opts ...scw.RequestOption) (*block.Volume, error)
 	ListVolumes(req *block.ListVolumesRequest, opts ...scw.RequestOption) (*block.ListVolumesResponse, error)
 	DeleteVolume(req *block.DeleteVolumeRequest, opts ...scw.RequestOption) error
+	CreateSnapshot(req *block.CreateSnapshotRequest, opts ...scw.RequestOption) (*block.Snapshot, error)
+	ListSnapshots(req *block.ListSnapshotsRequest, opts ...scw.RequestOption) (*block.ListSnapshotsResponse, error)
 }
 
 type Block interface {
 	CreateVolume(ctx context.Context, zone scw.Zone, name string, size scw.Size, iops int64, tags []string) (*block.Volume, error)
+	GetVolume(ctx context.Context, zone scw.Zone, volumeID string) (*block.Volume, error)
 	UpdateVolumeIOPS(ctx context.Context, zone scw.Zone, volumeID string, iops int64) error
+	UpdateVolumeSize(ctx context.Context, zone scw.Zone, volumeID string, size scw.Size) error
 	UpdateVolumeTags(ctx context.Context, zone scw.Zone, volumeID string, tags []string) error
 	FindVolumes(ctx context.Context, zone scw.Zone, tags []string) ([]*block.Volume, error)
 	DeleteVolume(ctx context.Context, zone scw.Zone, volumeID string) error
+	CreateVolumeFromSnapshot(
+		ctx context.Context,
+		zone scw.Zone,
+		name, snapshotID string,
+		size scw.Size,
+		iops int64,
+		tags []string,
+	) (*block.Volume, error)
+	FindSnapshotByName(ctx context.Context, zone scw.Zone, name string) (*block.Snapshot, error)
+	FindSnapshots(ctx context.Context, zone scw.Zone, tags []string) ([]*block.Snapshot, error)
+	CreateSnapshot(ctx context.Context, zone scw.Zone, volumeID, name string, tags []string) (*block.Snapshot, error)
 }
 
 func (c *Client) CreateVolume(ctx context.Context, zone scw.Zone, name string, size scw.Size, iops int64, tags []string) (*block.Volume, error) {
@@ -51,6 +68,22 @@ func (c *Client) CreateVolume(ctx context.Context, zone scw.Zone, name string, s
 	return volume, nil
 }
 
+func (c *Client) GetVolume(ctx context.Context, zone scw.Zone, volumeID string) (*block.Volume, error) {
+	if err := c.validateZone(c.block, zone); err != nil {
+		return nil, err
+	}
+
+	volume, err := c.block.GetVolume(&block.GetVolumeRequest{
+		Zone:     zone,
+		VolumeID: volumeID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, newCallError("GetVolume", err)
+	}
+
+	return volume, nil
+}
+
 func (c *Client) UpdateVolumeIOPS(ctx context.Context, zone scw.Zone, volumeID string, iops int64) error {
 	if err := c.validateZone(c.block, zone); err != nil {
 		return err
@@ -67,11 +100,30 @@ func (c *Client) UpdateVolumeIOPS(ctx context.Context, zone scw.Zone, volumeID s
 	return nil
 }
 
+// UpdateVolumeSize grows a block volume, volumes cannot be shrunk.
+func (c *Client) UpdateVolumeSize(ctx context.Context, zone scw.Zone, volumeID string, size scw.Size) error {
+	if err := c.validateZone(c.block, zone); err != nil {
+		return err
+	}
+
+	if _, err := c.block.UpdateVolume(&block.UpdateVolumeRequest{
+		Zone:     zone,
+		VolumeID: volumeID,
+		Size:     &size,
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("UpdateVolume", err)
+	}
+
+	return nil
+}
+
 func (c *Client) UpdateVolumeTags(ctx context.Context, zone scw.Zone, volumeID string, tags []string) error {
 	if err := c.validateZone(c.block, zone); err != nil {
 		return err
 	}
 
+	tags = append(tags, createdByTag)
+
 	if _, err := c.block.UpdateVolume(&block.UpdateVolumeRequest{
 		Zone:     zone,
 		VolumeID: volumeID,
@@ -122,3 +174,116 @@ func (c *Client) DeleteVolume(ctx context.Context, zone scw.Zone, volumeID strin
 
 	return nil
 }
+
+// CreateVolumeFromSnapshot creates a block volume from a snapshot. The size of
+// the snapshot is used when size is 0.
+func (c *Client) CreateVolumeFromSnapshot(
+	ctx context.Context,
+	zone scw.Zone,
+	name, snapshotID string,
+	size scw.Size,
+	iops int64,
+	tags []string,
+) (*block.Volume, error) {
+	if err := c.validateZone(c.block, zone); err != nil {
+		return nil, err
+	}
+
+	req := &block.CreateVolumeRequest{
+		Zone: zone,
+		Name: name,
+		FromSnapshot: &block.CreateVolumeRequestFromSnapshot{
+			SnapshotID: snapshotID,
+		},
+		Tags: append(tags, createdByTag),
+	}
+
+	if size != 0 {
+		req.FromSnapshot.Size = &size
+	}
+
+	if iops != 0 {
+		req.PerfIops = scw.Uint32Ptr(uint32(iops))
+	}
+
+	volume, err := c.block.CreateVolume(req, scw.WithContext(ctx))
+	if err != nil {
+		return nil, newCallError("CreateVolume", err)
+	}
+
+	return volume, nil
+}
+
+func (c *Client) FindSnapshotByName(ctx context.Context, zone scw.Zone, name string) (*block.Snapshot, error) {
+	if err := c.validateZone(c.block, zone); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.block.ListSnapshots(&block.ListSnapshotsRequest{
+		Zone:      zone,
+		Name:      &name,
+		ProjectID: &c.projectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("ListSnapshots", err)
+	}
+
+	// Filter out all snapshots that have the wrong name.
+	snapshots := slices.DeleteFunc(resp.Snapshots, func(snapshot *block.Snapshot) bool {
+		return snapshot.Name != name
+	})
+
+	switch len(snapshots) {
+	case 0:
+		return nil, ErrNoItemFound
+	case 1:
+		return snapshots[0], nil
+	default:
+		return nil, fmt.Errorf("%w: found %d snapshots with name %s", ErrTooManyItemsFound, len(snapshots), name)
+	}
+}
+
+func (c *Client) FindSnapshots(ctx context.Context, zone scw.Zone, tags []string) ([]*block.Snapshot, error) {
+	if err := c.validateZone(c.block, zone); err != nil {
+		return nil, err
+	}
+
+	if err := validateTags(tags); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.block.ListSnapshots(&block.ListSnapshotsRequest{
+		Zone:      zone,
+		Tags:      tags,
+		ProjectID: &c.projectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("ListSnapshots", err)
+	}
+
+	// Filter out all snapshots that have the wrong tags.
+	snapshots := slices.DeleteFunc(resp.Snapshots, func(snapshot *block.Snapshot) bool {
+		return !matchTags(snapshot.Tags, tags)
+	})
+
+	return snapshots, nil
+}
+
+func (c *Client) CreateSnapshot(ctx context.Context, zone scw.Zone, volumeID, name string, tags []string) (*block.Snapshot, error) {
+	if err := c.validateZone(c.block, zone); err != nil {
+		return nil, err
+	}
+
+	snapshot, err := c.block.CreateSnapshot(&block.CreateSnapshotRequest{
+		Zone:      zone,
+		VolumeID:  volumeID,
+		Name:      name,
+		ProjectID: c.projectID,
+		Tags:      append(tags, createdByTag),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, newCallError("CreateSnapshot", err)
+	}
+
+	return snapshot, nil
+}
@@ -12,7 +12,105 @@ import (
 	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client/mock_client"
 )
 
-const volumeID = "22222222-2222-2222-2222-222222222222"
+const (
+	volumeID   = "22222222-2222-2222-2222-222222222222"
+	snapshotID = "33333333-3333-3333-3333-333333333333"
+)
+
+func TestClient_GetVolume(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx      context.Context
+		zone     scw.Zone
+		volumeID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *block.Volume
+		wantErr bool
+		expect  func(b *mock_client.MockBlockAPIMockRecorder)
+	}{
+		{
+			name: "get volume",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {
+				b.GetVolume(&block.GetVolumeRequest{
+					Zone:     scw.ZoneFrPar1,
+					VolumeID: volumeID,
+				}, gomock.Any()).Return(&block.Volume{
+					ID:   volumeID,
+					Size: 20 * scw.GB,
+				}, nil)
+			},
+			args: args{
+				ctx:      context.TODO(),
+				zone:     scw.ZoneFrPar1,
+				volumeID: volumeID,
+			},
+			want: &block.Volume{
+				ID:   volumeID,
+				Size: 20 * scw.GB,
+			},
+		},
+		{
+			name: "volume not found",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {
+				b.GetVolume(&block.GetVolumeRequest{
+					Zone:     scw.ZoneFrPar1,
+					VolumeID: volumeID,
+				}, gomock.Any()).Return(nil, &scw.ResourceNotFoundError{})
+			},
+			args: args{
+				ctx:      context.TODO(),
+				zone:     scw.ZoneFrPar1,
+				volumeID: volumeID,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			blockMock := mock_client.NewMockBlockAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			blockMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(blockMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				block:     blockMock,
+			}
+			got, err := c.GetVolume(tt.args.ctx, tt.args.zone, tt.args.volumeID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.GetVolume() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.GetVolume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
 
 func TestClient_UpdateVolumeIOPS(t *testing.T) {
 	t.Parallel()
@@ -113,6 +211,105 @@ func TestClient_UpdateVolumeIOPS(t *testing.T) {
 	}
 }
 
+func TestClient_UpdateVolumeSize(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx      context.Context
+		zone     scw.Zone
+		volumeID string
+		size     scw.Size
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		expect  func(b *mock_client.MockBlockAPIMockRecorder)
+	}{
+		{
+			name: "unknown zone",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {},
+			args: args{
+				zone: "fr-par-999",
+			},
+			wantErr: true,
+		},
+		{
+			name: "grow volume to 50GB",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {
+				b.UpdateVolume(&block.UpdateVolumeRequest{
+					Zone:     scw.ZoneFrPar1,
+					VolumeID: volumeID,
+					Size:     scw.SizePtr(50 * scw.GB),
+				}, gomock.Any())
+			},
+			args: args{
+				ctx:      context.TODO(),
+				zone:     scw.ZoneFrPar1,
+				volumeID: volumeID,
+				size:     50 * scw.GB,
+			},
+		},
+		{
+			name: "API error",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {
+				b.UpdateVolume(&block.UpdateVolumeRequest{
+					Zone:     scw.ZoneFrPar1,
+					VolumeID: volumeID,
+					Size:     scw.SizePtr(50 * scw.GB),
+				}, gomock.Any()).Return(nil, errAPI)
+			},
+			args: args{
+				ctx:      context.TODO(),
+				zone:     scw.ZoneFrPar1,
+				volumeID: volumeID,
+				size:     50 * scw.GB,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			blockMock := mock_client.NewMockBlockAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			blockMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(blockMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				block:     blockMock,
+			}
+			if err := c.UpdateVolumeSize(tt.args.ctx, tt.args.zone, tt.args.volumeID, tt.args.size); (err != nil) != tt.wantErr {
+				t.Errorf("Client.UpdateVolumeSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestClient_UpdateVolumeTags(t *testing.T) {
 	t.Parallel()
 	type fields struct {
@@ -154,7 +351,7 @@ func TestClient_UpdateVolumeTags(t *testing.T) {
 				b.UpdateVolume(&block.UpdateVolumeRequest{
 					Zone:     scw.ZoneFrPar1,
 					VolumeID: volumeID,
-					Tags:     &[]string{"tag1", "tag2", "tag3"},
+					Tags:     &[]string{"tag1", "tag2", "tag3", createdByTag},
 				}, gomock.Any())
 			},
 			args: args{
@@ -174,7 +371,7 @@ func TestClient_UpdateVolumeTags(t *testing.T) {
 				b.UpdateVolume(&block.UpdateVolumeRequest{
 					Zone:     scw.ZoneFrPar1,
 					VolumeID: volumeID,
-					Tags:     &[]string{"tag1", "tag2", "tag3"},
+					Tags:     &[]string{"tag1", "tag2", "tag3", createdByTag},
 				}, gomock.Any()).Return(nil, errAPI)
 			},
 			args: args{
@@ -555,3 +752,489 @@ func TestClient_FindVolumes(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_CreateVolumeFromSnapshot(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx        context.Context
+		zone       scw.Zone
+		name       string
+		snapshotID string
+		size       scw.Size
+		iops       int64
+		tags       []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *block.Volume
+		wantErr bool
+		expect  func(b *mock_client.MockBlockAPIMockRecorder)
+	}{
+		{
+			name: "unknown zone",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {},
+			args: args{
+				zone: "fr-par-999",
+			},
+			wantErr: true,
+		},
+		{
+			name: "create volume with snapshot size",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			args: args{
+				ctx:        context.TODO(),
+				zone:       scw.ZoneFrPar1,
+				name:       "my-volume",
+				snapshotID: snapshotID,
+				tags:       []string{"tag1", "tag2"},
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {
+				b.CreateVolume(&block.CreateVolumeRequest{
+					Zone: scw.ZoneFrPar1,
+					Name: "my-volume",
+					FromSnapshot: &block.CreateVolumeRequestFromSnapshot{
+						SnapshotID: snapshotID,
+					},
+					Tags: []string{"tag1", "tag2", createdByTag},
+				}, gomock.Any()).Return(&block.Volume{Name: "my-volume"}, nil)
+			},
+			want: &block.Volume{Name: "my-volume"},
+		},
+		{
+			name: "create volume with size and iops",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			args: args{
+				ctx:        context.TODO(),
+				zone:       scw.ZoneFrPar1,
+				name:       "my-volume",
+				snapshotID: snapshotID,
+				size:       50 * scw.GB,
+				iops:       15000,
+				tags:       []string{"tag1", "tag2"},
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {
+				b.CreateVolume(&block.CreateVolumeRequest{
+					Zone: scw.ZoneFrPar1,
+					Name: "my-volume",
+					FromSnapshot: &block.CreateVolumeRequestFromSnapshot{
+						SnapshotID: snapshotID,
+						Size:       scw.SizePtr(50 * scw.GB),
+					},
+					Tags:     []string{"tag1", "tag2", createdByTag},
+					PerfIops: scw.Uint32Ptr(15000),
+				}, gomock.Any()).Return(&block.Volume{Name: "my-volume"}, nil)
+			},
+			want: &block.Volume{Name: "my-volume"},
+		},
+		{
+			name: "API error",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			args: args{
+				ctx:        context.TODO(),
+				zone:       scw.ZoneFrPar1,
+				name:       "my-volume",
+				snapshotID: snapshotID,
+				tags:       []string{"tag1", "tag2"},
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {
+				b.CreateVolume(gomock.Any(), gomock.Any()).Return(nil, errAPI)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			blockMock := mock_client.NewMockBlockAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			blockMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(blockMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				block:     blockMock,
+			}
+			got, err := c.CreateVolumeFromSnapshot(
+				tt.args.ctx,
+				tt.args.zone,
+				tt.args.name,
+				tt.args.snapshotID,
+				tt.args.size,
+				tt.args.iops,
+				tt.args.tags,
+			)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.CreateVolumeFromSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.CreateVolumeFromSnapshot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_FindSnapshotByName(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx  context.Context
+		zone scw.Zone
+		name string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *block.Snapshot
+		wantErr bool
+		expect  func(b *mock_client.MockBlockAPIMockRecorder)
+	}{
+		{
+			name: "unknown zone",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {},
+			args: args{
+				zone: "fr-par-999",
+			},
+			wantErr: true,
+		},
+		{
+			name: "snapshot found",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				name: "data",
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {
+				b.ListSnapshots(&block.ListSnapshotsRequest{
+					Zone:      scw.ZoneFrPar1,
+					Name:      scw.StringPtr("data"),
+					ProjectID: scw.StringPtr(projectID),
+				}, gomock.Any(), gomock.Any()).Return(&block.ListSnapshotsResponse{
+					Snapshots: []*block.Snapshot{
+						{ID: snapshotID, Name: "data"},
+						{Name: "data-old"},
+					},
+					TotalCount: 2,
+				}, nil)
+			},
+			want: &block.Snapshot{ID: snapshotID, Name: "data"},
+		},
+		{
+			name: "no snapshot found",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				name: "data",
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {
+				b.ListSnapshots(gomock.Any(), gomock.Any(), gomock.Any()).Return(&block.ListSnapshotsResponse{}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "multiple snapshots found",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				name: "data",
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {
+				b.ListSnapshots(gomock.Any(), gomock.Any(), gomock.Any()).Return(&block.ListSnapshotsResponse{
+					Snapshots: []*block.Snapshot{
+						{Name: "data"},
+						{Name: "data"},
+					},
+					TotalCount: 2,
+				}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			blockMock := mock_client.NewMockBlockAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			blockMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(blockMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				block:     blockMock,
+			}
+			got, err := c.FindSnapshotByName(tt.args.ctx, tt.args.zone, tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindSnapshotByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindSnapshotByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_FindSnapshots(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx  context.Context
+		zone scw.Zone
+		tags []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*block.Snapshot
+		wantErr bool
+		expect  func(b *mock_client.MockBlockAPIMockRecorder)
+	}{
+		{
+			name: "unknown zone",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {},
+			args: args{
+				zone: "fr-par-999",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail with empty tags",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				tags: []string{},
+			},
+			wantErr: true,
+			expect:  func(b *mock_client.MockBlockAPIMockRecorder) {},
+		},
+		{
+			name: "snapshots found",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				tags: []string{"tag1", "tag2"},
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {
+				b.ListSnapshots(&block.ListSnapshotsRequest{
+					Zone:      scw.ZoneFrPar1,
+					Tags:      []string{"tag1", "tag2"},
+					ProjectID: scw.StringPtr(projectID),
+				}, gomock.Any(), gomock.Any()).Return(&block.ListSnapshotsResponse{
+					Snapshots: []*block.Snapshot{
+						{Tags: []string{"tag1", "tag2", "tag3"}},
+						{Tags: []string{"tag1"}},
+					},
+					TotalCount: 2,
+				}, nil)
+			},
+			want: []*block.Snapshot{
+				{Tags: []string{"tag1", "tag2", "tag3"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			blockMock := mock_client.NewMockBlockAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			blockMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(blockMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				block:     blockMock,
+			}
+			got, err := c.FindSnapshots(tt.args.ctx, tt.args.zone, tt.args.tags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindSnapshots() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindSnapshots() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_CreateSnapshot(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx      context.Context
+		zone     scw.Zone
+		volumeID string
+		name     string
+		tags     []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *block.Snapshot
+		wantErr bool
+		expect  func(b *mock_client.MockBlockAPIMockRecorder)
+	}{
+		{
+			name: "unknown zone",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {},
+			args: args{
+				zone: "fr-par-999",
+			},
+			wantErr: true,
+		},
+		{
+			name: "create snapshot",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			args: args{
+				ctx:      context.TODO(),
+				zone:     scw.ZoneFrPar1,
+				volumeID: volumeID,
+				name:     "my-volume",
+				tags:     []string{"tag1", "tag2"},
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {
+				b.CreateSnapshot(&block.CreateSnapshotRequest{
+					Zone:      scw.ZoneFrPar1,
+					VolumeID:  volumeID,
+					Name:      "my-volume",
+					ProjectID: projectID,
+					Tags:      []string{"tag1", "tag2", createdByTag},
+				}, gomock.Any()).Return(&block.Snapshot{ID: snapshotID}, nil)
+			},
+			want: &block.Snapshot{ID: snapshotID},
+		},
+		{
+			name: "API error",
+			fields: fields{
+				region:    scw.RegionFrPar,
+				projectID: projectID,
+			},
+			args: args{
+				ctx:      context.TODO(),
+				zone:     scw.ZoneFrPar1,
+				volumeID: volumeID,
+				name:     "my-volume",
+				tags:     []string{"tag1", "tag2"},
+			},
+			expect: func(b *mock_client.MockBlockAPIMockRecorder) {
+				b.CreateSnapshot(gomock.Any(), gomock.Any()).Return(nil, errAPI)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			blockMock := mock_client.NewMockBlockAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			blockMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(blockMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				block:     blockMock,
+			}
+			got, err := c.CreateSnapshot(tt.args.ctx, tt.args.zone, tt.args.volumeID, tt.args.name, tt.args.tags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.CreateSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.CreateSnapshot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"slices"
 
+	baremetal "github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	baremetalpn "github.com/scaleway/scaleway-sdk-go/api/baremetal/v3"
 	"github.com/scaleway/scaleway-sdk-go/api/block/v1"
 	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
@@ -47,6 +49,9 @@ type Client struct {
 	marketplace MarketplaceAPI
 	ipam        IPAMAPI
 	k8s         K8sAPI
+
+	baremetal               BaremetalAPI
+	baremetalPrivateNetwork BaremetalPrivateNetworkAPI
 }
 
 // New returns a new Scaleway client based on the provided region and secretData.
@@ -91,6 +96,9 @@ func New(region scw.Region, projectID string, secretData map[string][]byte) (*Cl
 		marketplace: marketplace.NewAPI(client),
 		ipam:        ipam.NewAPI(client),
 		k8s:         k8s.NewAPI(client),
+
+		baremetal:               baremetal.NewAPI(client),
+		baremetalPrivateNetwork: baremetalpn.NewPrivateNetworkAPI(client),
 	}, nil
 }
 
@@ -41,6 +41,12 @@ func IsPreconditionFailedError(err error) bool {
 	return errors.As(err, &preconditionFailedError)
 }
 
+// IsOutOfStockError returns true if err is an OutOfStockError.
+func IsOutOfStockError(err error) bool {
+	var outOfStockError *scw.OutOfStockError
+	return errors.As(err, &outOfStockError)
+}
+
 func newCallError(method string, err error) error {
 	return fmt.Errorf("error occurred while calling %s: %w", method, err)
 }
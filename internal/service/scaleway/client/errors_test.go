@@ -113,3 +113,36 @@ func TestIsPreconditionFailedError(t *testing.T) {
 		})
 	}
 }
+
+func TestIsOutOfStockError(t *testing.T) {
+	type args struct {
+		err error
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "scaleway OutOfStock error",
+			args: args{
+				err: newCallError("CreateServer", &scw.OutOfStockError{Resource: "server"}),
+			},
+			want: true,
+		},
+		{
+			name: "not an OutOfStock error",
+			args: args{
+				err: &scw.InvalidArgumentsError{},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsOutOfStockError(tt.args.err); got != tt.want {
+				t.Errorf("IsOutOfStockError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
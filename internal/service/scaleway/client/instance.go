@@ -16,13 +16,17 @@ type InstanceAPI interface {
 	zonesGetter
 
 	ListServers(req *instance.ListServersRequest, opts ...scw.RequestOption) (*instance.ListServersResponse, error)
-	GetServerType(req *instance.GetServerTypeRequest) (*instance.ServerType, error)
+	ListServersTypes(req *instance.ListServersTypesRequest, opts ...scw.RequestOption) (*instance.ListServersTypesResponse, error)
+	GetServer(req *instance.GetServerRequest, opts ...scw.RequestOption) (*instance.GetServerResponse, error)
+	GetServerTypesAvailability(req *instance.GetServerTypesAvailabilityRequest, opts ...scw.RequestOption) (*instance.GetServerTypesAvailabilityResponse, error)
 	CreateServer(req *instance.CreateServerRequest, opts ...scw.RequestOption) (*instance.CreateServerResponse, error)
 	ListImages(req *instance.ListImagesRequest, opts ...scw.RequestOption) (*instance.ListImagesResponse, error)
 	ListIPs(req *instance.ListIPsRequest, opts ...scw.RequestOption) (*instance.ListIPsResponse, error)
+	GetIP(req *instance.GetIPRequest, opts ...scw.RequestOption) (*instance.GetIPResponse, error)
 	CreateIP(req *instance.CreateIPRequest, opts ...scw.RequestOption) (*instance.CreateIPResponse, error)
 	DeleteIP(req *instance.DeleteIPRequest, opts ...scw.RequestOption) error
 	CreatePrivateNIC(req *instance.CreatePrivateNICRequest, opts ...scw.RequestOption) (*instance.CreatePrivateNICResponse, error)
+	DeletePrivateNIC(req *instance.DeletePrivateNICRequest, opts ...scw.RequestOption) error
 	GetAllServerUserData(req *instance.GetAllServerUserDataRequest, opts ...scw.RequestOption) (*instance.GetAllServerUserDataResponse, error)
 	SetServerUserData(req *instance.SetServerUserDataRequest, opts ...scw.RequestOption) error
 	DeleteServerUserData(req *instance.DeleteServerUserDataRequest, opts ...scw.RequestOption) error
@@ -35,12 +39,22 @@ type InstanceAPI interface {
 	DeleteVolume(req *instance.DeleteVolumeRequest, opts ...scw.RequestOption) error
 	DeleteServer(req *instance.DeleteServerRequest, opts ...scw.RequestOption) error
 	ListPlacementGroups(req *instance.ListPlacementGroupsRequest, opts ...scw.RequestOption) (*instance.ListPlacementGroupsResponse, error)
+	CreatePlacementGroup(req *instance.CreatePlacementGroupRequest, opts ...scw.RequestOption) (*instance.CreatePlacementGroupResponse, error)
+	DeletePlacementGroup(req *instance.DeletePlacementGroupRequest, opts ...scw.RequestOption) error
+	GetPlacementGroupServers(req *instance.GetPlacementGroupServersRequest, opts ...scw.RequestOption) (*instance.GetPlacementGroupServersResponse, error)
 	ListSecurityGroups(req *instance.ListSecurityGroupsRequest, opts ...scw.RequestOption) (*instance.ListSecurityGroupsResponse, error)
+	CreateSecurityGroup(req *instance.CreateSecurityGroupRequest, opts ...scw.RequestOption) (*instance.CreateSecurityGroupResponse, error)
+	DeleteSecurityGroup(req *instance.DeleteSecurityGroupRequest, opts ...scw.RequestOption) error
+	ListSecurityGroupRules(req *instance.ListSecurityGroupRulesRequest, opts ...scw.RequestOption) (*instance.ListSecurityGroupRulesResponse, error)
+	SetSecurityGroupRules(req *instance.SetSecurityGroupRulesRequest, opts ...scw.RequestOption) (*instance.SetSecurityGroupRulesResponse, error)
 	UpdateServer(req *instance.UpdateServerRequest, opts ...scw.RequestOption) (*instance.UpdateServerResponse, error)
+	UpdateIP(req *instance.UpdateIPRequest, opts ...scw.RequestOption) (*instance.UpdateIPResponse, error)
 }
 
 type Instance interface {
 	FindServer(ctx context.Context, zone scw.Zone, tags []string) (*instance.Server, error)
+	FindServers(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.Server, error)
+	GetServer(ctx context.Context, zone scw.Zone, serverID string) (*instance.Server, error)
 	CreateServer(
 		ctx context.Context,
 		zone scw.Zone,
@@ -49,13 +63,18 @@ type Instance interface {
 		rootVolumeSize scw.Size,
 		rootVolumeType instance.VolumeVolumeType,
 		scratchVolumeSizes []scw.Size,
+		protected, natIPs bool,
 		tags []string,
 	) (*instance.Server, error)
 	FindImage(ctx context.Context, zone scw.Zone, name string) (*instance.Image, error)
+	GetServerType(ctx context.Context, zone scw.Zone, commercialType string) (*instance.ServerType, error)
+	GetServerTypesAvailability(ctx context.Context, zone scw.Zone) (map[string]instance.ServerTypesAvailability, error)
 	FindIPs(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.IP, error)
+	GetIP(ctx context.Context, zone scw.Zone, ipIDOrAddress string) (*instance.IP, error)
 	CreateIP(ctx context.Context, zone scw.Zone, ipType instance.IPType, tags []string) (*instance.IP, error)
 	DeleteIP(ctx context.Context, zone scw.Zone, ipID string) error
-	CreatePrivateNIC(ctx context.Context, zone scw.Zone, serverID, privateNetworkID string) (*instance.PrivateNIC, error)
+	CreatePrivateNIC(ctx context.Context, zone scw.Zone, serverID, privateNetworkID string, ipamIPIDs []string) (*instance.PrivateNIC, error)
+	DeletePrivateNIC(ctx context.Context, zone scw.Zone, serverID, privateNICID string) error
 	GetAllServerUserData(ctx context.Context, zone scw.Zone, serverID string) (map[string]io.Reader, error)
 	SetServerUserData(ctx context.Context, zone scw.Zone, serverID, key, content string) error
 	DeleteServerUserData(ctx context.Context, zone scw.Zone, serverID, key string) error
@@ -68,13 +87,52 @@ type Instance interface {
 	DeleteInstanceVolume(ctx context.Context, zone scw.Zone, volumeID string) error
 	DeleteServer(ctx context.Context, zone scw.Zone, serverID string) error
 	FindPlacementGroup(ctx context.Context, zone scw.Zone, name string) (*instance.PlacementGroup, error)
+	FindPlacementGroups(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.PlacementGroup, error)
+	CreatePlacementGroup(
+		ctx context.Context,
+		zone scw.Zone,
+		name string,
+		policyType instance.PlacementGroupPolicyType,
+		policyMode instance.PlacementGroupPolicyMode,
+		tags []string,
+	) (*instance.PlacementGroup, error)
+	DeletePlacementGroup(ctx context.Context, zone scw.Zone, placementGroupID string) error
+	ListPlacementGroupServers(ctx context.Context, zone scw.Zone, placementGroupID string) ([]*instance.PlacementGroupServer, error)
 	FindSecurityGroup(ctx context.Context, zone scw.Zone, name string) (*instance.SecurityGroup, error)
+	FindSecurityGroupByTags(ctx context.Context, zone scw.Zone, tags []string) (*instance.SecurityGroup, error)
+	FindDefaultSecurityGroup(ctx context.Context, zone scw.Zone) (*instance.SecurityGroup, error)
+	CreateSecurityGroup(ctx context.Context, zone scw.Zone, name string, tags []string) (*instance.SecurityGroup, error)
+	DeleteSecurityGroup(ctx context.Context, zone scw.Zone, securityGroupID string) error
+	ListSecurityGroupRules(ctx context.Context, zone scw.Zone, securityGroupID string) ([]*instance.SecurityGroupRule, error)
+	SetSecurityGroupRules(ctx context.Context, zone scw.Zone, securityGroupID string, rules []*instance.SetSecurityGroupRulesRequestRule) error
 	UpdateServerPublicIPs(ctx context.Context, zone scw.Zone, id string, publicIPIDs []string) (*instance.Server, error)
+	UpdateServerTags(ctx context.Context, zone scw.Zone, id string, tags []string) error
+	UpdateServerProtection(ctx context.Context, zone scw.Zone, id string, protected bool) error
+	UpdateServerSecurityGroup(ctx context.Context, zone scw.Zone, id, securityGroupID string) error
+	RemoveServerPlacementGroup(ctx context.Context, zone scw.Zone, id string) error
+	UpdateIPTags(ctx context.Context, zone scw.Zone, ipID string, tags []string) error
 }
 
 // FindServer finds an existing Instance server by tags.
 // It returns ErrNoItemFound if no matching server is found.
 func (c *Client) FindServer(ctx context.Context, zone scw.Zone, tags []string) (*instance.Server, error) {
+	servers, err := c.FindServers(ctx, zone, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(servers) {
+	case 0:
+		return nil, ErrNoItemFound
+	case 1:
+		return servers[0], nil
+	default:
+		return nil, fmt.Errorf("%w: found %d servers with tags %s", ErrTooManyItemsFound, len(servers), tags)
+	}
+}
+
+// FindServers finds all Instance servers that have the provided tags.
+func (c *Client) FindServers(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.Server, error) {
 	if err := c.validateZone(c.instance, zone); err != nil {
 		return nil, err
 	}
@@ -93,18 +151,26 @@ func (c *Client) FindServer(ctx context.Context, zone scw.Zone, tags []string) (
 	}
 
 	// Filter out all servers that have the wrong tags.
-	servers := slices.DeleteFunc(resp.Servers, func(server *instance.Server) bool {
+	return slices.DeleteFunc(resp.Servers, func(server *instance.Server) bool {
 		return !matchTags(server.Tags, tags)
-	})
+	}), nil
+}
 
-	switch len(servers) {
-	case 0:
-		return nil, ErrNoItemFound
-	case 1:
-		return servers[0], nil
-	default:
-		return nil, fmt.Errorf("%w: found %d servers with tags %s", ErrTooManyItemsFound, len(servers), tags)
+// GetServer returns the Instance server with the provided ID.
+func (c *Client) GetServer(ctx context.Context, zone scw.Zone, serverID string) (*instance.Server, error) {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return nil, err
 	}
+
+	resp, err := c.instance.GetServer(&instance.GetServerRequest{
+		Zone:     zone,
+		ServerID: serverID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, newCallError("GetServer", err)
+	}
+
+	return resp.Server, nil
 }
 
 func (c *Client) CreateServer(
@@ -115,6 +181,7 @@ func (c *Client) CreateServer(
 	rootVolumeSize scw.Size,
 	rootVolumeType instance.VolumeVolumeType,
 	scratchVolumeSizes []scw.Size,
+	protected, natIPs bool,
 	tags []string,
 ) (*instance.Server, error) {
 	if err := c.validateZone(c.instance, zone); err != nil {
@@ -129,6 +196,7 @@ func (c *Client) CreateServer(
 		Image:             &imageID,
 		PlacementGroup:    placementGroupID,
 		SecurityGroup:     securityGroupID,
+		Protected:         protected,
 		Volumes: map[string]*instance.VolumeServerTemplate{
 			"0": {
 				Size:       &rootVolumeSize,
@@ -139,13 +207,15 @@ func (c *Client) CreateServer(
 		Tags: append(tags, createdByTag),
 	}
 
+	// NAT IPs are only available when the routed IP mode is disabled.
+	if natIPs {
+		req.RoutedIPEnabled = ptr.To(false) //nolint:staticcheck
+	}
+
 	if len(scratchVolumeSizes) > 0 {
-		serverType, err := c.instance.GetServerType(&instance.GetServerTypeRequest{
-			Zone: zone,
-			Name: commercialType,
-		})
+		serverType, err := c.serverType(ctx, zone, commercialType)
 		if err != nil {
-			return nil, newCallError("GetServerType", err)
+			return nil, err
 		}
 
 		if serverType.ScratchStorageMaxSize == nil || *serverType.ScratchStorageMaxSize == 0 {
@@ -214,6 +284,59 @@ func (c *Client) FindImage(ctx context.Context, zone scw.Zone, name string) (*in
 	}
 }
 
+// GetServerType gets the Instance server type with the provided commercial type.
+// It returns ErrNoItemFound if the server type is not available in the zone.
+func (c *Client) GetServerType(ctx context.Context, zone scw.Zone, commercialType string) (*instance.ServerType, error) {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return nil, err
+	}
+
+	return c.serverType(ctx, zone, commercialType)
+}
+
+// serverType lists the server types of the zone to find the provided commercial
+// type, the GetServerType method of the SDK does not return a typed error when
+// the server type does not exist.
+func (c *Client) serverType(ctx context.Context, zone scw.Zone, commercialType string) (*instance.ServerType, error) {
+	resp, err := c.instance.ListServersTypes(&instance.ListServersTypesRequest{
+		Zone: zone,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("ListServersTypes", err)
+	}
+
+	serverType, ok := resp.Servers[commercialType]
+	if !ok || serverType == nil {
+		return nil, fmt.Errorf("%w: server type %s not found in zone %s", ErrNoItemFound, commercialType, zone)
+	}
+
+	return serverType, nil
+}
+
+// GetServerTypesAvailability returns the availability of all server types in
+// the provided zone, indexed by commercial type.
+func (c *Client) GetServerTypesAvailability(ctx context.Context, zone scw.Zone) (map[string]instance.ServerTypesAvailability, error) {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.instance.GetServerTypesAvailability(&instance.GetServerTypesAvailabilityRequest{
+		Zone: zone,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("GetServerTypesAvailability", err)
+	}
+
+	availability := make(map[string]instance.ServerTypesAvailability, len(resp.Servers))
+	for commercialType, serverType := range resp.Servers {
+		if serverType != nil {
+			availability[commercialType] = serverType.Availability
+		}
+	}
+
+	return availability, nil
+}
+
 func (c *Client) FindIPs(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.IP, error) {
 	if err := c.validateZone(c.instance, zone); err != nil {
 		return nil, err
@@ -240,6 +363,23 @@ func (c *Client) FindIPs(ctx context.Context, zone scw.Zone, tags []string) ([]*
 	return ips, nil
 }
 
+// GetIP returns the IP with the provided ID or address.
+func (c *Client) GetIP(ctx context.Context, zone scw.Zone, ipIDOrAddress string) (*instance.IP, error) {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.instance.GetIP(&instance.GetIPRequest{
+		Zone: zone,
+		IP:   ipIDOrAddress,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, newCallError("GetIP", err)
+	}
+
+	return resp.IP, nil
+}
+
 func (c *Client) CreateIP(ctx context.Context, zone scw.Zone, ipType instance.IPType, tags []string) (*instance.IP, error) {
 	if err := c.validateZone(c.instance, zone); err != nil {
 		return nil, err
@@ -272,7 +412,12 @@ func (c *Client) DeleteIP(ctx context.Context, zone scw.Zone, ipID string) error
 	return nil
 }
 
-func (c *Client) CreatePrivateNIC(ctx context.Context, zone scw.Zone, serverID, privateNetworkID string) (*instance.PrivateNIC, error) {
+func (c *Client) CreatePrivateNIC(
+	ctx context.Context,
+	zone scw.Zone,
+	serverID, privateNetworkID string,
+	ipamIPIDs []string,
+) (*instance.PrivateNIC, error) {
 	if err := c.validateZone(c.instance, zone); err != nil {
 		return nil, err
 	}
@@ -282,6 +427,7 @@ func (c *Client) CreatePrivateNIC(ctx context.Context, zone scw.Zone, serverID,
 		ServerID:         serverID,
 		PrivateNetworkID: privateNetworkID,
 		Tags:             []string{createdByTag},
+		IpamIPIDs:        ipamIPIDs,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return nil, newCallError("CreatePrivateNIC", err)
@@ -290,6 +436,23 @@ func (c *Client) CreatePrivateNIC(ctx context.Context, zone scw.Zone, serverID,
 	return privateNIC.PrivateNic, nil
 }
 
+// DeletePrivateNIC detaches a server from a Private Network by deleting its private NIC.
+func (c *Client) DeletePrivateNIC(ctx context.Context, zone scw.Zone, serverID, privateNICID string) error {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return err
+	}
+
+	if err := c.instance.DeletePrivateNIC(&instance.DeletePrivateNICRequest{
+		Zone:         zone,
+		ServerID:     serverID,
+		PrivateNicID: privateNICID,
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("DeletePrivateNIC", err)
+	}
+
+	return nil
+}
+
 func (c *Client) GetAllServerUserData(ctx context.Context, zone scw.Zone, serverID string) (map[string]io.Reader, error) {
 	if err := c.validateZone(c.instance, zone); err != nil {
 		return nil, err
@@ -418,6 +581,8 @@ func (c *Client) UpdateInstanceVolumeTags(ctx context.Context, zone scw.Zone, vo
 		return err
 	}
 
+	tags = append(tags, createdByTag)
+
 	if _, err := c.instance.UpdateVolume(&instance.UpdateVolumeRequest{
 		Zone:     zone,
 		VolumeID: volumeID,
@@ -513,6 +678,88 @@ func (c *Client) FindPlacementGroup(ctx context.Context, zone scw.Zone, name str
 	}
 }
 
+func (c *Client) FindPlacementGroups(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.PlacementGroup, error) {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return nil, err
+	}
+
+	if err := validateTags(tags); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.instance.ListPlacementGroups(&instance.ListPlacementGroupsRequest{
+		Zone:    zone,
+		Tags:    tags,
+		Project: &c.projectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("ListPlacementGroups", err)
+	}
+
+	// Filter out all placement groups that have the wrong tags.
+	return slices.DeleteFunc(resp.PlacementGroups, func(pg *instance.PlacementGroup) bool {
+		return !matchTags(pg.Tags, tags)
+	}), nil
+}
+
+func (c *Client) CreatePlacementGroup(
+	ctx context.Context,
+	zone scw.Zone,
+	name string,
+	policyType instance.PlacementGroupPolicyType,
+	policyMode instance.PlacementGroupPolicyMode,
+	tags []string,
+) (*instance.PlacementGroup, error) {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.instance.CreatePlacementGroup(&instance.CreatePlacementGroupRequest{
+		Zone:       zone,
+		Name:       name,
+		Project:    &c.projectID,
+		Tags:       append(tags, createdByTag),
+		PolicyType: policyType,
+		PolicyMode: policyMode,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, newCallError("CreatePlacementGroup", err)
+	}
+
+	return resp.PlacementGroup, nil
+}
+
+func (c *Client) DeletePlacementGroup(ctx context.Context, zone scw.Zone, placementGroupID string) error {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return err
+	}
+
+	if err := c.instance.DeletePlacementGroup(&instance.DeletePlacementGroupRequest{
+		Zone:             zone,
+		PlacementGroupID: placementGroupID,
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("DeletePlacementGroup", err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListPlacementGroupServers(ctx context.Context, zone scw.Zone, placementGroupID string) ([]*instance.PlacementGroupServer, error) {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.instance.GetPlacementGroupServers(&instance.GetPlacementGroupServersRequest{
+		Zone:             zone,
+		PlacementGroupID: placementGroupID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, newCallError("GetPlacementGroupServers", err)
+	}
+
+	return resp.Servers, nil
+}
+
 func (c *Client) FindSecurityGroup(ctx context.Context, zone scw.Zone, name string) (*instance.SecurityGroup, error) {
 	if err := c.validateZone(c.instance, zone); err != nil {
 		return nil, err
@@ -542,6 +789,148 @@ func (c *Client) FindSecurityGroup(ctx context.Context, zone scw.Zone, name stri
 	}
 }
 
+// FindDefaultSecurityGroup finds the default security group of the project.
+// It returns ErrNoItemFound if the project has no default security group.
+func (c *Client) FindDefaultSecurityGroup(ctx context.Context, zone scw.Zone) (*instance.SecurityGroup, error) {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.instance.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
+		Zone:           zone,
+		Project:        &c.projectID,
+		ProjectDefault: scw.BoolPtr(true),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("ListSecurityGroups", err)
+	}
+
+	// Filter out all security groups that are not the default one.
+	securityGroups := slices.DeleteFunc(resp.SecurityGroups, func(sg *instance.SecurityGroup) bool {
+		return !sg.ProjectDefault
+	})
+
+	if len(securityGroups) == 0 {
+		return nil, ErrNoItemFound
+	}
+
+	return securityGroups[0], nil
+}
+
+// FindSecurityGroupByTags finds an existing security group by tags.
+// It returns ErrNoItemFound if no matching security group is found.
+func (c *Client) FindSecurityGroupByTags(ctx context.Context, zone scw.Zone, tags []string) (*instance.SecurityGroup, error) {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return nil, err
+	}
+
+	if err := validateTags(tags); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.instance.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
+		Zone:    zone,
+		Tags:    tags,
+		Project: &c.projectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("ListSecurityGroups", err)
+	}
+
+	// Filter out all security groups that have the wrong tags.
+	securityGroups := slices.DeleteFunc(resp.SecurityGroups, func(sg *instance.SecurityGroup) bool {
+		return !matchTags(sg.Tags, tags)
+	})
+
+	switch len(securityGroups) {
+	case 0:
+		return nil, ErrNoItemFound
+	case 1:
+		return securityGroups[0], nil
+	default:
+		return nil, fmt.Errorf("%w: found %d security groups with tags %s", ErrTooManyItemsFound, len(securityGroups), tags)
+	}
+}
+
+// CreateSecurityGroup creates a stateful security group that drops inbound
+// traffic and accepts outbound traffic by default.
+func (c *Client) CreateSecurityGroup(ctx context.Context, zone scw.Zone, name string, tags []string) (*instance.SecurityGroup, error) {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.instance.CreateSecurityGroup(&instance.CreateSecurityGroupRequest{
+		Zone:                  zone,
+		Name:                  name,
+		Description:           createdByDescription,
+		Project:               &c.projectID,
+		Tags:                  append(tags, createdByTag),
+		Stateful:              true,
+		InboundDefaultPolicy:  instance.SecurityGroupPolicyDrop,
+		OutboundDefaultPolicy: instance.SecurityGroupPolicyAccept,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, newCallError("CreateSecurityGroup", err)
+	}
+
+	return resp.SecurityGroup, nil
+}
+
+// DeleteSecurityGroup deletes a security group.
+func (c *Client) DeleteSecurityGroup(ctx context.Context, zone scw.Zone, securityGroupID string) error {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return err
+	}
+
+	if err := c.instance.DeleteSecurityGroup(&instance.DeleteSecurityGroupRequest{
+		Zone:            zone,
+		SecurityGroupID: securityGroupID,
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("DeleteSecurityGroup", err)
+	}
+
+	return nil
+}
+
+// ListSecurityGroupRules lists the rules of a security group.
+func (c *Client) ListSecurityGroupRules(ctx context.Context, zone scw.Zone, securityGroupID string) ([]*instance.SecurityGroupRule, error) {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.instance.ListSecurityGroupRules(&instance.ListSecurityGroupRulesRequest{
+		Zone:            zone,
+		SecurityGroupID: securityGroupID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("ListSecurityGroupRules", err)
+	}
+
+	return resp.Rules, nil
+}
+
+// SetSecurityGroupRules replaces the editable rules of a security group.
+func (c *Client) SetSecurityGroupRules(
+	ctx context.Context,
+	zone scw.Zone,
+	securityGroupID string,
+	rules []*instance.SetSecurityGroupRulesRequestRule,
+) error {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return err
+	}
+
+	if _, err := c.instance.SetSecurityGroupRules(&instance.SetSecurityGroupRulesRequest{
+		Zone:            zone,
+		SecurityGroupID: securityGroupID,
+		Rules:           rules,
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("SetSecurityGroupRules", err)
+	}
+
+	return nil
+}
+
 func (c *Client) UpdateServerPublicIPs(ctx context.Context, zone scw.Zone, id string, publicIPIDs []string) (*instance.Server, error) {
 	if err := c.validateZone(c.instance, zone); err != nil {
 		return nil, err
@@ -558,3 +947,96 @@ func (c *Client) UpdateServerPublicIPs(ctx context.Context, zone scw.Zone, id st
 
 	return resp.Server, nil
 }
+
+// UpdateServerTags replaces the tags of a server. The created-by tag is kept.
+func (c *Client) UpdateServerTags(ctx context.Context, zone scw.Zone, id string, tags []string) error {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return err
+	}
+
+	tags = append(tags, createdByTag)
+
+	if _, err := c.instance.UpdateServer(&instance.UpdateServerRequest{
+		Zone:     zone,
+		ServerID: id,
+		Tags:     &tags,
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("UpdateServer", err)
+	}
+
+	return nil
+}
+
+// UpdateServerProtection enables or disables the protection of a server. A protected
+// server cannot be deleted.
+func (c *Client) UpdateServerProtection(ctx context.Context, zone scw.Zone, id string, protected bool) error {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return err
+	}
+
+	if _, err := c.instance.UpdateServer(&instance.UpdateServerRequest{
+		Zone:      zone,
+		ServerID:  id,
+		Protected: &protected,
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("UpdateServer", err)
+	}
+
+	return nil
+}
+
+// UpdateServerSecurityGroup moves a server to another security group.
+func (c *Client) UpdateServerSecurityGroup(ctx context.Context, zone scw.Zone, id, securityGroupID string) error {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return err
+	}
+
+	if _, err := c.instance.UpdateServer(&instance.UpdateServerRequest{
+		Zone:     zone,
+		ServerID: id,
+		SecurityGroup: &instance.SecurityGroupTemplate{
+			ID: securityGroupID,
+		},
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("UpdateServer", err)
+	}
+
+	return nil
+}
+
+// RemoveServerPlacementGroup removes a server from its placement group. The server
+// must be stopped.
+func (c *Client) RemoveServerPlacementGroup(ctx context.Context, zone scw.Zone, id string) error {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return err
+	}
+
+	if _, err := c.instance.UpdateServer(&instance.UpdateServerRequest{
+		Zone:           zone,
+		ServerID:       id,
+		PlacementGroup: &instance.NullableStringValue{Null: true},
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("UpdateServer", err)
+	}
+
+	return nil
+}
+
+// UpdateIPTags replaces the tags of a flexible IP. The created-by tag is kept.
+func (c *Client) UpdateIPTags(ctx context.Context, zone scw.Zone, ipID string, tags []string) error {
+	if err := c.validateZone(c.instance, zone); err != nil {
+		return err
+	}
+
+	tags = append(tags, createdByTag)
+
+	if _, err := c.instance.UpdateIP(&instance.UpdateIPRequest{
+		Zone: zone,
+		IP:   ipID,
+		Tags: &tags,
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("UpdateIP", err)
+	}
+
+	return nil
+}
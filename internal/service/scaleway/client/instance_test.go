@@ -162,6 +162,219 @@ func TestClient_FindServer(t *testing.T) {
 	}
 }
 
+func TestClient_FindServers(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx  context.Context
+		zone scw.Zone
+		tags []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*instance.Server
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "no server found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				tags: []string{"tag1", "tag2"},
+			},
+			want: []*instance.Server{},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListServers(&instance.ListServersRequest{
+					Zone:    scw.ZoneFrPar1,
+					Tags:    []string{"tag1", "tag2"},
+					Project: ptr.To(projectID),
+				}, gomock.Any()).Return(&instance.ListServersResponse{Servers: []*instance.Server{}}, nil)
+			},
+		},
+		{
+			name: "servers found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				tags: []string{"tag1", "tag2"},
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListServers(&instance.ListServersRequest{
+					Zone:    scw.ZoneFrPar1,
+					Tags:    []string{"tag1", "tag2"},
+					Project: ptr.To(projectID),
+				}, gomock.Any()).Return(&instance.ListServersResponse{
+					TotalCount: 3,
+					Servers: []*instance.Server{
+						{
+							Name: "server",
+							Tags: []string{"misc", "tag1", "tag2"},
+						},
+						{
+							Name: "server1",
+							Tags: []string{"tag1", "tag2"},
+						},
+						{
+							Name: "other",
+							Tags: []string{"tag1", "tag2-other"},
+						},
+					},
+				}, nil)
+			},
+			want: []*instance.Server{
+				{
+					Name: "server",
+					Tags: []string{"misc", "tag1", "tag2"},
+				},
+				{
+					Name: "server1",
+					Tags: []string{"tag1", "tag2"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			got, err := c.FindServers(tt.args.ctx, tt.args.zone, tt.args.tags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindServers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindServers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_GetServer(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx      context.Context
+		zone     scw.Zone
+		serverID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *instance.Server
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "get server",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:      context.TODO(),
+				zone:     scw.ZoneFrPar1,
+				serverID: serverID,
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.GetServer(&instance.GetServerRequest{
+					Zone:     scw.ZoneFrPar1,
+					ServerID: serverID,
+				}, gomock.Any()).Return(&instance.GetServerResponse{
+					Server: &instance.Server{
+						ID:    serverID,
+						State: instance.ServerStateRunning,
+					},
+				}, nil)
+			},
+			want: &instance.Server{
+				ID:    serverID,
+				State: instance.ServerStateRunning,
+			},
+		},
+		{
+			name: "server not found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:      context.TODO(),
+				zone:     scw.ZoneFrPar1,
+				serverID: serverID,
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.GetServer(&instance.GetServerRequest{
+					Zone:     scw.ZoneFrPar1,
+					ServerID: serverID,
+				}, gomock.Any()).Return(nil, &scw.ResourceNotFoundError{})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			got, err := c.GetServer(tt.args.ctx, tt.args.zone, tt.args.serverID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.GetServer() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.GetServer() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestClient_CreateServer(t *testing.T) {
 	t.Parallel()
 	type fields struct {
@@ -179,6 +392,8 @@ func TestClient_CreateServer(t *testing.T) {
 		rootVolumeSize     scw.Size
 		rootVolumeType     instance.VolumeVolumeType
 		scratchVolumeSizes []scw.Size
+		protected          bool
+		natIPs             bool
 		tags               []string
 	}
 	tests := []struct {
@@ -205,6 +420,7 @@ func TestClient_CreateServer(t *testing.T) {
 				securityGroupID:  ptr.To(securityGroupID),
 				rootVolumeSize:   rootVolumeSize,
 				rootVolumeType:   instance.VolumeVolumeTypeBSSD,
+				protected:        true,
 				tags:             []string{"tag1", "tag2", "tag3"},
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
@@ -216,6 +432,7 @@ func TestClient_CreateServer(t *testing.T) {
 					Image:             ptr.To(imageID),
 					PlacementGroup:    ptr.To(placementGroupID),
 					SecurityGroup:     ptr.To(securityGroupID),
+					Protected:         true,
 					Volumes: map[string]*instance.VolumeServerTemplate{
 						"0": {
 							Size:       ptr.To(rootVolumeSize),
@@ -234,6 +451,49 @@ func TestClient_CreateServer(t *testing.T) {
 				Name: "server",
 			},
 		},
+		{
+			name: "create server with NAT IPs",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:            context.TODO(),
+				zone:           scw.ZoneFrPar1,
+				name:           "server",
+				commercialType: "DEV1-S",
+				imageID:        imageID,
+				rootVolumeSize: rootVolumeSize,
+				rootVolumeType: instance.VolumeVolumeTypeBSSD,
+				natIPs:         true,
+				tags:           []string{"tag1"},
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.CreateServer(&instance.CreateServerRequest{
+					Zone:              scw.ZoneFrPar1,
+					Name:              "server",
+					CommercialType:    "DEV1-S",
+					DynamicIPRequired: ptr.To(false),
+					RoutedIPEnabled:   ptr.To(false),
+					Image:             ptr.To(imageID),
+					Volumes: map[string]*instance.VolumeServerTemplate{
+						"0": {
+							Size:       ptr.To(rootVolumeSize),
+							VolumeType: instance.VolumeVolumeTypeBSSD,
+							Boot:       ptr.To(true),
+						},
+					},
+					Tags: []string{"tag1", createdByTag},
+				}, gomock.Any()).Return(&instance.CreateServerResponse{
+					Server: &instance.Server{
+						Name: "server",
+					},
+				}, nil)
+			},
+			want: &instance.Server{
+				Name: "server",
+			},
+		},
 		{
 			name: "create server with max scratch storage",
 			fields: fields{
@@ -254,11 +514,12 @@ func TestClient_CreateServer(t *testing.T) {
 				tags:               []string{"tag1", "tag2", "tag3"},
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.GetServerType(&instance.GetServerTypeRequest{
+				d.ListServersTypes(&instance.ListServersTypesRequest{
 					Zone: scw.ZoneFrPar2,
-					Name: "H100-1-80G",
-				}).Return(&instance.ServerType{
-					ScratchStorageMaxSize: ptr.To(scratchVolumeSize),
+				}, gomock.Any()).Return(&instance.ListServersTypesResponse{
+					Servers: map[string]*instance.ServerType{
+						"H100-1-80G": {ScratchStorageMaxSize: ptr.To(scratchVolumeSize)},
+					},
 				}, nil)
 
 				d.CreateServer(&instance.CreateServerRequest{
@@ -312,11 +573,12 @@ func TestClient_CreateServer(t *testing.T) {
 				tags:               []string{"tag1", "tag2", "tag3"},
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.GetServerType(&instance.GetServerTypeRequest{
+				d.ListServersTypes(&instance.ListServersTypesRequest{
 					Zone: scw.ZoneFrPar2,
-					Name: "H100-1-80G",
-				}).Return(&instance.ServerType{
-					ScratchStorageMaxSize: ptr.To(scratchVolumeSize),
+				}, gomock.Any()).Return(&instance.ListServersTypesResponse{
+					Servers: map[string]*instance.ServerType{
+						"H100-1-80G": {ScratchStorageMaxSize: ptr.To(scratchVolumeSize)},
+					},
 				}, nil)
 			},
 			wantErr: true,
@@ -341,7 +603,7 @@ func TestClient_CreateServer(t *testing.T) {
 				region:    tt.fields.region,
 				instance:  instanceMock,
 			}
-			got, err := c.CreateServer(tt.args.ctx, tt.args.zone, tt.args.name, tt.args.commercialType, tt.args.imageID, tt.args.placementGroupID, tt.args.securityGroupID, tt.args.rootVolumeSize, tt.args.rootVolumeType, tt.args.scratchVolumeSizes, tt.args.tags)
+			got, err := c.CreateServer(tt.args.ctx, tt.args.zone, tt.args.name, tt.args.commercialType, tt.args.imageID, tt.args.placementGroupID, tt.args.securityGroupID, tt.args.rootVolumeSize, tt.args.rootVolumeType, tt.args.scratchVolumeSizes, tt.args.protected, tt.args.natIPs, tt.args.tags)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Client.CreateServer() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -486,88 +748,101 @@ func TestClient_FindImage(t *testing.T) {
 	}
 }
 
-func TestClient_FindIPs(t *testing.T) {
+func TestClient_GetServerType(t *testing.T) {
 	t.Parallel()
 	type fields struct {
 		projectID string
 		region    scw.Region
 	}
 	type args struct {
-		ctx  context.Context
-		zone scw.Zone
-		tags []string
+		ctx            context.Context
+		zone           scw.Zone
+		commercialType string
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    []*instance.IP
-		wantErr bool
-		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+		name         string
+		fields       fields
+		args         args
+		want         *instance.ServerType
+		wantErr      bool
+		wantNotFound bool
+		expect       func(d *mock_client.MockInstanceAPIMockRecorder)
 	}{
 		{
-			name: "no ip found",
+			name: "server type found",
 			fields: fields{
 				projectID: projectID,
 				region:    scw.RegionFrPar,
 			},
 			args: args{
-				ctx:  context.TODO(),
-				zone: scw.ZoneFrPar1,
-				tags: []string{"tag1", "tag2", "tag3"},
+				ctx:            context.TODO(),
+				zone:           scw.ZoneFrPar1,
+				commercialType: "DEV1-S",
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.ListIPs(&instance.ListIPsRequest{
-					Zone:    scw.ZoneFrPar1,
-					Project: ptr.To(projectID),
-					Tags:    []string{"tag1", "tag2", "tag3"},
-				}, gomock.Any(), gomock.Any()).Return(&instance.ListIPsResponse{}, nil)
+				d.ListServersTypes(&instance.ListServersTypesRequest{
+					Zone: scw.ZoneFrPar1,
+				}, gomock.Any()).Return(&instance.ListServersTypesResponse{
+					Servers: map[string]*instance.ServerType{
+						"DEV1-S": {
+							Ncpus: 2,
+							RAM:   2 * 1024 * 1024 * 1024,
+							Arch:  instance.ArchX86_64,
+						},
+						"DEV1-M": {
+							Ncpus: 3,
+							RAM:   4 * 1024 * 1024 * 1024,
+							Arch:  instance.ArchX86_64,
+						},
+					},
+				}, nil)
+			},
+			want: &instance.ServerType{
+				Ncpus: 2,
+				RAM:   2 * 1024 * 1024 * 1024,
+				Arch:  instance.ArchX86_64,
 			},
 		},
 		{
-			name: "some ips found",
+			name: "server type not found",
 			fields: fields{
 				projectID: projectID,
 				region:    scw.RegionFrPar,
 			},
 			args: args{
-				ctx:  context.TODO(),
-				zone: scw.ZoneFrPar1,
-				tags: []string{"tag1", "tag2", "tag3"},
+				ctx:            context.TODO(),
+				zone:           scw.ZoneFrPar1,
+				commercialType: "UNKNOWN",
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.ListIPs(&instance.ListIPsRequest{
-					Zone:    scw.ZoneFrPar1,
-					Project: ptr.To(projectID),
-					Tags:    []string{"tag1", "tag2", "tag3"},
-				}, gomock.Any(), gomock.Any()).Return(&instance.ListIPsResponse{
-					TotalCount: 2,
-					IPs: []*instance.IP{
-						{
-							Address: net.IPv4(42, 42, 42, 42),
-							Tags:    []string{"tag1", "tag2", "tag3"},
-							Type:    instance.IPTypeRoutedIPv4,
-						},
-						{
-							Address: net.IPv4(43, 43, 43, 43),
-							Tags:    []string{"tag1", "tag2", "tag3"},
-							Type:    instance.IPTypeRoutedIPv4,
-						},
+				d.ListServersTypes(&instance.ListServersTypesRequest{
+					Zone: scw.ZoneFrPar1,
+				}, gomock.Any()).Return(&instance.ListServersTypesResponse{
+					Servers: map[string]*instance.ServerType{
+						"DEV1-S": {Ncpus: 2},
 					},
 				}, nil)
 			},
-			want: []*instance.IP{
-				{
-					Address: net.IPv4(42, 42, 42, 42),
-					Tags:    []string{"tag1", "tag2", "tag3"},
-					Type:    instance.IPTypeRoutedIPv4,
-				},
-				{
-					Address: net.IPv4(43, 43, 43, 43),
-					Tags:    []string{"tag1", "tag2", "tag3"},
-					Type:    instance.IPTypeRoutedIPv4,
-				},
+			wantErr:      true,
+			wantNotFound: true,
+		},
+		{
+			name: "failed to list server types",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:            context.TODO(),
+				zone:           scw.ZoneFrPar1,
+				commercialType: "DEV1-S",
 			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListServersTypes(&instance.ListServersTypesRequest{
+					Zone: scw.ZoneFrPar1,
+				}, gomock.Any()).Return(nil, errAPI)
+			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -589,68 +864,82 @@ func TestClient_FindIPs(t *testing.T) {
 				region:    tt.fields.region,
 				instance:  instanceMock,
 			}
-			got, err := c.FindIPs(tt.args.ctx, tt.args.zone, tt.args.tags)
+			got, err := c.GetServerType(tt.args.ctx, tt.args.zone, tt.args.commercialType)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("Client.FindIPs() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Client.GetServerType() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if IsNotFoundError(err) != tt.wantNotFound {
+				t.Errorf("Client.GetServerType() error = %v, wantNotFound %v", err, tt.wantNotFound)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Client.FindIPs() = %v, want %v", got, tt.want)
+				t.Errorf("Client.GetServerType() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestClient_CreateIP(t *testing.T) {
+func TestClient_GetServerTypesAvailability(t *testing.T) {
 	t.Parallel()
 	type fields struct {
 		projectID string
 		region    scw.Region
 	}
 	type args struct {
-		ctx    context.Context
-		zone   scw.Zone
-		ipType instance.IPType
-		tags   []string
+		ctx  context.Context
+		zone scw.Zone
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
-		want    *instance.IP
+		want    map[string]instance.ServerTypesAvailability
 		wantErr bool
 		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
 	}{
 		{
-			name: "create IP",
+			name: "availability found",
 			fields: fields{
 				projectID: projectID,
 				region:    scw.RegionFrPar,
 			},
 			args: args{
-				ctx:    context.TODO(),
-				zone:   scw.ZoneFrPar1,
-				ipType: instance.IPTypeRoutedIPv4,
-				tags:   []string{"tag1", "tag2"},
-			},
-			want: &instance.IP{
-				Address: net.IPv4(42, 42, 42, 42),
-				Tags:    []string{"tag1", "tag2", createdByTag},
-				Type:    instance.IPTypeRoutedIPv4,
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.CreateIP(&instance.CreateIPRequest{
+				d.GetServerTypesAvailability(&instance.GetServerTypesAvailabilityRequest{
 					Zone: scw.ZoneFrPar1,
-					Tags: []string{"tag1", "tag2", createdByTag},
-					Type: instance.IPTypeRoutedIPv4,
-				}, gomock.Any()).Return(&instance.CreateIPResponse{
-					IP: &instance.IP{
-						Address: net.IPv4(42, 42, 42, 42),
-						Tags:    []string{"tag1", "tag2", createdByTag},
-						Type:    instance.IPTypeRoutedIPv4,
+				}, gomock.Any(), gomock.Any()).Return(&instance.GetServerTypesAvailabilityResponse{
+					Servers: map[string]*instance.GetServerTypesAvailabilityResponseAvailability{
+						"DEV1-S": {Availability: instance.ServerTypesAvailabilityAvailable},
+						"PRO2-S": {Availability: instance.ServerTypesAvailabilityShortage},
 					},
+					TotalCount: 2,
 				}, nil)
 			},
+			want: map[string]instance.ServerTypesAvailability{
+				"DEV1-S": instance.ServerTypesAvailabilityAvailable,
+				"PRO2-S": instance.ServerTypesAvailabilityShortage,
+			},
+		},
+		{
+			name: "api error",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.GetServerTypesAvailability(&instance.GetServerTypesAvailabilityRequest{
+					Zone: scw.ZoneFrPar1,
+				}, gomock.Any(), gomock.Any()).Return(nil, errAPI)
+			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -672,19 +961,19 @@ func TestClient_CreateIP(t *testing.T) {
 				region:    tt.fields.region,
 				instance:  instanceMock,
 			}
-			got, err := c.CreateIP(tt.args.ctx, tt.args.zone, tt.args.ipType, tt.args.tags)
+			got, err := c.GetServerTypesAvailability(tt.args.ctx, tt.args.zone)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("Client.CreateIP() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Client.GetServerTypesAvailability() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Client.CreateIP() = %v, want %v", got, tt.want)
+				t.Errorf("Client.GetServerTypesAvailability() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestClient_DeleteIP(t *testing.T) {
+func TestClient_FindIPs(t *testing.T) {
 	t.Parallel()
 	type fields struct {
 		projectID string
@@ -693,17 +982,18 @@ func TestClient_DeleteIP(t *testing.T) {
 	type args struct {
 		ctx  context.Context
 		zone scw.Zone
-		ipID string
+		tags []string
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
+		want    []*instance.IP
 		wantErr bool
 		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
 	}{
 		{
-			name: "delete IP",
+			name: "no ip found",
 			fields: fields{
 				projectID: projectID,
 				region:    scw.RegionFrPar,
@@ -711,13 +1001,59 @@ func TestClient_DeleteIP(t *testing.T) {
 			args: args{
 				ctx:  context.TODO(),
 				zone: scw.ZoneFrPar1,
-				ipID: ipID,
+				tags: []string{"tag1", "tag2", "tag3"},
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.DeleteIP(&instance.DeleteIPRequest{
-					Zone: scw.ZoneFrPar1,
-					IP:   ipID,
-				}, gomock.Any())
+				d.ListIPs(&instance.ListIPsRequest{
+					Zone:    scw.ZoneFrPar1,
+					Project: ptr.To(projectID),
+					Tags:    []string{"tag1", "tag2", "tag3"},
+				}, gomock.Any(), gomock.Any()).Return(&instance.ListIPsResponse{}, nil)
+			},
+		},
+		{
+			name: "some ips found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				tags: []string{"tag1", "tag2", "tag3"},
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListIPs(&instance.ListIPsRequest{
+					Zone:    scw.ZoneFrPar1,
+					Project: ptr.To(projectID),
+					Tags:    []string{"tag1", "tag2", "tag3"},
+				}, gomock.Any(), gomock.Any()).Return(&instance.ListIPsResponse{
+					TotalCount: 2,
+					IPs: []*instance.IP{
+						{
+							Address: net.IPv4(42, 42, 42, 42),
+							Tags:    []string{"tag1", "tag2", "tag3"},
+							Type:    instance.IPTypeRoutedIPv4,
+						},
+						{
+							Address: net.IPv4(43, 43, 43, 43),
+							Tags:    []string{"tag1", "tag2", "tag3"},
+							Type:    instance.IPTypeRoutedIPv4,
+						},
+					},
+				}, nil)
+			},
+			want: []*instance.IP{
+				{
+					Address: net.IPv4(42, 42, 42, 42),
+					Tags:    []string{"tag1", "tag2", "tag3"},
+					Type:    instance.IPTypeRoutedIPv4,
+				},
+				{
+					Address: net.IPv4(43, 43, 43, 43),
+					Tags:    []string{"tag1", "tag2", "tag3"},
+					Type:    instance.IPTypeRoutedIPv4,
+				},
 			},
 		},
 	}
@@ -740,59 +1076,65 @@ func TestClient_DeleteIP(t *testing.T) {
 				region:    tt.fields.region,
 				instance:  instanceMock,
 			}
-			if err := c.DeleteIP(tt.args.ctx, tt.args.zone, tt.args.ipID); (err != nil) != tt.wantErr {
-				t.Errorf("Client.DeleteIP() error = %v, wantErr %v", err, tt.wantErr)
+			got, err := c.FindIPs(tt.args.ctx, tt.args.zone, tt.args.tags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindIPs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindIPs() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestClient_CreatePrivateNIC(t *testing.T) {
+func TestClient_CreateIP(t *testing.T) {
 	t.Parallel()
 	type fields struct {
 		projectID string
 		region    scw.Region
 	}
 	type args struct {
-		ctx              context.Context
-		zone             scw.Zone
-		serverID         string
-		privateNetworkID string
+		ctx    context.Context
+		zone   scw.Zone
+		ipType instance.IPType
+		tags   []string
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
-		want    *instance.PrivateNIC
+		want    *instance.IP
 		wantErr bool
 		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
 	}{
 		{
-			name: "create private nic",
+			name: "create IP",
 			fields: fields{
 				projectID: projectID,
 				region:    scw.RegionFrPar,
 			},
 			args: args{
-				ctx:              context.TODO(),
-				zone:             scw.ZoneFrPar1,
-				serverID:         serverID,
-				privateNetworkID: privateNetworkID,
+				ctx:    context.TODO(),
+				zone:   scw.ZoneFrPar1,
+				ipType: instance.IPTypeRoutedIPv4,
+				tags:   []string{"tag1", "tag2"},
 			},
-			want: &instance.PrivateNIC{
-				ServerID:         serverID,
-				PrivateNetworkID: privateNetworkID,
+			want: &instance.IP{
+				Address: net.IPv4(42, 42, 42, 42),
+				Tags:    []string{"tag1", "tag2", createdByTag},
+				Type:    instance.IPTypeRoutedIPv4,
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.CreatePrivateNIC(&instance.CreatePrivateNICRequest{
-					Zone:             scw.ZoneFrPar1,
-					ServerID:         serverID,
-					PrivateNetworkID: privateNetworkID,
-					Tags:             []string{createdByTag},
-				}, gomock.Any()).Return(&instance.CreatePrivateNICResponse{
-					PrivateNic: &instance.PrivateNIC{
-						ServerID:         serverID,
-						PrivateNetworkID: privateNetworkID,
+				d.CreateIP(&instance.CreateIPRequest{
+					Zone: scw.ZoneFrPar1,
+					Tags: []string{"tag1", "tag2", createdByTag},
+					Type: instance.IPTypeRoutedIPv4,
+				}, gomock.Any()).Return(&instance.CreateIPResponse{
+					IP: &instance.IP{
+						Address: net.IPv4(42, 42, 42, 42),
+						Tags:    []string{"tag1", "tag2", createdByTag},
+						Type:    instance.IPTypeRoutedIPv4,
 					},
 				}, nil)
 			},
@@ -817,61 +1159,84 @@ func TestClient_CreatePrivateNIC(t *testing.T) {
 				region:    tt.fields.region,
 				instance:  instanceMock,
 			}
-			got, err := c.CreatePrivateNIC(tt.args.ctx, tt.args.zone, tt.args.serverID, tt.args.privateNetworkID)
+			got, err := c.CreateIP(tt.args.ctx, tt.args.zone, tt.args.ipType, tt.args.tags)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("Client.CreatePrivateNIC() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Client.CreateIP() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Client.CreatePrivateNIC() = %v, want %v", got, tt.want)
+				t.Errorf("Client.CreateIP() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestClient_GetAllServerUserData(t *testing.T) {
+func TestClient_GetIP(t *testing.T) {
 	t.Parallel()
 	type fields struct {
 		projectID string
 		region    scw.Region
 	}
 	type args struct {
-		ctx      context.Context
-		zone     scw.Zone
-		serverID string
+		ctx           context.Context
+		zone          scw.Zone
+		ipIDOrAddress string
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
-		want    map[string]io.Reader
+		want    *instance.IP
 		wantErr bool
 		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
 	}{
 		{
-			name: "get all server user data",
+			name: "get IP by address",
 			fields: fields{
 				projectID: projectID,
 				region:    scw.RegionFrPar,
 			},
 			args: args{
-				ctx:      context.TODO(),
-				zone:     scw.ZoneFrPar1,
-				serverID: serverID,
-			},
-			want: map[string]io.Reader{
-				"test": strings.NewReader("test value"),
+				ctx:           context.TODO(),
+				zone:          scw.ZoneFrPar1,
+				ipIDOrAddress: "42.42.42.42",
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.GetAllServerUserData(&instance.GetAllServerUserDataRequest{
-					Zone:     scw.ZoneFrPar1,
-					ServerID: serverID,
-				}, gomock.Any()).Return(&instance.GetAllServerUserDataResponse{
-					UserData: map[string]io.Reader{
-						"test": strings.NewReader("test value"),
+				d.GetIP(&instance.GetIPRequest{
+					Zone: scw.ZoneFrPar1,
+					IP:   "42.42.42.42",
+				}, gomock.Any()).Return(&instance.GetIPResponse{
+					IP: &instance.IP{
+						ID:      ipID,
+						Address: net.IPv4(42, 42, 42, 42),
+						Type:    instance.IPTypeRoutedIPv4,
 					},
 				}, nil)
 			},
+			want: &instance.IP{
+				ID:      ipID,
+				Address: net.IPv4(42, 42, 42, 42),
+				Type:    instance.IPTypeRoutedIPv4,
+			},
+		},
+		{
+			name: "IP not found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:           context.TODO(),
+				zone:          scw.ZoneFrPar1,
+				ipIDOrAddress: ipID,
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.GetIP(&instance.GetIPRequest{
+					Zone: scw.ZoneFrPar1,
+					IP:   ipID,
+				}, gomock.Any()).Return(nil, errAPI)
+			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -893,30 +1258,254 @@ func TestClient_GetAllServerUserData(t *testing.T) {
 				region:    tt.fields.region,
 				instance:  instanceMock,
 			}
-			got, err := c.GetAllServerUserData(tt.args.ctx, tt.args.zone, tt.args.serverID)
+			got, err := c.GetIP(tt.args.ctx, tt.args.zone, tt.args.ipIDOrAddress)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("Client.GetAllServerUserData() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Client.GetIP() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Client.GetAllServerUserData() = %v, want %v", got, tt.want)
+				t.Errorf("Client.GetIP() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestClient_SetServerUserData(t *testing.T) {
+func TestClient_DeleteIP(t *testing.T) {
 	t.Parallel()
 	type fields struct {
 		projectID string
 		region    scw.Region
 	}
 	type args struct {
-		ctx      context.Context
-		zone     scw.Zone
-		serverID string
-		key      string
-		content  string
+		ctx  context.Context
+		zone scw.Zone
+		ipID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "delete IP",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				ipID: ipID,
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.DeleteIP(&instance.DeleteIPRequest{
+					Zone: scw.ZoneFrPar1,
+					IP:   ipID,
+				}, gomock.Any())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			if err := c.DeleteIP(tt.args.ctx, tt.args.zone, tt.args.ipID); (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeleteIP() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_CreatePrivateNIC(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx              context.Context
+		zone             scw.Zone
+		serverID         string
+		privateNetworkID string
+		ipamIPIDs        []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *instance.PrivateNIC
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "create private nic",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:              context.TODO(),
+				zone:             scw.ZoneFrPar1,
+				serverID:         serverID,
+				privateNetworkID: privateNetworkID,
+				ipamIPIDs:        []string{ipamIPID1},
+			},
+			want: &instance.PrivateNIC{
+				ServerID:         serverID,
+				PrivateNetworkID: privateNetworkID,
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.CreatePrivateNIC(&instance.CreatePrivateNICRequest{
+					Zone:             scw.ZoneFrPar1,
+					ServerID:         serverID,
+					PrivateNetworkID: privateNetworkID,
+					Tags:             []string{createdByTag},
+					IpamIPIDs:        []string{ipamIPID1},
+				}, gomock.Any()).Return(&instance.CreatePrivateNICResponse{
+					PrivateNic: &instance.PrivateNIC{
+						ServerID:         serverID,
+						PrivateNetworkID: privateNetworkID,
+					},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			got, err := c.CreatePrivateNIC(tt.args.ctx, tt.args.zone, tt.args.serverID, tt.args.privateNetworkID, tt.args.ipamIPIDs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.CreatePrivateNIC() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.CreatePrivateNIC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_GetAllServerUserData(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx      context.Context
+		zone     scw.Zone
+		serverID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    map[string]io.Reader
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "get all server user data",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:      context.TODO(),
+				zone:     scw.ZoneFrPar1,
+				serverID: serverID,
+			},
+			want: map[string]io.Reader{
+				"test": strings.NewReader("test value"),
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.GetAllServerUserData(&instance.GetAllServerUserDataRequest{
+					Zone:     scw.ZoneFrPar1,
+					ServerID: serverID,
+				}, gomock.Any()).Return(&instance.GetAllServerUserDataResponse{
+					UserData: map[string]io.Reader{
+						"test": strings.NewReader("test value"),
+					},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			got, err := c.GetAllServerUserData(tt.args.ctx, tt.args.zone, tt.args.serverID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.GetAllServerUserData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.GetAllServerUserData() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_SetServerUserData(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx      context.Context
+		zone     scw.Zone
+		serverID string
+		key      string
+		content  string
 	}
 	tests := []struct {
 		name    string
@@ -1448,7 +2037,7 @@ func TestClient_UpdateInstanceVolumeTags(t *testing.T) {
 				d.UpdateVolume(&instance.UpdateVolumeRequest{
 					Zone:     scw.ZoneFrPar1,
 					VolumeID: volumeID,
-					Tags:     &[]string{"tag1", "tag2", "tag3"},
+					Tags:     &[]string{"tag1", "tag2", "tag3", createdByTag},
 				}, gomock.Any())
 			},
 		},
@@ -1550,28 +2139,880 @@ func TestClient_FindInstanceVolumes(t *testing.T) {
 				region:    tt.fields.region,
 				instance:  instanceMock,
 			}
-			got, err := c.FindInstanceVolumes(tt.args.ctx, tt.args.zone, tt.args.tags)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("Client.FindInstanceVolumes() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Client.FindInstanceVolumes() = %v, want %v", got, tt.want)
+			got, err := c.FindInstanceVolumes(tt.args.ctx, tt.args.zone, tt.args.tags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindInstanceVolumes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindInstanceVolumes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DeleteInstanceVolume(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx      context.Context
+		zone     scw.Zone
+		volumeID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "delete instance volume",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:      context.TODO(),
+				zone:     scw.ZoneFrPar1,
+				volumeID: volumeID,
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.DeleteVolume(&instance.DeleteVolumeRequest{
+					Zone:     scw.ZoneFrPar1,
+					VolumeID: volumeID,
+				}, gomock.Any())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			if err := c.DeleteInstanceVolume(tt.args.ctx, tt.args.zone, tt.args.volumeID); (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeleteInstanceVolume() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_DeleteServer(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx      context.Context
+		zone     scw.Zone
+		serverID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "delete server",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:      context.TODO(),
+				zone:     scw.ZoneFrPar1,
+				serverID: serverID,
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.DeleteServer(&instance.DeleteServerRequest{
+					Zone:     scw.ZoneFrPar1,
+					ServerID: serverID,
+				}, gomock.Any())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			if err := c.DeleteServer(tt.args.ctx, tt.args.zone, tt.args.serverID); (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeleteServer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_FindPlacementGroup(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx  context.Context
+		zone scw.Zone
+		name string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *instance.PlacementGroup
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "placement group not found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				name: "test-placement-group",
+			},
+			wantErr: true,
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListPlacementGroups(&instance.ListPlacementGroupsRequest{
+					Zone:    scw.ZoneFrPar1,
+					Name:    ptr.To("test-placement-group"),
+					Project: ptr.To(projectID),
+				}, gomock.Any(), gomock.Any()).Return(&instance.ListPlacementGroupsResponse{}, nil)
+			},
+		},
+		{
+			name: "placement group found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				name: "test-placement-group",
+			},
+			want: &instance.PlacementGroup{
+				ID:   placementGroupID,
+				Name: "test-placement-group",
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListPlacementGroups(&instance.ListPlacementGroupsRequest{
+					Zone:    scw.ZoneFrPar1,
+					Name:    ptr.To("test-placement-group"),
+					Project: ptr.To(projectID),
+				}, gomock.Any(), gomock.Any()).Return(&instance.ListPlacementGroupsResponse{
+					TotalCount: 1,
+					PlacementGroups: []*instance.PlacementGroup{
+						{
+							ID:   placementGroupID,
+							Name: "test-placement-group",
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "duplicate placement groups found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				name: "test-placement-group",
+			},
+			wantErr: true,
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListPlacementGroups(&instance.ListPlacementGroupsRequest{
+					Zone:    scw.ZoneFrPar1,
+					Name:    ptr.To("test-placement-group"),
+					Project: ptr.To(projectID),
+				}, gomock.Any(), gomock.Any()).Return(&instance.ListPlacementGroupsResponse{
+					TotalCount: 2,
+					PlacementGroups: []*instance.PlacementGroup{
+						{
+							Name: "test-placement-group",
+						},
+						{
+							Name: "test-placement-group",
+						},
+					},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			got, err := c.FindPlacementGroup(tt.args.ctx, tt.args.zone, tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindPlacementGroup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindPlacementGroup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_FindSecurityGroup(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx  context.Context
+		zone scw.Zone
+		name string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *instance.SecurityGroup
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "security group not found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				name: "test-security-group",
+			},
+			wantErr: true,
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
+					Zone:    scw.ZoneFrPar1,
+					Name:    ptr.To("test-security-group"),
+					Project: ptr.To(projectID),
+				}, gomock.Any(), gomock.Any()).Return(&instance.ListSecurityGroupsResponse{}, nil)
+			},
+		},
+		{
+			name: "security group found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				name: "test-security-group",
+			},
+			want: &instance.SecurityGroup{
+				ID:   securityGroupID,
+				Name: "test-security-group",
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
+					Zone:    scw.ZoneFrPar1,
+					Name:    ptr.To("test-security-group"),
+					Project: ptr.To(projectID),
+				}, gomock.Any(), gomock.Any()).Return(&instance.ListSecurityGroupsResponse{
+					TotalCount: 1,
+					SecurityGroups: []*instance.SecurityGroup{
+						{
+							ID:   securityGroupID,
+							Name: "test-security-group",
+						},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "duplicate security groups found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				name: "test-security-group",
+			},
+			wantErr: true,
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
+					Zone:    scw.ZoneFrPar1,
+					Name:    ptr.To("test-security-group"),
+					Project: ptr.To(projectID),
+				}, gomock.Any(), gomock.Any()).Return(&instance.ListSecurityGroupsResponse{
+					TotalCount: 2,
+					SecurityGroups: []*instance.SecurityGroup{
+						{
+							Name: "test-security-group",
+						},
+						{
+							Name: "test-security-group",
+						},
+					},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			got, err := c.FindSecurityGroup(tt.args.ctx, tt.args.zone, tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindSecurityGroup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindSecurityGroup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_FindSecurityGroupByTags(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx  context.Context
+		zone scw.Zone
+		tags []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *instance.SecurityGroup
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "security group not found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				tags: []string{"tag1", "tag2"},
+			},
+			wantErr: true,
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
+					Zone:    scw.ZoneFrPar1,
+					Tags:    []string{"tag1", "tag2"},
+					Project: ptr.To(projectID),
+				}, gomock.Any(), gomock.Any()).Return(&instance.ListSecurityGroupsResponse{}, nil)
+			},
+		},
+		{
+			name: "security group found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				tags: []string{"tag1", "tag2"},
+			},
+			want: &instance.SecurityGroup{
+				ID:   securityGroupID,
+				Tags: []string{"tag1", "tag2", "misc"},
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
+					Zone:    scw.ZoneFrPar1,
+					Tags:    []string{"tag1", "tag2"},
+					Project: ptr.To(projectID),
+				}, gomock.Any(), gomock.Any()).Return(&instance.ListSecurityGroupsResponse{
+					TotalCount: 2,
+					SecurityGroups: []*instance.SecurityGroup{
+						{
+							ID:   securityGroupID,
+							Tags: []string{"tag1", "tag2", "misc"},
+						},
+						{
+							ID:   "22222222-2222-2222-2222-222222222222",
+							Tags: []string{"tag1"},
+						},
+					},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			got, err := c.FindSecurityGroupByTags(tt.args.ctx, tt.args.zone, tt.args.tags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindSecurityGroupByTags() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindSecurityGroupByTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_FindPlacementGroups(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx  context.Context
+		zone scw.Zone
+		tags []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*instance.PlacementGroup
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "no placement group",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				tags: []string{"tag1", "tag2"},
+			},
+			want: []*instance.PlacementGroup{},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListPlacementGroups(&instance.ListPlacementGroupsRequest{
+					Zone:    scw.ZoneFrPar1,
+					Tags:    []string{"tag1", "tag2"},
+					Project: ptr.To(projectID),
+				}, gomock.Any(), gomock.Any()).Return(&instance.ListPlacementGroupsResponse{
+					PlacementGroups: []*instance.PlacementGroup{},
+				}, nil)
+			},
+		},
+		{
+			name: "placement groups found",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				tags: []string{"tag1", "tag2"},
+			},
+			want: []*instance.PlacementGroup{
+				{
+					ID:   placementGroupID,
+					Tags: []string{"tag1", "tag2", "misc"},
+				},
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.ListPlacementGroups(&instance.ListPlacementGroupsRequest{
+					Zone:    scw.ZoneFrPar1,
+					Tags:    []string{"tag1", "tag2"},
+					Project: ptr.To(projectID),
+				}, gomock.Any(), gomock.Any()).Return(&instance.ListPlacementGroupsResponse{
+					TotalCount: 2,
+					PlacementGroups: []*instance.PlacementGroup{
+						{
+							ID:   placementGroupID,
+							Tags: []string{"tag1", "tag2", "misc"},
+						},
+						{
+							ID:   "22222222-2222-2222-2222-222222222222",
+							Tags: []string{"tag1"},
+						},
+					},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			got, err := c.FindPlacementGroups(tt.args.ctx, tt.args.zone, tt.args.tags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindPlacementGroups() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindPlacementGroups() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_UpdateServerPublicIPs(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx         context.Context
+		zone        scw.Zone
+		id          string
+		publicIPIDs []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *instance.Server
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "update public IPs",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:         context.TODO(),
+				zone:        scw.ZoneFrPar1,
+				id:          serverID,
+				publicIPIDs: []string{ipID},
+			},
+			want: &instance.Server{
+				ID: serverID,
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.UpdateServer(&instance.UpdateServerRequest{
+					Zone:      scw.ZoneFrPar1,
+					ServerID:  serverID,
+					PublicIPs: &[]string{ipID},
+				}, gomock.Any()).Return(&instance.UpdateServerResponse{
+					Server: &instance.Server{
+						ID: serverID,
+					},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			got, err := c.UpdateServerPublicIPs(tt.args.ctx, tt.args.zone, tt.args.id, tt.args.publicIPIDs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.UpdateServerPublicIPs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.UpdateServerPublicIPs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_UpdateServerTags(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx  context.Context
+		zone scw.Zone
+		id   string
+		tags []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "update tags",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				id:   serverID,
+				tags: []string{"tag1", "tag2"},
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.UpdateServer(&instance.UpdateServerRequest{
+					Zone:     scw.ZoneFrPar1,
+					ServerID: serverID,
+					Tags:     &[]string{"tag1", "tag2", createdByTag},
+				}, gomock.Any()).Return(&instance.UpdateServerResponse{}, nil)
+			},
+		},
+		{
+			name: "API error",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				id:   serverID,
+				tags: []string{"tag1", "tag2"},
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.UpdateServer(gomock.Any(), gomock.Any()).Return(nil, errAPI)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			if err := c.UpdateServerTags(tt.args.ctx, tt.args.zone, tt.args.id, tt.args.tags); (err != nil) != tt.wantErr {
+				t.Errorf("Client.UpdateServerTags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_UpdateServerProtection(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx       context.Context
+		zone      scw.Zone
+		id        string
+		protected bool
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
+	}{
+		{
+			name: "disable protection",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:       context.TODO(),
+				zone:      scw.ZoneFrPar1,
+				id:        serverID,
+				protected: false,
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.UpdateServer(&instance.UpdateServerRequest{
+					Zone:      scw.ZoneFrPar1,
+					ServerID:  serverID,
+					Protected: ptr.To(false),
+				}, gomock.Any()).Return(&instance.UpdateServerResponse{}, nil)
+			},
+		},
+		{
+			name: "API error",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:       context.TODO(),
+				zone:      scw.ZoneFrPar1,
+				id:        serverID,
+				protected: false,
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.UpdateServer(gomock.Any(), gomock.Any()).Return(nil, errAPI)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			instanceMock := mock_client.NewMockInstanceAPI(mockCtrl)
+
+			// Every API call must be preceded by a zone check.
+			instanceMock.EXPECT().Zones().Return(tt.fields.region.GetZones())
+
+			tt.expect(instanceMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				instance:  instanceMock,
+			}
+			if err := c.UpdateServerProtection(tt.args.ctx, tt.args.zone, tt.args.id, tt.args.protected); (err != nil) != tt.wantErr {
+				t.Errorf("Client.UpdateServerProtection() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
-func TestClient_DeleteInstanceVolume(t *testing.T) {
+func TestClient_UpdateIPTags(t *testing.T) {
 	t.Parallel()
 	type fields struct {
 		projectID string
 		region    scw.Region
 	}
 	type args struct {
-		ctx      context.Context
-		zone     scw.Zone
-		volumeID string
+		ctx  context.Context
+		zone scw.Zone
+		id   string
+		tags []string
 	}
 	tests := []struct {
 		name    string
@@ -1581,22 +3022,41 @@ func TestClient_DeleteInstanceVolume(t *testing.T) {
 		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
 	}{
 		{
-			name: "delete instance volume",
+			name: "update tags",
 			fields: fields{
 				projectID: projectID,
 				region:    scw.RegionFrPar,
 			},
 			args: args{
-				ctx:      context.TODO(),
-				zone:     scw.ZoneFrPar1,
-				volumeID: volumeID,
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				id:   ipID,
+				tags: []string{"tag1", "tag2"},
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.DeleteVolume(&instance.DeleteVolumeRequest{
-					Zone:     scw.ZoneFrPar1,
-					VolumeID: volumeID,
-				}, gomock.Any())
+				d.UpdateIP(&instance.UpdateIPRequest{
+					Zone: scw.ZoneFrPar1,
+					IP:   ipID,
+					Tags: &[]string{"tag1", "tag2", createdByTag},
+				}, gomock.Any()).Return(&instance.UpdateIPResponse{}, nil)
+			},
+		},
+		{
+			name: "API error",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				id:   ipID,
+				tags: []string{"tag1", "tag2"},
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.UpdateIP(gomock.Any(), gomock.Any()).Return(nil, errAPI)
 			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -1618,23 +3078,24 @@ func TestClient_DeleteInstanceVolume(t *testing.T) {
 				region:    tt.fields.region,
 				instance:  instanceMock,
 			}
-			if err := c.DeleteInstanceVolume(tt.args.ctx, tt.args.zone, tt.args.volumeID); (err != nil) != tt.wantErr {
-				t.Errorf("Client.DeleteInstanceVolume() error = %v, wantErr %v", err, tt.wantErr)
+			if err := c.UpdateIPTags(tt.args.ctx, tt.args.zone, tt.args.id, tt.args.tags); (err != nil) != tt.wantErr {
+				t.Errorf("Client.UpdateIPTags() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
-func TestClient_DeleteServer(t *testing.T) {
+func TestClient_DeletePrivateNIC(t *testing.T) {
 	t.Parallel()
 	type fields struct {
 		projectID string
 		region    scw.Region
 	}
 	type args struct {
-		ctx      context.Context
-		zone     scw.Zone
-		serverID string
+		ctx          context.Context
+		zone         scw.Zone
+		serverID     string
+		privateNICID string
 	}
 	tests := []struct {
 		name    string
@@ -1644,22 +3105,41 @@ func TestClient_DeleteServer(t *testing.T) {
 		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
 	}{
 		{
-			name: "delete server",
+			name: "delete private NIC",
 			fields: fields{
 				projectID: projectID,
 				region:    scw.RegionFrPar,
 			},
 			args: args{
-				ctx:      context.TODO(),
-				zone:     scw.ZoneFrPar1,
-				serverID: serverID,
+				ctx:          context.TODO(),
+				zone:         scw.ZoneFrPar1,
+				serverID:     serverID,
+				privateNICID: privateNICID,
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.DeleteServer(&instance.DeleteServerRequest{
-					Zone:     scw.ZoneFrPar1,
-					ServerID: serverID,
-				}, gomock.Any())
+				d.DeletePrivateNIC(&instance.DeletePrivateNICRequest{
+					Zone:         scw.ZoneFrPar1,
+					ServerID:     serverID,
+					PrivateNicID: privateNICID,
+				}, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "API error",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:          context.TODO(),
+				zone:         scw.ZoneFrPar1,
+				serverID:     serverID,
+				privateNICID: privateNICID,
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.DeletePrivateNIC(gomock.Any(), gomock.Any()).Return(errAPI)
 			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -1681,14 +3161,14 @@ func TestClient_DeleteServer(t *testing.T) {
 				region:    tt.fields.region,
 				instance:  instanceMock,
 			}
-			if err := c.DeleteServer(tt.args.ctx, tt.args.zone, tt.args.serverID); (err != nil) != tt.wantErr {
-				t.Errorf("Client.DeleteServer() error = %v, wantErr %v", err, tt.wantErr)
+			if err := c.DeletePrivateNIC(tt.args.ctx, tt.args.zone, tt.args.serverID, tt.args.privateNICID); (err != nil) != tt.wantErr {
+				t.Errorf("Client.DeletePrivateNIC() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
-func TestClient_FindPlacementGroup(t *testing.T) {
+func TestClient_FindDefaultSecurityGroup(t *testing.T) {
 	t.Parallel()
 	type fields struct {
 		projectID string
@@ -1697,38 +3177,17 @@ func TestClient_FindPlacementGroup(t *testing.T) {
 	type args struct {
 		ctx  context.Context
 		zone scw.Zone
-		name string
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
-		want    *instance.PlacementGroup
+		want    *instance.SecurityGroup
 		wantErr bool
 		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
 	}{
 		{
-			name: "placement group not found",
-			fields: fields{
-				projectID: projectID,
-				region:    scw.RegionFrPar,
-			},
-			args: args{
-				ctx:  context.TODO(),
-				zone: scw.ZoneFrPar1,
-				name: "test-placement-group",
-			},
-			wantErr: true,
-			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.ListPlacementGroups(&instance.ListPlacementGroupsRequest{
-					Zone:    scw.ZoneFrPar1,
-					Name:    ptr.To("test-placement-group"),
-					Project: ptr.To(projectID),
-				}, gomock.Any(), gomock.Any()).Return(&instance.ListPlacementGroupsResponse{}, nil)
-			},
-		},
-		{
-			name: "placement group found",
+			name: "found default security group",
 			fields: fields{
 				projectID: projectID,
 				region:    scw.RegionFrPar,
@@ -1736,30 +3195,29 @@ func TestClient_FindPlacementGroup(t *testing.T) {
 			args: args{
 				ctx:  context.TODO(),
 				zone: scw.ZoneFrPar1,
-				name: "test-placement-group",
 			},
-			want: &instance.PlacementGroup{
-				ID:   placementGroupID,
-				Name: "test-placement-group",
+			want: &instance.SecurityGroup{
+				ID:             securityGroupID,
+				ProjectDefault: true,
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.ListPlacementGroups(&instance.ListPlacementGroupsRequest{
-					Zone:    scw.ZoneFrPar1,
-					Name:    ptr.To("test-placement-group"),
-					Project: ptr.To(projectID),
-				}, gomock.Any(), gomock.Any()).Return(&instance.ListPlacementGroupsResponse{
+				d.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
+					Zone:           scw.ZoneFrPar1,
+					Project:        ptr.To(projectID),
+					ProjectDefault: ptr.To(true),
+				}, gomock.Any(), gomock.Any()).Return(&instance.ListSecurityGroupsResponse{
 					TotalCount: 1,
-					PlacementGroups: []*instance.PlacementGroup{
+					SecurityGroups: []*instance.SecurityGroup{
 						{
-							ID:   placementGroupID,
-							Name: "test-placement-group",
+							ID:             securityGroupID,
+							ProjectDefault: true,
 						},
 					},
 				}, nil)
 			},
 		},
 		{
-			name: "duplicate placement groups found",
+			name: "no default security group",
 			fields: fields{
 				projectID: projectID,
 				region:    scw.RegionFrPar,
@@ -1767,25 +3225,10 @@ func TestClient_FindPlacementGroup(t *testing.T) {
 			args: args{
 				ctx:  context.TODO(),
 				zone: scw.ZoneFrPar1,
-				name: "test-placement-group",
 			},
 			wantErr: true,
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.ListPlacementGroups(&instance.ListPlacementGroupsRequest{
-					Zone:    scw.ZoneFrPar1,
-					Name:    ptr.To("test-placement-group"),
-					Project: ptr.To(projectID),
-				}, gomock.Any(), gomock.Any()).Return(&instance.ListPlacementGroupsResponse{
-					TotalCount: 2,
-					PlacementGroups: []*instance.PlacementGroup{
-						{
-							Name: "test-placement-group",
-						},
-						{
-							Name: "test-placement-group",
-						},
-					},
-				}, nil)
+				d.ListSecurityGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(&instance.ListSecurityGroupsResponse{}, nil)
 			},
 		},
 	}
@@ -1808,117 +3251,73 @@ func TestClient_FindPlacementGroup(t *testing.T) {
 				region:    tt.fields.region,
 				instance:  instanceMock,
 			}
-			got, err := c.FindPlacementGroup(tt.args.ctx, tt.args.zone, tt.args.name)
+			got, err := c.FindDefaultSecurityGroup(tt.args.ctx, tt.args.zone)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("Client.FindPlacementGroup() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Client.FindDefaultSecurityGroup() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Client.FindPlacementGroup() = %v, want %v", got, tt.want)
+				t.Errorf("Client.FindDefaultSecurityGroup() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestClient_FindSecurityGroup(t *testing.T) {
+func TestClient_UpdateServerSecurityGroup(t *testing.T) {
 	t.Parallel()
 	type fields struct {
 		projectID string
 		region    scw.Region
 	}
 	type args struct {
-		ctx  context.Context
-		zone scw.Zone
-		name string
+		ctx             context.Context
+		zone            scw.Zone
+		id              string
+		securityGroupID string
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
-		want    *instance.SecurityGroup
 		wantErr bool
 		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
 	}{
 		{
-			name: "security group not found",
+			name: "update security group",
 			fields: fields{
 				projectID: projectID,
 				region:    scw.RegionFrPar,
 			},
 			args: args{
-				ctx:  context.TODO(),
-				zone: scw.ZoneFrPar1,
-				name: "test-security-group",
+				ctx:             context.TODO(),
+				zone:            scw.ZoneFrPar1,
+				id:              serverID,
+				securityGroupID: securityGroupID,
 			},
-			wantErr: true,
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
-					Zone:    scw.ZoneFrPar1,
-					Name:    ptr.To("test-security-group"),
-					Project: ptr.To(projectID),
-				}, gomock.Any(), gomock.Any()).Return(&instance.ListSecurityGroupsResponse{}, nil)
+				d.UpdateServer(&instance.UpdateServerRequest{
+					Zone:          scw.ZoneFrPar1,
+					ServerID:      serverID,
+					SecurityGroup: &instance.SecurityGroupTemplate{ID: securityGroupID},
+				}, gomock.Any()).Return(&instance.UpdateServerResponse{}, nil)
 			},
 		},
 		{
-			name: "security group found",
+			name: "API error",
 			fields: fields{
 				projectID: projectID,
 				region:    scw.RegionFrPar,
 			},
 			args: args{
-				ctx:  context.TODO(),
-				zone: scw.ZoneFrPar1,
-				name: "test-security-group",
-			},
-			want: &instance.SecurityGroup{
-				ID:   securityGroupID,
-				Name: "test-security-group",
+				ctx:             context.TODO(),
+				zone:            scw.ZoneFrPar1,
+				id:              serverID,
+				securityGroupID: securityGroupID,
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
-					Zone:    scw.ZoneFrPar1,
-					Name:    ptr.To("test-security-group"),
-					Project: ptr.To(projectID),
-				}, gomock.Any(), gomock.Any()).Return(&instance.ListSecurityGroupsResponse{
-					TotalCount: 1,
-					SecurityGroups: []*instance.SecurityGroup{
-						{
-							ID:   securityGroupID,
-							Name: "test-security-group",
-						},
-					},
-				}, nil)
-			},
-		},
-		{
-			name: "duplicate security groups found",
-			fields: fields{
-				projectID: projectID,
-				region:    scw.RegionFrPar,
-			},
-			args: args{
-				ctx:  context.TODO(),
-				zone: scw.ZoneFrPar1,
-				name: "test-security-group",
+				d.UpdateServer(gomock.Any(), gomock.Any()).Return(nil, errAPI)
 			},
 			wantErr: true,
-			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
-				d.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
-					Zone:    scw.ZoneFrPar1,
-					Name:    ptr.To("test-security-group"),
-					Project: ptr.To(projectID),
-				}, gomock.Any(), gomock.Any()).Return(&instance.ListSecurityGroupsResponse{
-					TotalCount: 2,
-					SecurityGroups: []*instance.SecurityGroup{
-						{
-							Name: "test-security-group",
-						},
-						{
-							Name: "test-security-group",
-						},
-					},
-				}, nil)
-			},
 		},
 	}
 	for _, tt := range tests {
@@ -1940,64 +3339,65 @@ func TestClient_FindSecurityGroup(t *testing.T) {
 				region:    tt.fields.region,
 				instance:  instanceMock,
 			}
-			got, err := c.FindSecurityGroup(tt.args.ctx, tt.args.zone, tt.args.name)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("Client.FindSecurityGroup() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Client.FindSecurityGroup() = %v, want %v", got, tt.want)
+			if err := c.UpdateServerSecurityGroup(tt.args.ctx, tt.args.zone, tt.args.id, tt.args.securityGroupID); (err != nil) != tt.wantErr {
+				t.Errorf("Client.UpdateServerSecurityGroup() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
-func TestClient_UpdateServerPublicIPs(t *testing.T) {
+func TestClient_RemoveServerPlacementGroup(t *testing.T) {
 	t.Parallel()
 	type fields struct {
 		projectID string
 		region    scw.Region
 	}
 	type args struct {
-		ctx         context.Context
-		zone        scw.Zone
-		id          string
-		publicIPIDs []string
+		ctx  context.Context
+		zone scw.Zone
+		id   string
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		args    args
-		want    *instance.Server
 		wantErr bool
 		expect  func(d *mock_client.MockInstanceAPIMockRecorder)
 	}{
 		{
-			name: "update public IPs",
+			name: "remove placement group",
 			fields: fields{
 				projectID: projectID,
 				region:    scw.RegionFrPar,
 			},
 			args: args{
-				ctx:         context.TODO(),
-				zone:        scw.ZoneFrPar1,
-				id:          serverID,
-				publicIPIDs: []string{ipID},
-			},
-			want: &instance.Server{
-				ID: serverID,
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				id:   serverID,
 			},
 			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
 				d.UpdateServer(&instance.UpdateServerRequest{
-					Zone:      scw.ZoneFrPar1,
-					ServerID:  serverID,
-					PublicIPs: &[]string{ipID},
-				}, gomock.Any()).Return(&instance.UpdateServerResponse{
-					Server: &instance.Server{
-						ID: serverID,
-					},
-				}, nil)
+					Zone:           scw.ZoneFrPar1,
+					ServerID:       serverID,
+					PlacementGroup: &instance.NullableStringValue{Null: true},
+				}, gomock.Any()).Return(&instance.UpdateServerResponse{}, nil)
+			},
+		},
+		{
+			name: "API error",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				id:   serverID,
+			},
+			expect: func(d *mock_client.MockInstanceAPIMockRecorder) {
+				d.UpdateServer(gomock.Any(), gomock.Any()).Return(nil, errAPI)
 			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -2019,13 +3419,8 @@ func TestClient_UpdateServerPublicIPs(t *testing.T) {
 				region:    tt.fields.region,
 				instance:  instanceMock,
 			}
-			got, err := c.UpdateServerPublicIPs(tt.args.ctx, tt.args.zone, tt.args.id, tt.args.publicIPIDs)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("Client.UpdateServerPublicIPs() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Client.UpdateServerPublicIPs() = %v, want %v", got, tt.want)
+			if err := c.RemoveServerPlacementGroup(tt.args.ctx, tt.args.zone, tt.args.id); (err != nil) != tt.wantErr {
+				t.Errorf("Client.RemoveServerPlacementGroup() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
@@ -3,6 +3,7 @@ package client
 // Interface of the scaleway-sdk-go wrapper to access Scaleway Product APIs in
 // a specific region and project.
 type Interface interface {
+	Baremetal
 	Block
 	Config
 	Domain
@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"net"
+	"slices"
 
 	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -11,13 +13,19 @@ import (
 type IPAMAPI interface {
 	ListIPs(req *ipam.ListIPsRequest, opts ...scw.RequestOption) (*ipam.ListIPsResponse, error)
 	ReleaseIPSet(req *ipam.ReleaseIPSetRequest, opts ...scw.RequestOption) error
+	BookIP(req *ipam.BookIPRequest, opts ...scw.RequestOption) (*ipam.IP, error)
+	ReleaseIP(req *ipam.ReleaseIPRequest, opts ...scw.RequestOption) error
 }
 
 type IPAM interface {
 	FindPrivateNICIPs(ctx context.Context, privateNICID string) ([]*ipam.IP, error)
+	FindBaremetalPrivateNICIPs(ctx context.Context, privateNICID string) ([]*ipam.IP, error)
 	FindLBServersIPs(ctx context.Context, privateNetworkID string, lbIDs []string) ([]*ipam.IP, error)
 	FindAvailableIPs(ctx context.Context, privateNetworkID string) ([]*ipam.IP, error)
 	CleanAvailableIPs(ctx context.Context, privateNetworkID string) error
+	FindPrivateNetworkIPs(ctx context.Context, privateNetworkID string, tags []string) ([]*ipam.IP, error)
+	BookPrivateNetworkIP(ctx context.Context, privateNetworkID string, address net.IP, tags []string) (*ipam.IP, error)
+	ReleaseIP(ctx context.Context, ipID string) error
 }
 
 func (c *Client) FindPrivateNICIPs(ctx context.Context, privateNICID string) ([]*ipam.IP, error) {
@@ -34,6 +42,20 @@ func (c *Client) FindPrivateNICIPs(ctx context.Context, privateNICID string) ([]
 	return ips.IPs, nil
 }
 
+func (c *Client) FindBaremetalPrivateNICIPs(ctx context.Context, privateNICID string) ([]*ipam.IP, error) {
+	ips, err := c.ipam.ListIPs(&ipam.ListIPsRequest{
+		ProjectID:    &c.projectID,
+		ResourceType: ipam.ResourceTypeBaremetalPrivateNic,
+		ResourceID:   &privateNICID,
+		IsIPv6:       ptr.To(false),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("ListIPs", err)
+	}
+
+	return ips.IPs, nil
+}
+
 func (c *Client) FindLBServersIPs(ctx context.Context, privateNetworkID string, lbIDs []string) ([]*ipam.IP, error) {
 	ips, err := c.ipam.ListIPs(&ipam.ListIPsRequest{
 		ProjectID:        &c.projectID,
@@ -87,3 +109,51 @@ func (c *Client) CleanAvailableIPs(ctx context.Context, privateNetworkID string)
 
 	return nil
 }
+
+// FindPrivateNetworkIPs returns the IPv4 of the Private Network that have all the
+// provided tags. All IPv4 of the Private Network are returned if tags are empty.
+func (c *Client) FindPrivateNetworkIPs(ctx context.Context, privateNetworkID string, tags []string) ([]*ipam.IP, error) {
+	resp, err := c.ipam.ListIPs(&ipam.ListIPsRequest{
+		ProjectID:        &c.projectID,
+		PrivateNetworkID: &privateNetworkID,
+		IsIPv6:           ptr.To(false),
+		Tags:             tags,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, newCallError("ListIPs", err)
+	}
+
+	// Filter out all IPs that have the wrong tags.
+	ips := slices.DeleteFunc(resp.IPs, func(ip *ipam.IP) bool {
+		return !matchTags(ip.Tags, tags)
+	})
+
+	return ips, nil
+}
+
+// BookPrivateNetworkIP reserves an IPv4 in the Private Network.
+func (c *Client) BookPrivateNetworkIP(ctx context.Context, privateNetworkID string, address net.IP, tags []string) (*ipam.IP, error) {
+	ip, err := c.ipam.BookIP(&ipam.BookIPRequest{
+		ProjectID: c.projectID,
+		Source: &ipam.Source{
+			PrivateNetworkID: &privateNetworkID,
+		},
+		Address: &address,
+		Tags:    append(tags, createdByTag),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, newCallError("BookIP", err)
+	}
+
+	return ip, nil
+}
+
+func (c *Client) ReleaseIP(ctx context.Context, ipID string) error {
+	if err := c.ipam.ReleaseIP(&ipam.ReleaseIPRequest{
+		IPID: ipID,
+	}, scw.WithContext(ctx)); err != nil {
+		return newCallError("ReleaseIP", err)
+	}
+
+	return nil
+}
@@ -96,6 +96,82 @@ func TestClient_FindPrivateNICIPs(t *testing.T) {
 	}
 }
 
+func TestClient_FindBaremetalPrivateNICIPs(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx          context.Context
+		privateNICID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*ipam.IP
+		wantErr bool
+		expect  func(d *mock_client.MockIPAMAPIMockRecorder)
+	}{
+		{
+			name: "find baremetal private NIC IPs",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:          context.TODO(),
+				privateNICID: privateNICID,
+			},
+			want: []*ipam.IP{
+				{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 1), Mask: net.CIDRMask(24, 32)}}},
+				{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 2), Mask: net.CIDRMask(24, 32)}}},
+			},
+			expect: func(d *mock_client.MockIPAMAPIMockRecorder) {
+				d.ListIPs(&ipam.ListIPsRequest{
+					ProjectID:    ptr.To(projectID),
+					ResourceType: ipam.ResourceTypeBaremetalPrivateNic,
+					ResourceID:   ptr.To(privateNICID),
+					IsIPv6:       ptr.To(false),
+				}, gomock.Any(), gomock.Any()).Return(&ipam.ListIPsResponse{
+					TotalCount: 2,
+					IPs: []*ipam.IP{
+						{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 1), Mask: net.CIDRMask(24, 32)}}},
+						{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 2), Mask: net.CIDRMask(24, 32)}}},
+					},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ipamMock := mock_client.NewMockIPAMAPI(mockCtrl)
+
+			tt.expect(ipamMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				ipam:      ipamMock,
+			}
+			got, err := c.FindBaremetalPrivateNICIPs(tt.args.ctx, tt.args.privateNICID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindBaremetalPrivateNICIPs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindBaremetalPrivateNICIPs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestClient_FindLBServersIPs(t *testing.T) {
 	t.Parallel()
 	type fields struct {
@@ -323,3 +399,181 @@ func TestClient_CleanAvailableIPs(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_FindPrivateNetworkIPs(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx              context.Context
+		privateNetworkID string
+		tags             []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    []*ipam.IP
+		wantErr bool
+		expect  func(d *mock_client.MockIPAMAPIMockRecorder)
+	}{
+		{
+			name: "find ips with tags",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:              context.TODO(),
+				privateNetworkID: privateNetworkID,
+				tags:             []string{"tag1", "tag2"},
+			},
+			want: []*ipam.IP{
+				{ID: ipamIPID1, Tags: []string{"tag1", "tag2"}},
+			},
+			expect: func(d *mock_client.MockIPAMAPIMockRecorder) {
+				d.ListIPs(&ipam.ListIPsRequest{
+					ProjectID:        ptr.To(projectID),
+					PrivateNetworkID: ptr.To(privateNetworkID),
+					IsIPv6:           ptr.To(false),
+					Tags:             []string{"tag1", "tag2"},
+				}, gomock.Any(), gomock.Any()).Return(&ipam.ListIPsResponse{
+					TotalCount: 2,
+					IPs: []*ipam.IP{
+						{ID: ipamIPID1, Tags: []string{"tag1", "tag2"}},
+						{ID: ipamIPID2, Tags: []string{"tag1", "tag22"}},
+					},
+				}, nil)
+			},
+		},
+		{
+			name: "find all ips",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:              context.TODO(),
+				privateNetworkID: privateNetworkID,
+			},
+			want: []*ipam.IP{
+				{ID: ipamIPID1},
+				{ID: ipamIPID2},
+			},
+			expect: func(d *mock_client.MockIPAMAPIMockRecorder) {
+				d.ListIPs(&ipam.ListIPsRequest{
+					ProjectID:        ptr.To(projectID),
+					PrivateNetworkID: ptr.To(privateNetworkID),
+					IsIPv6:           ptr.To(false),
+				}, gomock.Any(), gomock.Any()).Return(&ipam.ListIPsResponse{
+					TotalCount: 2,
+					IPs: []*ipam.IP{
+						{ID: ipamIPID1},
+						{ID: ipamIPID2},
+					},
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ipamMock := mock_client.NewMockIPAMAPI(mockCtrl)
+
+			tt.expect(ipamMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				ipam:      ipamMock,
+			}
+			got, err := c.FindPrivateNetworkIPs(tt.args.ctx, tt.args.privateNetworkID, tt.args.tags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.FindPrivateNetworkIPs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.FindPrivateNetworkIPs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_BookPrivateNetworkIP(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		projectID string
+		region    scw.Region
+	}
+	type args struct {
+		ctx              context.Context
+		privateNetworkID string
+		address          net.IP
+		tags             []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *ipam.IP
+		wantErr bool
+		expect  func(d *mock_client.MockIPAMAPIMockRecorder)
+	}{
+		{
+			name: "book ip",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:              context.TODO(),
+				privateNetworkID: privateNetworkID,
+				address:          net.IPv4(10, 0, 0, 10),
+				tags:             []string{"tag1"},
+			},
+			want: &ipam.IP{ID: ipamIPID1},
+			expect: func(d *mock_client.MockIPAMAPIMockRecorder) {
+				d.BookIP(&ipam.BookIPRequest{
+					ProjectID: projectID,
+					Source: &ipam.Source{
+						PrivateNetworkID: ptr.To(privateNetworkID),
+					},
+					Address: ptr.To(net.IPv4(10, 0, 0, 10)),
+					Tags:    []string{"tag1", createdByTag},
+				}, gomock.Any()).Return(&ipam.IP{ID: ipamIPID1}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ipamMock := mock_client.NewMockIPAMAPI(mockCtrl)
+
+			tt.expect(ipamMock.EXPECT())
+
+			c := &Client{
+				projectID: tt.fields.projectID,
+				region:    tt.fields.region,
+				ipam:      ipamMock,
+			}
+			got, err := c.BookPrivateNetworkIP(tt.args.ctx, tt.args.privateNetworkID, tt.args.address, tt.args.tags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.BookPrivateNetworkIP() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.BookPrivateNetworkIP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
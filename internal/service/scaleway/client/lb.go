@@ -55,16 +55,9 @@ type LB interface {
 	FindLBs(ctx context.Context, tags []string) ([]*lb.LB, error)
 	ListBackends(ctx context.Context, zone scw.Zone, lbID string) ([]*lb.Backend, error)
 	DeleteBackend(ctx context.Context, zone scw.Zone, backendID string) error
-	UpdateBackend(ctx context.Context, zone scw.Zone, backendID, name string, port int32) (*lb.Backend, error)
-	UpdateHealthCheck(ctx context.Context, zone scw.Zone, backendID string, port int32) (*lb.HealthCheck, error)
-	CreateBackend(
-		ctx context.Context,
-		zone scw.Zone,
-		lbID,
-		name string,
-		servers []string,
-		port int32,
-	) (*lb.Backend, error)
+	UpdateBackend(ctx context.Context, zone scw.Zone, backendID string, backend *lb.Backend) (*lb.Backend, error)
+	UpdateHealthCheck(ctx context.Context, zone scw.Zone, backendID string, healthCheck *lb.HealthCheck) (*lb.HealthCheck, error)
+	CreateBackend(ctx context.Context, zone scw.Zone, lbID string, backend *lb.Backend) (*lb.Backend, error)
 	SetBackendServers(
 		ctx context.Context,
 		zone scw.Zone,
@@ -289,30 +282,25 @@ func (c *Client) DeleteBackend(ctx context.Context, zone scw.Zone, backendID str
 	return nil
 }
 
-func (c *Client) CreateBackend(
-	ctx context.Context,
-	zone scw.Zone,
-	lbID,
-	name string,
-	servers []string,
-	port int32,
-) (*lb.Backend, error) {
+func (c *Client) CreateBackend(ctx context.Context, zone scw.Zone, lbID string, desired *lb.Backend) (*lb.Backend, error) {
 	if err := c.validateZone(c.lb, zone); err != nil {
 		return nil, err
 	}
 
 	backend, err := c.lb.CreateBackend(&lb.ZonedAPICreateBackendRequest{
-		Zone:            zone,
-		LBID:            lbID,
-		Name:            name,
-		ForwardProtocol: lb.ProtocolTCP,
-		ForwardPort:     port,
-		HealthCheck: &lb.HealthCheck{
-			Port:            port,
-			CheckMaxRetries: 5,
-			TCPConfig:       &lb.HealthCheckTCPConfig{},
-		},
-		ServerIP: servers,
+		Zone:                 zone,
+		LBID:                 lbID,
+		Name:                 desired.Name,
+		ForwardProtocol:      lb.ProtocolTCP,
+		ForwardPort:          desired.ForwardPort,
+		ForwardPortAlgorithm: desired.ForwardPortAlgorithm,
+		HealthCheck:          desired.HealthCheck,
+		ServerIP:             desired.Pool,
+		TimeoutServer:        desired.TimeoutServer,
+		TimeoutConnect:       desired.TimeoutConnect,
+		TimeoutTunnel:        desired.TimeoutTunnel,
+		OnMarkedDownAction:   desired.OnMarkedDownAction,
+		ProxyProtocol:        desired.ProxyProtocol,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return nil, newCallError("CreateBackend", err)
@@ -343,23 +331,23 @@ func (c *Client) SetBackendServers(
 	return backend, nil
 }
 
-func (c *Client) UpdateBackend(
-	ctx context.Context,
-	zone scw.Zone,
-	backendID string,
-	name string,
-	port int32,
-) (*lb.Backend, error) {
+func (c *Client) UpdateBackend(ctx context.Context, zone scw.Zone, backendID string, desired *lb.Backend) (*lb.Backend, error) {
 	if err := c.validateZone(c.lb, zone); err != nil {
 		return nil, err
 	}
 
 	backend, err := c.lb.UpdateBackend(&lb.ZonedAPIUpdateBackendRequest{
-		Name:            name,
-		ForwardProtocol: lb.ProtocolTCP,
-		Zone:            zone,
-		BackendID:       backendID,
-		ForwardPort:     port,
+		Name:                 desired.Name,
+		ForwardProtocol:      lb.ProtocolTCP,
+		Zone:                 zone,
+		BackendID:            backendID,
+		ForwardPort:          desired.ForwardPort,
+		ForwardPortAlgorithm: desired.ForwardPortAlgorithm,
+		TimeoutServer:        desired.TimeoutServer,
+		TimeoutConnect:       desired.TimeoutConnect,
+		TimeoutTunnel:        desired.TimeoutTunnel,
+		OnMarkedDownAction:   desired.OnMarkedDownAction,
+		ProxyProtocol:        desired.ProxyProtocol,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return nil, newCallError("UpdateBackend", err)
@@ -372,7 +360,7 @@ func (c *Client) UpdateHealthCheck(
 	ctx context.Context,
 	zone scw.Zone,
 	backendID string,
-	port int32,
+	healthCheck *lb.HealthCheck,
 ) (*lb.HealthCheck, error) {
 	if err := c.validateZone(c.lb, zone); err != nil {
 		return nil, err
@@ -381,9 +369,12 @@ func (c *Client) UpdateHealthCheck(
 	healthcheck, err := c.lb.UpdateHealthCheck(&lb.ZonedAPIUpdateHealthCheckRequest{
 		Zone:            zone,
 		BackendID:       backendID,
-		Port:            port,
-		CheckMaxRetries: 5,
-		TCPConfig:       &lb.HealthCheckTCPConfig{},
+		Port:            healthCheck.Port,
+		CheckDelay:      healthCheck.CheckDelay,
+		CheckTimeout:    healthCheck.CheckTimeout,
+		CheckMaxRetries: healthCheck.CheckMaxRetries,
+		TCPConfig:       healthCheck.TCPConfig,
+		HTTPSConfig:     healthCheck.HTTPSConfig,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return nil, newCallError("UpdateHealthCheck", err)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -650,9 +651,7 @@ func TestClient_CreateBackend(t *testing.T) {
 		ctx     context.Context
 		zone    scw.Zone
 		lbID    string
-		name    string
-		servers []string
-		port    int32
+		backend *lb.Backend
 	}
 	tests := []struct {
 		name    string
@@ -669,12 +668,23 @@ func TestClient_CreateBackend(t *testing.T) {
 				region:    scw.RegionFrPar,
 			},
 			args: args{
-				ctx:     context.TODO(),
-				zone:    scw.ZoneFrPar1,
-				lbID:    lbID,
-				name:    "backend-name",
-				servers: []string{"42.42.42.42"},
-				port:    6443,
+				ctx:  context.TODO(),
+				zone: scw.ZoneFrPar1,
+				lbID: lbID,
+				backend: &lb.Backend{
+					Name:                 "backend-name",
+					Pool:                 []string{"42.42.42.42"},
+					ForwardPort:          6443,
+					ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
+					ProxyProtocol:        lb.ProxyProtocolProxyProtocolV2,
+					OnMarkedDownAction:   lb.OnMarkedDownActionShutdownSessions,
+					TimeoutTunnel:        ptr.To(time.Hour),
+					HealthCheck: &lb.HealthCheck{
+						Port:            6443,
+						CheckMaxRetries: 5,
+						TCPConfig:       &lb.HealthCheckTCPConfig{},
+					},
+				},
 			},
 			want: &lb.Backend{
 				ID:   backendID,
@@ -683,17 +693,21 @@ func TestClient_CreateBackend(t *testing.T) {
 			},
 			expect: func(l *mock_client.MockLBAPIMockRecorder) {
 				l.CreateBackend(&lb.ZonedAPICreateBackendRequest{
-					Zone:            scw.ZoneFrPar1,
-					LBID:            lbID,
-					Name:            "backend-name",
-					ForwardProtocol: lb.ProtocolTCP,
-					ForwardPort:     6443,
+					Zone:                 scw.ZoneFrPar1,
+					LBID:                 lbID,
+					Name:                 "backend-name",
+					ForwardProtocol:      lb.ProtocolTCP,
+					ForwardPort:          6443,
+					ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
 					HealthCheck: &lb.HealthCheck{
 						Port:            6443,
 						CheckMaxRetries: 5,
 						TCPConfig:       &lb.HealthCheckTCPConfig{},
 					},
-					ServerIP: []string{"42.42.42.42"},
+					ServerIP:           []string{"42.42.42.42"},
+					TimeoutTunnel:      ptr.To(time.Hour),
+					OnMarkedDownAction: lb.OnMarkedDownActionShutdownSessions,
+					ProxyProtocol:      lb.ProxyProtocolProxyProtocolV2,
 				}, gomock.Any()).Return(&lb.Backend{
 					ID:   backendID,
 					Name: "backend-name",
@@ -721,7 +735,7 @@ func TestClient_CreateBackend(t *testing.T) {
 				region:    tt.fields.region,
 				lb:        lbMock,
 			}
-			got, err := c.CreateBackend(tt.args.ctx, tt.args.zone, tt.args.lbID, tt.args.name, tt.args.servers, tt.args.port)
+			got, err := c.CreateBackend(tt.args.ctx, tt.args.zone, tt.args.lbID, tt.args.backend)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Client.CreateBackend() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1998,8 +2012,7 @@ func TestClient_UpdateBackend(t *testing.T) {
 		ctx       context.Context
 		zone      scw.Zone
 		backendID string
-		name      string
-		port      int32
+		backend   *lb.Backend
 	}
 	tests := []struct {
 		name    string
@@ -2019,8 +2032,14 @@ func TestClient_UpdateBackend(t *testing.T) {
 				ctx:       context.TODO(),
 				zone:      scw.ZoneFrPar1,
 				backendID: backendID,
-				name:      "backend-name",
-				port:      4242,
+				backend: &lb.Backend{
+					Name:                 "backend-name",
+					ForwardPort:          4242,
+					ForwardPortAlgorithm: lb.ForwardPortAlgorithmLeastconn,
+					ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+					OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
+					TimeoutServer:        ptr.To(time.Minute),
+				},
 			},
 			want: &lb.Backend{
 				ID:              backendID,
@@ -2031,11 +2050,15 @@ func TestClient_UpdateBackend(t *testing.T) {
 			wantErr: false,
 			expect: func(l *mock_client.MockLBAPIMockRecorder) {
 				l.UpdateBackend(&lb.ZonedAPIUpdateBackendRequest{
-					Zone:            scw.ZoneFrPar1,
-					BackendID:       backendID,
-					Name:            "backend-name",
-					ForwardPort:     4242,
-					ForwardProtocol: lb.ProtocolTCP,
+					Zone:                 scw.ZoneFrPar1,
+					BackendID:            backendID,
+					Name:                 "backend-name",
+					ForwardPort:          4242,
+					ForwardProtocol:      lb.ProtocolTCP,
+					ForwardPortAlgorithm: lb.ForwardPortAlgorithmLeastconn,
+					ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+					OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
+					TimeoutServer:        ptr.To(time.Minute),
 				}, gomock.Any()).Return(&lb.Backend{
 					ID:              backendID,
 					Name:            "backend-name",
@@ -2064,7 +2087,7 @@ func TestClient_UpdateBackend(t *testing.T) {
 				region:    tt.fields.region,
 				lb:        lbMock,
 			}
-			got, err := c.UpdateBackend(tt.args.ctx, tt.args.zone, tt.args.backendID, tt.args.name, tt.args.port)
+			got, err := c.UpdateBackend(tt.args.ctx, tt.args.zone, tt.args.backendID, tt.args.backend)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Client.UpdateBackend() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -2082,10 +2105,10 @@ func TestClient_UpdateHealthCheck(t *testing.T) {
 		region    scw.Region
 	}
 	type args struct {
-		ctx       context.Context
-		zone      scw.Zone
-		backendID string
-		port      int32
+		ctx         context.Context
+		zone        scw.Zone
+		backendID   string
+		healthCheck *lb.HealthCheck
 	}
 	tests := []struct {
 		name    string
@@ -2105,7 +2128,11 @@ func TestClient_UpdateHealthCheck(t *testing.T) {
 				ctx:       context.TODO(),
 				zone:      scw.ZoneFrPar1,
 				backendID: backendID,
-				port:      4242,
+				healthCheck: &lb.HealthCheck{
+					Port:            4242,
+					CheckMaxRetries: 5,
+					TCPConfig:       &lb.HealthCheckTCPConfig{},
+				},
 			},
 			want: &lb.HealthCheck{
 				Port:            4242,
@@ -2127,6 +2154,65 @@ func TestClient_UpdateHealthCheck(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			name: "update https health check",
+			fields: fields{
+				projectID: projectID,
+				region:    scw.RegionFrPar,
+			},
+			args: args{
+				ctx:       context.TODO(),
+				zone:      scw.ZoneFrPar1,
+				backendID: backendID,
+				healthCheck: &lb.HealthCheck{
+					Port:            6443,
+					CheckDelay:      ptr.To(10 * time.Second),
+					CheckTimeout:    ptr.To(5 * time.Second),
+					CheckMaxRetries: 3,
+					HTTPSConfig: &lb.HealthCheckHTTPSConfig{
+						URI:    "/readyz",
+						Method: "GET",
+						Code:   ptr.To(int32(200)),
+					},
+				},
+			},
+			want: &lb.HealthCheck{
+				Port:            6443,
+				CheckDelay:      ptr.To(10 * time.Second),
+				CheckTimeout:    ptr.To(5 * time.Second),
+				CheckMaxRetries: 3,
+				HTTPSConfig: &lb.HealthCheckHTTPSConfig{
+					URI:    "/readyz",
+					Method: "GET",
+					Code:   ptr.To(int32(200)),
+				},
+			},
+			expect: func(l *mock_client.MockLBAPIMockRecorder) {
+				l.UpdateHealthCheck(&lb.ZonedAPIUpdateHealthCheckRequest{
+					Zone:            scw.ZoneFrPar1,
+					BackendID:       backendID,
+					Port:            6443,
+					CheckDelay:      ptr.To(10 * time.Second),
+					CheckTimeout:    ptr.To(5 * time.Second),
+					CheckMaxRetries: 3,
+					HTTPSConfig: &lb.HealthCheckHTTPSConfig{
+						URI:    "/readyz",
+						Method: "GET",
+						Code:   ptr.To(int32(200)),
+					},
+				}, gomock.Any()).Return(&lb.HealthCheck{
+					Port:            6443,
+					CheckDelay:      ptr.To(10 * time.Second),
+					CheckTimeout:    ptr.To(5 * time.Second),
+					CheckMaxRetries: 3,
+					HTTPSConfig: &lb.HealthCheckHTTPSConfig{
+						URI:    "/readyz",
+						Method: "GET",
+						Code:   ptr.To(int32(200)),
+					},
+				}, nil)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2147,7 +2233,7 @@ func TestClient_UpdateHealthCheck(t *testing.T) {
 				region:    tt.fields.region,
 				lb:        lbMock,
 			}
-			got, err := c.UpdateHealthCheck(tt.args.ctx, tt.args.zone, tt.args.backendID, tt.args.port)
+			got, err := c.UpdateHealthCheck(tt.args.ctx, tt.args.zone, tt.args.backendID, tt.args.healthCheck)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Client.UpdateHealthCheck() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -0,0 +1,957 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../baremetal.go
+//
+// Generated by this command:
+//
+//	mockgen -destination baremetal_mock.go -package mock_client -source ../baremetal.go -typed
+//
+
+// Package mock_client is a generated GoMock package.
+package mock_client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	baremetal "github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	baremetal0 "github.com/scaleway/scaleway-sdk-go/api/baremetal/v3"
+	scw "github.com/scaleway/scaleway-sdk-go/scw"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBaremetalAPI is a mock of BaremetalAPI interface.
+type MockBaremetalAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockBaremetalAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockBaremetalAPIMockRecorder is the mock recorder for MockBaremetalAPI.
+type MockBaremetalAPIMockRecorder struct {
+	mock *MockBaremetalAPI
+}
+
+// NewMockBaremetalAPI creates a new mock instance.
+func NewMockBaremetalAPI(ctrl *gomock.Controller) *MockBaremetalAPI {
+	mock := &MockBaremetalAPI{ctrl: ctrl}
+	mock.recorder = &MockBaremetalAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBaremetalAPI) EXPECT() *MockBaremetalAPIMockRecorder {
+	return m.recorder
+}
+
+// CreateServer mocks base method.
+func (m *MockBaremetalAPI) CreateServer(req *baremetal.CreateServerRequest, opts ...scw.RequestOption) (*baremetal.Server, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServer", varargs...)
+	ret0, _ := ret[0].(*baremetal.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServer indicates an expected call of CreateServer.
+func (mr *MockBaremetalAPIMockRecorder) CreateServer(req any, opts ...any) *MockBaremetalAPICreateServerCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServer", reflect.TypeOf((*MockBaremetalAPI)(nil).CreateServer), varargs...)
+	return &MockBaremetalAPICreateServerCall{Call: call}
+}
+
+// MockBaremetalAPICreateServerCall wrap *gomock.Call
+type MockBaremetalAPICreateServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalAPICreateServerCall) Return(arg0 *baremetal.Server, arg1 error) *MockBaremetalAPICreateServerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalAPICreateServerCall) Do(f func(*baremetal.CreateServerRequest, ...scw.RequestOption) (*baremetal.Server, error)) *MockBaremetalAPICreateServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalAPICreateServerCall) DoAndReturn(f func(*baremetal.CreateServerRequest, ...scw.RequestOption) (*baremetal.Server, error)) *MockBaremetalAPICreateServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteServer mocks base method.
+func (m *MockBaremetalAPI) DeleteServer(req *baremetal.DeleteServerRequest, opts ...scw.RequestOption) (*baremetal.Server, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteServer", varargs...)
+	ret0, _ := ret[0].(*baremetal.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteServer indicates an expected call of DeleteServer.
+func (mr *MockBaremetalAPIMockRecorder) DeleteServer(req any, opts ...any) *MockBaremetalAPIDeleteServerCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServer", reflect.TypeOf((*MockBaremetalAPI)(nil).DeleteServer), varargs...)
+	return &MockBaremetalAPIDeleteServerCall{Call: call}
+}
+
+// MockBaremetalAPIDeleteServerCall wrap *gomock.Call
+type MockBaremetalAPIDeleteServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalAPIDeleteServerCall) Return(arg0 *baremetal.Server, arg1 error) *MockBaremetalAPIDeleteServerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalAPIDeleteServerCall) Do(f func(*baremetal.DeleteServerRequest, ...scw.RequestOption) (*baremetal.Server, error)) *MockBaremetalAPIDeleteServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalAPIDeleteServerCall) DoAndReturn(f func(*baremetal.DeleteServerRequest, ...scw.RequestOption) (*baremetal.Server, error)) *MockBaremetalAPIDeleteServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetOS mocks base method.
+func (m *MockBaremetalAPI) GetOS(req *baremetal.GetOSRequest, opts ...scw.RequestOption) (*baremetal.OS, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOS", varargs...)
+	ret0, _ := ret[0].(*baremetal.OS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOS indicates an expected call of GetOS.
+func (mr *MockBaremetalAPIMockRecorder) GetOS(req any, opts ...any) *MockBaremetalAPIGetOSCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOS", reflect.TypeOf((*MockBaremetalAPI)(nil).GetOS), varargs...)
+	return &MockBaremetalAPIGetOSCall{Call: call}
+}
+
+// MockBaremetalAPIGetOSCall wrap *gomock.Call
+type MockBaremetalAPIGetOSCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalAPIGetOSCall) Return(arg0 *baremetal.OS, arg1 error) *MockBaremetalAPIGetOSCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalAPIGetOSCall) Do(f func(*baremetal.GetOSRequest, ...scw.RequestOption) (*baremetal.OS, error)) *MockBaremetalAPIGetOSCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalAPIGetOSCall) DoAndReturn(f func(*baremetal.GetOSRequest, ...scw.RequestOption) (*baremetal.OS, error)) *MockBaremetalAPIGetOSCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// InstallServer mocks base method.
+func (m *MockBaremetalAPI) InstallServer(req *baremetal.InstallServerRequest, opts ...scw.RequestOption) (*baremetal.Server, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InstallServer", varargs...)
+	ret0, _ := ret[0].(*baremetal.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallServer indicates an expected call of InstallServer.
+func (mr *MockBaremetalAPIMockRecorder) InstallServer(req any, opts ...any) *MockBaremetalAPIInstallServerCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallServer", reflect.TypeOf((*MockBaremetalAPI)(nil).InstallServer), varargs...)
+	return &MockBaremetalAPIInstallServerCall{Call: call}
+}
+
+// MockBaremetalAPIInstallServerCall wrap *gomock.Call
+type MockBaremetalAPIInstallServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalAPIInstallServerCall) Return(arg0 *baremetal.Server, arg1 error) *MockBaremetalAPIInstallServerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalAPIInstallServerCall) Do(f func(*baremetal.InstallServerRequest, ...scw.RequestOption) (*baremetal.Server, error)) *MockBaremetalAPIInstallServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalAPIInstallServerCall) DoAndReturn(f func(*baremetal.InstallServerRequest, ...scw.RequestOption) (*baremetal.Server, error)) *MockBaremetalAPIInstallServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListOS mocks base method.
+func (m *MockBaremetalAPI) ListOS(req *baremetal.ListOSRequest, opts ...scw.RequestOption) (*baremetal.ListOSResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOS", varargs...)
+	ret0, _ := ret[0].(*baremetal.ListOSResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOS indicates an expected call of ListOS.
+func (mr *MockBaremetalAPIMockRecorder) ListOS(req any, opts ...any) *MockBaremetalAPIListOSCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOS", reflect.TypeOf((*MockBaremetalAPI)(nil).ListOS), varargs...)
+	return &MockBaremetalAPIListOSCall{Call: call}
+}
+
+// MockBaremetalAPIListOSCall wrap *gomock.Call
+type MockBaremetalAPIListOSCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalAPIListOSCall) Return(arg0 *baremetal.ListOSResponse, arg1 error) *MockBaremetalAPIListOSCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalAPIListOSCall) Do(f func(*baremetal.ListOSRequest, ...scw.RequestOption) (*baremetal.ListOSResponse, error)) *MockBaremetalAPIListOSCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalAPIListOSCall) DoAndReturn(f func(*baremetal.ListOSRequest, ...scw.RequestOption) (*baremetal.ListOSResponse, error)) *MockBaremetalAPIListOSCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListOffers mocks base method.
+func (m *MockBaremetalAPI) ListOffers(req *baremetal.ListOffersRequest, opts ...scw.RequestOption) (*baremetal.ListOffersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOffers", varargs...)
+	ret0, _ := ret[0].(*baremetal.ListOffersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOffers indicates an expected call of ListOffers.
+func (mr *MockBaremetalAPIMockRecorder) ListOffers(req any, opts ...any) *MockBaremetalAPIListOffersCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOffers", reflect.TypeOf((*MockBaremetalAPI)(nil).ListOffers), varargs...)
+	return &MockBaremetalAPIListOffersCall{Call: call}
+}
+
+// MockBaremetalAPIListOffersCall wrap *gomock.Call
+type MockBaremetalAPIListOffersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalAPIListOffersCall) Return(arg0 *baremetal.ListOffersResponse, arg1 error) *MockBaremetalAPIListOffersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalAPIListOffersCall) Do(f func(*baremetal.ListOffersRequest, ...scw.RequestOption) (*baremetal.ListOffersResponse, error)) *MockBaremetalAPIListOffersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalAPIListOffersCall) DoAndReturn(f func(*baremetal.ListOffersRequest, ...scw.RequestOption) (*baremetal.ListOffersResponse, error)) *MockBaremetalAPIListOffersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListServers mocks base method.
+func (m *MockBaremetalAPI) ListServers(req *baremetal.ListServersRequest, opts ...scw.RequestOption) (*baremetal.ListServersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServers", varargs...)
+	ret0, _ := ret[0].(*baremetal.ListServersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServers indicates an expected call of ListServers.
+func (mr *MockBaremetalAPIMockRecorder) ListServers(req any, opts ...any) *MockBaremetalAPIListServersCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServers", reflect.TypeOf((*MockBaremetalAPI)(nil).ListServers), varargs...)
+	return &MockBaremetalAPIListServersCall{Call: call}
+}
+
+// MockBaremetalAPIListServersCall wrap *gomock.Call
+type MockBaremetalAPIListServersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalAPIListServersCall) Return(arg0 *baremetal.ListServersResponse, arg1 error) *MockBaremetalAPIListServersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalAPIListServersCall) Do(f func(*baremetal.ListServersRequest, ...scw.RequestOption) (*baremetal.ListServersResponse, error)) *MockBaremetalAPIListServersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalAPIListServersCall) DoAndReturn(f func(*baremetal.ListServersRequest, ...scw.RequestOption) (*baremetal.ListServersResponse, error)) *MockBaremetalAPIListServersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateServer mocks base method.
+func (m *MockBaremetalAPI) UpdateServer(req *baremetal.UpdateServerRequest, opts ...scw.RequestOption) (*baremetal.Server, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServer", varargs...)
+	ret0, _ := ret[0].(*baremetal.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServer indicates an expected call of UpdateServer.
+func (mr *MockBaremetalAPIMockRecorder) UpdateServer(req any, opts ...any) *MockBaremetalAPIUpdateServerCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServer", reflect.TypeOf((*MockBaremetalAPI)(nil).UpdateServer), varargs...)
+	return &MockBaremetalAPIUpdateServerCall{Call: call}
+}
+
+// MockBaremetalAPIUpdateServerCall wrap *gomock.Call
+type MockBaremetalAPIUpdateServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalAPIUpdateServerCall) Return(arg0 *baremetal.Server, arg1 error) *MockBaremetalAPIUpdateServerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalAPIUpdateServerCall) Do(f func(*baremetal.UpdateServerRequest, ...scw.RequestOption) (*baremetal.Server, error)) *MockBaremetalAPIUpdateServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalAPIUpdateServerCall) DoAndReturn(f func(*baremetal.UpdateServerRequest, ...scw.RequestOption) (*baremetal.Server, error)) *MockBaremetalAPIUpdateServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Zones mocks base method.
+func (m *MockBaremetalAPI) Zones() []scw.Zone {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Zones")
+	ret0, _ := ret[0].([]scw.Zone)
+	return ret0
+}
+
+// Zones indicates an expected call of Zones.
+func (mr *MockBaremetalAPIMockRecorder) Zones() *MockBaremetalAPIZonesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Zones", reflect.TypeOf((*MockBaremetalAPI)(nil).Zones))
+	return &MockBaremetalAPIZonesCall{Call: call}
+}
+
+// MockBaremetalAPIZonesCall wrap *gomock.Call
+type MockBaremetalAPIZonesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalAPIZonesCall) Return(arg0 []scw.Zone) *MockBaremetalAPIZonesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalAPIZonesCall) Do(f func() []scw.Zone) *MockBaremetalAPIZonesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalAPIZonesCall) DoAndReturn(f func() []scw.Zone) *MockBaremetalAPIZonesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockBaremetalPrivateNetworkAPI is a mock of BaremetalPrivateNetworkAPI interface.
+type MockBaremetalPrivateNetworkAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockBaremetalPrivateNetworkAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockBaremetalPrivateNetworkAPIMockRecorder is the mock recorder for MockBaremetalPrivateNetworkAPI.
+type MockBaremetalPrivateNetworkAPIMockRecorder struct {
+	mock *MockBaremetalPrivateNetworkAPI
+}
+
+// NewMockBaremetalPrivateNetworkAPI creates a new mock instance.
+func NewMockBaremetalPrivateNetworkAPI(ctrl *gomock.Controller) *MockBaremetalPrivateNetworkAPI {
+	mock := &MockBaremetalPrivateNetworkAPI{ctrl: ctrl}
+	mock.recorder = &MockBaremetalPrivateNetworkAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBaremetalPrivateNetworkAPI) EXPECT() *MockBaremetalPrivateNetworkAPIMockRecorder {
+	return m.recorder
+}
+
+// AddServerPrivateNetwork mocks base method.
+func (m *MockBaremetalPrivateNetworkAPI) AddServerPrivateNetwork(req *baremetal0.PrivateNetworkAPIAddServerPrivateNetworkRequest, opts ...scw.RequestOption) (*baremetal0.ServerPrivateNetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddServerPrivateNetwork", varargs...)
+	ret0, _ := ret[0].(*baremetal0.ServerPrivateNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddServerPrivateNetwork indicates an expected call of AddServerPrivateNetwork.
+func (mr *MockBaremetalPrivateNetworkAPIMockRecorder) AddServerPrivateNetwork(req any, opts ...any) *MockBaremetalPrivateNetworkAPIAddServerPrivateNetworkCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServerPrivateNetwork", reflect.TypeOf((*MockBaremetalPrivateNetworkAPI)(nil).AddServerPrivateNetwork), varargs...)
+	return &MockBaremetalPrivateNetworkAPIAddServerPrivateNetworkCall{Call: call}
+}
+
+// MockBaremetalPrivateNetworkAPIAddServerPrivateNetworkCall wrap *gomock.Call
+type MockBaremetalPrivateNetworkAPIAddServerPrivateNetworkCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalPrivateNetworkAPIAddServerPrivateNetworkCall) Return(arg0 *baremetal0.ServerPrivateNetwork, arg1 error) *MockBaremetalPrivateNetworkAPIAddServerPrivateNetworkCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalPrivateNetworkAPIAddServerPrivateNetworkCall) Do(f func(*baremetal0.PrivateNetworkAPIAddServerPrivateNetworkRequest, ...scw.RequestOption) (*baremetal0.ServerPrivateNetwork, error)) *MockBaremetalPrivateNetworkAPIAddServerPrivateNetworkCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalPrivateNetworkAPIAddServerPrivateNetworkCall) DoAndReturn(f func(*baremetal0.PrivateNetworkAPIAddServerPrivateNetworkRequest, ...scw.RequestOption) (*baremetal0.ServerPrivateNetwork, error)) *MockBaremetalPrivateNetworkAPIAddServerPrivateNetworkCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListServerPrivateNetworks mocks base method.
+func (m *MockBaremetalPrivateNetworkAPI) ListServerPrivateNetworks(req *baremetal0.PrivateNetworkAPIListServerPrivateNetworksRequest, opts ...scw.RequestOption) (*baremetal0.ListServerPrivateNetworksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServerPrivateNetworks", varargs...)
+	ret0, _ := ret[0].(*baremetal0.ListServerPrivateNetworksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServerPrivateNetworks indicates an expected call of ListServerPrivateNetworks.
+func (mr *MockBaremetalPrivateNetworkAPIMockRecorder) ListServerPrivateNetworks(req any, opts ...any) *MockBaremetalPrivateNetworkAPIListServerPrivateNetworksCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServerPrivateNetworks", reflect.TypeOf((*MockBaremetalPrivateNetworkAPI)(nil).ListServerPrivateNetworks), varargs...)
+	return &MockBaremetalPrivateNetworkAPIListServerPrivateNetworksCall{Call: call}
+}
+
+// MockBaremetalPrivateNetworkAPIListServerPrivateNetworksCall wrap *gomock.Call
+type MockBaremetalPrivateNetworkAPIListServerPrivateNetworksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalPrivateNetworkAPIListServerPrivateNetworksCall) Return(arg0 *baremetal0.ListServerPrivateNetworksResponse, arg1 error) *MockBaremetalPrivateNetworkAPIListServerPrivateNetworksCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalPrivateNetworkAPIListServerPrivateNetworksCall) Do(f func(*baremetal0.PrivateNetworkAPIListServerPrivateNetworksRequest, ...scw.RequestOption) (*baremetal0.ListServerPrivateNetworksResponse, error)) *MockBaremetalPrivateNetworkAPIListServerPrivateNetworksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalPrivateNetworkAPIListServerPrivateNetworksCall) DoAndReturn(f func(*baremetal0.PrivateNetworkAPIListServerPrivateNetworksRequest, ...scw.RequestOption) (*baremetal0.ListServerPrivateNetworksResponse, error)) *MockBaremetalPrivateNetworkAPIListServerPrivateNetworksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockBaremetal is a mock of Baremetal interface.
+type MockBaremetal struct {
+	ctrl     *gomock.Controller
+	recorder *MockBaremetalMockRecorder
+	isgomock struct{}
+}
+
+// MockBaremetalMockRecorder is the mock recorder for MockBaremetal.
+type MockBaremetalMockRecorder struct {
+	mock *MockBaremetal
+}
+
+// NewMockBaremetal creates a new mock instance.
+func NewMockBaremetal(ctrl *gomock.Controller) *MockBaremetal {
+	mock := &MockBaremetal{ctrl: ctrl}
+	mock.recorder = &MockBaremetalMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBaremetal) EXPECT() *MockBaremetalMockRecorder {
+	return m.recorder
+}
+
+// AddBaremetalServerPrivateNetwork mocks base method.
+func (m *MockBaremetal) AddBaremetalServerPrivateNetwork(ctx context.Context, zone scw.Zone, serverID, privateNetworkID string) (*baremetal0.ServerPrivateNetwork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBaremetalServerPrivateNetwork", ctx, zone, serverID, privateNetworkID)
+	ret0, _ := ret[0].(*baremetal0.ServerPrivateNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBaremetalServerPrivateNetwork indicates an expected call of AddBaremetalServerPrivateNetwork.
+func (mr *MockBaremetalMockRecorder) AddBaremetalServerPrivateNetwork(ctx, zone, serverID, privateNetworkID any) *MockBaremetalAddBaremetalServerPrivateNetworkCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalServerPrivateNetwork", reflect.TypeOf((*MockBaremetal)(nil).AddBaremetalServerPrivateNetwork), ctx, zone, serverID, privateNetworkID)
+	return &MockBaremetalAddBaremetalServerPrivateNetworkCall{Call: call}
+}
+
+// MockBaremetalAddBaremetalServerPrivateNetworkCall wrap *gomock.Call
+type MockBaremetalAddBaremetalServerPrivateNetworkCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalAddBaremetalServerPrivateNetworkCall) Return(arg0 *baremetal0.ServerPrivateNetwork, arg1 error) *MockBaremetalAddBaremetalServerPrivateNetworkCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalAddBaremetalServerPrivateNetworkCall) Do(f func(context.Context, scw.Zone, string, string) (*baremetal0.ServerPrivateNetwork, error)) *MockBaremetalAddBaremetalServerPrivateNetworkCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalAddBaremetalServerPrivateNetworkCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) (*baremetal0.ServerPrivateNetwork, error)) *MockBaremetalAddBaremetalServerPrivateNetworkCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateBaremetalServer mocks base method.
+func (m *MockBaremetal) CreateBaremetalServer(ctx context.Context, zone scw.Zone, name, offerID string, optionIDs, tags []string) (*baremetal.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBaremetalServer", ctx, zone, name, offerID, optionIDs, tags)
+	ret0, _ := ret[0].(*baremetal.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBaremetalServer indicates an expected call of CreateBaremetalServer.
+func (mr *MockBaremetalMockRecorder) CreateBaremetalServer(ctx, zone, name, offerID, optionIDs, tags any) *MockBaremetalCreateBaremetalServerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBaremetalServer", reflect.TypeOf((*MockBaremetal)(nil).CreateBaremetalServer), ctx, zone, name, offerID, optionIDs, tags)
+	return &MockBaremetalCreateBaremetalServerCall{Call: call}
+}
+
+// MockBaremetalCreateBaremetalServerCall wrap *gomock.Call
+type MockBaremetalCreateBaremetalServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalCreateBaremetalServerCall) Return(arg0 *baremetal.Server, arg1 error) *MockBaremetalCreateBaremetalServerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalCreateBaremetalServerCall) Do(f func(context.Context, scw.Zone, string, string, []string, []string) (*baremetal.Server, error)) *MockBaremetalCreateBaremetalServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalCreateBaremetalServerCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, []string, []string) (*baremetal.Server, error)) *MockBaremetalCreateBaremetalServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteBaremetalServer mocks base method.
+func (m *MockBaremetal) DeleteBaremetalServer(ctx context.Context, zone scw.Zone, serverID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBaremetalServer", ctx, zone, serverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBaremetalServer indicates an expected call of DeleteBaremetalServer.
+func (mr *MockBaremetalMockRecorder) DeleteBaremetalServer(ctx, zone, serverID any) *MockBaremetalDeleteBaremetalServerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBaremetalServer", reflect.TypeOf((*MockBaremetal)(nil).DeleteBaremetalServer), ctx, zone, serverID)
+	return &MockBaremetalDeleteBaremetalServerCall{Call: call}
+}
+
+// MockBaremetalDeleteBaremetalServerCall wrap *gomock.Call
+type MockBaremetalDeleteBaremetalServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalDeleteBaremetalServerCall) Return(arg0 error) *MockBaremetalDeleteBaremetalServerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalDeleteBaremetalServerCall) Do(f func(context.Context, scw.Zone, string) error) *MockBaremetalDeleteBaremetalServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalDeleteBaremetalServerCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockBaremetalDeleteBaremetalServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindBaremetalOS mocks base method.
+func (m *MockBaremetal) FindBaremetalOS(ctx context.Context, zone scw.Zone, offerID, name, version string) (*baremetal.OS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBaremetalOS", ctx, zone, offerID, name, version)
+	ret0, _ := ret[0].(*baremetal.OS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBaremetalOS indicates an expected call of FindBaremetalOS.
+func (mr *MockBaremetalMockRecorder) FindBaremetalOS(ctx, zone, offerID, name, version any) *MockBaremetalFindBaremetalOSCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBaremetalOS", reflect.TypeOf((*MockBaremetal)(nil).FindBaremetalOS), ctx, zone, offerID, name, version)
+	return &MockBaremetalFindBaremetalOSCall{Call: call}
+}
+
+// MockBaremetalFindBaremetalOSCall wrap *gomock.Call
+type MockBaremetalFindBaremetalOSCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalFindBaremetalOSCall) Return(arg0 *baremetal.OS, arg1 error) *MockBaremetalFindBaremetalOSCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalFindBaremetalOSCall) Do(f func(context.Context, scw.Zone, string, string, string) (*baremetal.OS, error)) *MockBaremetalFindBaremetalOSCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalFindBaremetalOSCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, string) (*baremetal.OS, error)) *MockBaremetalFindBaremetalOSCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindBaremetalOffer mocks base method.
+func (m *MockBaremetal) FindBaremetalOffer(ctx context.Context, zone scw.Zone, name string) (*baremetal.Offer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBaremetalOffer", ctx, zone, name)
+	ret0, _ := ret[0].(*baremetal.Offer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBaremetalOffer indicates an expected call of FindBaremetalOffer.
+func (mr *MockBaremetalMockRecorder) FindBaremetalOffer(ctx, zone, name any) *MockBaremetalFindBaremetalOfferCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBaremetalOffer", reflect.TypeOf((*MockBaremetal)(nil).FindBaremetalOffer), ctx, zone, name)
+	return &MockBaremetalFindBaremetalOfferCall{Call: call}
+}
+
+// MockBaremetalFindBaremetalOfferCall wrap *gomock.Call
+type MockBaremetalFindBaremetalOfferCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalFindBaremetalOfferCall) Return(arg0 *baremetal.Offer, arg1 error) *MockBaremetalFindBaremetalOfferCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalFindBaremetalOfferCall) Do(f func(context.Context, scw.Zone, string) (*baremetal.Offer, error)) *MockBaremetalFindBaremetalOfferCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalFindBaremetalOfferCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*baremetal.Offer, error)) *MockBaremetalFindBaremetalOfferCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindBaremetalServer mocks base method.
+func (m *MockBaremetal) FindBaremetalServer(ctx context.Context, zone scw.Zone, tags []string) (*baremetal.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBaremetalServer", ctx, zone, tags)
+	ret0, _ := ret[0].(*baremetal.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBaremetalServer indicates an expected call of FindBaremetalServer.
+func (mr *MockBaremetalMockRecorder) FindBaremetalServer(ctx, zone, tags any) *MockBaremetalFindBaremetalServerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBaremetalServer", reflect.TypeOf((*MockBaremetal)(nil).FindBaremetalServer), ctx, zone, tags)
+	return &MockBaremetalFindBaremetalServerCall{Call: call}
+}
+
+// MockBaremetalFindBaremetalServerCall wrap *gomock.Call
+type MockBaremetalFindBaremetalServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalFindBaremetalServerCall) Return(arg0 *baremetal.Server, arg1 error) *MockBaremetalFindBaremetalServerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalFindBaremetalServerCall) Do(f func(context.Context, scw.Zone, []string) (*baremetal.Server, error)) *MockBaremetalFindBaremetalServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalFindBaremetalServerCall) DoAndReturn(f func(context.Context, scw.Zone, []string) (*baremetal.Server, error)) *MockBaremetalFindBaremetalServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindBaremetalServerPrivateNetwork mocks base method.
+func (m *MockBaremetal) FindBaremetalServerPrivateNetwork(ctx context.Context, zone scw.Zone, serverID, privateNetworkID string) (*baremetal0.ServerPrivateNetwork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBaremetalServerPrivateNetwork", ctx, zone, serverID, privateNetworkID)
+	ret0, _ := ret[0].(*baremetal0.ServerPrivateNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBaremetalServerPrivateNetwork indicates an expected call of FindBaremetalServerPrivateNetwork.
+func (mr *MockBaremetalMockRecorder) FindBaremetalServerPrivateNetwork(ctx, zone, serverID, privateNetworkID any) *MockBaremetalFindBaremetalServerPrivateNetworkCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBaremetalServerPrivateNetwork", reflect.TypeOf((*MockBaremetal)(nil).FindBaremetalServerPrivateNetwork), ctx, zone, serverID, privateNetworkID)
+	return &MockBaremetalFindBaremetalServerPrivateNetworkCall{Call: call}
+}
+
+// MockBaremetalFindBaremetalServerPrivateNetworkCall wrap *gomock.Call
+type MockBaremetalFindBaremetalServerPrivateNetworkCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalFindBaremetalServerPrivateNetworkCall) Return(arg0 *baremetal0.ServerPrivateNetwork, arg1 error) *MockBaremetalFindBaremetalServerPrivateNetworkCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalFindBaremetalServerPrivateNetworkCall) Do(f func(context.Context, scw.Zone, string, string) (*baremetal0.ServerPrivateNetwork, error)) *MockBaremetalFindBaremetalServerPrivateNetworkCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalFindBaremetalServerPrivateNetworkCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) (*baremetal0.ServerPrivateNetwork, error)) *MockBaremetalFindBaremetalServerPrivateNetworkCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetBaremetalOS mocks base method.
+func (m *MockBaremetal) GetBaremetalOS(ctx context.Context, zone scw.Zone, osID string) (*baremetal.OS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaremetalOS", ctx, zone, osID)
+	ret0, _ := ret[0].(*baremetal.OS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaremetalOS indicates an expected call of GetBaremetalOS.
+func (mr *MockBaremetalMockRecorder) GetBaremetalOS(ctx, zone, osID any) *MockBaremetalGetBaremetalOSCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaremetalOS", reflect.TypeOf((*MockBaremetal)(nil).GetBaremetalOS), ctx, zone, osID)
+	return &MockBaremetalGetBaremetalOSCall{Call: call}
+}
+
+// MockBaremetalGetBaremetalOSCall wrap *gomock.Call
+type MockBaremetalGetBaremetalOSCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalGetBaremetalOSCall) Return(arg0 *baremetal.OS, arg1 error) *MockBaremetalGetBaremetalOSCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalGetBaremetalOSCall) Do(f func(context.Context, scw.Zone, string) (*baremetal.OS, error)) *MockBaremetalGetBaremetalOSCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalGetBaremetalOSCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*baremetal.OS, error)) *MockBaremetalGetBaremetalOSCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// InstallBaremetalServer mocks base method.
+func (m *MockBaremetal) InstallBaremetalServer(ctx context.Context, zone scw.Zone, serverID, osID, hostname string, sshKeyIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallBaremetalServer", ctx, zone, serverID, osID, hostname, sshKeyIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallBaremetalServer indicates an expected call of InstallBaremetalServer.
+func (mr *MockBaremetalMockRecorder) InstallBaremetalServer(ctx, zone, serverID, osID, hostname, sshKeyIDs any) *MockBaremetalInstallBaremetalServerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallBaremetalServer", reflect.TypeOf((*MockBaremetal)(nil).InstallBaremetalServer), ctx, zone, serverID, osID, hostname, sshKeyIDs)
+	return &MockBaremetalInstallBaremetalServerCall{Call: call}
+}
+
+// MockBaremetalInstallBaremetalServerCall wrap *gomock.Call
+type MockBaremetalInstallBaremetalServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalInstallBaremetalServerCall) Return(arg0 error) *MockBaremetalInstallBaremetalServerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalInstallBaremetalServerCall) Do(f func(context.Context, scw.Zone, string, string, string, []string) error) *MockBaremetalInstallBaremetalServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalInstallBaremetalServerCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, string, []string) error) *MockBaremetalInstallBaremetalServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateBaremetalServerUserData mocks base method.
+func (m *MockBaremetal) UpdateBaremetalServerUserData(ctx context.Context, zone scw.Zone, serverID string, userData []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBaremetalServerUserData", ctx, zone, serverID, userData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBaremetalServerUserData indicates an expected call of UpdateBaremetalServerUserData.
+func (mr *MockBaremetalMockRecorder) UpdateBaremetalServerUserData(ctx, zone, serverID, userData any) *MockBaremetalUpdateBaremetalServerUserDataCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBaremetalServerUserData", reflect.TypeOf((*MockBaremetal)(nil).UpdateBaremetalServerUserData), ctx, zone, serverID, userData)
+	return &MockBaremetalUpdateBaremetalServerUserDataCall{Call: call}
+}
+
+// MockBaremetalUpdateBaremetalServerUserDataCall wrap *gomock.Call
+type MockBaremetalUpdateBaremetalServerUserDataCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBaremetalUpdateBaremetalServerUserDataCall) Return(arg0 error) *MockBaremetalUpdateBaremetalServerUserDataCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBaremetalUpdateBaremetalServerUserDataCall) Do(f func(context.Context, scw.Zone, string, []byte) error) *MockBaremetalUpdateBaremetalServerUserDataCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBaremetalUpdateBaremetalServerUserDataCall) DoAndReturn(f func(context.Context, scw.Zone, string, []byte) error) *MockBaremetalUpdateBaremetalServerUserDataCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -42,6 +42,50 @@ func (m *MockBlockAPI) EXPECT() *MockBlockAPIMockRecorder {
 	return m.recorder
 }
 
+// CreateSnapshot mocks base method.
+func (m *MockBlockAPI) CreateSnapshot(req *block.CreateSnapshotRequest, opts ...scw.RequestOption) (*block.Snapshot, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSnapshot", varargs...)
+	ret0, _ := ret[0].(*block.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockBlockAPIMockRecorder) CreateSnapshot(req any, opts ...any) *MockBlockAPICreateSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockBlockAPI)(nil).CreateSnapshot), varargs...)
+	return &MockBlockAPICreateSnapshotCall{Call: call}
+}
+
+// MockBlockAPICreateSnapshotCall wrap *gomock.Call
+type MockBlockAPICreateSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlockAPICreateSnapshotCall) Return(arg0 *block.Snapshot, arg1 error) *MockBlockAPICreateSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlockAPICreateSnapshotCall) Do(f func(*block.CreateSnapshotRequest, ...scw.RequestOption) (*block.Snapshot, error)) *MockBlockAPICreateSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlockAPICreateSnapshotCall) DoAndReturn(f func(*block.CreateSnapshotRequest, ...scw.RequestOption) (*block.Snapshot, error)) *MockBlockAPICreateSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateVolume mocks base method.
 func (m *MockBlockAPI) CreateVolume(req *block.CreateVolumeRequest, opts ...scw.RequestOption) (*block.Volume, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +173,94 @@ func (c *MockBlockAPIDeleteVolumeCall) DoAndReturn(f func(*block.DeleteVolumeReq
 	return c
 }
 
+// GetVolume mocks base method.
+func (m *MockBlockAPI) GetVolume(req *block.GetVolumeRequest, opts ...scw.RequestOption) (*block.Volume, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolume", varargs...)
+	ret0, _ := ret[0].(*block.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockBlockAPIMockRecorder) GetVolume(req any, opts ...any) *MockBlockAPIGetVolumeCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockBlockAPI)(nil).GetVolume), varargs...)
+	return &MockBlockAPIGetVolumeCall{Call: call}
+}
+
+// MockBlockAPIGetVolumeCall wrap *gomock.Call
+type MockBlockAPIGetVolumeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlockAPIGetVolumeCall) Return(arg0 *block.Volume, arg1 error) *MockBlockAPIGetVolumeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlockAPIGetVolumeCall) Do(f func(*block.GetVolumeRequest, ...scw.RequestOption) (*block.Volume, error)) *MockBlockAPIGetVolumeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlockAPIGetVolumeCall) DoAndReturn(f func(*block.GetVolumeRequest, ...scw.RequestOption) (*block.Volume, error)) *MockBlockAPIGetVolumeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSnapshots mocks base method.
+func (m *MockBlockAPI) ListSnapshots(req *block.ListSnapshotsRequest, opts ...scw.RequestOption) (*block.ListSnapshotsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSnapshots", varargs...)
+	ret0, _ := ret[0].(*block.ListSnapshotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockBlockAPIMockRecorder) ListSnapshots(req any, opts ...any) *MockBlockAPIListSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockBlockAPI)(nil).ListSnapshots), varargs...)
+	return &MockBlockAPIListSnapshotsCall{Call: call}
+}
+
+// MockBlockAPIListSnapshotsCall wrap *gomock.Call
+type MockBlockAPIListSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlockAPIListSnapshotsCall) Return(arg0 *block.ListSnapshotsResponse, arg1 error) *MockBlockAPIListSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlockAPIListSnapshotsCall) Do(f func(*block.ListSnapshotsRequest, ...scw.RequestOption) (*block.ListSnapshotsResponse, error)) *MockBlockAPIListSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlockAPIListSnapshotsCall) DoAndReturn(f func(*block.ListSnapshotsRequest, ...scw.RequestOption) (*block.ListSnapshotsResponse, error)) *MockBlockAPIListSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListVolumes mocks base method.
 func (m *MockBlockAPI) ListVolumes(req *block.ListVolumesRequest, opts ...scw.RequestOption) (*block.ListVolumesResponse, error) {
 	m.ctrl.T.Helper()
@@ -279,6 +411,45 @@ func (m *MockBlock) EXPECT() *MockBlockMockRecorder {
 	return m.recorder
 }
 
+// CreateSnapshot mocks base method.
+func (m *MockBlock) CreateSnapshot(ctx context.Context, zone scw.Zone, volumeID, name string, tags []string) (*block.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", ctx, zone, volumeID, name, tags)
+	ret0, _ := ret[0].(*block.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockBlockMockRecorder) CreateSnapshot(ctx, zone, volumeID, name, tags any) *MockBlockCreateSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockBlock)(nil).CreateSnapshot), ctx, zone, volumeID, name, tags)
+	return &MockBlockCreateSnapshotCall{Call: call}
+}
+
+// MockBlockCreateSnapshotCall wrap *gomock.Call
+type MockBlockCreateSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlockCreateSnapshotCall) Return(arg0 *block.Snapshot, arg1 error) *MockBlockCreateSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlockCreateSnapshotCall) Do(f func(context.Context, scw.Zone, string, string, []string) (*block.Snapshot, error)) *MockBlockCreateSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlockCreateSnapshotCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, []string) (*block.Snapshot, error)) *MockBlockCreateSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateVolume mocks base method.
 func (m *MockBlock) CreateVolume(ctx context.Context, zone scw.Zone, name string, size scw.Size, iops int64, tags []string) (*block.Volume, error) {
 	m.ctrl.T.Helper()
@@ -318,6 +489,45 @@ func (c *MockBlockCreateVolumeCall) DoAndReturn(f func(context.Context, scw.Zone
 	return c
 }
 
+// CreateVolumeFromSnapshot mocks base method.
+func (m *MockBlock) CreateVolumeFromSnapshot(ctx context.Context, zone scw.Zone, name, snapshotID string, size scw.Size, iops int64, tags []string) (*block.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeFromSnapshot", ctx, zone, name, snapshotID, size, iops, tags)
+	ret0, _ := ret[0].(*block.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeFromSnapshot indicates an expected call of CreateVolumeFromSnapshot.
+func (mr *MockBlockMockRecorder) CreateVolumeFromSnapshot(ctx, zone, name, snapshotID, size, iops, tags any) *MockBlockCreateVolumeFromSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeFromSnapshot", reflect.TypeOf((*MockBlock)(nil).CreateVolumeFromSnapshot), ctx, zone, name, snapshotID, size, iops, tags)
+	return &MockBlockCreateVolumeFromSnapshotCall{Call: call}
+}
+
+// MockBlockCreateVolumeFromSnapshotCall wrap *gomock.Call
+type MockBlockCreateVolumeFromSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlockCreateVolumeFromSnapshotCall) Return(arg0 *block.Volume, arg1 error) *MockBlockCreateVolumeFromSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlockCreateVolumeFromSnapshotCall) Do(f func(context.Context, scw.Zone, string, string, scw.Size, int64, []string) (*block.Volume, error)) *MockBlockCreateVolumeFromSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlockCreateVolumeFromSnapshotCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, scw.Size, int64, []string) (*block.Volume, error)) *MockBlockCreateVolumeFromSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteVolume mocks base method.
 func (m *MockBlock) DeleteVolume(ctx context.Context, zone scw.Zone, volumeID string) error {
 	m.ctrl.T.Helper()
@@ -356,6 +566,84 @@ func (c *MockBlockDeleteVolumeCall) DoAndReturn(f func(context.Context, scw.Zone
 	return c
 }
 
+// FindSnapshotByName mocks base method.
+func (m *MockBlock) FindSnapshotByName(ctx context.Context, zone scw.Zone, name string) (*block.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSnapshotByName", ctx, zone, name)
+	ret0, _ := ret[0].(*block.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSnapshotByName indicates an expected call of FindSnapshotByName.
+func (mr *MockBlockMockRecorder) FindSnapshotByName(ctx, zone, name any) *MockBlockFindSnapshotByNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSnapshotByName", reflect.TypeOf((*MockBlock)(nil).FindSnapshotByName), ctx, zone, name)
+	return &MockBlockFindSnapshotByNameCall{Call: call}
+}
+
+// MockBlockFindSnapshotByNameCall wrap *gomock.Call
+type MockBlockFindSnapshotByNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlockFindSnapshotByNameCall) Return(arg0 *block.Snapshot, arg1 error) *MockBlockFindSnapshotByNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlockFindSnapshotByNameCall) Do(f func(context.Context, scw.Zone, string) (*block.Snapshot, error)) *MockBlockFindSnapshotByNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlockFindSnapshotByNameCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*block.Snapshot, error)) *MockBlockFindSnapshotByNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindSnapshots mocks base method.
+func (m *MockBlock) FindSnapshots(ctx context.Context, zone scw.Zone, tags []string) ([]*block.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSnapshots", ctx, zone, tags)
+	ret0, _ := ret[0].([]*block.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSnapshots indicates an expected call of FindSnapshots.
+func (mr *MockBlockMockRecorder) FindSnapshots(ctx, zone, tags any) *MockBlockFindSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSnapshots", reflect.TypeOf((*MockBlock)(nil).FindSnapshots), ctx, zone, tags)
+	return &MockBlockFindSnapshotsCall{Call: call}
+}
+
+// MockBlockFindSnapshotsCall wrap *gomock.Call
+type MockBlockFindSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlockFindSnapshotsCall) Return(arg0 []*block.Snapshot, arg1 error) *MockBlockFindSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlockFindSnapshotsCall) Do(f func(context.Context, scw.Zone, []string) ([]*block.Snapshot, error)) *MockBlockFindSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlockFindSnapshotsCall) DoAndReturn(f func(context.Context, scw.Zone, []string) ([]*block.Snapshot, error)) *MockBlockFindSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindVolumes mocks base method.
 func (m *MockBlock) FindVolumes(ctx context.Context, zone scw.Zone, tags []string) ([]*block.Volume, error) {
 	m.ctrl.T.Helper()
@@ -395,6 +683,45 @@ func (c *MockBlockFindVolumesCall) DoAndReturn(f func(context.Context, scw.Zone,
 	return c
 }
 
+// GetVolume mocks base method.
+func (m *MockBlock) GetVolume(ctx context.Context, zone scw.Zone, volumeID string) (*block.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolume", ctx, zone, volumeID)
+	ret0, _ := ret[0].(*block.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockBlockMockRecorder) GetVolume(ctx, zone, volumeID any) *MockBlockGetVolumeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockBlock)(nil).GetVolume), ctx, zone, volumeID)
+	return &MockBlockGetVolumeCall{Call: call}
+}
+
+// MockBlockGetVolumeCall wrap *gomock.Call
+type MockBlockGetVolumeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlockGetVolumeCall) Return(arg0 *block.Volume, arg1 error) *MockBlockGetVolumeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlockGetVolumeCall) Do(f func(context.Context, scw.Zone, string) (*block.Volume, error)) *MockBlockGetVolumeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlockGetVolumeCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*block.Volume, error)) *MockBlockGetVolumeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateVolumeIOPS mocks base method.
 func (m *MockBlock) UpdateVolumeIOPS(ctx context.Context, zone scw.Zone, volumeID string, iops int64) error {
 	m.ctrl.T.Helper()
@@ -433,6 +760,44 @@ func (c *MockBlockUpdateVolumeIOPSCall) DoAndReturn(f func(context.Context, scw.
 	return c
 }
 
+// UpdateVolumeSize mocks base method.
+func (m *MockBlock) UpdateVolumeSize(ctx context.Context, zone scw.Zone, volumeID string, size scw.Size) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolumeSize", ctx, zone, volumeID, size)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVolumeSize indicates an expected call of UpdateVolumeSize.
+func (mr *MockBlockMockRecorder) UpdateVolumeSize(ctx, zone, volumeID, size any) *MockBlockUpdateVolumeSizeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolumeSize", reflect.TypeOf((*MockBlock)(nil).UpdateVolumeSize), ctx, zone, volumeID, size)
+	return &MockBlockUpdateVolumeSizeCall{Call: call}
+}
+
+// MockBlockUpdateVolumeSizeCall wrap *gomock.Call
+type MockBlockUpdateVolumeSizeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlockUpdateVolumeSizeCall) Return(arg0 error) *MockBlockUpdateVolumeSizeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlockUpdateVolumeSizeCall) Do(f func(context.Context, scw.Zone, string, scw.Size) error) *MockBlockUpdateVolumeSizeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlockUpdateVolumeSizeCall) DoAndReturn(f func(context.Context, scw.Zone, string, scw.Size) error) *MockBlockUpdateVolumeSizeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateVolumeTags mocks base method.
 func (m *MockBlock) UpdateVolumeTags(ctx context.Context, zone scw.Zone, volumeID string, tags []string) error {
 	m.ctrl.T.Helper()
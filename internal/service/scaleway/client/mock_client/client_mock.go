@@ -12,8 +12,11 @@ package mock_client
 import (
 	context "context"
 	io "io"
+	net "net"
 	reflect "reflect"
 
+	baremetal "github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	baremetal0 "github.com/scaleway/scaleway-sdk-go/api/baremetal/v3"
 	block "github.com/scaleway/scaleway-sdk-go/api/block/v1"
 	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
 	instance "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
@@ -89,6 +92,45 @@ func (c *MockInterfaceAddBackendServerCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// AddBaremetalServerPrivateNetwork mocks base method.
+func (m *MockInterface) AddBaremetalServerPrivateNetwork(ctx context.Context, zone scw.Zone, serverID, privateNetworkID string) (*baremetal0.ServerPrivateNetwork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBaremetalServerPrivateNetwork", ctx, zone, serverID, privateNetworkID)
+	ret0, _ := ret[0].(*baremetal0.ServerPrivateNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBaremetalServerPrivateNetwork indicates an expected call of AddBaremetalServerPrivateNetwork.
+func (mr *MockInterfaceMockRecorder) AddBaremetalServerPrivateNetwork(ctx, zone, serverID, privateNetworkID any) *MockInterfaceAddBaremetalServerPrivateNetworkCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalServerPrivateNetwork", reflect.TypeOf((*MockInterface)(nil).AddBaremetalServerPrivateNetwork), ctx, zone, serverID, privateNetworkID)
+	return &MockInterfaceAddBaremetalServerPrivateNetworkCall{Call: call}
+}
+
+// MockInterfaceAddBaremetalServerPrivateNetworkCall wrap *gomock.Call
+type MockInterfaceAddBaremetalServerPrivateNetworkCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceAddBaremetalServerPrivateNetworkCall) Return(arg0 *baremetal0.ServerPrivateNetwork, arg1 error) *MockInterfaceAddBaremetalServerPrivateNetworkCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceAddBaremetalServerPrivateNetworkCall) Do(f func(context.Context, scw.Zone, string, string) (*baremetal0.ServerPrivateNetwork, error)) *MockInterfaceAddBaremetalServerPrivateNetworkCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceAddBaremetalServerPrivateNetworkCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) (*baremetal0.ServerPrivateNetwork, error)) *MockInterfaceAddBaremetalServerPrivateNetworkCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AttachLBPrivateNetwork mocks base method.
 func (m *MockInterface) AttachLBPrivateNetwork(ctx context.Context, zone scw.Zone, lbID, privateNetworkID string, ipID *string) error {
 	m.ctrl.T.Helper()
@@ -165,6 +207,45 @@ func (c *MockInterfaceAttachServerVolumeCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// BookPrivateNetworkIP mocks base method.
+func (m *MockInterface) BookPrivateNetworkIP(ctx context.Context, privateNetworkID string, address net.IP, tags []string) (*ipam.IP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BookPrivateNetworkIP", ctx, privateNetworkID, address, tags)
+	ret0, _ := ret[0].(*ipam.IP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BookPrivateNetworkIP indicates an expected call of BookPrivateNetworkIP.
+func (mr *MockInterfaceMockRecorder) BookPrivateNetworkIP(ctx, privateNetworkID, address, tags any) *MockInterfaceBookPrivateNetworkIPCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BookPrivateNetworkIP", reflect.TypeOf((*MockInterface)(nil).BookPrivateNetworkIP), ctx, privateNetworkID, address, tags)
+	return &MockInterfaceBookPrivateNetworkIPCall{Call: call}
+}
+
+// MockInterfaceBookPrivateNetworkIPCall wrap *gomock.Call
+type MockInterfaceBookPrivateNetworkIPCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceBookPrivateNetworkIPCall) Return(arg0 *ipam.IP, arg1 error) *MockInterfaceBookPrivateNetworkIPCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceBookPrivateNetworkIPCall) Do(f func(context.Context, string, net.IP, []string) (*ipam.IP, error)) *MockInterfaceBookPrivateNetworkIPCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceBookPrivateNetworkIPCall) DoAndReturn(f func(context.Context, string, net.IP, []string) (*ipam.IP, error)) *MockInterfaceBookPrivateNetworkIPCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CleanAvailableIPs mocks base method.
 func (m *MockInterface) CleanAvailableIPs(ctx context.Context, privateNetworkID string) error {
 	m.ctrl.T.Helper()
@@ -204,18 +285,18 @@ func (c *MockInterfaceCleanAvailableIPsCall) DoAndReturn(f func(context.Context,
 }
 
 // CreateBackend mocks base method.
-func (m *MockInterface) CreateBackend(ctx context.Context, zone scw.Zone, lbID, name string, servers []string, port int32) (*lb.Backend, error) {
+func (m *MockInterface) CreateBackend(ctx context.Context, zone scw.Zone, lbID string, backend *lb.Backend) (*lb.Backend, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBackend", ctx, zone, lbID, name, servers, port)
+	ret := m.ctrl.Call(m, "CreateBackend", ctx, zone, lbID, backend)
 	ret0, _ := ret[0].(*lb.Backend)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateBackend indicates an expected call of CreateBackend.
-func (mr *MockInterfaceMockRecorder) CreateBackend(ctx, zone, lbID, name, servers, port any) *MockInterfaceCreateBackendCall {
+func (mr *MockInterfaceMockRecorder) CreateBackend(ctx, zone, lbID, backend any) *MockInterfaceCreateBackendCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackend", reflect.TypeOf((*MockInterface)(nil).CreateBackend), ctx, zone, lbID, name, servers, port)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackend", reflect.TypeOf((*MockInterface)(nil).CreateBackend), ctx, zone, lbID, backend)
 	return &MockInterfaceCreateBackendCall{Call: call}
 }
 
@@ -231,13 +312,52 @@ func (c *MockInterfaceCreateBackendCall) Return(arg0 *lb.Backend, arg1 error) *M
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInterfaceCreateBackendCall) Do(f func(context.Context, scw.Zone, string, string, []string, int32) (*lb.Backend, error)) *MockInterfaceCreateBackendCall {
+func (c *MockInterfaceCreateBackendCall) Do(f func(context.Context, scw.Zone, string, *lb.Backend) (*lb.Backend, error)) *MockInterfaceCreateBackendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceCreateBackendCall) DoAndReturn(f func(context.Context, scw.Zone, string, *lb.Backend) (*lb.Backend, error)) *MockInterfaceCreateBackendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateBaremetalServer mocks base method.
+func (m *MockInterface) CreateBaremetalServer(ctx context.Context, zone scw.Zone, name, offerID string, optionIDs, tags []string) (*baremetal.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBaremetalServer", ctx, zone, name, offerID, optionIDs, tags)
+	ret0, _ := ret[0].(*baremetal.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBaremetalServer indicates an expected call of CreateBaremetalServer.
+func (mr *MockInterfaceMockRecorder) CreateBaremetalServer(ctx, zone, name, offerID, optionIDs, tags any) *MockInterfaceCreateBaremetalServerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBaremetalServer", reflect.TypeOf((*MockInterface)(nil).CreateBaremetalServer), ctx, zone, name, offerID, optionIDs, tags)
+	return &MockInterfaceCreateBaremetalServerCall{Call: call}
+}
+
+// MockInterfaceCreateBaremetalServerCall wrap *gomock.Call
+type MockInterfaceCreateBaremetalServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceCreateBaremetalServerCall) Return(arg0 *baremetal.Server, arg1 error) *MockInterfaceCreateBaremetalServerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceCreateBaremetalServerCall) Do(f func(context.Context, scw.Zone, string, string, []string, []string) (*baremetal.Server, error)) *MockInterfaceCreateBaremetalServerCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInterfaceCreateBackendCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, []string, int32) (*lb.Backend, error)) *MockInterfaceCreateBackendCall {
+func (c *MockInterfaceCreateBaremetalServerCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, []string, []string) (*baremetal.Server, error)) *MockInterfaceCreateBaremetalServerCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -552,6 +672,45 @@ func (c *MockInterfaceCreateLBACLCall) DoAndReturn(f func(context.Context, scw.Z
 	return c
 }
 
+// CreatePlacementGroup mocks base method.
+func (m *MockInterface) CreatePlacementGroup(ctx context.Context, zone scw.Zone, name string, policyType instance.PlacementGroupPolicyType, policyMode instance.PlacementGroupPolicyMode, tags []string) (*instance.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlacementGroup", ctx, zone, name, policyType, policyMode, tags)
+	ret0, _ := ret[0].(*instance.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlacementGroup indicates an expected call of CreatePlacementGroup.
+func (mr *MockInterfaceMockRecorder) CreatePlacementGroup(ctx, zone, name, policyType, policyMode, tags any) *MockInterfaceCreatePlacementGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlacementGroup", reflect.TypeOf((*MockInterface)(nil).CreatePlacementGroup), ctx, zone, name, policyType, policyMode, tags)
+	return &MockInterfaceCreatePlacementGroupCall{Call: call}
+}
+
+// MockInterfaceCreatePlacementGroupCall wrap *gomock.Call
+type MockInterfaceCreatePlacementGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceCreatePlacementGroupCall) Return(arg0 *instance.PlacementGroup, arg1 error) *MockInterfaceCreatePlacementGroupCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceCreatePlacementGroupCall) Do(f func(context.Context, scw.Zone, string, instance.PlacementGroupPolicyType, instance.PlacementGroupPolicyMode, []string) (*instance.PlacementGroup, error)) *MockInterfaceCreatePlacementGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceCreatePlacementGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string, instance.PlacementGroupPolicyType, instance.PlacementGroupPolicyMode, []string) (*instance.PlacementGroup, error)) *MockInterfaceCreatePlacementGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreatePool mocks base method.
 func (m *MockInterface) CreatePool(ctx context.Context, zone scw.Zone, clusterID, name, nodeType string, placementGroupID, securityGroupID *string, autoscaling, autohealing, publicIPDisabled bool, size uint32, minSize, maxSize *uint32, tags []string, kubeletArgs map[string]string, rootVolumeType k8s.PoolVolumeType, rootVolumeSizeGB *uint64, upgradePolicy *k8s.CreatePoolRequestUpgradePolicy) (*k8s.Pool, error) {
 	m.ctrl.T.Helper()
@@ -592,18 +751,18 @@ func (c *MockInterfaceCreatePoolCall) DoAndReturn(f func(context.Context, scw.Zo
 }
 
 // CreatePrivateNIC mocks base method.
-func (m *MockInterface) CreatePrivateNIC(ctx context.Context, zone scw.Zone, serverID, privateNetworkID string) (*instance.PrivateNIC, error) {
+func (m *MockInterface) CreatePrivateNIC(ctx context.Context, zone scw.Zone, serverID, privateNetworkID string, ipamIPIDs []string) (*instance.PrivateNIC, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePrivateNIC", ctx, zone, serverID, privateNetworkID)
+	ret := m.ctrl.Call(m, "CreatePrivateNIC", ctx, zone, serverID, privateNetworkID, ipamIPIDs)
 	ret0, _ := ret[0].(*instance.PrivateNIC)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreatePrivateNIC indicates an expected call of CreatePrivateNIC.
-func (mr *MockInterfaceMockRecorder) CreatePrivateNIC(ctx, zone, serverID, privateNetworkID any) *MockInterfaceCreatePrivateNICCall {
+func (mr *MockInterfaceMockRecorder) CreatePrivateNIC(ctx, zone, serverID, privateNetworkID, ipamIPIDs any) *MockInterfaceCreatePrivateNICCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateNIC", reflect.TypeOf((*MockInterface)(nil).CreatePrivateNIC), ctx, zone, serverID, privateNetworkID)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateNIC", reflect.TypeOf((*MockInterface)(nil).CreatePrivateNIC), ctx, zone, serverID, privateNetworkID, ipamIPIDs)
 	return &MockInterfaceCreatePrivateNICCall{Call: call}
 }
 
@@ -619,13 +778,13 @@ func (c *MockInterfaceCreatePrivateNICCall) Return(arg0 *instance.PrivateNIC, ar
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInterfaceCreatePrivateNICCall) Do(f func(context.Context, scw.Zone, string, string) (*instance.PrivateNIC, error)) *MockInterfaceCreatePrivateNICCall {
+func (c *MockInterfaceCreatePrivateNICCall) Do(f func(context.Context, scw.Zone, string, string, []string) (*instance.PrivateNIC, error)) *MockInterfaceCreatePrivateNICCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInterfaceCreatePrivateNICCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) (*instance.PrivateNIC, error)) *MockInterfaceCreatePrivateNICCall {
+func (c *MockInterfaceCreatePrivateNICCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, []string) (*instance.PrivateNIC, error)) *MockInterfaceCreatePrivateNICCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -669,19 +828,58 @@ func (c *MockInterfaceCreatePrivateNetworkCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// CreateSecurityGroup mocks base method.
+func (m *MockInterface) CreateSecurityGroup(ctx context.Context, zone scw.Zone, name string, tags []string) (*instance.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecurityGroup", ctx, zone, name, tags)
+	ret0, _ := ret[0].(*instance.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecurityGroup indicates an expected call of CreateSecurityGroup.
+func (mr *MockInterfaceMockRecorder) CreateSecurityGroup(ctx, zone, name, tags any) *MockInterfaceCreateSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroup", reflect.TypeOf((*MockInterface)(nil).CreateSecurityGroup), ctx, zone, name, tags)
+	return &MockInterfaceCreateSecurityGroupCall{Call: call}
+}
+
+// MockInterfaceCreateSecurityGroupCall wrap *gomock.Call
+type MockInterfaceCreateSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceCreateSecurityGroupCall) Return(arg0 *instance.SecurityGroup, arg1 error) *MockInterfaceCreateSecurityGroupCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceCreateSecurityGroupCall) Do(f func(context.Context, scw.Zone, string, []string) (*instance.SecurityGroup, error)) *MockInterfaceCreateSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceCreateSecurityGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string, []string) (*instance.SecurityGroup, error)) *MockInterfaceCreateSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateServer mocks base method.
-func (m *MockInterface) CreateServer(ctx context.Context, zone scw.Zone, name, commercialType, imageID string, placementGroupID, securityGroupID *string, rootVolumeSize scw.Size, rootVolumeType instance.VolumeVolumeType, scratchVolumeSizes []scw.Size, tags []string) (*instance.Server, error) {
+func (m *MockInterface) CreateServer(ctx context.Context, zone scw.Zone, name, commercialType, imageID string, placementGroupID, securityGroupID *string, rootVolumeSize scw.Size, rootVolumeType instance.VolumeVolumeType, scratchVolumeSizes []scw.Size, protected, natIPs bool, tags []string) (*instance.Server, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateServer", ctx, zone, name, commercialType, imageID, placementGroupID, securityGroupID, rootVolumeSize, rootVolumeType, scratchVolumeSizes, tags)
+	ret := m.ctrl.Call(m, "CreateServer", ctx, zone, name, commercialType, imageID, placementGroupID, securityGroupID, rootVolumeSize, rootVolumeType, scratchVolumeSizes, protected, natIPs, tags)
 	ret0, _ := ret[0].(*instance.Server)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateServer indicates an expected call of CreateServer.
-func (mr *MockInterfaceMockRecorder) CreateServer(ctx, zone, name, commercialType, imageID, placementGroupID, securityGroupID, rootVolumeSize, rootVolumeType, scratchVolumeSizes, tags any) *MockInterfaceCreateServerCall {
+func (mr *MockInterfaceMockRecorder) CreateServer(ctx, zone, name, commercialType, imageID, placementGroupID, securityGroupID, rootVolumeSize, rootVolumeType, scratchVolumeSizes, protected, natIPs, tags any) *MockInterfaceCreateServerCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServer", reflect.TypeOf((*MockInterface)(nil).CreateServer), ctx, zone, name, commercialType, imageID, placementGroupID, securityGroupID, rootVolumeSize, rootVolumeType, scratchVolumeSizes, tags)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServer", reflect.TypeOf((*MockInterface)(nil).CreateServer), ctx, zone, name, commercialType, imageID, placementGroupID, securityGroupID, rootVolumeSize, rootVolumeType, scratchVolumeSizes, protected, natIPs, tags)
 	return &MockInterfaceCreateServerCall{Call: call}
 }
 
@@ -697,13 +895,52 @@ func (c *MockInterfaceCreateServerCall) Return(arg0 *instance.Server, arg1 error
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInterfaceCreateServerCall) Do(f func(context.Context, scw.Zone, string, string, string, *string, *string, scw.Size, instance.VolumeVolumeType, []scw.Size, []string) (*instance.Server, error)) *MockInterfaceCreateServerCall {
+func (c *MockInterfaceCreateServerCall) Do(f func(context.Context, scw.Zone, string, string, string, *string, *string, scw.Size, instance.VolumeVolumeType, []scw.Size, bool, bool, []string) (*instance.Server, error)) *MockInterfaceCreateServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceCreateServerCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, string, *string, *string, scw.Size, instance.VolumeVolumeType, []scw.Size, bool, bool, []string) (*instance.Server, error)) *MockInterfaceCreateServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockInterface) CreateSnapshot(ctx context.Context, zone scw.Zone, volumeID, name string, tags []string) (*block.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", ctx, zone, volumeID, name, tags)
+	ret0, _ := ret[0].(*block.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockInterfaceMockRecorder) CreateSnapshot(ctx, zone, volumeID, name, tags any) *MockInterfaceCreateSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockInterface)(nil).CreateSnapshot), ctx, zone, volumeID, name, tags)
+	return &MockInterfaceCreateSnapshotCall{Call: call}
+}
+
+// MockInterfaceCreateSnapshotCall wrap *gomock.Call
+type MockInterfaceCreateSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceCreateSnapshotCall) Return(arg0 *block.Snapshot, arg1 error) *MockInterfaceCreateSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceCreateSnapshotCall) Do(f func(context.Context, scw.Zone, string, string, []string) (*block.Snapshot, error)) *MockInterfaceCreateSnapshotCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInterfaceCreateServerCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, string, *string, *string, scw.Size, instance.VolumeVolumeType, []scw.Size, []string) (*instance.Server, error)) *MockInterfaceCreateServerCall {
+func (c *MockInterfaceCreateSnapshotCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, []string) (*block.Snapshot, error)) *MockInterfaceCreateSnapshotCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -747,6 +984,45 @@ func (c *MockInterfaceCreateVolumeCall) DoAndReturn(f func(context.Context, scw.
 	return c
 }
 
+// CreateVolumeFromSnapshot mocks base method.
+func (m *MockInterface) CreateVolumeFromSnapshot(ctx context.Context, zone scw.Zone, name, snapshotID string, size scw.Size, iops int64, tags []string) (*block.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeFromSnapshot", ctx, zone, name, snapshotID, size, iops, tags)
+	ret0, _ := ret[0].(*block.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeFromSnapshot indicates an expected call of CreateVolumeFromSnapshot.
+func (mr *MockInterfaceMockRecorder) CreateVolumeFromSnapshot(ctx, zone, name, snapshotID, size, iops, tags any) *MockInterfaceCreateVolumeFromSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeFromSnapshot", reflect.TypeOf((*MockInterface)(nil).CreateVolumeFromSnapshot), ctx, zone, name, snapshotID, size, iops, tags)
+	return &MockInterfaceCreateVolumeFromSnapshotCall{Call: call}
+}
+
+// MockInterfaceCreateVolumeFromSnapshotCall wrap *gomock.Call
+type MockInterfaceCreateVolumeFromSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceCreateVolumeFromSnapshotCall) Return(arg0 *block.Volume, arg1 error) *MockInterfaceCreateVolumeFromSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceCreateVolumeFromSnapshotCall) Do(f func(context.Context, scw.Zone, string, string, scw.Size, int64, []string) (*block.Volume, error)) *MockInterfaceCreateVolumeFromSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceCreateVolumeFromSnapshotCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, scw.Size, int64, []string) (*block.Volume, error)) *MockInterfaceCreateVolumeFromSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteBackend mocks base method.
 func (m *MockInterface) DeleteBackend(ctx context.Context, zone scw.Zone, backendID string) error {
 	m.ctrl.T.Helper()
@@ -785,6 +1061,44 @@ func (c *MockInterfaceDeleteBackendCall) DoAndReturn(f func(context.Context, scw
 	return c
 }
 
+// DeleteBaremetalServer mocks base method.
+func (m *MockInterface) DeleteBaremetalServer(ctx context.Context, zone scw.Zone, serverID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBaremetalServer", ctx, zone, serverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBaremetalServer indicates an expected call of DeleteBaremetalServer.
+func (mr *MockInterfaceMockRecorder) DeleteBaremetalServer(ctx, zone, serverID any) *MockInterfaceDeleteBaremetalServerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBaremetalServer", reflect.TypeOf((*MockInterface)(nil).DeleteBaremetalServer), ctx, zone, serverID)
+	return &MockInterfaceDeleteBaremetalServerCall{Call: call}
+}
+
+// MockInterfaceDeleteBaremetalServerCall wrap *gomock.Call
+type MockInterfaceDeleteBaremetalServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceDeleteBaremetalServerCall) Return(arg0 error) *MockInterfaceDeleteBaremetalServerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceDeleteBaremetalServerCall) Do(f func(context.Context, scw.Zone, string) error) *MockInterfaceDeleteBaremetalServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceDeleteBaremetalServerCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockInterfaceDeleteBaremetalServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteCluster mocks base method.
 func (m *MockInterface) DeleteCluster(ctx context.Context, id string, withAdditionalResources bool) error {
 	m.ctrl.T.Helper()
@@ -1089,6 +1403,44 @@ func (c *MockInterfaceDeleteLBACLCall) DoAndReturn(f func(context.Context, scw.Z
 	return c
 }
 
+// DeletePlacementGroup mocks base method.
+func (m *MockInterface) DeletePlacementGroup(ctx context.Context, zone scw.Zone, placementGroupID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlacementGroup", ctx, zone, placementGroupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlacementGroup indicates an expected call of DeletePlacementGroup.
+func (mr *MockInterfaceMockRecorder) DeletePlacementGroup(ctx, zone, placementGroupID any) *MockInterfaceDeletePlacementGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlacementGroup", reflect.TypeOf((*MockInterface)(nil).DeletePlacementGroup), ctx, zone, placementGroupID)
+	return &MockInterfaceDeletePlacementGroupCall{Call: call}
+}
+
+// MockInterfaceDeletePlacementGroupCall wrap *gomock.Call
+type MockInterfaceDeletePlacementGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceDeletePlacementGroupCall) Return(arg0 error) *MockInterfaceDeletePlacementGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceDeletePlacementGroupCall) Do(f func(context.Context, scw.Zone, string) error) *MockInterfaceDeletePlacementGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceDeletePlacementGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockInterfaceDeletePlacementGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeletePool mocks base method.
 func (m *MockInterface) DeletePool(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -1127,53 +1479,129 @@ func (c *MockInterfaceDeletePoolCall) DoAndReturn(f func(context.Context, string
 	return c
 }
 
-// DeletePrivateNetwork mocks base method.
-func (m *MockInterface) DeletePrivateNetwork(ctx context.Context, id string) error {
+// DeletePrivateNIC mocks base method.
+func (m *MockInterface) DeletePrivateNIC(ctx context.Context, zone scw.Zone, serverID, privateNICID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePrivateNetwork", ctx, id)
+	ret := m.ctrl.Call(m, "DeletePrivateNIC", ctx, zone, serverID, privateNICID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeletePrivateNetwork indicates an expected call of DeletePrivateNetwork.
-func (mr *MockInterfaceMockRecorder) DeletePrivateNetwork(ctx, id any) *MockInterfaceDeletePrivateNetworkCall {
+// DeletePrivateNIC indicates an expected call of DeletePrivateNIC.
+func (mr *MockInterfaceMockRecorder) DeletePrivateNIC(ctx, zone, serverID, privateNICID any) *MockInterfaceDeletePrivateNICCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateNetwork", reflect.TypeOf((*MockInterface)(nil).DeletePrivateNetwork), ctx, id)
-	return &MockInterfaceDeletePrivateNetworkCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateNIC", reflect.TypeOf((*MockInterface)(nil).DeletePrivateNIC), ctx, zone, serverID, privateNICID)
+	return &MockInterfaceDeletePrivateNICCall{Call: call}
 }
 
-// MockInterfaceDeletePrivateNetworkCall wrap *gomock.Call
-type MockInterfaceDeletePrivateNetworkCall struct {
+// MockInterfaceDeletePrivateNICCall wrap *gomock.Call
+type MockInterfaceDeletePrivateNICCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInterfaceDeletePrivateNetworkCall) Return(arg0 error) *MockInterfaceDeletePrivateNetworkCall {
+func (c *MockInterfaceDeletePrivateNICCall) Return(arg0 error) *MockInterfaceDeletePrivateNICCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInterfaceDeletePrivateNetworkCall) Do(f func(context.Context, string) error) *MockInterfaceDeletePrivateNetworkCall {
+func (c *MockInterfaceDeletePrivateNICCall) Do(f func(context.Context, scw.Zone, string, string) error) *MockInterfaceDeletePrivateNICCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInterfaceDeletePrivateNetworkCall) DoAndReturn(f func(context.Context, string) error) *MockInterfaceDeletePrivateNetworkCall {
+func (c *MockInterfaceDeletePrivateNICCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) error) *MockInterfaceDeletePrivateNICCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteServer mocks base method.
-func (m *MockInterface) DeleteServer(ctx context.Context, zone scw.Zone, serverID string) error {
+// DeletePrivateNetwork mocks base method.
+func (m *MockInterface) DeletePrivateNetwork(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteServer", ctx, zone, serverID)
+	ret := m.ctrl.Call(m, "DeletePrivateNetwork", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteServer indicates an expected call of DeleteServer.
+// DeletePrivateNetwork indicates an expected call of DeletePrivateNetwork.
+func (mr *MockInterfaceMockRecorder) DeletePrivateNetwork(ctx, id any) *MockInterfaceDeletePrivateNetworkCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateNetwork", reflect.TypeOf((*MockInterface)(nil).DeletePrivateNetwork), ctx, id)
+	return &MockInterfaceDeletePrivateNetworkCall{Call: call}
+}
+
+// MockInterfaceDeletePrivateNetworkCall wrap *gomock.Call
+type MockInterfaceDeletePrivateNetworkCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceDeletePrivateNetworkCall) Return(arg0 error) *MockInterfaceDeletePrivateNetworkCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceDeletePrivateNetworkCall) Do(f func(context.Context, string) error) *MockInterfaceDeletePrivateNetworkCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceDeletePrivateNetworkCall) DoAndReturn(f func(context.Context, string) error) *MockInterfaceDeletePrivateNetworkCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSecurityGroup mocks base method.
+func (m *MockInterface) DeleteSecurityGroup(ctx context.Context, zone scw.Zone, securityGroupID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecurityGroup", ctx, zone, securityGroupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecurityGroup indicates an expected call of DeleteSecurityGroup.
+func (mr *MockInterfaceMockRecorder) DeleteSecurityGroup(ctx, zone, securityGroupID any) *MockInterfaceDeleteSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockInterface)(nil).DeleteSecurityGroup), ctx, zone, securityGroupID)
+	return &MockInterfaceDeleteSecurityGroupCall{Call: call}
+}
+
+// MockInterfaceDeleteSecurityGroupCall wrap *gomock.Call
+type MockInterfaceDeleteSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceDeleteSecurityGroupCall) Return(arg0 error) *MockInterfaceDeleteSecurityGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceDeleteSecurityGroupCall) Do(f func(context.Context, scw.Zone, string) error) *MockInterfaceDeleteSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceDeleteSecurityGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockInterfaceDeleteSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteServer mocks base method.
+func (m *MockInterface) DeleteServer(ctx context.Context, zone scw.Zone, serverID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServer", ctx, zone, serverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServer indicates an expected call of DeleteServer.
 func (mr *MockInterfaceMockRecorder) DeleteServer(ctx, zone, serverID any) *MockInterfaceDeleteServerCall {
 	mr.mock.ctrl.T.Helper()
 	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServer", reflect.TypeOf((*MockInterface)(nil).DeleteServer), ctx, zone, serverID)
@@ -1356,6 +1784,201 @@ func (c *MockInterfaceFindAvailableIPsCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// FindBaremetalOS mocks base method.
+func (m *MockInterface) FindBaremetalOS(ctx context.Context, zone scw.Zone, offerID, name, version string) (*baremetal.OS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBaremetalOS", ctx, zone, offerID, name, version)
+	ret0, _ := ret[0].(*baremetal.OS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBaremetalOS indicates an expected call of FindBaremetalOS.
+func (mr *MockInterfaceMockRecorder) FindBaremetalOS(ctx, zone, offerID, name, version any) *MockInterfaceFindBaremetalOSCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBaremetalOS", reflect.TypeOf((*MockInterface)(nil).FindBaremetalOS), ctx, zone, offerID, name, version)
+	return &MockInterfaceFindBaremetalOSCall{Call: call}
+}
+
+// MockInterfaceFindBaremetalOSCall wrap *gomock.Call
+type MockInterfaceFindBaremetalOSCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceFindBaremetalOSCall) Return(arg0 *baremetal.OS, arg1 error) *MockInterfaceFindBaremetalOSCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceFindBaremetalOSCall) Do(f func(context.Context, scw.Zone, string, string, string) (*baremetal.OS, error)) *MockInterfaceFindBaremetalOSCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceFindBaremetalOSCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, string) (*baremetal.OS, error)) *MockInterfaceFindBaremetalOSCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindBaremetalOffer mocks base method.
+func (m *MockInterface) FindBaremetalOffer(ctx context.Context, zone scw.Zone, name string) (*baremetal.Offer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBaremetalOffer", ctx, zone, name)
+	ret0, _ := ret[0].(*baremetal.Offer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBaremetalOffer indicates an expected call of FindBaremetalOffer.
+func (mr *MockInterfaceMockRecorder) FindBaremetalOffer(ctx, zone, name any) *MockInterfaceFindBaremetalOfferCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBaremetalOffer", reflect.TypeOf((*MockInterface)(nil).FindBaremetalOffer), ctx, zone, name)
+	return &MockInterfaceFindBaremetalOfferCall{Call: call}
+}
+
+// MockInterfaceFindBaremetalOfferCall wrap *gomock.Call
+type MockInterfaceFindBaremetalOfferCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceFindBaremetalOfferCall) Return(arg0 *baremetal.Offer, arg1 error) *MockInterfaceFindBaremetalOfferCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceFindBaremetalOfferCall) Do(f func(context.Context, scw.Zone, string) (*baremetal.Offer, error)) *MockInterfaceFindBaremetalOfferCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceFindBaremetalOfferCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*baremetal.Offer, error)) *MockInterfaceFindBaremetalOfferCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindBaremetalPrivateNICIPs mocks base method.
+func (m *MockInterface) FindBaremetalPrivateNICIPs(ctx context.Context, privateNICID string) ([]*ipam.IP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBaremetalPrivateNICIPs", ctx, privateNICID)
+	ret0, _ := ret[0].([]*ipam.IP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBaremetalPrivateNICIPs indicates an expected call of FindBaremetalPrivateNICIPs.
+func (mr *MockInterfaceMockRecorder) FindBaremetalPrivateNICIPs(ctx, privateNICID any) *MockInterfaceFindBaremetalPrivateNICIPsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBaremetalPrivateNICIPs", reflect.TypeOf((*MockInterface)(nil).FindBaremetalPrivateNICIPs), ctx, privateNICID)
+	return &MockInterfaceFindBaremetalPrivateNICIPsCall{Call: call}
+}
+
+// MockInterfaceFindBaremetalPrivateNICIPsCall wrap *gomock.Call
+type MockInterfaceFindBaremetalPrivateNICIPsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceFindBaremetalPrivateNICIPsCall) Return(arg0 []*ipam.IP, arg1 error) *MockInterfaceFindBaremetalPrivateNICIPsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceFindBaremetalPrivateNICIPsCall) Do(f func(context.Context, string) ([]*ipam.IP, error)) *MockInterfaceFindBaremetalPrivateNICIPsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceFindBaremetalPrivateNICIPsCall) DoAndReturn(f func(context.Context, string) ([]*ipam.IP, error)) *MockInterfaceFindBaremetalPrivateNICIPsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindBaremetalServer mocks base method.
+func (m *MockInterface) FindBaremetalServer(ctx context.Context, zone scw.Zone, tags []string) (*baremetal.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBaremetalServer", ctx, zone, tags)
+	ret0, _ := ret[0].(*baremetal.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBaremetalServer indicates an expected call of FindBaremetalServer.
+func (mr *MockInterfaceMockRecorder) FindBaremetalServer(ctx, zone, tags any) *MockInterfaceFindBaremetalServerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBaremetalServer", reflect.TypeOf((*MockInterface)(nil).FindBaremetalServer), ctx, zone, tags)
+	return &MockInterfaceFindBaremetalServerCall{Call: call}
+}
+
+// MockInterfaceFindBaremetalServerCall wrap *gomock.Call
+type MockInterfaceFindBaremetalServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceFindBaremetalServerCall) Return(arg0 *baremetal.Server, arg1 error) *MockInterfaceFindBaremetalServerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceFindBaremetalServerCall) Do(f func(context.Context, scw.Zone, []string) (*baremetal.Server, error)) *MockInterfaceFindBaremetalServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceFindBaremetalServerCall) DoAndReturn(f func(context.Context, scw.Zone, []string) (*baremetal.Server, error)) *MockInterfaceFindBaremetalServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindBaremetalServerPrivateNetwork mocks base method.
+func (m *MockInterface) FindBaremetalServerPrivateNetwork(ctx context.Context, zone scw.Zone, serverID, privateNetworkID string) (*baremetal0.ServerPrivateNetwork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBaremetalServerPrivateNetwork", ctx, zone, serverID, privateNetworkID)
+	ret0, _ := ret[0].(*baremetal0.ServerPrivateNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBaremetalServerPrivateNetwork indicates an expected call of FindBaremetalServerPrivateNetwork.
+func (mr *MockInterfaceMockRecorder) FindBaremetalServerPrivateNetwork(ctx, zone, serverID, privateNetworkID any) *MockInterfaceFindBaremetalServerPrivateNetworkCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBaremetalServerPrivateNetwork", reflect.TypeOf((*MockInterface)(nil).FindBaremetalServerPrivateNetwork), ctx, zone, serverID, privateNetworkID)
+	return &MockInterfaceFindBaremetalServerPrivateNetworkCall{Call: call}
+}
+
+// MockInterfaceFindBaremetalServerPrivateNetworkCall wrap *gomock.Call
+type MockInterfaceFindBaremetalServerPrivateNetworkCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceFindBaremetalServerPrivateNetworkCall) Return(arg0 *baremetal0.ServerPrivateNetwork, arg1 error) *MockInterfaceFindBaremetalServerPrivateNetworkCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceFindBaremetalServerPrivateNetworkCall) Do(f func(context.Context, scw.Zone, string, string) (*baremetal0.ServerPrivateNetwork, error)) *MockInterfaceFindBaremetalServerPrivateNetworkCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceFindBaremetalServerPrivateNetworkCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) (*baremetal0.ServerPrivateNetwork, error)) *MockInterfaceFindBaremetalServerPrivateNetworkCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindCluster mocks base method.
 func (m *MockInterface) FindCluster(ctx context.Context, name string) (*k8s.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -1395,6 +2018,45 @@ func (c *MockInterfaceFindClusterCall) DoAndReturn(f func(context.Context, strin
 	return c
 }
 
+// FindDefaultSecurityGroup mocks base method.
+func (m *MockInterface) FindDefaultSecurityGroup(ctx context.Context, zone scw.Zone) (*instance.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDefaultSecurityGroup", ctx, zone)
+	ret0, _ := ret[0].(*instance.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDefaultSecurityGroup indicates an expected call of FindDefaultSecurityGroup.
+func (mr *MockInterfaceMockRecorder) FindDefaultSecurityGroup(ctx, zone any) *MockInterfaceFindDefaultSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDefaultSecurityGroup", reflect.TypeOf((*MockInterface)(nil).FindDefaultSecurityGroup), ctx, zone)
+	return &MockInterfaceFindDefaultSecurityGroupCall{Call: call}
+}
+
+// MockInterfaceFindDefaultSecurityGroupCall wrap *gomock.Call
+type MockInterfaceFindDefaultSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceFindDefaultSecurityGroupCall) Return(arg0 *instance.SecurityGroup, arg1 error) *MockInterfaceFindDefaultSecurityGroupCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceFindDefaultSecurityGroupCall) Do(f func(context.Context, scw.Zone) (*instance.SecurityGroup, error)) *MockInterfaceFindDefaultSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceFindDefaultSecurityGroupCall) DoAndReturn(f func(context.Context, scw.Zone) (*instance.SecurityGroup, error)) *MockInterfaceFindDefaultSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindGatewayIP mocks base method.
 func (m *MockInterface) FindGatewayIP(ctx context.Context, zone scw.Zone, ip string) (*vpcgw.IP, error) {
 	m.ctrl.T.Helper()
@@ -1863,6 +2525,45 @@ func (c *MockInterfaceFindPlacementGroupCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// FindPlacementGroups mocks base method.
+func (m *MockInterface) FindPlacementGroups(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPlacementGroups", ctx, zone, tags)
+	ret0, _ := ret[0].([]*instance.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPlacementGroups indicates an expected call of FindPlacementGroups.
+func (mr *MockInterfaceMockRecorder) FindPlacementGroups(ctx, zone, tags any) *MockInterfaceFindPlacementGroupsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPlacementGroups", reflect.TypeOf((*MockInterface)(nil).FindPlacementGroups), ctx, zone, tags)
+	return &MockInterfaceFindPlacementGroupsCall{Call: call}
+}
+
+// MockInterfaceFindPlacementGroupsCall wrap *gomock.Call
+type MockInterfaceFindPlacementGroupsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceFindPlacementGroupsCall) Return(arg0 []*instance.PlacementGroup, arg1 error) *MockInterfaceFindPlacementGroupsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceFindPlacementGroupsCall) Do(f func(context.Context, scw.Zone, []string) ([]*instance.PlacementGroup, error)) *MockInterfaceFindPlacementGroupsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceFindPlacementGroupsCall) DoAndReturn(f func(context.Context, scw.Zone, []string) ([]*instance.PlacementGroup, error)) *MockInterfaceFindPlacementGroupsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindPool mocks base method.
 func (m *MockInterface) FindPool(ctx context.Context, clusterID, name string) (*k8s.Pool, error) {
 	m.ctrl.T.Helper()
@@ -1980,17 +2681,56 @@ func (c *MockInterfaceFindPrivateNetworkCall) DoAndReturn(f func(context.Context
 	return c
 }
 
-// FindSecurityGroup mocks base method.
-func (m *MockInterface) FindSecurityGroup(ctx context.Context, zone scw.Zone, name string) (*instance.SecurityGroup, error) {
+// FindPrivateNetworkIPs mocks base method.
+func (m *MockInterface) FindPrivateNetworkIPs(ctx context.Context, privateNetworkID string, tags []string) ([]*ipam.IP, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindSecurityGroup", ctx, zone, name)
-	ret0, _ := ret[0].(*instance.SecurityGroup)
+	ret := m.ctrl.Call(m, "FindPrivateNetworkIPs", ctx, privateNetworkID, tags)
+	ret0, _ := ret[0].([]*ipam.IP)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindSecurityGroup indicates an expected call of FindSecurityGroup.
-func (mr *MockInterfaceMockRecorder) FindSecurityGroup(ctx, zone, name any) *MockInterfaceFindSecurityGroupCall {
+// FindPrivateNetworkIPs indicates an expected call of FindPrivateNetworkIPs.
+func (mr *MockInterfaceMockRecorder) FindPrivateNetworkIPs(ctx, privateNetworkID, tags any) *MockInterfaceFindPrivateNetworkIPsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrivateNetworkIPs", reflect.TypeOf((*MockInterface)(nil).FindPrivateNetworkIPs), ctx, privateNetworkID, tags)
+	return &MockInterfaceFindPrivateNetworkIPsCall{Call: call}
+}
+
+// MockInterfaceFindPrivateNetworkIPsCall wrap *gomock.Call
+type MockInterfaceFindPrivateNetworkIPsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceFindPrivateNetworkIPsCall) Return(arg0 []*ipam.IP, arg1 error) *MockInterfaceFindPrivateNetworkIPsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceFindPrivateNetworkIPsCall) Do(f func(context.Context, string, []string) ([]*ipam.IP, error)) *MockInterfaceFindPrivateNetworkIPsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceFindPrivateNetworkIPsCall) DoAndReturn(f func(context.Context, string, []string) ([]*ipam.IP, error)) *MockInterfaceFindPrivateNetworkIPsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindSecurityGroup mocks base method.
+func (m *MockInterface) FindSecurityGroup(ctx context.Context, zone scw.Zone, name string) (*instance.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSecurityGroup", ctx, zone, name)
+	ret0, _ := ret[0].(*instance.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSecurityGroup indicates an expected call of FindSecurityGroup.
+func (mr *MockInterfaceMockRecorder) FindSecurityGroup(ctx, zone, name any) *MockInterfaceFindSecurityGroupCall {
 	mr.mock.ctrl.T.Helper()
 	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSecurityGroup", reflect.TypeOf((*MockInterface)(nil).FindSecurityGroup), ctx, zone, name)
 	return &MockInterfaceFindSecurityGroupCall{Call: call}
@@ -2019,6 +2759,45 @@ func (c *MockInterfaceFindSecurityGroupCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// FindSecurityGroupByTags mocks base method.
+func (m *MockInterface) FindSecurityGroupByTags(ctx context.Context, zone scw.Zone, tags []string) (*instance.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSecurityGroupByTags", ctx, zone, tags)
+	ret0, _ := ret[0].(*instance.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSecurityGroupByTags indicates an expected call of FindSecurityGroupByTags.
+func (mr *MockInterfaceMockRecorder) FindSecurityGroupByTags(ctx, zone, tags any) *MockInterfaceFindSecurityGroupByTagsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSecurityGroupByTags", reflect.TypeOf((*MockInterface)(nil).FindSecurityGroupByTags), ctx, zone, tags)
+	return &MockInterfaceFindSecurityGroupByTagsCall{Call: call}
+}
+
+// MockInterfaceFindSecurityGroupByTagsCall wrap *gomock.Call
+type MockInterfaceFindSecurityGroupByTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceFindSecurityGroupByTagsCall) Return(arg0 *instance.SecurityGroup, arg1 error) *MockInterfaceFindSecurityGroupByTagsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceFindSecurityGroupByTagsCall) Do(f func(context.Context, scw.Zone, []string) (*instance.SecurityGroup, error)) *MockInterfaceFindSecurityGroupByTagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceFindSecurityGroupByTagsCall) DoAndReturn(f func(context.Context, scw.Zone, []string) (*instance.SecurityGroup, error)) *MockInterfaceFindSecurityGroupByTagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindServer mocks base method.
 func (m *MockInterface) FindServer(ctx context.Context, zone scw.Zone, tags []string) (*instance.Server, error) {
 	m.ctrl.T.Helper()
@@ -2058,6 +2837,123 @@ func (c *MockInterfaceFindServerCall) DoAndReturn(f func(context.Context, scw.Zo
 	return c
 }
 
+// FindServers mocks base method.
+func (m *MockInterface) FindServers(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindServers", ctx, zone, tags)
+	ret0, _ := ret[0].([]*instance.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindServers indicates an expected call of FindServers.
+func (mr *MockInterfaceMockRecorder) FindServers(ctx, zone, tags any) *MockInterfaceFindServersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindServers", reflect.TypeOf((*MockInterface)(nil).FindServers), ctx, zone, tags)
+	return &MockInterfaceFindServersCall{Call: call}
+}
+
+// MockInterfaceFindServersCall wrap *gomock.Call
+type MockInterfaceFindServersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceFindServersCall) Return(arg0 []*instance.Server, arg1 error) *MockInterfaceFindServersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceFindServersCall) Do(f func(context.Context, scw.Zone, []string) ([]*instance.Server, error)) *MockInterfaceFindServersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceFindServersCall) DoAndReturn(f func(context.Context, scw.Zone, []string) ([]*instance.Server, error)) *MockInterfaceFindServersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindSnapshotByName mocks base method.
+func (m *MockInterface) FindSnapshotByName(ctx context.Context, zone scw.Zone, name string) (*block.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSnapshotByName", ctx, zone, name)
+	ret0, _ := ret[0].(*block.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSnapshotByName indicates an expected call of FindSnapshotByName.
+func (mr *MockInterfaceMockRecorder) FindSnapshotByName(ctx, zone, name any) *MockInterfaceFindSnapshotByNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSnapshotByName", reflect.TypeOf((*MockInterface)(nil).FindSnapshotByName), ctx, zone, name)
+	return &MockInterfaceFindSnapshotByNameCall{Call: call}
+}
+
+// MockInterfaceFindSnapshotByNameCall wrap *gomock.Call
+type MockInterfaceFindSnapshotByNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceFindSnapshotByNameCall) Return(arg0 *block.Snapshot, arg1 error) *MockInterfaceFindSnapshotByNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceFindSnapshotByNameCall) Do(f func(context.Context, scw.Zone, string) (*block.Snapshot, error)) *MockInterfaceFindSnapshotByNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceFindSnapshotByNameCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*block.Snapshot, error)) *MockInterfaceFindSnapshotByNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindSnapshots mocks base method.
+func (m *MockInterface) FindSnapshots(ctx context.Context, zone scw.Zone, tags []string) ([]*block.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSnapshots", ctx, zone, tags)
+	ret0, _ := ret[0].([]*block.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSnapshots indicates an expected call of FindSnapshots.
+func (mr *MockInterfaceMockRecorder) FindSnapshots(ctx, zone, tags any) *MockInterfaceFindSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSnapshots", reflect.TypeOf((*MockInterface)(nil).FindSnapshots), ctx, zone, tags)
+	return &MockInterfaceFindSnapshotsCall{Call: call}
+}
+
+// MockInterfaceFindSnapshotsCall wrap *gomock.Call
+type MockInterfaceFindSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceFindSnapshotsCall) Return(arg0 []*block.Snapshot, arg1 error) *MockInterfaceFindSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceFindSnapshotsCall) Do(f func(context.Context, scw.Zone, []string) ([]*block.Snapshot, error)) *MockInterfaceFindSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceFindSnapshotsCall) DoAndReturn(f func(context.Context, scw.Zone, []string) ([]*block.Snapshot, error)) *MockInterfaceFindSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindVolumes mocks base method.
 func (m *MockInterface) FindVolumes(ctx context.Context, zone scw.Zone, tags []string) ([]*block.Volume, error) {
 	m.ctrl.T.Helper()
@@ -2136,6 +3032,45 @@ func (c *MockInterfaceGetAllServerUserDataCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// GetBaremetalOS mocks base method.
+func (m *MockInterface) GetBaremetalOS(ctx context.Context, zone scw.Zone, osID string) (*baremetal.OS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaremetalOS", ctx, zone, osID)
+	ret0, _ := ret[0].(*baremetal.OS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaremetalOS indicates an expected call of GetBaremetalOS.
+func (mr *MockInterfaceMockRecorder) GetBaremetalOS(ctx, zone, osID any) *MockInterfaceGetBaremetalOSCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaremetalOS", reflect.TypeOf((*MockInterface)(nil).GetBaremetalOS), ctx, zone, osID)
+	return &MockInterfaceGetBaremetalOSCall{Call: call}
+}
+
+// MockInterfaceGetBaremetalOSCall wrap *gomock.Call
+type MockInterfaceGetBaremetalOSCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceGetBaremetalOSCall) Return(arg0 *baremetal.OS, arg1 error) *MockInterfaceGetBaremetalOSCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceGetBaremetalOSCall) Do(f func(context.Context, scw.Zone, string) (*baremetal.OS, error)) *MockInterfaceGetBaremetalOSCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceGetBaremetalOSCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*baremetal.OS, error)) *MockInterfaceGetBaremetalOSCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetClusterKubeConfig mocks base method.
 func (m *MockInterface) GetClusterKubeConfig(ctx context.Context, id string) (*k8s.Kubeconfig, error) {
 	m.ctrl.T.Helper()
@@ -2213,6 +3148,45 @@ func (c *MockInterfaceGetControlPlaneZonesCall) DoAndReturn(f func() []scw.Zone)
 	return c
 }
 
+// GetIP mocks base method.
+func (m *MockInterface) GetIP(ctx context.Context, zone scw.Zone, ipIDOrAddress string) (*instance.IP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIP", ctx, zone, ipIDOrAddress)
+	ret0, _ := ret[0].(*instance.IP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIP indicates an expected call of GetIP.
+func (mr *MockInterfaceMockRecorder) GetIP(ctx, zone, ipIDOrAddress any) *MockInterfaceGetIPCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIP", reflect.TypeOf((*MockInterface)(nil).GetIP), ctx, zone, ipIDOrAddress)
+	return &MockInterfaceGetIPCall{Call: call}
+}
+
+// MockInterfaceGetIPCall wrap *gomock.Call
+type MockInterfaceGetIPCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceGetIPCall) Return(arg0 *instance.IP, arg1 error) *MockInterfaceGetIPCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceGetIPCall) Do(f func(context.Context, scw.Zone, string) (*instance.IP, error)) *MockInterfaceGetIPCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceGetIPCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*instance.IP, error)) *MockInterfaceGetIPCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetLocalImageByLabel mocks base method.
 func (m *MockInterface) GetLocalImageByLabel(ctx context.Context, zone scw.Zone, commercialType, imageLabel string, imageType marketplace.LocalImageType) (*marketplace.LocalImage, error) {
 	m.ctrl.T.Helper()
@@ -2329,6 +3303,162 @@ func (c *MockInterfaceGetSecretKeyCall) DoAndReturn(f func() string) *MockInterf
 	return c
 }
 
+// GetServer mocks base method.
+func (m *MockInterface) GetServer(ctx context.Context, zone scw.Zone, serverID string) (*instance.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServer", ctx, zone, serverID)
+	ret0, _ := ret[0].(*instance.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServer indicates an expected call of GetServer.
+func (mr *MockInterfaceMockRecorder) GetServer(ctx, zone, serverID any) *MockInterfaceGetServerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServer", reflect.TypeOf((*MockInterface)(nil).GetServer), ctx, zone, serverID)
+	return &MockInterfaceGetServerCall{Call: call}
+}
+
+// MockInterfaceGetServerCall wrap *gomock.Call
+type MockInterfaceGetServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceGetServerCall) Return(arg0 *instance.Server, arg1 error) *MockInterfaceGetServerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceGetServerCall) Do(f func(context.Context, scw.Zone, string) (*instance.Server, error)) *MockInterfaceGetServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceGetServerCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*instance.Server, error)) *MockInterfaceGetServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetServerType mocks base method.
+func (m *MockInterface) GetServerType(ctx context.Context, zone scw.Zone, commercialType string) (*instance.ServerType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerType", ctx, zone, commercialType)
+	ret0, _ := ret[0].(*instance.ServerType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerType indicates an expected call of GetServerType.
+func (mr *MockInterfaceMockRecorder) GetServerType(ctx, zone, commercialType any) *MockInterfaceGetServerTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerType", reflect.TypeOf((*MockInterface)(nil).GetServerType), ctx, zone, commercialType)
+	return &MockInterfaceGetServerTypeCall{Call: call}
+}
+
+// MockInterfaceGetServerTypeCall wrap *gomock.Call
+type MockInterfaceGetServerTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceGetServerTypeCall) Return(arg0 *instance.ServerType, arg1 error) *MockInterfaceGetServerTypeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceGetServerTypeCall) Do(f func(context.Context, scw.Zone, string) (*instance.ServerType, error)) *MockInterfaceGetServerTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceGetServerTypeCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*instance.ServerType, error)) *MockInterfaceGetServerTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetServerTypesAvailability mocks base method.
+func (m *MockInterface) GetServerTypesAvailability(ctx context.Context, zone scw.Zone) (map[string]instance.ServerTypesAvailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerTypesAvailability", ctx, zone)
+	ret0, _ := ret[0].(map[string]instance.ServerTypesAvailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerTypesAvailability indicates an expected call of GetServerTypesAvailability.
+func (mr *MockInterfaceMockRecorder) GetServerTypesAvailability(ctx, zone any) *MockInterfaceGetServerTypesAvailabilityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerTypesAvailability", reflect.TypeOf((*MockInterface)(nil).GetServerTypesAvailability), ctx, zone)
+	return &MockInterfaceGetServerTypesAvailabilityCall{Call: call}
+}
+
+// MockInterfaceGetServerTypesAvailabilityCall wrap *gomock.Call
+type MockInterfaceGetServerTypesAvailabilityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceGetServerTypesAvailabilityCall) Return(arg0 map[string]instance.ServerTypesAvailability, arg1 error) *MockInterfaceGetServerTypesAvailabilityCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceGetServerTypesAvailabilityCall) Do(f func(context.Context, scw.Zone) (map[string]instance.ServerTypesAvailability, error)) *MockInterfaceGetServerTypesAvailabilityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceGetServerTypesAvailabilityCall) DoAndReturn(f func(context.Context, scw.Zone) (map[string]instance.ServerTypesAvailability, error)) *MockInterfaceGetServerTypesAvailabilityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetVolume mocks base method.
+func (m *MockInterface) GetVolume(ctx context.Context, zone scw.Zone, volumeID string) (*block.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolume", ctx, zone, volumeID)
+	ret0, _ := ret[0].(*block.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockInterfaceMockRecorder) GetVolume(ctx, zone, volumeID any) *MockInterfaceGetVolumeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockInterface)(nil).GetVolume), ctx, zone, volumeID)
+	return &MockInterfaceGetVolumeCall{Call: call}
+}
+
+// MockInterfaceGetVolumeCall wrap *gomock.Call
+type MockInterfaceGetVolumeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceGetVolumeCall) Return(arg0 *block.Volume, arg1 error) *MockInterfaceGetVolumeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceGetVolumeCall) Do(f func(context.Context, scw.Zone, string) (*block.Volume, error)) *MockInterfaceGetVolumeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceGetVolumeCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*block.Volume, error)) *MockInterfaceGetVolumeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetZoneOrDefault mocks base method.
 func (m *MockInterface) GetZoneOrDefault(zone string) (scw.Zone, error) {
 	m.ctrl.T.Helper()
@@ -2368,6 +3498,44 @@ func (c *MockInterfaceGetZoneOrDefaultCall) DoAndReturn(f func(string) (scw.Zone
 	return c
 }
 
+// InstallBaremetalServer mocks base method.
+func (m *MockInterface) InstallBaremetalServer(ctx context.Context, zone scw.Zone, serverID, osID, hostname string, sshKeyIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallBaremetalServer", ctx, zone, serverID, osID, hostname, sshKeyIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallBaremetalServer indicates an expected call of InstallBaremetalServer.
+func (mr *MockInterfaceMockRecorder) InstallBaremetalServer(ctx, zone, serverID, osID, hostname, sshKeyIDs any) *MockInterfaceInstallBaremetalServerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallBaremetalServer", reflect.TypeOf((*MockInterface)(nil).InstallBaremetalServer), ctx, zone, serverID, osID, hostname, sshKeyIDs)
+	return &MockInterfaceInstallBaremetalServerCall{Call: call}
+}
+
+// MockInterfaceInstallBaremetalServerCall wrap *gomock.Call
+type MockInterfaceInstallBaremetalServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceInstallBaremetalServerCall) Return(arg0 error) *MockInterfaceInstallBaremetalServerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceInstallBaremetalServerCall) Do(f func(context.Context, scw.Zone, string, string, string, []string) error) *MockInterfaceInstallBaremetalServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceInstallBaremetalServerCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, string, []string) error) *MockInterfaceInstallBaremetalServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListBackends mocks base method.
 func (m *MockInterface) ListBackends(ctx context.Context, zone scw.Zone, lbID string) ([]*lb.Backend, error) {
 	m.ctrl.T.Helper()
@@ -2572,71 +3740,149 @@ func (m *MockInterface) ListLBACLs(ctx context.Context, zone scw.Zone, frontendI
 	return ret0, ret1
 }
 
-// ListLBACLs indicates an expected call of ListLBACLs.
-func (mr *MockInterfaceMockRecorder) ListLBACLs(ctx, zone, frontendID any) *MockInterfaceListLBACLsCall {
+// ListLBACLs indicates an expected call of ListLBACLs.
+func (mr *MockInterfaceMockRecorder) ListLBACLs(ctx, zone, frontendID any) *MockInterfaceListLBACLsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBACLs", reflect.TypeOf((*MockInterface)(nil).ListLBACLs), ctx, zone, frontendID)
+	return &MockInterfaceListLBACLsCall{Call: call}
+}
+
+// MockInterfaceListLBACLsCall wrap *gomock.Call
+type MockInterfaceListLBACLsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceListLBACLsCall) Return(arg0 []*lb.ACL, arg1 error) *MockInterfaceListLBACLsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceListLBACLsCall) Do(f func(context.Context, scw.Zone, string) ([]*lb.ACL, error)) *MockInterfaceListLBACLsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceListLBACLsCall) DoAndReturn(f func(context.Context, scw.Zone, string) ([]*lb.ACL, error)) *MockInterfaceListLBACLsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListNodes mocks base method.
+func (m *MockInterface) ListNodes(ctx context.Context, clusterID, poolID string) ([]*k8s.Node, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNodes", ctx, clusterID, poolID)
+	ret0, _ := ret[0].([]*k8s.Node)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNodes indicates an expected call of ListNodes.
+func (mr *MockInterfaceMockRecorder) ListNodes(ctx, clusterID, poolID any) *MockInterfaceListNodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodes", reflect.TypeOf((*MockInterface)(nil).ListNodes), ctx, clusterID, poolID)
+	return &MockInterfaceListNodesCall{Call: call}
+}
+
+// MockInterfaceListNodesCall wrap *gomock.Call
+type MockInterfaceListNodesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceListNodesCall) Return(arg0 []*k8s.Node, arg1 error) *MockInterfaceListNodesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceListNodesCall) Do(f func(context.Context, string, string) ([]*k8s.Node, error)) *MockInterfaceListNodesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceListNodesCall) DoAndReturn(f func(context.Context, string, string) ([]*k8s.Node, error)) *MockInterfaceListNodesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListPlacementGroupServers mocks base method.
+func (m *MockInterface) ListPlacementGroupServers(ctx context.Context, zone scw.Zone, placementGroupID string) ([]*instance.PlacementGroupServer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlacementGroupServers", ctx, zone, placementGroupID)
+	ret0, _ := ret[0].([]*instance.PlacementGroupServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlacementGroupServers indicates an expected call of ListPlacementGroupServers.
+func (mr *MockInterfaceMockRecorder) ListPlacementGroupServers(ctx, zone, placementGroupID any) *MockInterfaceListPlacementGroupServersCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBACLs", reflect.TypeOf((*MockInterface)(nil).ListLBACLs), ctx, zone, frontendID)
-	return &MockInterfaceListLBACLsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlacementGroupServers", reflect.TypeOf((*MockInterface)(nil).ListPlacementGroupServers), ctx, zone, placementGroupID)
+	return &MockInterfaceListPlacementGroupServersCall{Call: call}
 }
 
-// MockInterfaceListLBACLsCall wrap *gomock.Call
-type MockInterfaceListLBACLsCall struct {
+// MockInterfaceListPlacementGroupServersCall wrap *gomock.Call
+type MockInterfaceListPlacementGroupServersCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInterfaceListLBACLsCall) Return(arg0 []*lb.ACL, arg1 error) *MockInterfaceListLBACLsCall {
+func (c *MockInterfaceListPlacementGroupServersCall) Return(arg0 []*instance.PlacementGroupServer, arg1 error) *MockInterfaceListPlacementGroupServersCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInterfaceListLBACLsCall) Do(f func(context.Context, scw.Zone, string) ([]*lb.ACL, error)) *MockInterfaceListLBACLsCall {
+func (c *MockInterfaceListPlacementGroupServersCall) Do(f func(context.Context, scw.Zone, string) ([]*instance.PlacementGroupServer, error)) *MockInterfaceListPlacementGroupServersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInterfaceListLBACLsCall) DoAndReturn(f func(context.Context, scw.Zone, string) ([]*lb.ACL, error)) *MockInterfaceListLBACLsCall {
+func (c *MockInterfaceListPlacementGroupServersCall) DoAndReturn(f func(context.Context, scw.Zone, string) ([]*instance.PlacementGroupServer, error)) *MockInterfaceListPlacementGroupServersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListNodes mocks base method.
-func (m *MockInterface) ListNodes(ctx context.Context, clusterID, poolID string) ([]*k8s.Node, error) {
+// ListSecurityGroupRules mocks base method.
+func (m *MockInterface) ListSecurityGroupRules(ctx context.Context, zone scw.Zone, securityGroupID string) ([]*instance.SecurityGroupRule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNodes", ctx, clusterID, poolID)
-	ret0, _ := ret[0].([]*k8s.Node)
+	ret := m.ctrl.Call(m, "ListSecurityGroupRules", ctx, zone, securityGroupID)
+	ret0, _ := ret[0].([]*instance.SecurityGroupRule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListNodes indicates an expected call of ListNodes.
-func (mr *MockInterfaceMockRecorder) ListNodes(ctx, clusterID, poolID any) *MockInterfaceListNodesCall {
+// ListSecurityGroupRules indicates an expected call of ListSecurityGroupRules.
+func (mr *MockInterfaceMockRecorder) ListSecurityGroupRules(ctx, zone, securityGroupID any) *MockInterfaceListSecurityGroupRulesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodes", reflect.TypeOf((*MockInterface)(nil).ListNodes), ctx, clusterID, poolID)
-	return &MockInterfaceListNodesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroupRules", reflect.TypeOf((*MockInterface)(nil).ListSecurityGroupRules), ctx, zone, securityGroupID)
+	return &MockInterfaceListSecurityGroupRulesCall{Call: call}
 }
 
-// MockInterfaceListNodesCall wrap *gomock.Call
-type MockInterfaceListNodesCall struct {
+// MockInterfaceListSecurityGroupRulesCall wrap *gomock.Call
+type MockInterfaceListSecurityGroupRulesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInterfaceListNodesCall) Return(arg0 []*k8s.Node, arg1 error) *MockInterfaceListNodesCall {
+func (c *MockInterfaceListSecurityGroupRulesCall) Return(arg0 []*instance.SecurityGroupRule, arg1 error) *MockInterfaceListSecurityGroupRulesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInterfaceListNodesCall) Do(f func(context.Context, string, string) ([]*k8s.Node, error)) *MockInterfaceListNodesCall {
+func (c *MockInterfaceListSecurityGroupRulesCall) Do(f func(context.Context, scw.Zone, string) ([]*instance.SecurityGroupRule, error)) *MockInterfaceListSecurityGroupRulesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInterfaceListNodesCall) DoAndReturn(f func(context.Context, string, string) ([]*k8s.Node, error)) *MockInterfaceListNodesCall {
+func (c *MockInterfaceListSecurityGroupRulesCall) DoAndReturn(f func(context.Context, scw.Zone, string) ([]*instance.SecurityGroupRule, error)) *MockInterfaceListSecurityGroupRulesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -2680,6 +3926,44 @@ func (c *MockInterfaceMigrateLBCall) DoAndReturn(f func(context.Context, scw.Zon
 	return c
 }
 
+// ReleaseIP mocks base method.
+func (m *MockInterface) ReleaseIP(ctx context.Context, ipID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIP", ctx, ipID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseIP indicates an expected call of ReleaseIP.
+func (mr *MockInterfaceMockRecorder) ReleaseIP(ctx, ipID any) *MockInterfaceReleaseIPCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIP", reflect.TypeOf((*MockInterface)(nil).ReleaseIP), ctx, ipID)
+	return &MockInterfaceReleaseIPCall{Call: call}
+}
+
+// MockInterfaceReleaseIPCall wrap *gomock.Call
+type MockInterfaceReleaseIPCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceReleaseIPCall) Return(arg0 error) *MockInterfaceReleaseIPCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceReleaseIPCall) Do(f func(context.Context, string) error) *MockInterfaceReleaseIPCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceReleaseIPCall) DoAndReturn(f func(context.Context, string) error) *MockInterfaceReleaseIPCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RemoveBackendServer mocks base method.
 func (m *MockInterface) RemoveBackendServer(ctx context.Context, zone scw.Zone, backendID, ip string) error {
 	m.ctrl.T.Helper()
@@ -2718,6 +4002,44 @@ func (c *MockInterfaceRemoveBackendServerCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// RemoveServerPlacementGroup mocks base method.
+func (m *MockInterface) RemoveServerPlacementGroup(ctx context.Context, zone scw.Zone, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveServerPlacementGroup", ctx, zone, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveServerPlacementGroup indicates an expected call of RemoveServerPlacementGroup.
+func (mr *MockInterfaceMockRecorder) RemoveServerPlacementGroup(ctx, zone, id any) *MockInterfaceRemoveServerPlacementGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServerPlacementGroup", reflect.TypeOf((*MockInterface)(nil).RemoveServerPlacementGroup), ctx, zone, id)
+	return &MockInterfaceRemoveServerPlacementGroupCall{Call: call}
+}
+
+// MockInterfaceRemoveServerPlacementGroupCall wrap *gomock.Call
+type MockInterfaceRemoveServerPlacementGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceRemoveServerPlacementGroupCall) Return(arg0 error) *MockInterfaceRemoveServerPlacementGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceRemoveServerPlacementGroupCall) Do(f func(context.Context, scw.Zone, string) error) *MockInterfaceRemoveServerPlacementGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceRemoveServerPlacementGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockInterfaceRemoveServerPlacementGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ServerAction mocks base method.
 func (m *MockInterface) ServerAction(ctx context.Context, zone scw.Zone, serverID string, action instance.ServerAction) error {
 	m.ctrl.T.Helper()
@@ -2947,6 +4269,44 @@ func (c *MockInterfaceSetLBACLsCall) DoAndReturn(f func(context.Context, scw.Zon
 	return c
 }
 
+// SetSecurityGroupRules mocks base method.
+func (m *MockInterface) SetSecurityGroupRules(ctx context.Context, zone scw.Zone, securityGroupID string, rules []*instance.SetSecurityGroupRulesRequestRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSecurityGroupRules", ctx, zone, securityGroupID, rules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSecurityGroupRules indicates an expected call of SetSecurityGroupRules.
+func (mr *MockInterfaceMockRecorder) SetSecurityGroupRules(ctx, zone, securityGroupID, rules any) *MockInterfaceSetSecurityGroupRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecurityGroupRules", reflect.TypeOf((*MockInterface)(nil).SetSecurityGroupRules), ctx, zone, securityGroupID, rules)
+	return &MockInterfaceSetSecurityGroupRulesCall{Call: call}
+}
+
+// MockInterfaceSetSecurityGroupRulesCall wrap *gomock.Call
+type MockInterfaceSetSecurityGroupRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceSetSecurityGroupRulesCall) Return(arg0 error) *MockInterfaceSetSecurityGroupRulesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceSetSecurityGroupRulesCall) Do(f func(context.Context, scw.Zone, string, []*instance.SetSecurityGroupRulesRequestRule) error) *MockInterfaceSetSecurityGroupRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceSetSecurityGroupRulesCall) DoAndReturn(f func(context.Context, scw.Zone, string, []*instance.SetSecurityGroupRulesRequestRule) error) *MockInterfaceSetSecurityGroupRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetServerUserData mocks base method.
 func (m *MockInterface) SetServerUserData(ctx context.Context, zone scw.Zone, serverID, key, content string) error {
 	m.ctrl.T.Helper()
@@ -2986,18 +4346,18 @@ func (c *MockInterfaceSetServerUserDataCall) DoAndReturn(f func(context.Context,
 }
 
 // UpdateBackend mocks base method.
-func (m *MockInterface) UpdateBackend(ctx context.Context, zone scw.Zone, backendID, name string, port int32) (*lb.Backend, error) {
+func (m *MockInterface) UpdateBackend(ctx context.Context, zone scw.Zone, backendID string, backend *lb.Backend) (*lb.Backend, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBackend", ctx, zone, backendID, name, port)
+	ret := m.ctrl.Call(m, "UpdateBackend", ctx, zone, backendID, backend)
 	ret0, _ := ret[0].(*lb.Backend)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateBackend indicates an expected call of UpdateBackend.
-func (mr *MockInterfaceMockRecorder) UpdateBackend(ctx, zone, backendID, name, port any) *MockInterfaceUpdateBackendCall {
+func (mr *MockInterfaceMockRecorder) UpdateBackend(ctx, zone, backendID, backend any) *MockInterfaceUpdateBackendCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackend", reflect.TypeOf((*MockInterface)(nil).UpdateBackend), ctx, zone, backendID, name, port)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackend", reflect.TypeOf((*MockInterface)(nil).UpdateBackend), ctx, zone, backendID, backend)
 	return &MockInterfaceUpdateBackendCall{Call: call}
 }
 
@@ -3013,13 +4373,51 @@ func (c *MockInterfaceUpdateBackendCall) Return(arg0 *lb.Backend, arg1 error) *M
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInterfaceUpdateBackendCall) Do(f func(context.Context, scw.Zone, string, string, int32) (*lb.Backend, error)) *MockInterfaceUpdateBackendCall {
+func (c *MockInterfaceUpdateBackendCall) Do(f func(context.Context, scw.Zone, string, *lb.Backend) (*lb.Backend, error)) *MockInterfaceUpdateBackendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceUpdateBackendCall) DoAndReturn(f func(context.Context, scw.Zone, string, *lb.Backend) (*lb.Backend, error)) *MockInterfaceUpdateBackendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateBaremetalServerUserData mocks base method.
+func (m *MockInterface) UpdateBaremetalServerUserData(ctx context.Context, zone scw.Zone, serverID string, userData []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBaremetalServerUserData", ctx, zone, serverID, userData)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBaremetalServerUserData indicates an expected call of UpdateBaremetalServerUserData.
+func (mr *MockInterfaceMockRecorder) UpdateBaremetalServerUserData(ctx, zone, serverID, userData any) *MockInterfaceUpdateBaremetalServerUserDataCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBaremetalServerUserData", reflect.TypeOf((*MockInterface)(nil).UpdateBaremetalServerUserData), ctx, zone, serverID, userData)
+	return &MockInterfaceUpdateBaremetalServerUserDataCall{Call: call}
+}
+
+// MockInterfaceUpdateBaremetalServerUserDataCall wrap *gomock.Call
+type MockInterfaceUpdateBaremetalServerUserDataCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceUpdateBaremetalServerUserDataCall) Return(arg0 error) *MockInterfaceUpdateBaremetalServerUserDataCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceUpdateBaremetalServerUserDataCall) Do(f func(context.Context, scw.Zone, string, []byte) error) *MockInterfaceUpdateBaremetalServerUserDataCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInterfaceUpdateBackendCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, int32) (*lb.Backend, error)) *MockInterfaceUpdateBackendCall {
+func (c *MockInterfaceUpdateBaremetalServerUserDataCall) DoAndReturn(f func(context.Context, scw.Zone, string, []byte) error) *MockInterfaceUpdateBaremetalServerUserDataCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -3063,18 +4461,18 @@ func (c *MockInterfaceUpdateClusterCall) DoAndReturn(f func(context.Context, str
 }
 
 // UpdateHealthCheck mocks base method.
-func (m *MockInterface) UpdateHealthCheck(ctx context.Context, zone scw.Zone, backendID string, port int32) (*lb.HealthCheck, error) {
+func (m *MockInterface) UpdateHealthCheck(ctx context.Context, zone scw.Zone, backendID string, healthCheck *lb.HealthCheck) (*lb.HealthCheck, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateHealthCheck", ctx, zone, backendID, port)
+	ret := m.ctrl.Call(m, "UpdateHealthCheck", ctx, zone, backendID, healthCheck)
 	ret0, _ := ret[0].(*lb.HealthCheck)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateHealthCheck indicates an expected call of UpdateHealthCheck.
-func (mr *MockInterfaceMockRecorder) UpdateHealthCheck(ctx, zone, backendID, port any) *MockInterfaceUpdateHealthCheckCall {
+func (mr *MockInterfaceMockRecorder) UpdateHealthCheck(ctx, zone, backendID, healthCheck any) *MockInterfaceUpdateHealthCheckCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHealthCheck", reflect.TypeOf((*MockInterface)(nil).UpdateHealthCheck), ctx, zone, backendID, port)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHealthCheck", reflect.TypeOf((*MockInterface)(nil).UpdateHealthCheck), ctx, zone, backendID, healthCheck)
 	return &MockInterfaceUpdateHealthCheckCall{Call: call}
 }
 
@@ -3090,13 +4488,51 @@ func (c *MockInterfaceUpdateHealthCheckCall) Return(arg0 *lb.HealthCheck, arg1 e
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInterfaceUpdateHealthCheckCall) Do(f func(context.Context, scw.Zone, string, int32) (*lb.HealthCheck, error)) *MockInterfaceUpdateHealthCheckCall {
+func (c *MockInterfaceUpdateHealthCheckCall) Do(f func(context.Context, scw.Zone, string, *lb.HealthCheck) (*lb.HealthCheck, error)) *MockInterfaceUpdateHealthCheckCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceUpdateHealthCheckCall) DoAndReturn(f func(context.Context, scw.Zone, string, *lb.HealthCheck) (*lb.HealthCheck, error)) *MockInterfaceUpdateHealthCheckCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateIPTags mocks base method.
+func (m *MockInterface) UpdateIPTags(ctx context.Context, zone scw.Zone, ipID string, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIPTags", ctx, zone, ipID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIPTags indicates an expected call of UpdateIPTags.
+func (mr *MockInterfaceMockRecorder) UpdateIPTags(ctx, zone, ipID, tags any) *MockInterfaceUpdateIPTagsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIPTags", reflect.TypeOf((*MockInterface)(nil).UpdateIPTags), ctx, zone, ipID, tags)
+	return &MockInterfaceUpdateIPTagsCall{Call: call}
+}
+
+// MockInterfaceUpdateIPTagsCall wrap *gomock.Call
+type MockInterfaceUpdateIPTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceUpdateIPTagsCall) Return(arg0 error) *MockInterfaceUpdateIPTagsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceUpdateIPTagsCall) Do(f func(context.Context, scw.Zone, string, []string) error) *MockInterfaceUpdateIPTagsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInterfaceUpdateHealthCheckCall) DoAndReturn(f func(context.Context, scw.Zone, string, int32) (*lb.HealthCheck, error)) *MockInterfaceUpdateHealthCheckCall {
+func (c *MockInterfaceUpdateIPTagsCall) DoAndReturn(f func(context.Context, scw.Zone, string, []string) error) *MockInterfaceUpdateIPTagsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -3215,6 +4651,44 @@ func (c *MockInterfaceUpdatePoolCall) DoAndReturn(f func(context.Context, string
 	return c
 }
 
+// UpdateServerProtection mocks base method.
+func (m *MockInterface) UpdateServerProtection(ctx context.Context, zone scw.Zone, id string, protected bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServerProtection", ctx, zone, id, protected)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServerProtection indicates an expected call of UpdateServerProtection.
+func (mr *MockInterfaceMockRecorder) UpdateServerProtection(ctx, zone, id, protected any) *MockInterfaceUpdateServerProtectionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServerProtection", reflect.TypeOf((*MockInterface)(nil).UpdateServerProtection), ctx, zone, id, protected)
+	return &MockInterfaceUpdateServerProtectionCall{Call: call}
+}
+
+// MockInterfaceUpdateServerProtectionCall wrap *gomock.Call
+type MockInterfaceUpdateServerProtectionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceUpdateServerProtectionCall) Return(arg0 error) *MockInterfaceUpdateServerProtectionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceUpdateServerProtectionCall) Do(f func(context.Context, scw.Zone, string, bool) error) *MockInterfaceUpdateServerProtectionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceUpdateServerProtectionCall) DoAndReturn(f func(context.Context, scw.Zone, string, bool) error) *MockInterfaceUpdateServerProtectionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateServerPublicIPs mocks base method.
 func (m *MockInterface) UpdateServerPublicIPs(ctx context.Context, zone scw.Zone, id string, publicIPIDs []string) (*instance.Server, error) {
 	m.ctrl.T.Helper()
@@ -3254,6 +4728,82 @@ func (c *MockInterfaceUpdateServerPublicIPsCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// UpdateServerSecurityGroup mocks base method.
+func (m *MockInterface) UpdateServerSecurityGroup(ctx context.Context, zone scw.Zone, id, securityGroupID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServerSecurityGroup", ctx, zone, id, securityGroupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServerSecurityGroup indicates an expected call of UpdateServerSecurityGroup.
+func (mr *MockInterfaceMockRecorder) UpdateServerSecurityGroup(ctx, zone, id, securityGroupID any) *MockInterfaceUpdateServerSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServerSecurityGroup", reflect.TypeOf((*MockInterface)(nil).UpdateServerSecurityGroup), ctx, zone, id, securityGroupID)
+	return &MockInterfaceUpdateServerSecurityGroupCall{Call: call}
+}
+
+// MockInterfaceUpdateServerSecurityGroupCall wrap *gomock.Call
+type MockInterfaceUpdateServerSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceUpdateServerSecurityGroupCall) Return(arg0 error) *MockInterfaceUpdateServerSecurityGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceUpdateServerSecurityGroupCall) Do(f func(context.Context, scw.Zone, string, string) error) *MockInterfaceUpdateServerSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceUpdateServerSecurityGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) error) *MockInterfaceUpdateServerSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateServerTags mocks base method.
+func (m *MockInterface) UpdateServerTags(ctx context.Context, zone scw.Zone, id string, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServerTags", ctx, zone, id, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServerTags indicates an expected call of UpdateServerTags.
+func (mr *MockInterfaceMockRecorder) UpdateServerTags(ctx, zone, id, tags any) *MockInterfaceUpdateServerTagsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServerTags", reflect.TypeOf((*MockInterface)(nil).UpdateServerTags), ctx, zone, id, tags)
+	return &MockInterfaceUpdateServerTagsCall{Call: call}
+}
+
+// MockInterfaceUpdateServerTagsCall wrap *gomock.Call
+type MockInterfaceUpdateServerTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceUpdateServerTagsCall) Return(arg0 error) *MockInterfaceUpdateServerTagsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceUpdateServerTagsCall) Do(f func(context.Context, scw.Zone, string, []string) error) *MockInterfaceUpdateServerTagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceUpdateServerTagsCall) DoAndReturn(f func(context.Context, scw.Zone, string, []string) error) *MockInterfaceUpdateServerTagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateVolumeIOPS mocks base method.
 func (m *MockInterface) UpdateVolumeIOPS(ctx context.Context, zone scw.Zone, volumeID string, iops int64) error {
 	m.ctrl.T.Helper()
@@ -3292,6 +4842,44 @@ func (c *MockInterfaceUpdateVolumeIOPSCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// UpdateVolumeSize mocks base method.
+func (m *MockInterface) UpdateVolumeSize(ctx context.Context, zone scw.Zone, volumeID string, size scw.Size) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolumeSize", ctx, zone, volumeID, size)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVolumeSize indicates an expected call of UpdateVolumeSize.
+func (mr *MockInterfaceMockRecorder) UpdateVolumeSize(ctx, zone, volumeID, size any) *MockInterfaceUpdateVolumeSizeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolumeSize", reflect.TypeOf((*MockInterface)(nil).UpdateVolumeSize), ctx, zone, volumeID, size)
+	return &MockInterfaceUpdateVolumeSizeCall{Call: call}
+}
+
+// MockInterfaceUpdateVolumeSizeCall wrap *gomock.Call
+type MockInterfaceUpdateVolumeSizeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterfaceUpdateVolumeSizeCall) Return(arg0 error) *MockInterfaceUpdateVolumeSizeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterfaceUpdateVolumeSizeCall) Do(f func(context.Context, scw.Zone, string, scw.Size) error) *MockInterfaceUpdateVolumeSizeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterfaceUpdateVolumeSizeCall) DoAndReturn(f func(context.Context, scw.Zone, string, scw.Size) error) *MockInterfaceUpdateVolumeSizeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateVolumeTags mocks base method.
 func (m *MockInterface) UpdateVolumeTags(ctx context.Context, zone scw.Zone, volumeID string, tags []string) error {
 	m.ctrl.T.Helper()
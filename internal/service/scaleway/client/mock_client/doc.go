@@ -1,3 +1,4 @@
+//go:generate ../../../../../bin/mockgen -destination baremetal_mock.go -package mock_client -source ../baremetal.go -typed
 //go:generate ../../../../../bin/mockgen -destination block_mock.go -package mock_client -source ../block.go -typed
 //go:generate ../../../../../bin/mockgen -destination client_mock.go -package mock_client -source ../interface.go -typed
 //go:generate ../../../../../bin/mockgen -destination config_mock.go -package mock_client -source ../config.go -typed
@@ -131,6 +131,50 @@ func (c *MockInstanceAPICreateIPCall) DoAndReturn(f func(*instance.CreateIPReque
 	return c
 }
 
+// CreatePlacementGroup mocks base method.
+func (m *MockInstanceAPI) CreatePlacementGroup(req *instance.CreatePlacementGroupRequest, opts ...scw.RequestOption) (*instance.CreatePlacementGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePlacementGroup", varargs...)
+	ret0, _ := ret[0].(*instance.CreatePlacementGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlacementGroup indicates an expected call of CreatePlacementGroup.
+func (mr *MockInstanceAPIMockRecorder) CreatePlacementGroup(req any, opts ...any) *MockInstanceAPICreatePlacementGroupCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlacementGroup", reflect.TypeOf((*MockInstanceAPI)(nil).CreatePlacementGroup), varargs...)
+	return &MockInstanceAPICreatePlacementGroupCall{Call: call}
+}
+
+// MockInstanceAPICreatePlacementGroupCall wrap *gomock.Call
+type MockInstanceAPICreatePlacementGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceAPICreatePlacementGroupCall) Return(arg0 *instance.CreatePlacementGroupResponse, arg1 error) *MockInstanceAPICreatePlacementGroupCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceAPICreatePlacementGroupCall) Do(f func(*instance.CreatePlacementGroupRequest, ...scw.RequestOption) (*instance.CreatePlacementGroupResponse, error)) *MockInstanceAPICreatePlacementGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceAPICreatePlacementGroupCall) DoAndReturn(f func(*instance.CreatePlacementGroupRequest, ...scw.RequestOption) (*instance.CreatePlacementGroupResponse, error)) *MockInstanceAPICreatePlacementGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreatePrivateNIC mocks base method.
 func (m *MockInstanceAPI) CreatePrivateNIC(req *instance.CreatePrivateNICRequest, opts ...scw.RequestOption) (*instance.CreatePrivateNICResponse, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +219,50 @@ func (c *MockInstanceAPICreatePrivateNICCall) DoAndReturn(f func(*instance.Creat
 	return c
 }
 
+// CreateSecurityGroup mocks base method.
+func (m *MockInstanceAPI) CreateSecurityGroup(req *instance.CreateSecurityGroupRequest, opts ...scw.RequestOption) (*instance.CreateSecurityGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSecurityGroup", varargs...)
+	ret0, _ := ret[0].(*instance.CreateSecurityGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecurityGroup indicates an expected call of CreateSecurityGroup.
+func (mr *MockInstanceAPIMockRecorder) CreateSecurityGroup(req any, opts ...any) *MockInstanceAPICreateSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroup", reflect.TypeOf((*MockInstanceAPI)(nil).CreateSecurityGroup), varargs...)
+	return &MockInstanceAPICreateSecurityGroupCall{Call: call}
+}
+
+// MockInstanceAPICreateSecurityGroupCall wrap *gomock.Call
+type MockInstanceAPICreateSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceAPICreateSecurityGroupCall) Return(arg0 *instance.CreateSecurityGroupResponse, arg1 error) *MockInstanceAPICreateSecurityGroupCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceAPICreateSecurityGroupCall) Do(f func(*instance.CreateSecurityGroupRequest, ...scw.RequestOption) (*instance.CreateSecurityGroupResponse, error)) *MockInstanceAPICreateSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceAPICreateSecurityGroupCall) DoAndReturn(f func(*instance.CreateSecurityGroupRequest, ...scw.RequestOption) (*instance.CreateSecurityGroupResponse, error)) *MockInstanceAPICreateSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateServer mocks base method.
 func (m *MockInstanceAPI) CreateServer(req *instance.CreateServerRequest, opts ...scw.RequestOption) (*instance.CreateServerResponse, error) {
 	m.ctrl.T.Helper()
@@ -306,6 +394,135 @@ func (c *MockInstanceAPIDeleteIPCall) DoAndReturn(f func(*instance.DeleteIPReque
 	return c
 }
 
+// DeletePlacementGroup mocks base method.
+func (m *MockInstanceAPI) DeletePlacementGroup(req *instance.DeletePlacementGroupRequest, opts ...scw.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePlacementGroup", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlacementGroup indicates an expected call of DeletePlacementGroup.
+func (mr *MockInstanceAPIMockRecorder) DeletePlacementGroup(req any, opts ...any) *MockInstanceAPIDeletePlacementGroupCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlacementGroup", reflect.TypeOf((*MockInstanceAPI)(nil).DeletePlacementGroup), varargs...)
+	return &MockInstanceAPIDeletePlacementGroupCall{Call: call}
+}
+
+// MockInstanceAPIDeletePlacementGroupCall wrap *gomock.Call
+type MockInstanceAPIDeletePlacementGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceAPIDeletePlacementGroupCall) Return(arg0 error) *MockInstanceAPIDeletePlacementGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceAPIDeletePlacementGroupCall) Do(f func(*instance.DeletePlacementGroupRequest, ...scw.RequestOption) error) *MockInstanceAPIDeletePlacementGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceAPIDeletePlacementGroupCall) DoAndReturn(f func(*instance.DeletePlacementGroupRequest, ...scw.RequestOption) error) *MockInstanceAPIDeletePlacementGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeletePrivateNIC mocks base method.
+func (m *MockInstanceAPI) DeletePrivateNIC(req *instance.DeletePrivateNICRequest, opts ...scw.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePrivateNIC", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrivateNIC indicates an expected call of DeletePrivateNIC.
+func (mr *MockInstanceAPIMockRecorder) DeletePrivateNIC(req any, opts ...any) *MockInstanceAPIDeletePrivateNICCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateNIC", reflect.TypeOf((*MockInstanceAPI)(nil).DeletePrivateNIC), varargs...)
+	return &MockInstanceAPIDeletePrivateNICCall{Call: call}
+}
+
+// MockInstanceAPIDeletePrivateNICCall wrap *gomock.Call
+type MockInstanceAPIDeletePrivateNICCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceAPIDeletePrivateNICCall) Return(arg0 error) *MockInstanceAPIDeletePrivateNICCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceAPIDeletePrivateNICCall) Do(f func(*instance.DeletePrivateNICRequest, ...scw.RequestOption) error) *MockInstanceAPIDeletePrivateNICCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceAPIDeletePrivateNICCall) DoAndReturn(f func(*instance.DeletePrivateNICRequest, ...scw.RequestOption) error) *MockInstanceAPIDeletePrivateNICCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSecurityGroup mocks base method.
+func (m *MockInstanceAPI) DeleteSecurityGroup(req *instance.DeleteSecurityGroupRequest, opts ...scw.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSecurityGroup", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecurityGroup indicates an expected call of DeleteSecurityGroup.
+func (mr *MockInstanceAPIMockRecorder) DeleteSecurityGroup(req any, opts ...any) *MockInstanceAPIDeleteSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockInstanceAPI)(nil).DeleteSecurityGroup), varargs...)
+	return &MockInstanceAPIDeleteSecurityGroupCall{Call: call}
+}
+
+// MockInstanceAPIDeleteSecurityGroupCall wrap *gomock.Call
+type MockInstanceAPIDeleteSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceAPIDeleteSecurityGroupCall) Return(arg0 error) *MockInstanceAPIDeleteSecurityGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceAPIDeleteSecurityGroupCall) Do(f func(*instance.DeleteSecurityGroupRequest, ...scw.RequestOption) error) *MockInstanceAPIDeleteSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceAPIDeleteSecurityGroupCall) DoAndReturn(f func(*instance.DeleteSecurityGroupRequest, ...scw.RequestOption) error) *MockInstanceAPIDeleteSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteServer mocks base method.
 func (m *MockInstanceAPI) DeleteServer(req *instance.DeleteServerRequest, opts ...scw.RequestOption) error {
 	m.ctrl.T.Helper()
@@ -523,1199 +740,2132 @@ func (c *MockInstanceAPIGetAllServerUserDataCall) DoAndReturn(f func(*instance.G
 	return c
 }
 
-// GetServerType mocks base method.
-func (m *MockInstanceAPI) GetServerType(req *instance.GetServerTypeRequest) (*instance.ServerType, error) {
+// GetIP mocks base method.
+func (m *MockInstanceAPI) GetIP(req *instance.GetIPRequest, opts ...scw.RequestOption) (*instance.GetIPResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetServerType", req)
-	ret0, _ := ret[0].(*instance.ServerType)
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIP", varargs...)
+	ret0, _ := ret[0].(*instance.GetIPResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetServerType indicates an expected call of GetServerType.
-func (mr *MockInstanceAPIMockRecorder) GetServerType(req any) *MockInstanceAPIGetServerTypeCall {
+// GetIP indicates an expected call of GetIP.
+func (mr *MockInstanceAPIMockRecorder) GetIP(req any, opts ...any) *MockInstanceAPIGetIPCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerType", reflect.TypeOf((*MockInstanceAPI)(nil).GetServerType), req)
-	return &MockInstanceAPIGetServerTypeCall{Call: call}
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIP", reflect.TypeOf((*MockInstanceAPI)(nil).GetIP), varargs...)
+	return &MockInstanceAPIGetIPCall{Call: call}
 }
 
-// MockInstanceAPIGetServerTypeCall wrap *gomock.Call
-type MockInstanceAPIGetServerTypeCall struct {
+// MockInstanceAPIGetIPCall wrap *gomock.Call
+type MockInstanceAPIGetIPCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceAPIGetServerTypeCall) Return(arg0 *instance.ServerType, arg1 error) *MockInstanceAPIGetServerTypeCall {
+func (c *MockInstanceAPIGetIPCall) Return(arg0 *instance.GetIPResponse, arg1 error) *MockInstanceAPIGetIPCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceAPIGetServerTypeCall) Do(f func(*instance.GetServerTypeRequest) (*instance.ServerType, error)) *MockInstanceAPIGetServerTypeCall {
+func (c *MockInstanceAPIGetIPCall) Do(f func(*instance.GetIPRequest, ...scw.RequestOption) (*instance.GetIPResponse, error)) *MockInstanceAPIGetIPCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceAPIGetServerTypeCall) DoAndReturn(f func(*instance.GetServerTypeRequest) (*instance.ServerType, error)) *MockInstanceAPIGetServerTypeCall {
+func (c *MockInstanceAPIGetIPCall) DoAndReturn(f func(*instance.GetIPRequest, ...scw.RequestOption) (*instance.GetIPResponse, error)) *MockInstanceAPIGetIPCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListIPs mocks base method.
-func (m *MockInstanceAPI) ListIPs(req *instance.ListIPsRequest, opts ...scw.RequestOption) (*instance.ListIPsResponse, error) {
+// GetPlacementGroupServers mocks base method.
+func (m *MockInstanceAPI) GetPlacementGroupServers(req *instance.GetPlacementGroupServersRequest, opts ...scw.RequestOption) (*instance.GetPlacementGroupServersResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{req}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListIPs", varargs...)
-	ret0, _ := ret[0].(*instance.ListIPsResponse)
+	ret := m.ctrl.Call(m, "GetPlacementGroupServers", varargs...)
+	ret0, _ := ret[0].(*instance.GetPlacementGroupServersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListIPs indicates an expected call of ListIPs.
-func (mr *MockInstanceAPIMockRecorder) ListIPs(req any, opts ...any) *MockInstanceAPIListIPsCall {
+// GetPlacementGroupServers indicates an expected call of GetPlacementGroupServers.
+func (mr *MockInstanceAPIMockRecorder) GetPlacementGroupServers(req any, opts ...any) *MockInstanceAPIGetPlacementGroupServersCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{req}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIPs", reflect.TypeOf((*MockInstanceAPI)(nil).ListIPs), varargs...)
-	return &MockInstanceAPIListIPsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlacementGroupServers", reflect.TypeOf((*MockInstanceAPI)(nil).GetPlacementGroupServers), varargs...)
+	return &MockInstanceAPIGetPlacementGroupServersCall{Call: call}
 }
 
-// MockInstanceAPIListIPsCall wrap *gomock.Call
-type MockInstanceAPIListIPsCall struct {
+// MockInstanceAPIGetPlacementGroupServersCall wrap *gomock.Call
+type MockInstanceAPIGetPlacementGroupServersCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceAPIListIPsCall) Return(arg0 *instance.ListIPsResponse, arg1 error) *MockInstanceAPIListIPsCall {
+func (c *MockInstanceAPIGetPlacementGroupServersCall) Return(arg0 *instance.GetPlacementGroupServersResponse, arg1 error) *MockInstanceAPIGetPlacementGroupServersCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceAPIListIPsCall) Do(f func(*instance.ListIPsRequest, ...scw.RequestOption) (*instance.ListIPsResponse, error)) *MockInstanceAPIListIPsCall {
+func (c *MockInstanceAPIGetPlacementGroupServersCall) Do(f func(*instance.GetPlacementGroupServersRequest, ...scw.RequestOption) (*instance.GetPlacementGroupServersResponse, error)) *MockInstanceAPIGetPlacementGroupServersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceAPIListIPsCall) DoAndReturn(f func(*instance.ListIPsRequest, ...scw.RequestOption) (*instance.ListIPsResponse, error)) *MockInstanceAPIListIPsCall {
+func (c *MockInstanceAPIGetPlacementGroupServersCall) DoAndReturn(f func(*instance.GetPlacementGroupServersRequest, ...scw.RequestOption) (*instance.GetPlacementGroupServersResponse, error)) *MockInstanceAPIGetPlacementGroupServersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListImages mocks base method.
-func (m *MockInstanceAPI) ListImages(req *instance.ListImagesRequest, opts ...scw.RequestOption) (*instance.ListImagesResponse, error) {
+// GetServer mocks base method.
+func (m *MockInstanceAPI) GetServer(req *instance.GetServerRequest, opts ...scw.RequestOption) (*instance.GetServerResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{req}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListImages", varargs...)
-	ret0, _ := ret[0].(*instance.ListImagesResponse)
+	ret := m.ctrl.Call(m, "GetServer", varargs...)
+	ret0, _ := ret[0].(*instance.GetServerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListImages indicates an expected call of ListImages.
-func (mr *MockInstanceAPIMockRecorder) ListImages(req any, opts ...any) *MockInstanceAPIListImagesCall {
+// GetServer indicates an expected call of GetServer.
+func (mr *MockInstanceAPIMockRecorder) GetServer(req any, opts ...any) *MockInstanceAPIGetServerCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{req}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockInstanceAPI)(nil).ListImages), varargs...)
-	return &MockInstanceAPIListImagesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServer", reflect.TypeOf((*MockInstanceAPI)(nil).GetServer), varargs...)
+	return &MockInstanceAPIGetServerCall{Call: call}
 }
 
-// MockInstanceAPIListImagesCall wrap *gomock.Call
-type MockInstanceAPIListImagesCall struct {
+// MockInstanceAPIGetServerCall wrap *gomock.Call
+type MockInstanceAPIGetServerCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceAPIListImagesCall) Return(arg0 *instance.ListImagesResponse, arg1 error) *MockInstanceAPIListImagesCall {
+func (c *MockInstanceAPIGetServerCall) Return(arg0 *instance.GetServerResponse, arg1 error) *MockInstanceAPIGetServerCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceAPIListImagesCall) Do(f func(*instance.ListImagesRequest, ...scw.RequestOption) (*instance.ListImagesResponse, error)) *MockInstanceAPIListImagesCall {
+func (c *MockInstanceAPIGetServerCall) Do(f func(*instance.GetServerRequest, ...scw.RequestOption) (*instance.GetServerResponse, error)) *MockInstanceAPIGetServerCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceAPIListImagesCall) DoAndReturn(f func(*instance.ListImagesRequest, ...scw.RequestOption) (*instance.ListImagesResponse, error)) *MockInstanceAPIListImagesCall {
+func (c *MockInstanceAPIGetServerCall) DoAndReturn(f func(*instance.GetServerRequest, ...scw.RequestOption) (*instance.GetServerResponse, error)) *MockInstanceAPIGetServerCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListPlacementGroups mocks base method.
-func (m *MockInstanceAPI) ListPlacementGroups(req *instance.ListPlacementGroupsRequest, opts ...scw.RequestOption) (*instance.ListPlacementGroupsResponse, error) {
+// GetServerTypesAvailability mocks base method.
+func (m *MockInstanceAPI) GetServerTypesAvailability(req *instance.GetServerTypesAvailabilityRequest, opts ...scw.RequestOption) (*instance.GetServerTypesAvailabilityResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{req}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListPlacementGroups", varargs...)
-	ret0, _ := ret[0].(*instance.ListPlacementGroupsResponse)
+	ret := m.ctrl.Call(m, "GetServerTypesAvailability", varargs...)
+	ret0, _ := ret[0].(*instance.GetServerTypesAvailabilityResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListPlacementGroups indicates an expected call of ListPlacementGroups.
-func (mr *MockInstanceAPIMockRecorder) ListPlacementGroups(req any, opts ...any) *MockInstanceAPIListPlacementGroupsCall {
+// GetServerTypesAvailability indicates an expected call of GetServerTypesAvailability.
+func (mr *MockInstanceAPIMockRecorder) GetServerTypesAvailability(req any, opts ...any) *MockInstanceAPIGetServerTypesAvailabilityCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{req}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlacementGroups", reflect.TypeOf((*MockInstanceAPI)(nil).ListPlacementGroups), varargs...)
-	return &MockInstanceAPIListPlacementGroupsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerTypesAvailability", reflect.TypeOf((*MockInstanceAPI)(nil).GetServerTypesAvailability), varargs...)
+	return &MockInstanceAPIGetServerTypesAvailabilityCall{Call: call}
 }
 
-// MockInstanceAPIListPlacementGroupsCall wrap *gomock.Call
-type MockInstanceAPIListPlacementGroupsCall struct {
+// MockInstanceAPIGetServerTypesAvailabilityCall wrap *gomock.Call
+type MockInstanceAPIGetServerTypesAvailabilityCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceAPIListPlacementGroupsCall) Return(arg0 *instance.ListPlacementGroupsResponse, arg1 error) *MockInstanceAPIListPlacementGroupsCall {
+func (c *MockInstanceAPIGetServerTypesAvailabilityCall) Return(arg0 *instance.GetServerTypesAvailabilityResponse, arg1 error) *MockInstanceAPIGetServerTypesAvailabilityCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceAPIListPlacementGroupsCall) Do(f func(*instance.ListPlacementGroupsRequest, ...scw.RequestOption) (*instance.ListPlacementGroupsResponse, error)) *MockInstanceAPIListPlacementGroupsCall {
+func (c *MockInstanceAPIGetServerTypesAvailabilityCall) Do(f func(*instance.GetServerTypesAvailabilityRequest, ...scw.RequestOption) (*instance.GetServerTypesAvailabilityResponse, error)) *MockInstanceAPIGetServerTypesAvailabilityCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceAPIListPlacementGroupsCall) DoAndReturn(f func(*instance.ListPlacementGroupsRequest, ...scw.RequestOption) (*instance.ListPlacementGroupsResponse, error)) *MockInstanceAPIListPlacementGroupsCall {
+func (c *MockInstanceAPIGetServerTypesAvailabilityCall) DoAndReturn(f func(*instance.GetServerTypesAvailabilityRequest, ...scw.RequestOption) (*instance.GetServerTypesAvailabilityResponse, error)) *MockInstanceAPIGetServerTypesAvailabilityCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListSecurityGroups mocks base method.
-func (m *MockInstanceAPI) ListSecurityGroups(req *instance.ListSecurityGroupsRequest, opts ...scw.RequestOption) (*instance.ListSecurityGroupsResponse, error) {
+// ListIPs mocks base method.
+func (m *MockInstanceAPI) ListIPs(req *instance.ListIPsRequest, opts ...scw.RequestOption) (*instance.ListIPsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{req}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListSecurityGroups", varargs...)
-	ret0, _ := ret[0].(*instance.ListSecurityGroupsResponse)
+	ret := m.ctrl.Call(m, "ListIPs", varargs...)
+	ret0, _ := ret[0].(*instance.ListIPsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListSecurityGroups indicates an expected call of ListSecurityGroups.
-func (mr *MockInstanceAPIMockRecorder) ListSecurityGroups(req any, opts ...any) *MockInstanceAPIListSecurityGroupsCall {
+// ListIPs indicates an expected call of ListIPs.
+func (mr *MockInstanceAPIMockRecorder) ListIPs(req any, opts ...any) *MockInstanceAPIListIPsCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{req}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroups", reflect.TypeOf((*MockInstanceAPI)(nil).ListSecurityGroups), varargs...)
-	return &MockInstanceAPIListSecurityGroupsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIPs", reflect.TypeOf((*MockInstanceAPI)(nil).ListIPs), varargs...)
+	return &MockInstanceAPIListIPsCall{Call: call}
 }
 
-// MockInstanceAPIListSecurityGroupsCall wrap *gomock.Call
-type MockInstanceAPIListSecurityGroupsCall struct {
+// MockInstanceAPIListIPsCall wrap *gomock.Call
+type MockInstanceAPIListIPsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceAPIListSecurityGroupsCall) Return(arg0 *instance.ListSecurityGroupsResponse, arg1 error) *MockInstanceAPIListSecurityGroupsCall {
+func (c *MockInstanceAPIListIPsCall) Return(arg0 *instance.ListIPsResponse, arg1 error) *MockInstanceAPIListIPsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceAPIListSecurityGroupsCall) Do(f func(*instance.ListSecurityGroupsRequest, ...scw.RequestOption) (*instance.ListSecurityGroupsResponse, error)) *MockInstanceAPIListSecurityGroupsCall {
+func (c *MockInstanceAPIListIPsCall) Do(f func(*instance.ListIPsRequest, ...scw.RequestOption) (*instance.ListIPsResponse, error)) *MockInstanceAPIListIPsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceAPIListSecurityGroupsCall) DoAndReturn(f func(*instance.ListSecurityGroupsRequest, ...scw.RequestOption) (*instance.ListSecurityGroupsResponse, error)) *MockInstanceAPIListSecurityGroupsCall {
+func (c *MockInstanceAPIListIPsCall) DoAndReturn(f func(*instance.ListIPsRequest, ...scw.RequestOption) (*instance.ListIPsResponse, error)) *MockInstanceAPIListIPsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListServers mocks base method.
-func (m *MockInstanceAPI) ListServers(req *instance.ListServersRequest, opts ...scw.RequestOption) (*instance.ListServersResponse, error) {
+// ListImages mocks base method.
+func (m *MockInstanceAPI) ListImages(req *instance.ListImagesRequest, opts ...scw.RequestOption) (*instance.ListImagesResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{req}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListServers", varargs...)
-	ret0, _ := ret[0].(*instance.ListServersResponse)
+	ret := m.ctrl.Call(m, "ListImages", varargs...)
+	ret0, _ := ret[0].(*instance.ListImagesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListServers indicates an expected call of ListServers.
-func (mr *MockInstanceAPIMockRecorder) ListServers(req any, opts ...any) *MockInstanceAPIListServersCall {
+// ListImages indicates an expected call of ListImages.
+func (mr *MockInstanceAPIMockRecorder) ListImages(req any, opts ...any) *MockInstanceAPIListImagesCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{req}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServers", reflect.TypeOf((*MockInstanceAPI)(nil).ListServers), varargs...)
-	return &MockInstanceAPIListServersCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockInstanceAPI)(nil).ListImages), varargs...)
+	return &MockInstanceAPIListImagesCall{Call: call}
 }
 
-// MockInstanceAPIListServersCall wrap *gomock.Call
-type MockInstanceAPIListServersCall struct {
+// MockInstanceAPIListImagesCall wrap *gomock.Call
+type MockInstanceAPIListImagesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceAPIListServersCall) Return(arg0 *instance.ListServersResponse, arg1 error) *MockInstanceAPIListServersCall {
+func (c *MockInstanceAPIListImagesCall) Return(arg0 *instance.ListImagesResponse, arg1 error) *MockInstanceAPIListImagesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceAPIListServersCall) Do(f func(*instance.ListServersRequest, ...scw.RequestOption) (*instance.ListServersResponse, error)) *MockInstanceAPIListServersCall {
+func (c *MockInstanceAPIListImagesCall) Do(f func(*instance.ListImagesRequest, ...scw.RequestOption) (*instance.ListImagesResponse, error)) *MockInstanceAPIListImagesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceAPIListServersCall) DoAndReturn(f func(*instance.ListServersRequest, ...scw.RequestOption) (*instance.ListServersResponse, error)) *MockInstanceAPIListServersCall {
+func (c *MockInstanceAPIListImagesCall) DoAndReturn(f func(*instance.ListImagesRequest, ...scw.RequestOption) (*instance.ListImagesResponse, error)) *MockInstanceAPIListImagesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ListVolumes mocks base method.
-func (m *MockInstanceAPI) ListVolumes(req *instance.ListVolumesRequest, opts ...scw.RequestOption) (*instance.ListVolumesResponse, error) {
+// ListPlacementGroups mocks base method.
+func (m *MockInstanceAPI) ListPlacementGroups(req *instance.ListPlacementGroupsRequest, opts ...scw.RequestOption) (*instance.ListPlacementGroupsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{req}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListVolumes", varargs...)
-	ret0, _ := ret[0].(*instance.ListVolumesResponse)
+	ret := m.ctrl.Call(m, "ListPlacementGroups", varargs...)
+	ret0, _ := ret[0].(*instance.ListPlacementGroupsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVolumes indicates an expected call of ListVolumes.
-func (mr *MockInstanceAPIMockRecorder) ListVolumes(req any, opts ...any) *MockInstanceAPIListVolumesCall {
+// ListPlacementGroups indicates an expected call of ListPlacementGroups.
+func (mr *MockInstanceAPIMockRecorder) ListPlacementGroups(req any, opts ...any) *MockInstanceAPIListPlacementGroupsCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{req}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockInstanceAPI)(nil).ListVolumes), varargs...)
-	return &MockInstanceAPIListVolumesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlacementGroups", reflect.TypeOf((*MockInstanceAPI)(nil).ListPlacementGroups), varargs...)
+	return &MockInstanceAPIListPlacementGroupsCall{Call: call}
 }
 
-// MockInstanceAPIListVolumesCall wrap *gomock.Call
-type MockInstanceAPIListVolumesCall struct {
+// MockInstanceAPIListPlacementGroupsCall wrap *gomock.Call
+type MockInstanceAPIListPlacementGroupsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceAPIListVolumesCall) Return(arg0 *instance.ListVolumesResponse, arg1 error) *MockInstanceAPIListVolumesCall {
+func (c *MockInstanceAPIListPlacementGroupsCall) Return(arg0 *instance.ListPlacementGroupsResponse, arg1 error) *MockInstanceAPIListPlacementGroupsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceAPIListVolumesCall) Do(f func(*instance.ListVolumesRequest, ...scw.RequestOption) (*instance.ListVolumesResponse, error)) *MockInstanceAPIListVolumesCall {
+func (c *MockInstanceAPIListPlacementGroupsCall) Do(f func(*instance.ListPlacementGroupsRequest, ...scw.RequestOption) (*instance.ListPlacementGroupsResponse, error)) *MockInstanceAPIListPlacementGroupsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceAPIListVolumesCall) DoAndReturn(f func(*instance.ListVolumesRequest, ...scw.RequestOption) (*instance.ListVolumesResponse, error)) *MockInstanceAPIListVolumesCall {
+func (c *MockInstanceAPIListPlacementGroupsCall) DoAndReturn(f func(*instance.ListPlacementGroupsRequest, ...scw.RequestOption) (*instance.ListPlacementGroupsResponse, error)) *MockInstanceAPIListPlacementGroupsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ServerAction mocks base method.
-func (m *MockInstanceAPI) ServerAction(req *instance.ServerActionRequest, opts ...scw.RequestOption) (*instance.ServerActionResponse, error) {
+// ListSecurityGroupRules mocks base method.
+func (m *MockInstanceAPI) ListSecurityGroupRules(req *instance.ListSecurityGroupRulesRequest, opts ...scw.RequestOption) (*instance.ListSecurityGroupRulesResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{req}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ServerAction", varargs...)
-	ret0, _ := ret[0].(*instance.ServerActionResponse)
+	ret := m.ctrl.Call(m, "ListSecurityGroupRules", varargs...)
+	ret0, _ := ret[0].(*instance.ListSecurityGroupRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ServerAction indicates an expected call of ServerAction.
-func (mr *MockInstanceAPIMockRecorder) ServerAction(req any, opts ...any) *MockInstanceAPIServerActionCall {
+// ListSecurityGroupRules indicates an expected call of ListSecurityGroupRules.
+func (mr *MockInstanceAPIMockRecorder) ListSecurityGroupRules(req any, opts ...any) *MockInstanceAPIListSecurityGroupRulesCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{req}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerAction", reflect.TypeOf((*MockInstanceAPI)(nil).ServerAction), varargs...)
-	return &MockInstanceAPIServerActionCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroupRules", reflect.TypeOf((*MockInstanceAPI)(nil).ListSecurityGroupRules), varargs...)
+	return &MockInstanceAPIListSecurityGroupRulesCall{Call: call}
 }
 
-// MockInstanceAPIServerActionCall wrap *gomock.Call
-type MockInstanceAPIServerActionCall struct {
+// MockInstanceAPIListSecurityGroupRulesCall wrap *gomock.Call
+type MockInstanceAPIListSecurityGroupRulesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceAPIServerActionCall) Return(arg0 *instance.ServerActionResponse, arg1 error) *MockInstanceAPIServerActionCall {
+func (c *MockInstanceAPIListSecurityGroupRulesCall) Return(arg0 *instance.ListSecurityGroupRulesResponse, arg1 error) *MockInstanceAPIListSecurityGroupRulesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceAPIServerActionCall) Do(f func(*instance.ServerActionRequest, ...scw.RequestOption) (*instance.ServerActionResponse, error)) *MockInstanceAPIServerActionCall {
+func (c *MockInstanceAPIListSecurityGroupRulesCall) Do(f func(*instance.ListSecurityGroupRulesRequest, ...scw.RequestOption) (*instance.ListSecurityGroupRulesResponse, error)) *MockInstanceAPIListSecurityGroupRulesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceAPIServerActionCall) DoAndReturn(f func(*instance.ServerActionRequest, ...scw.RequestOption) (*instance.ServerActionResponse, error)) *MockInstanceAPIServerActionCall {
+func (c *MockInstanceAPIListSecurityGroupRulesCall) DoAndReturn(f func(*instance.ListSecurityGroupRulesRequest, ...scw.RequestOption) (*instance.ListSecurityGroupRulesResponse, error)) *MockInstanceAPIListSecurityGroupRulesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SetServerUserData mocks base method.
-func (m *MockInstanceAPI) SetServerUserData(req *instance.SetServerUserDataRequest, opts ...scw.RequestOption) error {
+// ListSecurityGroups mocks base method.
+func (m *MockInstanceAPI) ListSecurityGroups(req *instance.ListSecurityGroupsRequest, opts ...scw.RequestOption) (*instance.ListSecurityGroupsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{req}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SetServerUserData", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListSecurityGroups", varargs...)
+	ret0, _ := ret[0].(*instance.ListSecurityGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SetServerUserData indicates an expected call of SetServerUserData.
-func (mr *MockInstanceAPIMockRecorder) SetServerUserData(req any, opts ...any) *MockInstanceAPISetServerUserDataCall {
+// ListSecurityGroups indicates an expected call of ListSecurityGroups.
+func (mr *MockInstanceAPIMockRecorder) ListSecurityGroups(req any, opts ...any) *MockInstanceAPIListSecurityGroupsCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{req}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServerUserData", reflect.TypeOf((*MockInstanceAPI)(nil).SetServerUserData), varargs...)
-	return &MockInstanceAPISetServerUserDataCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroups", reflect.TypeOf((*MockInstanceAPI)(nil).ListSecurityGroups), varargs...)
+	return &MockInstanceAPIListSecurityGroupsCall{Call: call}
 }
 
-// MockInstanceAPISetServerUserDataCall wrap *gomock.Call
-type MockInstanceAPISetServerUserDataCall struct {
+// MockInstanceAPIListSecurityGroupsCall wrap *gomock.Call
+type MockInstanceAPIListSecurityGroupsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceAPISetServerUserDataCall) Return(arg0 error) *MockInstanceAPISetServerUserDataCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockInstanceAPIListSecurityGroupsCall) Return(arg0 *instance.ListSecurityGroupsResponse, arg1 error) *MockInstanceAPIListSecurityGroupsCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceAPISetServerUserDataCall) Do(f func(*instance.SetServerUserDataRequest, ...scw.RequestOption) error) *MockInstanceAPISetServerUserDataCall {
+func (c *MockInstanceAPIListSecurityGroupsCall) Do(f func(*instance.ListSecurityGroupsRequest, ...scw.RequestOption) (*instance.ListSecurityGroupsResponse, error)) *MockInstanceAPIListSecurityGroupsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceAPISetServerUserDataCall) DoAndReturn(f func(*instance.SetServerUserDataRequest, ...scw.RequestOption) error) *MockInstanceAPISetServerUserDataCall {
+func (c *MockInstanceAPIListSecurityGroupsCall) DoAndReturn(f func(*instance.ListSecurityGroupsRequest, ...scw.RequestOption) (*instance.ListSecurityGroupsResponse, error)) *MockInstanceAPIListSecurityGroupsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// UpdateServer mocks base method.
-func (m *MockInstanceAPI) UpdateServer(req *instance.UpdateServerRequest, opts ...scw.RequestOption) (*instance.UpdateServerResponse, error) {
+// ListServers mocks base method.
+func (m *MockInstanceAPI) ListServers(req *instance.ListServersRequest, opts ...scw.RequestOption) (*instance.ListServersResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{req}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateServer", varargs...)
-	ret0, _ := ret[0].(*instance.UpdateServerResponse)
+	ret := m.ctrl.Call(m, "ListServers", varargs...)
+	ret0, _ := ret[0].(*instance.ListServersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateServer indicates an expected call of UpdateServer.
-func (mr *MockInstanceAPIMockRecorder) UpdateServer(req any, opts ...any) *MockInstanceAPIUpdateServerCall {
+// ListServers indicates an expected call of ListServers.
+func (mr *MockInstanceAPIMockRecorder) ListServers(req any, opts ...any) *MockInstanceAPIListServersCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{req}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServer", reflect.TypeOf((*MockInstanceAPI)(nil).UpdateServer), varargs...)
-	return &MockInstanceAPIUpdateServerCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServers", reflect.TypeOf((*MockInstanceAPI)(nil).ListServers), varargs...)
+	return &MockInstanceAPIListServersCall{Call: call}
 }
 
-// MockInstanceAPIUpdateServerCall wrap *gomock.Call
-type MockInstanceAPIUpdateServerCall struct {
+// MockInstanceAPIListServersCall wrap *gomock.Call
+type MockInstanceAPIListServersCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceAPIUpdateServerCall) Return(arg0 *instance.UpdateServerResponse, arg1 error) *MockInstanceAPIUpdateServerCall {
+func (c *MockInstanceAPIListServersCall) Return(arg0 *instance.ListServersResponse, arg1 error) *MockInstanceAPIListServersCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceAPIUpdateServerCall) Do(f func(*instance.UpdateServerRequest, ...scw.RequestOption) (*instance.UpdateServerResponse, error)) *MockInstanceAPIUpdateServerCall {
+func (c *MockInstanceAPIListServersCall) Do(f func(*instance.ListServersRequest, ...scw.RequestOption) (*instance.ListServersResponse, error)) *MockInstanceAPIListServersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceAPIUpdateServerCall) DoAndReturn(f func(*instance.UpdateServerRequest, ...scw.RequestOption) (*instance.UpdateServerResponse, error)) *MockInstanceAPIUpdateServerCall {
+func (c *MockInstanceAPIListServersCall) DoAndReturn(f func(*instance.ListServersRequest, ...scw.RequestOption) (*instance.ListServersResponse, error)) *MockInstanceAPIListServersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// UpdateVolume mocks base method.
-func (m *MockInstanceAPI) UpdateVolume(req *instance.UpdateVolumeRequest, opts ...scw.RequestOption) (*instance.UpdateVolumeResponse, error) {
+// ListServersTypes mocks base method.
+func (m *MockInstanceAPI) ListServersTypes(req *instance.ListServersTypesRequest, opts ...scw.RequestOption) (*instance.ListServersTypesResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{req}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateVolume", varargs...)
-	ret0, _ := ret[0].(*instance.UpdateVolumeResponse)
+	ret := m.ctrl.Call(m, "ListServersTypes", varargs...)
+	ret0, _ := ret[0].(*instance.ListServersTypesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateVolume indicates an expected call of UpdateVolume.
-func (mr *MockInstanceAPIMockRecorder) UpdateVolume(req any, opts ...any) *MockInstanceAPIUpdateVolumeCall {
+// ListServersTypes indicates an expected call of ListServersTypes.
+func (mr *MockInstanceAPIMockRecorder) ListServersTypes(req any, opts ...any) *MockInstanceAPIListServersTypesCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{req}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolume", reflect.TypeOf((*MockInstanceAPI)(nil).UpdateVolume), varargs...)
-	return &MockInstanceAPIUpdateVolumeCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServersTypes", reflect.TypeOf((*MockInstanceAPI)(nil).ListServersTypes), varargs...)
+	return &MockInstanceAPIListServersTypesCall{Call: call}
 }
 
-// MockInstanceAPIUpdateVolumeCall wrap *gomock.Call
-type MockInstanceAPIUpdateVolumeCall struct {
+// MockInstanceAPIListServersTypesCall wrap *gomock.Call
+type MockInstanceAPIListServersTypesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceAPIUpdateVolumeCall) Return(arg0 *instance.UpdateVolumeResponse, arg1 error) *MockInstanceAPIUpdateVolumeCall {
+func (c *MockInstanceAPIListServersTypesCall) Return(arg0 *instance.ListServersTypesResponse, arg1 error) *MockInstanceAPIListServersTypesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceAPIUpdateVolumeCall) Do(f func(*instance.UpdateVolumeRequest, ...scw.RequestOption) (*instance.UpdateVolumeResponse, error)) *MockInstanceAPIUpdateVolumeCall {
+func (c *MockInstanceAPIListServersTypesCall) Do(f func(*instance.ListServersTypesRequest, ...scw.RequestOption) (*instance.ListServersTypesResponse, error)) *MockInstanceAPIListServersTypesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceAPIUpdateVolumeCall) DoAndReturn(f func(*instance.UpdateVolumeRequest, ...scw.RequestOption) (*instance.UpdateVolumeResponse, error)) *MockInstanceAPIUpdateVolumeCall {
+func (c *MockInstanceAPIListServersTypesCall) DoAndReturn(f func(*instance.ListServersTypesRequest, ...scw.RequestOption) (*instance.ListServersTypesResponse, error)) *MockInstanceAPIListServersTypesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// Zones mocks base method.
-func (m *MockInstanceAPI) Zones() []scw.Zone {
+// ListVolumes mocks base method.
+func (m *MockInstanceAPI) ListVolumes(req *instance.ListVolumesRequest, opts ...scw.RequestOption) (*instance.ListVolumesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Zones")
-	ret0, _ := ret[0].([]scw.Zone)
-	return ret0
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumes", varargs...)
+	ret0, _ := ret[0].(*instance.ListVolumesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Zones indicates an expected call of Zones.
-func (mr *MockInstanceAPIMockRecorder) Zones() *MockInstanceAPIZonesCall {
+// ListVolumes indicates an expected call of ListVolumes.
+func (mr *MockInstanceAPIMockRecorder) ListVolumes(req any, opts ...any) *MockInstanceAPIListVolumesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Zones", reflect.TypeOf((*MockInstanceAPI)(nil).Zones))
-	return &MockInstanceAPIZonesCall{Call: call}
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockInstanceAPI)(nil).ListVolumes), varargs...)
+	return &MockInstanceAPIListVolumesCall{Call: call}
 }
 
-// MockInstanceAPIZonesCall wrap *gomock.Call
-type MockInstanceAPIZonesCall struct {
+// MockInstanceAPIListVolumesCall wrap *gomock.Call
+type MockInstanceAPIListVolumesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceAPIZonesCall) Return(arg0 []scw.Zone) *MockInstanceAPIZonesCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockInstanceAPIListVolumesCall) Return(arg0 *instance.ListVolumesResponse, arg1 error) *MockInstanceAPIListVolumesCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceAPIZonesCall) Do(f func() []scw.Zone) *MockInstanceAPIZonesCall {
+func (c *MockInstanceAPIListVolumesCall) Do(f func(*instance.ListVolumesRequest, ...scw.RequestOption) (*instance.ListVolumesResponse, error)) *MockInstanceAPIListVolumesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceAPIZonesCall) DoAndReturn(f func() []scw.Zone) *MockInstanceAPIZonesCall {
+func (c *MockInstanceAPIListVolumesCall) DoAndReturn(f func(*instance.ListVolumesRequest, ...scw.RequestOption) (*instance.ListVolumesResponse, error)) *MockInstanceAPIListVolumesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// MockInstance is a mock of Instance interface.
-type MockInstance struct {
-	ctrl     *gomock.Controller
-	recorder *MockInstanceMockRecorder
-	isgomock struct{}
-}
-
-// MockInstanceMockRecorder is the mock recorder for MockInstance.
-type MockInstanceMockRecorder struct {
-	mock *MockInstance
-}
-
-// NewMockInstance creates a new mock instance.
-func NewMockInstance(ctrl *gomock.Controller) *MockInstance {
-	mock := &MockInstance{ctrl: ctrl}
-	mock.recorder = &MockInstanceMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockInstance) EXPECT() *MockInstanceMockRecorder {
-	return m.recorder
-}
-
-// AttachServerVolume mocks base method.
-func (m *MockInstance) AttachServerVolume(ctx context.Context, zone scw.Zone, serverID, volumeID string, local bool) error {
+// ServerAction mocks base method.
+func (m *MockInstanceAPI) ServerAction(req *instance.ServerActionRequest, opts ...scw.RequestOption) (*instance.ServerActionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AttachServerVolume", ctx, zone, serverID, volumeID, local)
-	ret0, _ := ret[0].(error)
-	return ret0
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ServerAction", varargs...)
+	ret0, _ := ret[0].(*instance.ServerActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// AttachServerVolume indicates an expected call of AttachServerVolume.
-func (mr *MockInstanceMockRecorder) AttachServerVolume(ctx, zone, serverID, volumeID, local any) *MockInstanceAttachServerVolumeCall {
+// ServerAction indicates an expected call of ServerAction.
+func (mr *MockInstanceAPIMockRecorder) ServerAction(req any, opts ...any) *MockInstanceAPIServerActionCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachServerVolume", reflect.TypeOf((*MockInstance)(nil).AttachServerVolume), ctx, zone, serverID, volumeID, local)
-	return &MockInstanceAttachServerVolumeCall{Call: call}
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerAction", reflect.TypeOf((*MockInstanceAPI)(nil).ServerAction), varargs...)
+	return &MockInstanceAPIServerActionCall{Call: call}
 }
 
-// MockInstanceAttachServerVolumeCall wrap *gomock.Call
-type MockInstanceAttachServerVolumeCall struct {
+// MockInstanceAPIServerActionCall wrap *gomock.Call
+type MockInstanceAPIServerActionCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceAttachServerVolumeCall) Return(arg0 error) *MockInstanceAttachServerVolumeCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockInstanceAPIServerActionCall) Return(arg0 *instance.ServerActionResponse, arg1 error) *MockInstanceAPIServerActionCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceAttachServerVolumeCall) Do(f func(context.Context, scw.Zone, string, string, bool) error) *MockInstanceAttachServerVolumeCall {
+func (c *MockInstanceAPIServerActionCall) Do(f func(*instance.ServerActionRequest, ...scw.RequestOption) (*instance.ServerActionResponse, error)) *MockInstanceAPIServerActionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceAttachServerVolumeCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, bool) error) *MockInstanceAttachServerVolumeCall {
+func (c *MockInstanceAPIServerActionCall) DoAndReturn(f func(*instance.ServerActionRequest, ...scw.RequestOption) (*instance.ServerActionResponse, error)) *MockInstanceAPIServerActionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// CreateIP mocks base method.
-func (m *MockInstance) CreateIP(ctx context.Context, zone scw.Zone, ipType instance.IPType, tags []string) (*instance.IP, error) {
+// SetSecurityGroupRules mocks base method.
+func (m *MockInstanceAPI) SetSecurityGroupRules(req *instance.SetSecurityGroupRulesRequest, opts ...scw.RequestOption) (*instance.SetSecurityGroupRulesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateIP", ctx, zone, ipType, tags)
-	ret0, _ := ret[0].(*instance.IP)
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetSecurityGroupRules", varargs...)
+	ret0, _ := ret[0].(*instance.SetSecurityGroupRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateIP indicates an expected call of CreateIP.
-func (mr *MockInstanceMockRecorder) CreateIP(ctx, zone, ipType, tags any) *MockInstanceCreateIPCall {
+// SetSecurityGroupRules indicates an expected call of SetSecurityGroupRules.
+func (mr *MockInstanceAPIMockRecorder) SetSecurityGroupRules(req any, opts ...any) *MockInstanceAPISetSecurityGroupRulesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIP", reflect.TypeOf((*MockInstance)(nil).CreateIP), ctx, zone, ipType, tags)
-	return &MockInstanceCreateIPCall{Call: call}
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecurityGroupRules", reflect.TypeOf((*MockInstanceAPI)(nil).SetSecurityGroupRules), varargs...)
+	return &MockInstanceAPISetSecurityGroupRulesCall{Call: call}
 }
 
-// MockInstanceCreateIPCall wrap *gomock.Call
-type MockInstanceCreateIPCall struct {
+// MockInstanceAPISetSecurityGroupRulesCall wrap *gomock.Call
+type MockInstanceAPISetSecurityGroupRulesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceCreateIPCall) Return(arg0 *instance.IP, arg1 error) *MockInstanceCreateIPCall {
+func (c *MockInstanceAPISetSecurityGroupRulesCall) Return(arg0 *instance.SetSecurityGroupRulesResponse, arg1 error) *MockInstanceAPISetSecurityGroupRulesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceCreateIPCall) Do(f func(context.Context, scw.Zone, instance.IPType, []string) (*instance.IP, error)) *MockInstanceCreateIPCall {
+func (c *MockInstanceAPISetSecurityGroupRulesCall) Do(f func(*instance.SetSecurityGroupRulesRequest, ...scw.RequestOption) (*instance.SetSecurityGroupRulesResponse, error)) *MockInstanceAPISetSecurityGroupRulesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceCreateIPCall) DoAndReturn(f func(context.Context, scw.Zone, instance.IPType, []string) (*instance.IP, error)) *MockInstanceCreateIPCall {
+func (c *MockInstanceAPISetSecurityGroupRulesCall) DoAndReturn(f func(*instance.SetSecurityGroupRulesRequest, ...scw.RequestOption) (*instance.SetSecurityGroupRulesResponse, error)) *MockInstanceAPISetSecurityGroupRulesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// CreateInstanceVolume mocks base method.
-func (m *MockInstance) CreateInstanceVolume(ctx context.Context, zone scw.Zone, name string, size scw.Size, tags []string) (*instance.Volume, error) {
+// SetServerUserData mocks base method.
+func (m *MockInstanceAPI) SetServerUserData(req *instance.SetServerUserDataRequest, opts ...scw.RequestOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateInstanceVolume", ctx, zone, name, size, tags)
-	ret0, _ := ret[0].(*instance.Volume)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetServerUserData", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateInstanceVolume indicates an expected call of CreateInstanceVolume.
-func (mr *MockInstanceMockRecorder) CreateInstanceVolume(ctx, zone, name, size, tags any) *MockInstanceCreateInstanceVolumeCall {
+// SetServerUserData indicates an expected call of SetServerUserData.
+func (mr *MockInstanceAPIMockRecorder) SetServerUserData(req any, opts ...any) *MockInstanceAPISetServerUserDataCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceVolume", reflect.TypeOf((*MockInstance)(nil).CreateInstanceVolume), ctx, zone, name, size, tags)
-	return &MockInstanceCreateInstanceVolumeCall{Call: call}
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetServerUserData", reflect.TypeOf((*MockInstanceAPI)(nil).SetServerUserData), varargs...)
+	return &MockInstanceAPISetServerUserDataCall{Call: call}
 }
 
-// MockInstanceCreateInstanceVolumeCall wrap *gomock.Call
-type MockInstanceCreateInstanceVolumeCall struct {
+// MockInstanceAPISetServerUserDataCall wrap *gomock.Call
+type MockInstanceAPISetServerUserDataCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceCreateInstanceVolumeCall) Return(arg0 *instance.Volume, arg1 error) *MockInstanceCreateInstanceVolumeCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockInstanceAPISetServerUserDataCall) Return(arg0 error) *MockInstanceAPISetServerUserDataCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceCreateInstanceVolumeCall) Do(f func(context.Context, scw.Zone, string, scw.Size, []string) (*instance.Volume, error)) *MockInstanceCreateInstanceVolumeCall {
+func (c *MockInstanceAPISetServerUserDataCall) Do(f func(*instance.SetServerUserDataRequest, ...scw.RequestOption) error) *MockInstanceAPISetServerUserDataCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceCreateInstanceVolumeCall) DoAndReturn(f func(context.Context, scw.Zone, string, scw.Size, []string) (*instance.Volume, error)) *MockInstanceCreateInstanceVolumeCall {
+func (c *MockInstanceAPISetServerUserDataCall) DoAndReturn(f func(*instance.SetServerUserDataRequest, ...scw.RequestOption) error) *MockInstanceAPISetServerUserDataCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// CreatePrivateNIC mocks base method.
-func (m *MockInstance) CreatePrivateNIC(ctx context.Context, zone scw.Zone, serverID, privateNetworkID string) (*instance.PrivateNIC, error) {
+// UpdateIP mocks base method.
+func (m *MockInstanceAPI) UpdateIP(req *instance.UpdateIPRequest, opts ...scw.RequestOption) (*instance.UpdateIPResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePrivateNIC", ctx, zone, serverID, privateNetworkID)
-	ret0, _ := ret[0].(*instance.PrivateNIC)
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateIP", varargs...)
+	ret0, _ := ret[0].(*instance.UpdateIPResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreatePrivateNIC indicates an expected call of CreatePrivateNIC.
-func (mr *MockInstanceMockRecorder) CreatePrivateNIC(ctx, zone, serverID, privateNetworkID any) *MockInstanceCreatePrivateNICCall {
+// UpdateIP indicates an expected call of UpdateIP.
+func (mr *MockInstanceAPIMockRecorder) UpdateIP(req any, opts ...any) *MockInstanceAPIUpdateIPCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateNIC", reflect.TypeOf((*MockInstance)(nil).CreatePrivateNIC), ctx, zone, serverID, privateNetworkID)
-	return &MockInstanceCreatePrivateNICCall{Call: call}
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIP", reflect.TypeOf((*MockInstanceAPI)(nil).UpdateIP), varargs...)
+	return &MockInstanceAPIUpdateIPCall{Call: call}
 }
 
-// MockInstanceCreatePrivateNICCall wrap *gomock.Call
-type MockInstanceCreatePrivateNICCall struct {
+// MockInstanceAPIUpdateIPCall wrap *gomock.Call
+type MockInstanceAPIUpdateIPCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceCreatePrivateNICCall) Return(arg0 *instance.PrivateNIC, arg1 error) *MockInstanceCreatePrivateNICCall {
+func (c *MockInstanceAPIUpdateIPCall) Return(arg0 *instance.UpdateIPResponse, arg1 error) *MockInstanceAPIUpdateIPCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceCreatePrivateNICCall) Do(f func(context.Context, scw.Zone, string, string) (*instance.PrivateNIC, error)) *MockInstanceCreatePrivateNICCall {
+func (c *MockInstanceAPIUpdateIPCall) Do(f func(*instance.UpdateIPRequest, ...scw.RequestOption) (*instance.UpdateIPResponse, error)) *MockInstanceAPIUpdateIPCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceCreatePrivateNICCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) (*instance.PrivateNIC, error)) *MockInstanceCreatePrivateNICCall {
+func (c *MockInstanceAPIUpdateIPCall) DoAndReturn(f func(*instance.UpdateIPRequest, ...scw.RequestOption) (*instance.UpdateIPResponse, error)) *MockInstanceAPIUpdateIPCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// CreateServer mocks base method.
-func (m *MockInstance) CreateServer(ctx context.Context, zone scw.Zone, name, commercialType, imageID string, placementGroupID, securityGroupID *string, rootVolumeSize scw.Size, rootVolumeType instance.VolumeVolumeType, scratchVolumeSizes []scw.Size, tags []string) (*instance.Server, error) {
+// UpdateServer mocks base method.
+func (m *MockInstanceAPI) UpdateServer(req *instance.UpdateServerRequest, opts ...scw.RequestOption) (*instance.UpdateServerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateServer", ctx, zone, name, commercialType, imageID, placementGroupID, securityGroupID, rootVolumeSize, rootVolumeType, scratchVolumeSizes, tags)
-	ret0, _ := ret[0].(*instance.Server)
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServer", varargs...)
+	ret0, _ := ret[0].(*instance.UpdateServerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateServer indicates an expected call of CreateServer.
-func (mr *MockInstanceMockRecorder) CreateServer(ctx, zone, name, commercialType, imageID, placementGroupID, securityGroupID, rootVolumeSize, rootVolumeType, scratchVolumeSizes, tags any) *MockInstanceCreateServerCall {
+// UpdateServer indicates an expected call of UpdateServer.
+func (mr *MockInstanceAPIMockRecorder) UpdateServer(req any, opts ...any) *MockInstanceAPIUpdateServerCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServer", reflect.TypeOf((*MockInstance)(nil).CreateServer), ctx, zone, name, commercialType, imageID, placementGroupID, securityGroupID, rootVolumeSize, rootVolumeType, scratchVolumeSizes, tags)
-	return &MockInstanceCreateServerCall{Call: call}
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServer", reflect.TypeOf((*MockInstanceAPI)(nil).UpdateServer), varargs...)
+	return &MockInstanceAPIUpdateServerCall{Call: call}
 }
 
-// MockInstanceCreateServerCall wrap *gomock.Call
-type MockInstanceCreateServerCall struct {
+// MockInstanceAPIUpdateServerCall wrap *gomock.Call
+type MockInstanceAPIUpdateServerCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceCreateServerCall) Return(arg0 *instance.Server, arg1 error) *MockInstanceCreateServerCall {
+func (c *MockInstanceAPIUpdateServerCall) Return(arg0 *instance.UpdateServerResponse, arg1 error) *MockInstanceAPIUpdateServerCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceCreateServerCall) Do(f func(context.Context, scw.Zone, string, string, string, *string, *string, scw.Size, instance.VolumeVolumeType, []scw.Size, []string) (*instance.Server, error)) *MockInstanceCreateServerCall {
+func (c *MockInstanceAPIUpdateServerCall) Do(f func(*instance.UpdateServerRequest, ...scw.RequestOption) (*instance.UpdateServerResponse, error)) *MockInstanceAPIUpdateServerCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceCreateServerCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, string, *string, *string, scw.Size, instance.VolumeVolumeType, []scw.Size, []string) (*instance.Server, error)) *MockInstanceCreateServerCall {
+func (c *MockInstanceAPIUpdateServerCall) DoAndReturn(f func(*instance.UpdateServerRequest, ...scw.RequestOption) (*instance.UpdateServerResponse, error)) *MockInstanceAPIUpdateServerCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteIP mocks base method.
-func (m *MockInstance) DeleteIP(ctx context.Context, zone scw.Zone, ipID string) error {
+// UpdateVolume mocks base method.
+func (m *MockInstanceAPI) UpdateVolume(req *instance.UpdateVolumeRequest, opts ...scw.RequestOption) (*instance.UpdateVolumeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteIP", ctx, zone, ipID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVolume", varargs...)
+	ret0, _ := ret[0].(*instance.UpdateVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteIP indicates an expected call of DeleteIP.
-func (mr *MockInstanceMockRecorder) DeleteIP(ctx, zone, ipID any) *MockInstanceDeleteIPCall {
+// UpdateVolume indicates an expected call of UpdateVolume.
+func (mr *MockInstanceAPIMockRecorder) UpdateVolume(req any, opts ...any) *MockInstanceAPIUpdateVolumeCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIP", reflect.TypeOf((*MockInstance)(nil).DeleteIP), ctx, zone, ipID)
-	return &MockInstanceDeleteIPCall{Call: call}
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolume", reflect.TypeOf((*MockInstanceAPI)(nil).UpdateVolume), varargs...)
+	return &MockInstanceAPIUpdateVolumeCall{Call: call}
 }
 
-// MockInstanceDeleteIPCall wrap *gomock.Call
-type MockInstanceDeleteIPCall struct {
+// MockInstanceAPIUpdateVolumeCall wrap *gomock.Call
+type MockInstanceAPIUpdateVolumeCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceDeleteIPCall) Return(arg0 error) *MockInstanceDeleteIPCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockInstanceAPIUpdateVolumeCall) Return(arg0 *instance.UpdateVolumeResponse, arg1 error) *MockInstanceAPIUpdateVolumeCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceDeleteIPCall) Do(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteIPCall {
+func (c *MockInstanceAPIUpdateVolumeCall) Do(f func(*instance.UpdateVolumeRequest, ...scw.RequestOption) (*instance.UpdateVolumeResponse, error)) *MockInstanceAPIUpdateVolumeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceDeleteIPCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteIPCall {
+func (c *MockInstanceAPIUpdateVolumeCall) DoAndReturn(f func(*instance.UpdateVolumeRequest, ...scw.RequestOption) (*instance.UpdateVolumeResponse, error)) *MockInstanceAPIUpdateVolumeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteInstanceVolume mocks base method.
-func (m *MockInstance) DeleteInstanceVolume(ctx context.Context, zone scw.Zone, volumeID string) error {
+// Zones mocks base method.
+func (m *MockInstanceAPI) Zones() []scw.Zone {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteInstanceVolume", ctx, zone, volumeID)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "Zones")
+	ret0, _ := ret[0].([]scw.Zone)
 	return ret0
 }
 
-// DeleteInstanceVolume indicates an expected call of DeleteInstanceVolume.
-func (mr *MockInstanceMockRecorder) DeleteInstanceVolume(ctx, zone, volumeID any) *MockInstanceDeleteInstanceVolumeCall {
+// Zones indicates an expected call of Zones.
+func (mr *MockInstanceAPIMockRecorder) Zones() *MockInstanceAPIZonesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceVolume", reflect.TypeOf((*MockInstance)(nil).DeleteInstanceVolume), ctx, zone, volumeID)
-	return &MockInstanceDeleteInstanceVolumeCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Zones", reflect.TypeOf((*MockInstanceAPI)(nil).Zones))
+	return &MockInstanceAPIZonesCall{Call: call}
 }
 
-// MockInstanceDeleteInstanceVolumeCall wrap *gomock.Call
-type MockInstanceDeleteInstanceVolumeCall struct {
+// MockInstanceAPIZonesCall wrap *gomock.Call
+type MockInstanceAPIZonesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceDeleteInstanceVolumeCall) Return(arg0 error) *MockInstanceDeleteInstanceVolumeCall {
+func (c *MockInstanceAPIZonesCall) Return(arg0 []scw.Zone) *MockInstanceAPIZonesCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceDeleteInstanceVolumeCall) Do(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteInstanceVolumeCall {
+func (c *MockInstanceAPIZonesCall) Do(f func() []scw.Zone) *MockInstanceAPIZonesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceDeleteInstanceVolumeCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteInstanceVolumeCall {
+func (c *MockInstanceAPIZonesCall) DoAndReturn(f func() []scw.Zone) *MockInstanceAPIZonesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteServer mocks base method.
-func (m *MockInstance) DeleteServer(ctx context.Context, zone scw.Zone, serverID string) error {
+// MockInstance is a mock of Instance interface.
+type MockInstance struct {
+	ctrl     *gomock.Controller
+	recorder *MockInstanceMockRecorder
+	isgomock struct{}
+}
+
+// MockInstanceMockRecorder is the mock recorder for MockInstance.
+type MockInstanceMockRecorder struct {
+	mock *MockInstance
+}
+
+// NewMockInstance creates a new mock instance.
+func NewMockInstance(ctrl *gomock.Controller) *MockInstance {
+	mock := &MockInstance{ctrl: ctrl}
+	mock.recorder = &MockInstanceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInstance) EXPECT() *MockInstanceMockRecorder {
+	return m.recorder
+}
+
+// AttachServerVolume mocks base method.
+func (m *MockInstance) AttachServerVolume(ctx context.Context, zone scw.Zone, serverID, volumeID string, local bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteServer", ctx, zone, serverID)
+	ret := m.ctrl.Call(m, "AttachServerVolume", ctx, zone, serverID, volumeID, local)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteServer indicates an expected call of DeleteServer.
-func (mr *MockInstanceMockRecorder) DeleteServer(ctx, zone, serverID any) *MockInstanceDeleteServerCall {
+// AttachServerVolume indicates an expected call of AttachServerVolume.
+func (mr *MockInstanceMockRecorder) AttachServerVolume(ctx, zone, serverID, volumeID, local any) *MockInstanceAttachServerVolumeCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServer", reflect.TypeOf((*MockInstance)(nil).DeleteServer), ctx, zone, serverID)
-	return &MockInstanceDeleteServerCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachServerVolume", reflect.TypeOf((*MockInstance)(nil).AttachServerVolume), ctx, zone, serverID, volumeID, local)
+	return &MockInstanceAttachServerVolumeCall{Call: call}
 }
 
-// MockInstanceDeleteServerCall wrap *gomock.Call
-type MockInstanceDeleteServerCall struct {
+// MockInstanceAttachServerVolumeCall wrap *gomock.Call
+type MockInstanceAttachServerVolumeCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceDeleteServerCall) Return(arg0 error) *MockInstanceDeleteServerCall {
+func (c *MockInstanceAttachServerVolumeCall) Return(arg0 error) *MockInstanceAttachServerVolumeCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceDeleteServerCall) Do(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteServerCall {
+func (c *MockInstanceAttachServerVolumeCall) Do(f func(context.Context, scw.Zone, string, string, bool) error) *MockInstanceAttachServerVolumeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceDeleteServerCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteServerCall {
+func (c *MockInstanceAttachServerVolumeCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, bool) error) *MockInstanceAttachServerVolumeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteServerUserData mocks base method.
-func (m *MockInstance) DeleteServerUserData(ctx context.Context, zone scw.Zone, serverID, key string) error {
+// CreateIP mocks base method.
+func (m *MockInstance) CreateIP(ctx context.Context, zone scw.Zone, ipType instance.IPType, tags []string) (*instance.IP, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteServerUserData", ctx, zone, serverID, key)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateIP", ctx, zone, ipType, tags)
+	ret0, _ := ret[0].(*instance.IP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteServerUserData indicates an expected call of DeleteServerUserData.
-func (mr *MockInstanceMockRecorder) DeleteServerUserData(ctx, zone, serverID, key any) *MockInstanceDeleteServerUserDataCall {
+// CreateIP indicates an expected call of CreateIP.
+func (mr *MockInstanceMockRecorder) CreateIP(ctx, zone, ipType, tags any) *MockInstanceCreateIPCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServerUserData", reflect.TypeOf((*MockInstance)(nil).DeleteServerUserData), ctx, zone, serverID, key)
-	return &MockInstanceDeleteServerUserDataCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIP", reflect.TypeOf((*MockInstance)(nil).CreateIP), ctx, zone, ipType, tags)
+	return &MockInstanceCreateIPCall{Call: call}
 }
 
-// MockInstanceDeleteServerUserDataCall wrap *gomock.Call
-type MockInstanceDeleteServerUserDataCall struct {
+// MockInstanceCreateIPCall wrap *gomock.Call
+type MockInstanceCreateIPCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceDeleteServerUserDataCall) Return(arg0 error) *MockInstanceDeleteServerUserDataCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockInstanceCreateIPCall) Return(arg0 *instance.IP, arg1 error) *MockInstanceCreateIPCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceDeleteServerUserDataCall) Do(f func(context.Context, scw.Zone, string, string) error) *MockInstanceDeleteServerUserDataCall {
+func (c *MockInstanceCreateIPCall) Do(f func(context.Context, scw.Zone, instance.IPType, []string) (*instance.IP, error)) *MockInstanceCreateIPCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceDeleteServerUserDataCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) error) *MockInstanceDeleteServerUserDataCall {
+func (c *MockInstanceCreateIPCall) DoAndReturn(f func(context.Context, scw.Zone, instance.IPType, []string) (*instance.IP, error)) *MockInstanceCreateIPCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DetachServerVolume mocks base method.
-func (m *MockInstance) DetachServerVolume(ctx context.Context, zone scw.Zone, serverID, volumeID string) error {
+// CreateInstanceVolume mocks base method.
+func (m *MockInstance) CreateInstanceVolume(ctx context.Context, zone scw.Zone, name string, size scw.Size, tags []string) (*instance.Volume, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DetachServerVolume", ctx, zone, serverID, volumeID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateInstanceVolume", ctx, zone, name, size, tags)
+	ret0, _ := ret[0].(*instance.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInstanceVolume indicates an expected call of CreateInstanceVolume.
+func (mr *MockInstanceMockRecorder) CreateInstanceVolume(ctx, zone, name, size, tags any) *MockInstanceCreateInstanceVolumeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceVolume", reflect.TypeOf((*MockInstance)(nil).CreateInstanceVolume), ctx, zone, name, size, tags)
+	return &MockInstanceCreateInstanceVolumeCall{Call: call}
+}
+
+// MockInstanceCreateInstanceVolumeCall wrap *gomock.Call
+type MockInstanceCreateInstanceVolumeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceCreateInstanceVolumeCall) Return(arg0 *instance.Volume, arg1 error) *MockInstanceCreateInstanceVolumeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceCreateInstanceVolumeCall) Do(f func(context.Context, scw.Zone, string, scw.Size, []string) (*instance.Volume, error)) *MockInstanceCreateInstanceVolumeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceCreateInstanceVolumeCall) DoAndReturn(f func(context.Context, scw.Zone, string, scw.Size, []string) (*instance.Volume, error)) *MockInstanceCreateInstanceVolumeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreatePlacementGroup mocks base method.
+func (m *MockInstance) CreatePlacementGroup(ctx context.Context, zone scw.Zone, name string, policyType instance.PlacementGroupPolicyType, policyMode instance.PlacementGroupPolicyMode, tags []string) (*instance.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlacementGroup", ctx, zone, name, policyType, policyMode, tags)
+	ret0, _ := ret[0].(*instance.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlacementGroup indicates an expected call of CreatePlacementGroup.
+func (mr *MockInstanceMockRecorder) CreatePlacementGroup(ctx, zone, name, policyType, policyMode, tags any) *MockInstanceCreatePlacementGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlacementGroup", reflect.TypeOf((*MockInstance)(nil).CreatePlacementGroup), ctx, zone, name, policyType, policyMode, tags)
+	return &MockInstanceCreatePlacementGroupCall{Call: call}
+}
+
+// MockInstanceCreatePlacementGroupCall wrap *gomock.Call
+type MockInstanceCreatePlacementGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceCreatePlacementGroupCall) Return(arg0 *instance.PlacementGroup, arg1 error) *MockInstanceCreatePlacementGroupCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceCreatePlacementGroupCall) Do(f func(context.Context, scw.Zone, string, instance.PlacementGroupPolicyType, instance.PlacementGroupPolicyMode, []string) (*instance.PlacementGroup, error)) *MockInstanceCreatePlacementGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceCreatePlacementGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string, instance.PlacementGroupPolicyType, instance.PlacementGroupPolicyMode, []string) (*instance.PlacementGroup, error)) *MockInstanceCreatePlacementGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreatePrivateNIC mocks base method.
+func (m *MockInstance) CreatePrivateNIC(ctx context.Context, zone scw.Zone, serverID, privateNetworkID string, ipamIPIDs []string) (*instance.PrivateNIC, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrivateNIC", ctx, zone, serverID, privateNetworkID, ipamIPIDs)
+	ret0, _ := ret[0].(*instance.PrivateNIC)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePrivateNIC indicates an expected call of CreatePrivateNIC.
+func (mr *MockInstanceMockRecorder) CreatePrivateNIC(ctx, zone, serverID, privateNetworkID, ipamIPIDs any) *MockInstanceCreatePrivateNICCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateNIC", reflect.TypeOf((*MockInstance)(nil).CreatePrivateNIC), ctx, zone, serverID, privateNetworkID, ipamIPIDs)
+	return &MockInstanceCreatePrivateNICCall{Call: call}
+}
+
+// MockInstanceCreatePrivateNICCall wrap *gomock.Call
+type MockInstanceCreatePrivateNICCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceCreatePrivateNICCall) Return(arg0 *instance.PrivateNIC, arg1 error) *MockInstanceCreatePrivateNICCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceCreatePrivateNICCall) Do(f func(context.Context, scw.Zone, string, string, []string) (*instance.PrivateNIC, error)) *MockInstanceCreatePrivateNICCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceCreatePrivateNICCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, []string) (*instance.PrivateNIC, error)) *MockInstanceCreatePrivateNICCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateSecurityGroup mocks base method.
+func (m *MockInstance) CreateSecurityGroup(ctx context.Context, zone scw.Zone, name string, tags []string) (*instance.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecurityGroup", ctx, zone, name, tags)
+	ret0, _ := ret[0].(*instance.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecurityGroup indicates an expected call of CreateSecurityGroup.
+func (mr *MockInstanceMockRecorder) CreateSecurityGroup(ctx, zone, name, tags any) *MockInstanceCreateSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroup", reflect.TypeOf((*MockInstance)(nil).CreateSecurityGroup), ctx, zone, name, tags)
+	return &MockInstanceCreateSecurityGroupCall{Call: call}
+}
+
+// MockInstanceCreateSecurityGroupCall wrap *gomock.Call
+type MockInstanceCreateSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceCreateSecurityGroupCall) Return(arg0 *instance.SecurityGroup, arg1 error) *MockInstanceCreateSecurityGroupCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceCreateSecurityGroupCall) Do(f func(context.Context, scw.Zone, string, []string) (*instance.SecurityGroup, error)) *MockInstanceCreateSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceCreateSecurityGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string, []string) (*instance.SecurityGroup, error)) *MockInstanceCreateSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateServer mocks base method.
+func (m *MockInstance) CreateServer(ctx context.Context, zone scw.Zone, name, commercialType, imageID string, placementGroupID, securityGroupID *string, rootVolumeSize scw.Size, rootVolumeType instance.VolumeVolumeType, scratchVolumeSizes []scw.Size, protected, natIPs bool, tags []string) (*instance.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServer", ctx, zone, name, commercialType, imageID, placementGroupID, securityGroupID, rootVolumeSize, rootVolumeType, scratchVolumeSizes, protected, natIPs, tags)
+	ret0, _ := ret[0].(*instance.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServer indicates an expected call of CreateServer.
+func (mr *MockInstanceMockRecorder) CreateServer(ctx, zone, name, commercialType, imageID, placementGroupID, securityGroupID, rootVolumeSize, rootVolumeType, scratchVolumeSizes, protected, natIPs, tags any) *MockInstanceCreateServerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServer", reflect.TypeOf((*MockInstance)(nil).CreateServer), ctx, zone, name, commercialType, imageID, placementGroupID, securityGroupID, rootVolumeSize, rootVolumeType, scratchVolumeSizes, protected, natIPs, tags)
+	return &MockInstanceCreateServerCall{Call: call}
+}
+
+// MockInstanceCreateServerCall wrap *gomock.Call
+type MockInstanceCreateServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceCreateServerCall) Return(arg0 *instance.Server, arg1 error) *MockInstanceCreateServerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceCreateServerCall) Do(f func(context.Context, scw.Zone, string, string, string, *string, *string, scw.Size, instance.VolumeVolumeType, []scw.Size, bool, bool, []string) (*instance.Server, error)) *MockInstanceCreateServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceCreateServerCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, string, *string, *string, scw.Size, instance.VolumeVolumeType, []scw.Size, bool, bool, []string) (*instance.Server, error)) *MockInstanceCreateServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteIP mocks base method.
+func (m *MockInstance) DeleteIP(ctx context.Context, zone scw.Zone, ipID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIP", ctx, zone, ipID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIP indicates an expected call of DeleteIP.
+func (mr *MockInstanceMockRecorder) DeleteIP(ctx, zone, ipID any) *MockInstanceDeleteIPCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIP", reflect.TypeOf((*MockInstance)(nil).DeleteIP), ctx, zone, ipID)
+	return &MockInstanceDeleteIPCall{Call: call}
+}
+
+// MockInstanceDeleteIPCall wrap *gomock.Call
+type MockInstanceDeleteIPCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceDeleteIPCall) Return(arg0 error) *MockInstanceDeleteIPCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceDeleteIPCall) Do(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteIPCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceDeleteIPCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteIPCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteInstanceVolume mocks base method.
+func (m *MockInstance) DeleteInstanceVolume(ctx context.Context, zone scw.Zone, volumeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstanceVolume", ctx, zone, volumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInstanceVolume indicates an expected call of DeleteInstanceVolume.
+func (mr *MockInstanceMockRecorder) DeleteInstanceVolume(ctx, zone, volumeID any) *MockInstanceDeleteInstanceVolumeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceVolume", reflect.TypeOf((*MockInstance)(nil).DeleteInstanceVolume), ctx, zone, volumeID)
+	return &MockInstanceDeleteInstanceVolumeCall{Call: call}
+}
+
+// MockInstanceDeleteInstanceVolumeCall wrap *gomock.Call
+type MockInstanceDeleteInstanceVolumeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceDeleteInstanceVolumeCall) Return(arg0 error) *MockInstanceDeleteInstanceVolumeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceDeleteInstanceVolumeCall) Do(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteInstanceVolumeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceDeleteInstanceVolumeCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteInstanceVolumeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeletePlacementGroup mocks base method.
+func (m *MockInstance) DeletePlacementGroup(ctx context.Context, zone scw.Zone, placementGroupID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlacementGroup", ctx, zone, placementGroupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlacementGroup indicates an expected call of DeletePlacementGroup.
+func (mr *MockInstanceMockRecorder) DeletePlacementGroup(ctx, zone, placementGroupID any) *MockInstanceDeletePlacementGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlacementGroup", reflect.TypeOf((*MockInstance)(nil).DeletePlacementGroup), ctx, zone, placementGroupID)
+	return &MockInstanceDeletePlacementGroupCall{Call: call}
+}
+
+// MockInstanceDeletePlacementGroupCall wrap *gomock.Call
+type MockInstanceDeletePlacementGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceDeletePlacementGroupCall) Return(arg0 error) *MockInstanceDeletePlacementGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceDeletePlacementGroupCall) Do(f func(context.Context, scw.Zone, string) error) *MockInstanceDeletePlacementGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceDeletePlacementGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockInstanceDeletePlacementGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeletePrivateNIC mocks base method.
+func (m *MockInstance) DeletePrivateNIC(ctx context.Context, zone scw.Zone, serverID, privateNICID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivateNIC", ctx, zone, serverID, privateNICID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrivateNIC indicates an expected call of DeletePrivateNIC.
+func (mr *MockInstanceMockRecorder) DeletePrivateNIC(ctx, zone, serverID, privateNICID any) *MockInstanceDeletePrivateNICCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateNIC", reflect.TypeOf((*MockInstance)(nil).DeletePrivateNIC), ctx, zone, serverID, privateNICID)
+	return &MockInstanceDeletePrivateNICCall{Call: call}
+}
+
+// MockInstanceDeletePrivateNICCall wrap *gomock.Call
+type MockInstanceDeletePrivateNICCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceDeletePrivateNICCall) Return(arg0 error) *MockInstanceDeletePrivateNICCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceDeletePrivateNICCall) Do(f func(context.Context, scw.Zone, string, string) error) *MockInstanceDeletePrivateNICCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceDeletePrivateNICCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) error) *MockInstanceDeletePrivateNICCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSecurityGroup mocks base method.
+func (m *MockInstance) DeleteSecurityGroup(ctx context.Context, zone scw.Zone, securityGroupID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecurityGroup", ctx, zone, securityGroupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecurityGroup indicates an expected call of DeleteSecurityGroup.
+func (mr *MockInstanceMockRecorder) DeleteSecurityGroup(ctx, zone, securityGroupID any) *MockInstanceDeleteSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockInstance)(nil).DeleteSecurityGroup), ctx, zone, securityGroupID)
+	return &MockInstanceDeleteSecurityGroupCall{Call: call}
+}
+
+// MockInstanceDeleteSecurityGroupCall wrap *gomock.Call
+type MockInstanceDeleteSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceDeleteSecurityGroupCall) Return(arg0 error) *MockInstanceDeleteSecurityGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceDeleteSecurityGroupCall) Do(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceDeleteSecurityGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteServer mocks base method.
+func (m *MockInstance) DeleteServer(ctx context.Context, zone scw.Zone, serverID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServer", ctx, zone, serverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServer indicates an expected call of DeleteServer.
+func (mr *MockInstanceMockRecorder) DeleteServer(ctx, zone, serverID any) *MockInstanceDeleteServerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServer", reflect.TypeOf((*MockInstance)(nil).DeleteServer), ctx, zone, serverID)
+	return &MockInstanceDeleteServerCall{Call: call}
+}
+
+// MockInstanceDeleteServerCall wrap *gomock.Call
+type MockInstanceDeleteServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceDeleteServerCall) Return(arg0 error) *MockInstanceDeleteServerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceDeleteServerCall) Do(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceDeleteServerCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockInstanceDeleteServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteServerUserData mocks base method.
+func (m *MockInstance) DeleteServerUserData(ctx context.Context, zone scw.Zone, serverID, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServerUserData", ctx, zone, serverID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServerUserData indicates an expected call of DeleteServerUserData.
+func (mr *MockInstanceMockRecorder) DeleteServerUserData(ctx, zone, serverID, key any) *MockInstanceDeleteServerUserDataCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServerUserData", reflect.TypeOf((*MockInstance)(nil).DeleteServerUserData), ctx, zone, serverID, key)
+	return &MockInstanceDeleteServerUserDataCall{Call: call}
+}
+
+// MockInstanceDeleteServerUserDataCall wrap *gomock.Call
+type MockInstanceDeleteServerUserDataCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceDeleteServerUserDataCall) Return(arg0 error) *MockInstanceDeleteServerUserDataCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceDeleteServerUserDataCall) Do(f func(context.Context, scw.Zone, string, string) error) *MockInstanceDeleteServerUserDataCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceDeleteServerUserDataCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) error) *MockInstanceDeleteServerUserDataCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DetachServerVolume mocks base method.
+func (m *MockInstance) DetachServerVolume(ctx context.Context, zone scw.Zone, serverID, volumeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachServerVolume", ctx, zone, serverID, volumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachServerVolume indicates an expected call of DetachServerVolume.
+func (mr *MockInstanceMockRecorder) DetachServerVolume(ctx, zone, serverID, volumeID any) *MockInstanceDetachServerVolumeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachServerVolume", reflect.TypeOf((*MockInstance)(nil).DetachServerVolume), ctx, zone, serverID, volumeID)
+	return &MockInstanceDetachServerVolumeCall{Call: call}
+}
+
+// MockInstanceDetachServerVolumeCall wrap *gomock.Call
+type MockInstanceDetachServerVolumeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceDetachServerVolumeCall) Return(arg0 error) *MockInstanceDetachServerVolumeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceDetachServerVolumeCall) Do(f func(context.Context, scw.Zone, string, string) error) *MockInstanceDetachServerVolumeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceDetachServerVolumeCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) error) *MockInstanceDetachServerVolumeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindDefaultSecurityGroup mocks base method.
+func (m *MockInstance) FindDefaultSecurityGroup(ctx context.Context, zone scw.Zone) (*instance.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDefaultSecurityGroup", ctx, zone)
+	ret0, _ := ret[0].(*instance.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDefaultSecurityGroup indicates an expected call of FindDefaultSecurityGroup.
+func (mr *MockInstanceMockRecorder) FindDefaultSecurityGroup(ctx, zone any) *MockInstanceFindDefaultSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDefaultSecurityGroup", reflect.TypeOf((*MockInstance)(nil).FindDefaultSecurityGroup), ctx, zone)
+	return &MockInstanceFindDefaultSecurityGroupCall{Call: call}
+}
+
+// MockInstanceFindDefaultSecurityGroupCall wrap *gomock.Call
+type MockInstanceFindDefaultSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceFindDefaultSecurityGroupCall) Return(arg0 *instance.SecurityGroup, arg1 error) *MockInstanceFindDefaultSecurityGroupCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceFindDefaultSecurityGroupCall) Do(f func(context.Context, scw.Zone) (*instance.SecurityGroup, error)) *MockInstanceFindDefaultSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceFindDefaultSecurityGroupCall) DoAndReturn(f func(context.Context, scw.Zone) (*instance.SecurityGroup, error)) *MockInstanceFindDefaultSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindIPs mocks base method.
+func (m *MockInstance) FindIPs(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.IP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIPs", ctx, zone, tags)
+	ret0, _ := ret[0].([]*instance.IP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIPs indicates an expected call of FindIPs.
+func (mr *MockInstanceMockRecorder) FindIPs(ctx, zone, tags any) *MockInstanceFindIPsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIPs", reflect.TypeOf((*MockInstance)(nil).FindIPs), ctx, zone, tags)
+	return &MockInstanceFindIPsCall{Call: call}
+}
+
+// MockInstanceFindIPsCall wrap *gomock.Call
+type MockInstanceFindIPsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceFindIPsCall) Return(arg0 []*instance.IP, arg1 error) *MockInstanceFindIPsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceFindIPsCall) Do(f func(context.Context, scw.Zone, []string) ([]*instance.IP, error)) *MockInstanceFindIPsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceFindIPsCall) DoAndReturn(f func(context.Context, scw.Zone, []string) ([]*instance.IP, error)) *MockInstanceFindIPsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindImage mocks base method.
+func (m *MockInstance) FindImage(ctx context.Context, zone scw.Zone, name string) (*instance.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindImage", ctx, zone, name)
+	ret0, _ := ret[0].(*instance.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindImage indicates an expected call of FindImage.
+func (mr *MockInstanceMockRecorder) FindImage(ctx, zone, name any) *MockInstanceFindImageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImage", reflect.TypeOf((*MockInstance)(nil).FindImage), ctx, zone, name)
+	return &MockInstanceFindImageCall{Call: call}
+}
+
+// MockInstanceFindImageCall wrap *gomock.Call
+type MockInstanceFindImageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceFindImageCall) Return(arg0 *instance.Image, arg1 error) *MockInstanceFindImageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceFindImageCall) Do(f func(context.Context, scw.Zone, string) (*instance.Image, error)) *MockInstanceFindImageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceFindImageCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*instance.Image, error)) *MockInstanceFindImageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindInstanceVolumes mocks base method.
+func (m *MockInstance) FindInstanceVolumes(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInstanceVolumes", ctx, zone, tags)
+	ret0, _ := ret[0].([]*instance.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindInstanceVolumes indicates an expected call of FindInstanceVolumes.
+func (mr *MockInstanceMockRecorder) FindInstanceVolumes(ctx, zone, tags any) *MockInstanceFindInstanceVolumesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInstanceVolumes", reflect.TypeOf((*MockInstance)(nil).FindInstanceVolumes), ctx, zone, tags)
+	return &MockInstanceFindInstanceVolumesCall{Call: call}
+}
+
+// MockInstanceFindInstanceVolumesCall wrap *gomock.Call
+type MockInstanceFindInstanceVolumesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceFindInstanceVolumesCall) Return(arg0 []*instance.Volume, arg1 error) *MockInstanceFindInstanceVolumesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceFindInstanceVolumesCall) Do(f func(context.Context, scw.Zone, []string) ([]*instance.Volume, error)) *MockInstanceFindInstanceVolumesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceFindInstanceVolumesCall) DoAndReturn(f func(context.Context, scw.Zone, []string) ([]*instance.Volume, error)) *MockInstanceFindInstanceVolumesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindPlacementGroup mocks base method.
+func (m *MockInstance) FindPlacementGroup(ctx context.Context, zone scw.Zone, name string) (*instance.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPlacementGroup", ctx, zone, name)
+	ret0, _ := ret[0].(*instance.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPlacementGroup indicates an expected call of FindPlacementGroup.
+func (mr *MockInstanceMockRecorder) FindPlacementGroup(ctx, zone, name any) *MockInstanceFindPlacementGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPlacementGroup", reflect.TypeOf((*MockInstance)(nil).FindPlacementGroup), ctx, zone, name)
+	return &MockInstanceFindPlacementGroupCall{Call: call}
+}
+
+// MockInstanceFindPlacementGroupCall wrap *gomock.Call
+type MockInstanceFindPlacementGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceFindPlacementGroupCall) Return(arg0 *instance.PlacementGroup, arg1 error) *MockInstanceFindPlacementGroupCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceFindPlacementGroupCall) Do(f func(context.Context, scw.Zone, string) (*instance.PlacementGroup, error)) *MockInstanceFindPlacementGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceFindPlacementGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*instance.PlacementGroup, error)) *MockInstanceFindPlacementGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindPlacementGroups mocks base method.
+func (m *MockInstance) FindPlacementGroups(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPlacementGroups", ctx, zone, tags)
+	ret0, _ := ret[0].([]*instance.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPlacementGroups indicates an expected call of FindPlacementGroups.
+func (mr *MockInstanceMockRecorder) FindPlacementGroups(ctx, zone, tags any) *MockInstanceFindPlacementGroupsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPlacementGroups", reflect.TypeOf((*MockInstance)(nil).FindPlacementGroups), ctx, zone, tags)
+	return &MockInstanceFindPlacementGroupsCall{Call: call}
+}
+
+// MockInstanceFindPlacementGroupsCall wrap *gomock.Call
+type MockInstanceFindPlacementGroupsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceFindPlacementGroupsCall) Return(arg0 []*instance.PlacementGroup, arg1 error) *MockInstanceFindPlacementGroupsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceFindPlacementGroupsCall) Do(f func(context.Context, scw.Zone, []string) ([]*instance.PlacementGroup, error)) *MockInstanceFindPlacementGroupsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceFindPlacementGroupsCall) DoAndReturn(f func(context.Context, scw.Zone, []string) ([]*instance.PlacementGroup, error)) *MockInstanceFindPlacementGroupsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindSecurityGroup mocks base method.
+func (m *MockInstance) FindSecurityGroup(ctx context.Context, zone scw.Zone, name string) (*instance.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSecurityGroup", ctx, zone, name)
+	ret0, _ := ret[0].(*instance.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSecurityGroup indicates an expected call of FindSecurityGroup.
+func (mr *MockInstanceMockRecorder) FindSecurityGroup(ctx, zone, name any) *MockInstanceFindSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSecurityGroup", reflect.TypeOf((*MockInstance)(nil).FindSecurityGroup), ctx, zone, name)
+	return &MockInstanceFindSecurityGroupCall{Call: call}
+}
+
+// MockInstanceFindSecurityGroupCall wrap *gomock.Call
+type MockInstanceFindSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceFindSecurityGroupCall) Return(arg0 *instance.SecurityGroup, arg1 error) *MockInstanceFindSecurityGroupCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceFindSecurityGroupCall) Do(f func(context.Context, scw.Zone, string) (*instance.SecurityGroup, error)) *MockInstanceFindSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceFindSecurityGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*instance.SecurityGroup, error)) *MockInstanceFindSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindSecurityGroupByTags mocks base method.
+func (m *MockInstance) FindSecurityGroupByTags(ctx context.Context, zone scw.Zone, tags []string) (*instance.SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSecurityGroupByTags", ctx, zone, tags)
+	ret0, _ := ret[0].(*instance.SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSecurityGroupByTags indicates an expected call of FindSecurityGroupByTags.
+func (mr *MockInstanceMockRecorder) FindSecurityGroupByTags(ctx, zone, tags any) *MockInstanceFindSecurityGroupByTagsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSecurityGroupByTags", reflect.TypeOf((*MockInstance)(nil).FindSecurityGroupByTags), ctx, zone, tags)
+	return &MockInstanceFindSecurityGroupByTagsCall{Call: call}
+}
+
+// MockInstanceFindSecurityGroupByTagsCall wrap *gomock.Call
+type MockInstanceFindSecurityGroupByTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceFindSecurityGroupByTagsCall) Return(arg0 *instance.SecurityGroup, arg1 error) *MockInstanceFindSecurityGroupByTagsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceFindSecurityGroupByTagsCall) Do(f func(context.Context, scw.Zone, []string) (*instance.SecurityGroup, error)) *MockInstanceFindSecurityGroupByTagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceFindSecurityGroupByTagsCall) DoAndReturn(f func(context.Context, scw.Zone, []string) (*instance.SecurityGroup, error)) *MockInstanceFindSecurityGroupByTagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindServer mocks base method.
+func (m *MockInstance) FindServer(ctx context.Context, zone scw.Zone, tags []string) (*instance.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindServer", ctx, zone, tags)
+	ret0, _ := ret[0].(*instance.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindServer indicates an expected call of FindServer.
+func (mr *MockInstanceMockRecorder) FindServer(ctx, zone, tags any) *MockInstanceFindServerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindServer", reflect.TypeOf((*MockInstance)(nil).FindServer), ctx, zone, tags)
+	return &MockInstanceFindServerCall{Call: call}
+}
+
+// MockInstanceFindServerCall wrap *gomock.Call
+type MockInstanceFindServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceFindServerCall) Return(arg0 *instance.Server, arg1 error) *MockInstanceFindServerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceFindServerCall) Do(f func(context.Context, scw.Zone, []string) (*instance.Server, error)) *MockInstanceFindServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceFindServerCall) DoAndReturn(f func(context.Context, scw.Zone, []string) (*instance.Server, error)) *MockInstanceFindServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindServers mocks base method.
+func (m *MockInstance) FindServers(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindServers", ctx, zone, tags)
+	ret0, _ := ret[0].([]*instance.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindServers indicates an expected call of FindServers.
+func (mr *MockInstanceMockRecorder) FindServers(ctx, zone, tags any) *MockInstanceFindServersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindServers", reflect.TypeOf((*MockInstance)(nil).FindServers), ctx, zone, tags)
+	return &MockInstanceFindServersCall{Call: call}
+}
+
+// MockInstanceFindServersCall wrap *gomock.Call
+type MockInstanceFindServersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceFindServersCall) Return(arg0 []*instance.Server, arg1 error) *MockInstanceFindServersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceFindServersCall) Do(f func(context.Context, scw.Zone, []string) ([]*instance.Server, error)) *MockInstanceFindServersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceFindServersCall) DoAndReturn(f func(context.Context, scw.Zone, []string) ([]*instance.Server, error)) *MockInstanceFindServersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAllServerUserData mocks base method.
+func (m *MockInstance) GetAllServerUserData(ctx context.Context, zone scw.Zone, serverID string) (map[string]io.Reader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllServerUserData", ctx, zone, serverID)
+	ret0, _ := ret[0].(map[string]io.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DetachServerVolume indicates an expected call of DetachServerVolume.
-func (mr *MockInstanceMockRecorder) DetachServerVolume(ctx, zone, serverID, volumeID any) *MockInstanceDetachServerVolumeCall {
+// GetAllServerUserData indicates an expected call of GetAllServerUserData.
+func (mr *MockInstanceMockRecorder) GetAllServerUserData(ctx, zone, serverID any) *MockInstanceGetAllServerUserDataCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachServerVolume", reflect.TypeOf((*MockInstance)(nil).DetachServerVolume), ctx, zone, serverID, volumeID)
-	return &MockInstanceDetachServerVolumeCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllServerUserData", reflect.TypeOf((*MockInstance)(nil).GetAllServerUserData), ctx, zone, serverID)
+	return &MockInstanceGetAllServerUserDataCall{Call: call}
 }
 
-// MockInstanceDetachServerVolumeCall wrap *gomock.Call
-type MockInstanceDetachServerVolumeCall struct {
+// MockInstanceGetAllServerUserDataCall wrap *gomock.Call
+type MockInstanceGetAllServerUserDataCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceDetachServerVolumeCall) Return(arg0 error) *MockInstanceDetachServerVolumeCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockInstanceGetAllServerUserDataCall) Return(arg0 map[string]io.Reader, arg1 error) *MockInstanceGetAllServerUserDataCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceDetachServerVolumeCall) Do(f func(context.Context, scw.Zone, string, string) error) *MockInstanceDetachServerVolumeCall {
+func (c *MockInstanceGetAllServerUserDataCall) Do(f func(context.Context, scw.Zone, string) (map[string]io.Reader, error)) *MockInstanceGetAllServerUserDataCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceDetachServerVolumeCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) error) *MockInstanceDetachServerVolumeCall {
+func (c *MockInstanceGetAllServerUserDataCall) DoAndReturn(f func(context.Context, scw.Zone, string) (map[string]io.Reader, error)) *MockInstanceGetAllServerUserDataCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// FindIPs mocks base method.
-func (m *MockInstance) FindIPs(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.IP, error) {
+// GetIP mocks base method.
+func (m *MockInstance) GetIP(ctx context.Context, zone scw.Zone, ipIDOrAddress string) (*instance.IP, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindIPs", ctx, zone, tags)
-	ret0, _ := ret[0].([]*instance.IP)
+	ret := m.ctrl.Call(m, "GetIP", ctx, zone, ipIDOrAddress)
+	ret0, _ := ret[0].(*instance.IP)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindIPs indicates an expected call of FindIPs.
-func (mr *MockInstanceMockRecorder) FindIPs(ctx, zone, tags any) *MockInstanceFindIPsCall {
+// GetIP indicates an expected call of GetIP.
+func (mr *MockInstanceMockRecorder) GetIP(ctx, zone, ipIDOrAddress any) *MockInstanceGetIPCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIPs", reflect.TypeOf((*MockInstance)(nil).FindIPs), ctx, zone, tags)
-	return &MockInstanceFindIPsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIP", reflect.TypeOf((*MockInstance)(nil).GetIP), ctx, zone, ipIDOrAddress)
+	return &MockInstanceGetIPCall{Call: call}
 }
 
-// MockInstanceFindIPsCall wrap *gomock.Call
-type MockInstanceFindIPsCall struct {
+// MockInstanceGetIPCall wrap *gomock.Call
+type MockInstanceGetIPCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceFindIPsCall) Return(arg0 []*instance.IP, arg1 error) *MockInstanceFindIPsCall {
+func (c *MockInstanceGetIPCall) Return(arg0 *instance.IP, arg1 error) *MockInstanceGetIPCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceFindIPsCall) Do(f func(context.Context, scw.Zone, []string) ([]*instance.IP, error)) *MockInstanceFindIPsCall {
+func (c *MockInstanceGetIPCall) Do(f func(context.Context, scw.Zone, string) (*instance.IP, error)) *MockInstanceGetIPCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceFindIPsCall) DoAndReturn(f func(context.Context, scw.Zone, []string) ([]*instance.IP, error)) *MockInstanceFindIPsCall {
+func (c *MockInstanceGetIPCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*instance.IP, error)) *MockInstanceGetIPCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// FindImage mocks base method.
-func (m *MockInstance) FindImage(ctx context.Context, zone scw.Zone, name string) (*instance.Image, error) {
+// GetServer mocks base method.
+func (m *MockInstance) GetServer(ctx context.Context, zone scw.Zone, serverID string) (*instance.Server, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindImage", ctx, zone, name)
-	ret0, _ := ret[0].(*instance.Image)
+	ret := m.ctrl.Call(m, "GetServer", ctx, zone, serverID)
+	ret0, _ := ret[0].(*instance.Server)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindImage indicates an expected call of FindImage.
-func (mr *MockInstanceMockRecorder) FindImage(ctx, zone, name any) *MockInstanceFindImageCall {
+// GetServer indicates an expected call of GetServer.
+func (mr *MockInstanceMockRecorder) GetServer(ctx, zone, serverID any) *MockInstanceGetServerCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImage", reflect.TypeOf((*MockInstance)(nil).FindImage), ctx, zone, name)
-	return &MockInstanceFindImageCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServer", reflect.TypeOf((*MockInstance)(nil).GetServer), ctx, zone, serverID)
+	return &MockInstanceGetServerCall{Call: call}
 }
 
-// MockInstanceFindImageCall wrap *gomock.Call
-type MockInstanceFindImageCall struct {
+// MockInstanceGetServerCall wrap *gomock.Call
+type MockInstanceGetServerCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceFindImageCall) Return(arg0 *instance.Image, arg1 error) *MockInstanceFindImageCall {
+func (c *MockInstanceGetServerCall) Return(arg0 *instance.Server, arg1 error) *MockInstanceGetServerCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceFindImageCall) Do(f func(context.Context, scw.Zone, string) (*instance.Image, error)) *MockInstanceFindImageCall {
+func (c *MockInstanceGetServerCall) Do(f func(context.Context, scw.Zone, string) (*instance.Server, error)) *MockInstanceGetServerCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceFindImageCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*instance.Image, error)) *MockInstanceFindImageCall {
+func (c *MockInstanceGetServerCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*instance.Server, error)) *MockInstanceGetServerCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// FindInstanceVolumes mocks base method.
-func (m *MockInstance) FindInstanceVolumes(ctx context.Context, zone scw.Zone, tags []string) ([]*instance.Volume, error) {
+// GetServerType mocks base method.
+func (m *MockInstance) GetServerType(ctx context.Context, zone scw.Zone, commercialType string) (*instance.ServerType, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindInstanceVolumes", ctx, zone, tags)
-	ret0, _ := ret[0].([]*instance.Volume)
+	ret := m.ctrl.Call(m, "GetServerType", ctx, zone, commercialType)
+	ret0, _ := ret[0].(*instance.ServerType)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindInstanceVolumes indicates an expected call of FindInstanceVolumes.
-func (mr *MockInstanceMockRecorder) FindInstanceVolumes(ctx, zone, tags any) *MockInstanceFindInstanceVolumesCall {
+// GetServerType indicates an expected call of GetServerType.
+func (mr *MockInstanceMockRecorder) GetServerType(ctx, zone, commercialType any) *MockInstanceGetServerTypeCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInstanceVolumes", reflect.TypeOf((*MockInstance)(nil).FindInstanceVolumes), ctx, zone, tags)
-	return &MockInstanceFindInstanceVolumesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerType", reflect.TypeOf((*MockInstance)(nil).GetServerType), ctx, zone, commercialType)
+	return &MockInstanceGetServerTypeCall{Call: call}
 }
 
-// MockInstanceFindInstanceVolumesCall wrap *gomock.Call
-type MockInstanceFindInstanceVolumesCall struct {
+// MockInstanceGetServerTypeCall wrap *gomock.Call
+type MockInstanceGetServerTypeCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceFindInstanceVolumesCall) Return(arg0 []*instance.Volume, arg1 error) *MockInstanceFindInstanceVolumesCall {
+func (c *MockInstanceGetServerTypeCall) Return(arg0 *instance.ServerType, arg1 error) *MockInstanceGetServerTypeCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceFindInstanceVolumesCall) Do(f func(context.Context, scw.Zone, []string) ([]*instance.Volume, error)) *MockInstanceFindInstanceVolumesCall {
+func (c *MockInstanceGetServerTypeCall) Do(f func(context.Context, scw.Zone, string) (*instance.ServerType, error)) *MockInstanceGetServerTypeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceFindInstanceVolumesCall) DoAndReturn(f func(context.Context, scw.Zone, []string) ([]*instance.Volume, error)) *MockInstanceFindInstanceVolumesCall {
+func (c *MockInstanceGetServerTypeCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*instance.ServerType, error)) *MockInstanceGetServerTypeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// FindPlacementGroup mocks base method.
-func (m *MockInstance) FindPlacementGroup(ctx context.Context, zone scw.Zone, name string) (*instance.PlacementGroup, error) {
+// GetServerTypesAvailability mocks base method.
+func (m *MockInstance) GetServerTypesAvailability(ctx context.Context, zone scw.Zone) (map[string]instance.ServerTypesAvailability, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindPlacementGroup", ctx, zone, name)
-	ret0, _ := ret[0].(*instance.PlacementGroup)
+	ret := m.ctrl.Call(m, "GetServerTypesAvailability", ctx, zone)
+	ret0, _ := ret[0].(map[string]instance.ServerTypesAvailability)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindPlacementGroup indicates an expected call of FindPlacementGroup.
-func (mr *MockInstanceMockRecorder) FindPlacementGroup(ctx, zone, name any) *MockInstanceFindPlacementGroupCall {
+// GetServerTypesAvailability indicates an expected call of GetServerTypesAvailability.
+func (mr *MockInstanceMockRecorder) GetServerTypesAvailability(ctx, zone any) *MockInstanceGetServerTypesAvailabilityCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPlacementGroup", reflect.TypeOf((*MockInstance)(nil).FindPlacementGroup), ctx, zone, name)
-	return &MockInstanceFindPlacementGroupCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerTypesAvailability", reflect.TypeOf((*MockInstance)(nil).GetServerTypesAvailability), ctx, zone)
+	return &MockInstanceGetServerTypesAvailabilityCall{Call: call}
 }
 
-// MockInstanceFindPlacementGroupCall wrap *gomock.Call
-type MockInstanceFindPlacementGroupCall struct {
+// MockInstanceGetServerTypesAvailabilityCall wrap *gomock.Call
+type MockInstanceGetServerTypesAvailabilityCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceFindPlacementGroupCall) Return(arg0 *instance.PlacementGroup, arg1 error) *MockInstanceFindPlacementGroupCall {
+func (c *MockInstanceGetServerTypesAvailabilityCall) Return(arg0 map[string]instance.ServerTypesAvailability, arg1 error) *MockInstanceGetServerTypesAvailabilityCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceFindPlacementGroupCall) Do(f func(context.Context, scw.Zone, string) (*instance.PlacementGroup, error)) *MockInstanceFindPlacementGroupCall {
+func (c *MockInstanceGetServerTypesAvailabilityCall) Do(f func(context.Context, scw.Zone) (map[string]instance.ServerTypesAvailability, error)) *MockInstanceGetServerTypesAvailabilityCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceFindPlacementGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*instance.PlacementGroup, error)) *MockInstanceFindPlacementGroupCall {
+func (c *MockInstanceGetServerTypesAvailabilityCall) DoAndReturn(f func(context.Context, scw.Zone) (map[string]instance.ServerTypesAvailability, error)) *MockInstanceGetServerTypesAvailabilityCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// FindSecurityGroup mocks base method.
-func (m *MockInstance) FindSecurityGroup(ctx context.Context, zone scw.Zone, name string) (*instance.SecurityGroup, error) {
+// ListPlacementGroupServers mocks base method.
+func (m *MockInstance) ListPlacementGroupServers(ctx context.Context, zone scw.Zone, placementGroupID string) ([]*instance.PlacementGroupServer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindSecurityGroup", ctx, zone, name)
-	ret0, _ := ret[0].(*instance.SecurityGroup)
+	ret := m.ctrl.Call(m, "ListPlacementGroupServers", ctx, zone, placementGroupID)
+	ret0, _ := ret[0].([]*instance.PlacementGroupServer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindSecurityGroup indicates an expected call of FindSecurityGroup.
-func (mr *MockInstanceMockRecorder) FindSecurityGroup(ctx, zone, name any) *MockInstanceFindSecurityGroupCall {
+// ListPlacementGroupServers indicates an expected call of ListPlacementGroupServers.
+func (mr *MockInstanceMockRecorder) ListPlacementGroupServers(ctx, zone, placementGroupID any) *MockInstanceListPlacementGroupServersCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSecurityGroup", reflect.TypeOf((*MockInstance)(nil).FindSecurityGroup), ctx, zone, name)
-	return &MockInstanceFindSecurityGroupCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlacementGroupServers", reflect.TypeOf((*MockInstance)(nil).ListPlacementGroupServers), ctx, zone, placementGroupID)
+	return &MockInstanceListPlacementGroupServersCall{Call: call}
 }
 
-// MockInstanceFindSecurityGroupCall wrap *gomock.Call
-type MockInstanceFindSecurityGroupCall struct {
+// MockInstanceListPlacementGroupServersCall wrap *gomock.Call
+type MockInstanceListPlacementGroupServersCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceFindSecurityGroupCall) Return(arg0 *instance.SecurityGroup, arg1 error) *MockInstanceFindSecurityGroupCall {
+func (c *MockInstanceListPlacementGroupServersCall) Return(arg0 []*instance.PlacementGroupServer, arg1 error) *MockInstanceListPlacementGroupServersCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceFindSecurityGroupCall) Do(f func(context.Context, scw.Zone, string) (*instance.SecurityGroup, error)) *MockInstanceFindSecurityGroupCall {
+func (c *MockInstanceListPlacementGroupServersCall) Do(f func(context.Context, scw.Zone, string) ([]*instance.PlacementGroupServer, error)) *MockInstanceListPlacementGroupServersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceFindSecurityGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string) (*instance.SecurityGroup, error)) *MockInstanceFindSecurityGroupCall {
+func (c *MockInstanceListPlacementGroupServersCall) DoAndReturn(f func(context.Context, scw.Zone, string) ([]*instance.PlacementGroupServer, error)) *MockInstanceListPlacementGroupServersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// FindServer mocks base method.
-func (m *MockInstance) FindServer(ctx context.Context, zone scw.Zone, tags []string) (*instance.Server, error) {
+// ListSecurityGroupRules mocks base method.
+func (m *MockInstance) ListSecurityGroupRules(ctx context.Context, zone scw.Zone, securityGroupID string) ([]*instance.SecurityGroupRule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindServer", ctx, zone, tags)
-	ret0, _ := ret[0].(*instance.Server)
+	ret := m.ctrl.Call(m, "ListSecurityGroupRules", ctx, zone, securityGroupID)
+	ret0, _ := ret[0].([]*instance.SecurityGroupRule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindServer indicates an expected call of FindServer.
-func (mr *MockInstanceMockRecorder) FindServer(ctx, zone, tags any) *MockInstanceFindServerCall {
+// ListSecurityGroupRules indicates an expected call of ListSecurityGroupRules.
+func (mr *MockInstanceMockRecorder) ListSecurityGroupRules(ctx, zone, securityGroupID any) *MockInstanceListSecurityGroupRulesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindServer", reflect.TypeOf((*MockInstance)(nil).FindServer), ctx, zone, tags)
-	return &MockInstanceFindServerCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroupRules", reflect.TypeOf((*MockInstance)(nil).ListSecurityGroupRules), ctx, zone, securityGroupID)
+	return &MockInstanceListSecurityGroupRulesCall{Call: call}
 }
 
-// MockInstanceFindServerCall wrap *gomock.Call
-type MockInstanceFindServerCall struct {
+// MockInstanceListSecurityGroupRulesCall wrap *gomock.Call
+type MockInstanceListSecurityGroupRulesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceFindServerCall) Return(arg0 *instance.Server, arg1 error) *MockInstanceFindServerCall {
+func (c *MockInstanceListSecurityGroupRulesCall) Return(arg0 []*instance.SecurityGroupRule, arg1 error) *MockInstanceListSecurityGroupRulesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceFindServerCall) Do(f func(context.Context, scw.Zone, []string) (*instance.Server, error)) *MockInstanceFindServerCall {
+func (c *MockInstanceListSecurityGroupRulesCall) Do(f func(context.Context, scw.Zone, string) ([]*instance.SecurityGroupRule, error)) *MockInstanceListSecurityGroupRulesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceFindServerCall) DoAndReturn(f func(context.Context, scw.Zone, []string) (*instance.Server, error)) *MockInstanceFindServerCall {
+func (c *MockInstanceListSecurityGroupRulesCall) DoAndReturn(f func(context.Context, scw.Zone, string) ([]*instance.SecurityGroupRule, error)) *MockInstanceListSecurityGroupRulesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetAllServerUserData mocks base method.
-func (m *MockInstance) GetAllServerUserData(ctx context.Context, zone scw.Zone, serverID string) (map[string]io.Reader, error) {
+// RemoveServerPlacementGroup mocks base method.
+func (m *MockInstance) RemoveServerPlacementGroup(ctx context.Context, zone scw.Zone, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllServerUserData", ctx, zone, serverID)
-	ret0, _ := ret[0].(map[string]io.Reader)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RemoveServerPlacementGroup", ctx, zone, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAllServerUserData indicates an expected call of GetAllServerUserData.
-func (mr *MockInstanceMockRecorder) GetAllServerUserData(ctx, zone, serverID any) *MockInstanceGetAllServerUserDataCall {
+// RemoveServerPlacementGroup indicates an expected call of RemoveServerPlacementGroup.
+func (mr *MockInstanceMockRecorder) RemoveServerPlacementGroup(ctx, zone, id any) *MockInstanceRemoveServerPlacementGroupCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllServerUserData", reflect.TypeOf((*MockInstance)(nil).GetAllServerUserData), ctx, zone, serverID)
-	return &MockInstanceGetAllServerUserDataCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServerPlacementGroup", reflect.TypeOf((*MockInstance)(nil).RemoveServerPlacementGroup), ctx, zone, id)
+	return &MockInstanceRemoveServerPlacementGroupCall{Call: call}
 }
 
-// MockInstanceGetAllServerUserDataCall wrap *gomock.Call
-type MockInstanceGetAllServerUserDataCall struct {
+// MockInstanceRemoveServerPlacementGroupCall wrap *gomock.Call
+type MockInstanceRemoveServerPlacementGroupCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockInstanceGetAllServerUserDataCall) Return(arg0 map[string]io.Reader, arg1 error) *MockInstanceGetAllServerUserDataCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockInstanceRemoveServerPlacementGroupCall) Return(arg0 error) *MockInstanceRemoveServerPlacementGroupCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockInstanceGetAllServerUserDataCall) Do(f func(context.Context, scw.Zone, string) (map[string]io.Reader, error)) *MockInstanceGetAllServerUserDataCall {
+func (c *MockInstanceRemoveServerPlacementGroupCall) Do(f func(context.Context, scw.Zone, string) error) *MockInstanceRemoveServerPlacementGroupCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockInstanceGetAllServerUserDataCall) DoAndReturn(f func(context.Context, scw.Zone, string) (map[string]io.Reader, error)) *MockInstanceGetAllServerUserDataCall {
+func (c *MockInstanceRemoveServerPlacementGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string) error) *MockInstanceRemoveServerPlacementGroupCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -1758,6 +2908,44 @@ func (c *MockInstanceServerActionCall) DoAndReturn(f func(context.Context, scw.Z
 	return c
 }
 
+// SetSecurityGroupRules mocks base method.
+func (m *MockInstance) SetSecurityGroupRules(ctx context.Context, zone scw.Zone, securityGroupID string, rules []*instance.SetSecurityGroupRulesRequestRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSecurityGroupRules", ctx, zone, securityGroupID, rules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSecurityGroupRules indicates an expected call of SetSecurityGroupRules.
+func (mr *MockInstanceMockRecorder) SetSecurityGroupRules(ctx, zone, securityGroupID, rules any) *MockInstanceSetSecurityGroupRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecurityGroupRules", reflect.TypeOf((*MockInstance)(nil).SetSecurityGroupRules), ctx, zone, securityGroupID, rules)
+	return &MockInstanceSetSecurityGroupRulesCall{Call: call}
+}
+
+// MockInstanceSetSecurityGroupRulesCall wrap *gomock.Call
+type MockInstanceSetSecurityGroupRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceSetSecurityGroupRulesCall) Return(arg0 error) *MockInstanceSetSecurityGroupRulesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceSetSecurityGroupRulesCall) Do(f func(context.Context, scw.Zone, string, []*instance.SetSecurityGroupRulesRequestRule) error) *MockInstanceSetSecurityGroupRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceSetSecurityGroupRulesCall) DoAndReturn(f func(context.Context, scw.Zone, string, []*instance.SetSecurityGroupRulesRequestRule) error) *MockInstanceSetSecurityGroupRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetServerUserData mocks base method.
 func (m *MockInstance) SetServerUserData(ctx context.Context, zone scw.Zone, serverID, key, content string) error {
 	m.ctrl.T.Helper()
@@ -1796,6 +2984,44 @@ func (c *MockInstanceSetServerUserDataCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// UpdateIPTags mocks base method.
+func (m *MockInstance) UpdateIPTags(ctx context.Context, zone scw.Zone, ipID string, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIPTags", ctx, zone, ipID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIPTags indicates an expected call of UpdateIPTags.
+func (mr *MockInstanceMockRecorder) UpdateIPTags(ctx, zone, ipID, tags any) *MockInstanceUpdateIPTagsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIPTags", reflect.TypeOf((*MockInstance)(nil).UpdateIPTags), ctx, zone, ipID, tags)
+	return &MockInstanceUpdateIPTagsCall{Call: call}
+}
+
+// MockInstanceUpdateIPTagsCall wrap *gomock.Call
+type MockInstanceUpdateIPTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceUpdateIPTagsCall) Return(arg0 error) *MockInstanceUpdateIPTagsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceUpdateIPTagsCall) Do(f func(context.Context, scw.Zone, string, []string) error) *MockInstanceUpdateIPTagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceUpdateIPTagsCall) DoAndReturn(f func(context.Context, scw.Zone, string, []string) error) *MockInstanceUpdateIPTagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateInstanceVolumeTags mocks base method.
 func (m *MockInstance) UpdateInstanceVolumeTags(ctx context.Context, zone scw.Zone, volumeID string, tags []string) error {
 	m.ctrl.T.Helper()
@@ -1834,6 +3060,44 @@ func (c *MockInstanceUpdateInstanceVolumeTagsCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// UpdateServerProtection mocks base method.
+func (m *MockInstance) UpdateServerProtection(ctx context.Context, zone scw.Zone, id string, protected bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServerProtection", ctx, zone, id, protected)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServerProtection indicates an expected call of UpdateServerProtection.
+func (mr *MockInstanceMockRecorder) UpdateServerProtection(ctx, zone, id, protected any) *MockInstanceUpdateServerProtectionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServerProtection", reflect.TypeOf((*MockInstance)(nil).UpdateServerProtection), ctx, zone, id, protected)
+	return &MockInstanceUpdateServerProtectionCall{Call: call}
+}
+
+// MockInstanceUpdateServerProtectionCall wrap *gomock.Call
+type MockInstanceUpdateServerProtectionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceUpdateServerProtectionCall) Return(arg0 error) *MockInstanceUpdateServerProtectionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceUpdateServerProtectionCall) Do(f func(context.Context, scw.Zone, string, bool) error) *MockInstanceUpdateServerProtectionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceUpdateServerProtectionCall) DoAndReturn(f func(context.Context, scw.Zone, string, bool) error) *MockInstanceUpdateServerProtectionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateServerPublicIPs mocks base method.
 func (m *MockInstance) UpdateServerPublicIPs(ctx context.Context, zone scw.Zone, id string, publicIPIDs []string) (*instance.Server, error) {
 	m.ctrl.T.Helper()
@@ -1872,3 +3136,79 @@ func (c *MockInstanceUpdateServerPublicIPsCall) DoAndReturn(f func(context.Conte
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// UpdateServerSecurityGroup mocks base method.
+func (m *MockInstance) UpdateServerSecurityGroup(ctx context.Context, zone scw.Zone, id, securityGroupID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServerSecurityGroup", ctx, zone, id, securityGroupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServerSecurityGroup indicates an expected call of UpdateServerSecurityGroup.
+func (mr *MockInstanceMockRecorder) UpdateServerSecurityGroup(ctx, zone, id, securityGroupID any) *MockInstanceUpdateServerSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServerSecurityGroup", reflect.TypeOf((*MockInstance)(nil).UpdateServerSecurityGroup), ctx, zone, id, securityGroupID)
+	return &MockInstanceUpdateServerSecurityGroupCall{Call: call}
+}
+
+// MockInstanceUpdateServerSecurityGroupCall wrap *gomock.Call
+type MockInstanceUpdateServerSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceUpdateServerSecurityGroupCall) Return(arg0 error) *MockInstanceUpdateServerSecurityGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceUpdateServerSecurityGroupCall) Do(f func(context.Context, scw.Zone, string, string) error) *MockInstanceUpdateServerSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceUpdateServerSecurityGroupCall) DoAndReturn(f func(context.Context, scw.Zone, string, string) error) *MockInstanceUpdateServerSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateServerTags mocks base method.
+func (m *MockInstance) UpdateServerTags(ctx context.Context, zone scw.Zone, id string, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServerTags", ctx, zone, id, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServerTags indicates an expected call of UpdateServerTags.
+func (mr *MockInstanceMockRecorder) UpdateServerTags(ctx, zone, id, tags any) *MockInstanceUpdateServerTagsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServerTags", reflect.TypeOf((*MockInstance)(nil).UpdateServerTags), ctx, zone, id, tags)
+	return &MockInstanceUpdateServerTagsCall{Call: call}
+}
+
+// MockInstanceUpdateServerTagsCall wrap *gomock.Call
+type MockInstanceUpdateServerTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceUpdateServerTagsCall) Return(arg0 error) *MockInstanceUpdateServerTagsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceUpdateServerTagsCall) Do(f func(context.Context, scw.Zone, string, []string) error) *MockInstanceUpdateServerTagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceUpdateServerTagsCall) DoAndReturn(f func(context.Context, scw.Zone, string, []string) error) *MockInstanceUpdateServerTagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -11,6 +11,7 @@ package mock_client
 
 import (
 	context "context"
+	net "net"
 	reflect "reflect"
 
 	ipam "github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
@@ -42,6 +43,50 @@ func (m *MockIPAMAPI) EXPECT() *MockIPAMAPIMockRecorder {
 	return m.recorder
 }
 
+// BookIP mocks base method.
+func (m *MockIPAMAPI) BookIP(req *ipam.BookIPRequest, opts ...scw.RequestOption) (*ipam.IP, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BookIP", varargs...)
+	ret0, _ := ret[0].(*ipam.IP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BookIP indicates an expected call of BookIP.
+func (mr *MockIPAMAPIMockRecorder) BookIP(req any, opts ...any) *MockIPAMAPIBookIPCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BookIP", reflect.TypeOf((*MockIPAMAPI)(nil).BookIP), varargs...)
+	return &MockIPAMAPIBookIPCall{Call: call}
+}
+
+// MockIPAMAPIBookIPCall wrap *gomock.Call
+type MockIPAMAPIBookIPCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIPAMAPIBookIPCall) Return(arg0 *ipam.IP, arg1 error) *MockIPAMAPIBookIPCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIPAMAPIBookIPCall) Do(f func(*ipam.BookIPRequest, ...scw.RequestOption) (*ipam.IP, error)) *MockIPAMAPIBookIPCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIPAMAPIBookIPCall) DoAndReturn(f func(*ipam.BookIPRequest, ...scw.RequestOption) (*ipam.IP, error)) *MockIPAMAPIBookIPCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListIPs mocks base method.
 func (m *MockIPAMAPI) ListIPs(req *ipam.ListIPsRequest, opts ...scw.RequestOption) (*ipam.ListIPsResponse, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +131,49 @@ func (c *MockIPAMAPIListIPsCall) DoAndReturn(f func(*ipam.ListIPsRequest, ...scw
 	return c
 }
 
+// ReleaseIP mocks base method.
+func (m *MockIPAMAPI) ReleaseIP(req *ipam.ReleaseIPRequest, opts ...scw.RequestOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{req}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseIP", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseIP indicates an expected call of ReleaseIP.
+func (mr *MockIPAMAPIMockRecorder) ReleaseIP(req any, opts ...any) *MockIPAMAPIReleaseIPCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{req}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIP", reflect.TypeOf((*MockIPAMAPI)(nil).ReleaseIP), varargs...)
+	return &MockIPAMAPIReleaseIPCall{Call: call}
+}
+
+// MockIPAMAPIReleaseIPCall wrap *gomock.Call
+type MockIPAMAPIReleaseIPCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIPAMAPIReleaseIPCall) Return(arg0 error) *MockIPAMAPIReleaseIPCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIPAMAPIReleaseIPCall) Do(f func(*ipam.ReleaseIPRequest, ...scw.RequestOption) error) *MockIPAMAPIReleaseIPCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIPAMAPIReleaseIPCall) DoAndReturn(f func(*ipam.ReleaseIPRequest, ...scw.RequestOption) error) *MockIPAMAPIReleaseIPCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReleaseIPSet mocks base method.
 func (m *MockIPAMAPI) ReleaseIPSet(req *ipam.ReleaseIPSetRequest, opts ...scw.RequestOption) error {
 	m.ctrl.T.Helper()
@@ -153,6 +241,45 @@ func (m *MockIPAM) EXPECT() *MockIPAMMockRecorder {
 	return m.recorder
 }
 
+// BookPrivateNetworkIP mocks base method.
+func (m *MockIPAM) BookPrivateNetworkIP(ctx context.Context, privateNetworkID string, address net.IP, tags []string) (*ipam.IP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BookPrivateNetworkIP", ctx, privateNetworkID, address, tags)
+	ret0, _ := ret[0].(*ipam.IP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BookPrivateNetworkIP indicates an expected call of BookPrivateNetworkIP.
+func (mr *MockIPAMMockRecorder) BookPrivateNetworkIP(ctx, privateNetworkID, address, tags any) *MockIPAMBookPrivateNetworkIPCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BookPrivateNetworkIP", reflect.TypeOf((*MockIPAM)(nil).BookPrivateNetworkIP), ctx, privateNetworkID, address, tags)
+	return &MockIPAMBookPrivateNetworkIPCall{Call: call}
+}
+
+// MockIPAMBookPrivateNetworkIPCall wrap *gomock.Call
+type MockIPAMBookPrivateNetworkIPCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIPAMBookPrivateNetworkIPCall) Return(arg0 *ipam.IP, arg1 error) *MockIPAMBookPrivateNetworkIPCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIPAMBookPrivateNetworkIPCall) Do(f func(context.Context, string, net.IP, []string) (*ipam.IP, error)) *MockIPAMBookPrivateNetworkIPCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIPAMBookPrivateNetworkIPCall) DoAndReturn(f func(context.Context, string, net.IP, []string) (*ipam.IP, error)) *MockIPAMBookPrivateNetworkIPCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CleanAvailableIPs mocks base method.
 func (m *MockIPAM) CleanAvailableIPs(ctx context.Context, privateNetworkID string) error {
 	m.ctrl.T.Helper()
@@ -230,6 +357,45 @@ func (c *MockIPAMFindAvailableIPsCall) DoAndReturn(f func(context.Context, strin
 	return c
 }
 
+// FindBaremetalPrivateNICIPs mocks base method.
+func (m *MockIPAM) FindBaremetalPrivateNICIPs(ctx context.Context, privateNICID string) ([]*ipam.IP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBaremetalPrivateNICIPs", ctx, privateNICID)
+	ret0, _ := ret[0].([]*ipam.IP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBaremetalPrivateNICIPs indicates an expected call of FindBaremetalPrivateNICIPs.
+func (mr *MockIPAMMockRecorder) FindBaremetalPrivateNICIPs(ctx, privateNICID any) *MockIPAMFindBaremetalPrivateNICIPsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBaremetalPrivateNICIPs", reflect.TypeOf((*MockIPAM)(nil).FindBaremetalPrivateNICIPs), ctx, privateNICID)
+	return &MockIPAMFindBaremetalPrivateNICIPsCall{Call: call}
+}
+
+// MockIPAMFindBaremetalPrivateNICIPsCall wrap *gomock.Call
+type MockIPAMFindBaremetalPrivateNICIPsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIPAMFindBaremetalPrivateNICIPsCall) Return(arg0 []*ipam.IP, arg1 error) *MockIPAMFindBaremetalPrivateNICIPsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIPAMFindBaremetalPrivateNICIPsCall) Do(f func(context.Context, string) ([]*ipam.IP, error)) *MockIPAMFindBaremetalPrivateNICIPsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIPAMFindBaremetalPrivateNICIPsCall) DoAndReturn(f func(context.Context, string) ([]*ipam.IP, error)) *MockIPAMFindBaremetalPrivateNICIPsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindLBServersIPs mocks base method.
 func (m *MockIPAM) FindLBServersIPs(ctx context.Context, privateNetworkID string, lbIDs []string) ([]*ipam.IP, error) {
 	m.ctrl.T.Helper()
@@ -307,3 +473,80 @@ func (c *MockIPAMFindPrivateNICIPsCall) DoAndReturn(f func(context.Context, stri
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// FindPrivateNetworkIPs mocks base method.
+func (m *MockIPAM) FindPrivateNetworkIPs(ctx context.Context, privateNetworkID string, tags []string) ([]*ipam.IP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPrivateNetworkIPs", ctx, privateNetworkID, tags)
+	ret0, _ := ret[0].([]*ipam.IP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPrivateNetworkIPs indicates an expected call of FindPrivateNetworkIPs.
+func (mr *MockIPAMMockRecorder) FindPrivateNetworkIPs(ctx, privateNetworkID, tags any) *MockIPAMFindPrivateNetworkIPsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrivateNetworkIPs", reflect.TypeOf((*MockIPAM)(nil).FindPrivateNetworkIPs), ctx, privateNetworkID, tags)
+	return &MockIPAMFindPrivateNetworkIPsCall{Call: call}
+}
+
+// MockIPAMFindPrivateNetworkIPsCall wrap *gomock.Call
+type MockIPAMFindPrivateNetworkIPsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIPAMFindPrivateNetworkIPsCall) Return(arg0 []*ipam.IP, arg1 error) *MockIPAMFindPrivateNetworkIPsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIPAMFindPrivateNetworkIPsCall) Do(f func(context.Context, string, []string) ([]*ipam.IP, error)) *MockIPAMFindPrivateNetworkIPsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIPAMFindPrivateNetworkIPsCall) DoAndReturn(f func(context.Context, string, []string) ([]*ipam.IP, error)) *MockIPAMFindPrivateNetworkIPsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReleaseIP mocks base method.
+func (m *MockIPAM) ReleaseIP(ctx context.Context, ipID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIP", ctx, ipID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseIP indicates an expected call of ReleaseIP.
+func (mr *MockIPAMMockRecorder) ReleaseIP(ctx, ipID any) *MockIPAMReleaseIPCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIP", reflect.TypeOf((*MockIPAM)(nil).ReleaseIP), ctx, ipID)
+	return &MockIPAMReleaseIPCall{Call: call}
+}
+
+// MockIPAMReleaseIPCall wrap *gomock.Call
+type MockIPAMReleaseIPCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockIPAMReleaseIPCall) Return(arg0 error) *MockIPAMReleaseIPCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockIPAMReleaseIPCall) Do(f func(context.Context, string) error) *MockIPAMReleaseIPCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockIPAMReleaseIPCall) DoAndReturn(f func(context.Context, string) error) *MockIPAMReleaseIPCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
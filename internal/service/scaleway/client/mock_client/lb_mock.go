@@ -1189,18 +1189,18 @@ func (c *MockLBAttachLBPrivateNetworkCall) DoAndReturn(f func(context.Context, s
 }
 
 // CreateBackend mocks base method.
-func (m *MockLB) CreateBackend(ctx context.Context, zone scw.Zone, lbID, name string, servers []string, port int32) (*lb.Backend, error) {
+func (m *MockLB) CreateBackend(ctx context.Context, zone scw.Zone, lbID string, backend *lb.Backend) (*lb.Backend, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBackend", ctx, zone, lbID, name, servers, port)
+	ret := m.ctrl.Call(m, "CreateBackend", ctx, zone, lbID, backend)
 	ret0, _ := ret[0].(*lb.Backend)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateBackend indicates an expected call of CreateBackend.
-func (mr *MockLBMockRecorder) CreateBackend(ctx, zone, lbID, name, servers, port any) *MockLBCreateBackendCall {
+func (mr *MockLBMockRecorder) CreateBackend(ctx, zone, lbID, backend any) *MockLBCreateBackendCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackend", reflect.TypeOf((*MockLB)(nil).CreateBackend), ctx, zone, lbID, name, servers, port)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackend", reflect.TypeOf((*MockLB)(nil).CreateBackend), ctx, zone, lbID, backend)
 	return &MockLBCreateBackendCall{Call: call}
 }
 
@@ -1216,13 +1216,13 @@ func (c *MockLBCreateBackendCall) Return(arg0 *lb.Backend, arg1 error) *MockLBCr
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockLBCreateBackendCall) Do(f func(context.Context, scw.Zone, string, string, []string, int32) (*lb.Backend, error)) *MockLBCreateBackendCall {
+func (c *MockLBCreateBackendCall) Do(f func(context.Context, scw.Zone, string, *lb.Backend) (*lb.Backend, error)) *MockLBCreateBackendCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockLBCreateBackendCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, []string, int32) (*lb.Backend, error)) *MockLBCreateBackendCall {
+func (c *MockLBCreateBackendCall) DoAndReturn(f func(context.Context, scw.Zone, string, *lb.Backend) (*lb.Backend, error)) *MockLBCreateBackendCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -1962,18 +1962,18 @@ func (c *MockLBSetLBACLsCall) DoAndReturn(f func(context.Context, scw.Zone, stri
 }
 
 // UpdateBackend mocks base method.
-func (m *MockLB) UpdateBackend(ctx context.Context, zone scw.Zone, backendID, name string, port int32) (*lb.Backend, error) {
+func (m *MockLB) UpdateBackend(ctx context.Context, zone scw.Zone, backendID string, backend *lb.Backend) (*lb.Backend, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBackend", ctx, zone, backendID, name, port)
+	ret := m.ctrl.Call(m, "UpdateBackend", ctx, zone, backendID, backend)
 	ret0, _ := ret[0].(*lb.Backend)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateBackend indicates an expected call of UpdateBackend.
-func (mr *MockLBMockRecorder) UpdateBackend(ctx, zone, backendID, name, port any) *MockLBUpdateBackendCall {
+func (mr *MockLBMockRecorder) UpdateBackend(ctx, zone, backendID, backend any) *MockLBUpdateBackendCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackend", reflect.TypeOf((*MockLB)(nil).UpdateBackend), ctx, zone, backendID, name, port)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackend", reflect.TypeOf((*MockLB)(nil).UpdateBackend), ctx, zone, backendID, backend)
 	return &MockLBUpdateBackendCall{Call: call}
 }
 
@@ -1989,30 +1989,30 @@ func (c *MockLBUpdateBackendCall) Return(arg0 *lb.Backend, arg1 error) *MockLBUp
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockLBUpdateBackendCall) Do(f func(context.Context, scw.Zone, string, string, int32) (*lb.Backend, error)) *MockLBUpdateBackendCall {
+func (c *MockLBUpdateBackendCall) Do(f func(context.Context, scw.Zone, string, *lb.Backend) (*lb.Backend, error)) *MockLBUpdateBackendCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockLBUpdateBackendCall) DoAndReturn(f func(context.Context, scw.Zone, string, string, int32) (*lb.Backend, error)) *MockLBUpdateBackendCall {
+func (c *MockLBUpdateBackendCall) DoAndReturn(f func(context.Context, scw.Zone, string, *lb.Backend) (*lb.Backend, error)) *MockLBUpdateBackendCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // UpdateHealthCheck mocks base method.
-func (m *MockLB) UpdateHealthCheck(ctx context.Context, zone scw.Zone, backendID string, port int32) (*lb.HealthCheck, error) {
+func (m *MockLB) UpdateHealthCheck(ctx context.Context, zone scw.Zone, backendID string, healthCheck *lb.HealthCheck) (*lb.HealthCheck, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateHealthCheck", ctx, zone, backendID, port)
+	ret := m.ctrl.Call(m, "UpdateHealthCheck", ctx, zone, backendID, healthCheck)
 	ret0, _ := ret[0].(*lb.HealthCheck)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateHealthCheck indicates an expected call of UpdateHealthCheck.
-func (mr *MockLBMockRecorder) UpdateHealthCheck(ctx, zone, backendID, port any) *MockLBUpdateHealthCheckCall {
+func (mr *MockLBMockRecorder) UpdateHealthCheck(ctx, zone, backendID, healthCheck any) *MockLBUpdateHealthCheckCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHealthCheck", reflect.TypeOf((*MockLB)(nil).UpdateHealthCheck), ctx, zone, backendID, port)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHealthCheck", reflect.TypeOf((*MockLB)(nil).UpdateHealthCheck), ctx, zone, backendID, healthCheck)
 	return &MockLBUpdateHealthCheckCall{Call: call}
 }
 
@@ -2028,13 +2028,13 @@ func (c *MockLBUpdateHealthCheckCall) Return(arg0 *lb.HealthCheck, arg1 error) *
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockLBUpdateHealthCheckCall) Do(f func(context.Context, scw.Zone, string, int32) (*lb.HealthCheck, error)) *MockLBUpdateHealthCheckCall {
+func (c *MockLBUpdateHealthCheckCall) Do(f func(context.Context, scw.Zone, string, *lb.HealthCheck) (*lb.HealthCheck, error)) *MockLBUpdateHealthCheckCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockLBUpdateHealthCheckCall) DoAndReturn(f func(context.Context, scw.Zone, string, int32) (*lb.HealthCheck, error)) *MockLBUpdateHealthCheckCall {
+func (c *MockLBUpdateHealthCheckCall) DoAndReturn(f func(context.Context, scw.Zone, string, *lb.HealthCheck) (*lb.HealthCheck, error)) *MockLBUpdateHealthCheckCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,331 @@
+package common
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+)
+
+const (
+	// BootstrapFormatCloudConfig is the format of cloud-init bootstrap data.
+	BootstrapFormatCloudConfig = "cloud-config"
+	// BootstrapFormatIgnition is the format of Ignition bootstrap data.
+	BootstrapFormatIgnition = "ignition"
+)
+
+// BootstrapData contains the values that can be used in the bootstrap data
+// template, between the [[[ and ]]] delimiters (e.g. [[[ .NodeIP ]]]).
+// Fields are empty when the value is not available for the server.
+type BootstrapData struct {
+	// NodeIP is the private IPv4 of the server if the cluster has a Private
+	// Network, or its public IPv4 otherwise.
+	NodeIP string
+	// PublicIPv4 is the public IPv4 of the server.
+	PublicIPv4 string
+	// PublicIPv6 is the public IPv6 of the server.
+	PublicIPv6 string
+	// PrivateIP is the private IPv4 of the server in the Private Network of the cluster.
+	PrivateIP string
+	// ServerID is the ID of the server.
+	ServerID string
+	// ProviderID is the provider ID of the node (e.g. scaleway://instance/fr-par-1/<id>).
+	ProviderID string
+	// Zone is the zone of the server.
+	Zone string
+	// Region is the region of the server.
+	Region string
+	// CommercialType is the commercial type (or offer name) of the server.
+	CommercialType string
+	// ClusterName is the name of the Cluster.
+	ClusterName string
+	// ControlPlaneEndpoint is the endpoint of the control-plane. It is
+	// rendered as host:port, .ControlPlaneEndpoint.Host and .ControlPlaneEndpoint.Port
+	// can also be used.
+	ControlPlaneEndpoint clusterv1.APIEndpoint
+	// PrivateNetworkID is the ID of the Private Network of the cluster.
+	PrivateNetworkID string
+}
+
+// BootstrapServer contains the values of a server that are used to build its
+// BootstrapData. Only these values differ between Instance and Elastic Metal servers.
+type BootstrapServer struct {
+	// ID is the ID of the server.
+	ID string
+	// ProviderID is the provider ID of the node.
+	ProviderID string
+	// Zone is the zone of the server.
+	Zone scw.Zone
+	// CommercialType is the commercial type (or offer name) of the server.
+	CommercialType string
+	// PublicIPs are the public IPs of the server, the first IPv4 and IPv6 are used.
+	PublicIPs []net.IP
+	// PrivateIPs are the IPs of the server in the Private Network of the cluster.
+	PrivateIPs []*ipam.IP
+	// NodeIP is the IP of the node.
+	NodeIP string
+}
+
+// NewBootstrapData returns the values available in the bootstrap data template
+// of a server of the cluster.
+func NewBootstrapData(clusterScope *scope.Cluster, clusterName string, server *BootstrapServer) *BootstrapData {
+	data := &BootstrapData{
+		NodeIP:               server.NodeIP,
+		ServerID:             server.ID,
+		ProviderID:           server.ProviderID,
+		Zone:                 server.Zone.String(),
+		CommercialType:       server.CommercialType,
+		ClusterName:          clusterName,
+		ControlPlaneEndpoint: clusterScope.ScalewayCluster.Spec.ControlPlaneEndpoint,
+	}
+
+	if region, err := server.Zone.Region(); err == nil {
+		data.Region = region.String()
+	}
+
+	for _, ip := range server.PublicIPs {
+		switch {
+		case ip.To4() != nil && data.PublicIPv4 == "":
+			data.PublicIPv4 = ip.String()
+		case ip.To4() == nil && data.PublicIPv6 == "":
+			data.PublicIPv6 = ip.String()
+		}
+	}
+
+	if v4Index := slices.IndexFunc(server.PrivateIPs, func(ip *ipam.IP) bool { return !ip.IsIPv6 }); v4Index != -1 {
+		data.PrivateIP = server.PrivateIPs[v4Index].Address.IP.String()
+	}
+
+	if clusterScope.HasPrivateNetwork() {
+		// The error is ignored as the private network ID is only missing if the
+		// cluster has no Private Network.
+		data.PrivateNetworkID, _ = clusterScope.PrivateNetworkID()
+	}
+
+	return data
+}
+
+// bootstrapDataFuncs are the helper functions available in the bootstrap data template.
+var bootstrapDataFuncs = template.FuncMap{
+	// default returns def if value is empty: [[[ .PublicIPv4 | default "none" ]]].
+	"default": func(def, value string) string {
+		if value == "" {
+			return def
+		}
+
+		return value
+	},
+	// join concatenates the elements of a list: [[[ join "," (list .PublicIPv4 .PublicIPv6) ]]].
+	"join": func(sep string, elems []string) string {
+		return strings.Join(elems, sep)
+	},
+	// list returns its non-empty arguments as a list.
+	"list": func(elems ...string) []string {
+		out := make([]string, 0, len(elems))
+		for _, elem := range elems {
+			if elem != "" {
+				out = append(out, elem)
+			}
+		}
+
+		return out
+	},
+	"split":   func(sep, s string) []string { return strings.Split(s, sep) },
+	"lower":   strings.ToLower,
+	"upper":   strings.ToUpper,
+	"trim":    strings.TrimSpace,
+	"replace": func(oldStr, newStr, s string) string { return strings.ReplaceAll(s, oldStr, newStr) },
+}
+
+// BootstrapDataTemplateError is returned when the bootstrap data template is invalid.
+type BootstrapDataTemplateError struct {
+	err error
+}
+
+// Error returns the error message for a BootstrapDataTemplateError.
+func (e *BootstrapDataTemplateError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *BootstrapDataTemplateError) Unwrap() error {
+	return e.err
+}
+
+// IsBootstrapDataTemplateError returns true if the error is a BootstrapDataTemplateError.
+func IsBootstrapDataTemplateError(err error) bool {
+	var templateErr *BootstrapDataTemplateError
+	return errors.As(err, &templateErr)
+}
+
+// RenderBootstrapData renders the bootstrap data template with the provided data.
+// The format of the bootstrap data must be cloud-config or ignition, an empty
+// format is considered as cloud-config.
+func RenderBootstrapData(bootstrapData []byte, format string, data *BootstrapData) (string, error) {
+	switch format {
+	case "", BootstrapFormatCloudConfig:
+		return renderTemplate(string(bootstrapData), data)
+	case BootstrapFormatIgnition:
+		return renderIgnition(bootstrapData, data)
+	default:
+		return "", fmt.Errorf("unsupported bootstrap data format %q", format)
+	}
+}
+
+func renderTemplate(text string, data *BootstrapData) (string, error) {
+	tmpl, err := template.New("").
+		Delims("[[[", "]]]").
+		Funcs(bootstrapDataFuncs).
+		Option("missingkey=error").
+		Parse(text)
+	if err != nil {
+		return "", &BootstrapDataTemplateError{fmt.Errorf("failed to parse bootstrap data as template: %w", err)}
+	}
+
+	tmplExec := &strings.Builder{} // tmplExec will contain the executed template.
+	if err := tmpl.Execute(tmplExec, data); err != nil {
+		return "", &BootstrapDataTemplateError{fmt.Errorf("failed to execute bootstrap data template: %w", err)}
+	}
+
+	return tmplExec.String(), nil
+}
+
+// renderIgnition renders the templates found in the string values of an Ignition
+// config. The content of files, which is stored in data URLs, is decoded before
+// being rendered so that the rendered values are always correctly escaped.
+func renderIgnition(bootstrapData []byte, data *BootstrapData) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bootstrapData))
+	decoder.UseNumber()
+
+	var config any
+	if err := decoder.Decode(&config); err != nil {
+		return "", &BootstrapDataTemplateError{fmt.Errorf("failed to parse ignition bootstrap data: %w", err)}
+	}
+
+	config, err := renderIgnitionValue(config, data)
+	if err != nil {
+		return "", err
+	}
+
+	out := &bytes.Buffer{}
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(config); err != nil {
+		return "", fmt.Errorf("failed to marshal ignition bootstrap data: %w", err)
+	}
+
+	return strings.TrimSuffix(out.String(), "\n"), nil
+}
+
+func renderIgnitionValue(value any, data *BootstrapData) (any, error) {
+	var err error
+
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			// The source of a file contents is a data URL that must be decoded.
+			if source, ok := field.(string); ok && key == "source" && strings.HasPrefix(source, "data:") {
+				if err := renderIgnitionContents(v, data); err != nil {
+					return nil, err
+				}
+
+				continue
+			}
+
+			if v[key], err = renderIgnitionValue(field, data); err != nil {
+				return nil, err
+			}
+		}
+	case []any:
+		for i, item := range v {
+			if v[i], err = renderIgnitionValue(item, data); err != nil {
+				return nil, err
+			}
+		}
+	case string:
+		if strings.Contains(v, "[[[") {
+			return renderTemplate(v, data)
+		}
+	}
+
+	return value, nil
+}
+
+// renderIgnitionContents renders the data URL source of an Ignition resource
+// (e.g. file contents), which may be compressed with gzip.
+func renderIgnitionContents(resource map[string]any, data *BootstrapData) error {
+	source, _ := resource["source"].(string)
+	compression, _ := resource["compression"].(string)
+
+	header, payload, ok := strings.Cut(strings.TrimPrefix(source, "data:"), ",")
+	if !ok {
+		return &BootstrapDataTemplateError{errors.New("failed to parse ignition data URL: missing comma")}
+	}
+
+	var content []byte
+	if strings.HasSuffix(header, ";base64") {
+		decoded, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return &BootstrapDataTemplateError{fmt.Errorf("failed to decode ignition data URL: %w", err)}
+		}
+
+		content = decoded
+	} else {
+		decoded, err := url.PathUnescape(payload)
+		if err != nil {
+			return &BootstrapDataTemplateError{fmt.Errorf("failed to decode ignition data URL: %w", err)}
+		}
+
+		content = []byte(decoded)
+	}
+
+	switch compression {
+	case "":
+	case "gzip":
+		reader, err := gzip.NewReader(bytes.NewReader(content))
+		if err != nil {
+			return &BootstrapDataTemplateError{fmt.Errorf("failed to decompress ignition data URL: %w", err)}
+		}
+
+		if content, err = io.ReadAll(reader); err != nil {
+			return &BootstrapDataTemplateError{fmt.Errorf("failed to decompress ignition data URL: %w", err)}
+		}
+	default:
+		return &BootstrapDataTemplateError{fmt.Errorf("unsupported ignition compression %q", compression)}
+	}
+
+	if !bytes.Contains(content, []byte("[[[")) {
+		return nil
+	}
+
+	// The hash would no longer match the rendered content.
+	if _, ok := resource["verification"]; ok {
+		return &BootstrapDataTemplateError{errors.New("cannot render template in ignition data URL that has a verification hash")}
+	}
+
+	rendered, err := renderTemplate(string(content), data)
+	if err != nil {
+		return err
+	}
+
+	// The rendered content is stored uncompressed.
+	delete(resource, "compression")
+	resource["source"] = "data:;base64," + base64.StdEncoding.EncodeToString([]byte(rendered))
+
+	return nil
+}
@@ -0,0 +1,241 @@
+package common
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+)
+
+func TestRenderBootstrapData(t *testing.T) {
+	t.Parallel()
+	data := &BootstrapData{
+		NodeIP:         "10.0.0.2",
+		PublicIPv4:     "42.42.42.42",
+		ServerID:       "11111111-1111-1111-1111-111111111111",
+		ProviderID:     "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+		Zone:           "fr-par-1",
+		Region:         "fr-par",
+		CommercialType: "PRO2-S",
+		ClusterName:    "cluster",
+		ControlPlaneEndpoint: clusterv1.APIEndpoint{
+			Host: "42.42.42.43",
+			Port: 6443,
+		},
+	}
+	gzipped := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(gzipped)
+	if _, err := gzipWriter.Write([]byte("address: [[[ .NodeIP ]]]")); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	gzippedSource := "data:;base64," + base64.StdEncoding.EncodeToString(gzipped.Bytes())
+	renderedSource := "data:;base64," + base64.StdEncoding.EncodeToString([]byte("address: 10.0.0.2"))
+
+	type args struct {
+		bootstrapData []byte
+		format        string
+		data          *BootstrapData
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         string
+		wantErr      bool
+		wantTemplErr bool
+	}{
+		{
+			name: "no template",
+			args: args{
+				bootstrapData: []byte("#cloud-config\n"),
+				data:          data,
+			},
+			want: "#cloud-config\n",
+		},
+		{
+			name: "render fields",
+			args: args{
+				bootstrapData: []byte("[[[ .NodeIP ]]] [[[ .Region ]]] [[[ .ClusterName ]]] [[[ .ControlPlaneEndpoint ]]] [[[ .ControlPlaneEndpoint.Host ]]]"),
+				data:          data,
+			},
+			want: "10.0.0.2 fr-par cluster 42.42.42.43:6443 42.42.42.43",
+		},
+		{
+			name: "render helper functions",
+			args: args{
+				bootstrapData: []byte(`[[[ .PublicIPv6 | default "none" ]]] [[[ join "," (list .PublicIPv4 .PublicIPv6 .NodeIP) ]]] [[[ .CommercialType | lower ]]]`),
+				data:          data,
+			},
+			want: "none 42.42.42.42,10.0.0.2 pro2-s",
+		},
+		{
+			name: "doesn't conflict with go templates",
+			args: args{
+				bootstrapData: []byte("{{ .NodeIP }}"),
+				data:          data,
+			},
+			want: "{{ .NodeIP }}",
+		},
+		{
+			name: "invalid template",
+			args: args{
+				bootstrapData: []byte("[[[ .NodeIP "),
+				data:          data,
+			},
+			wantErr:      true,
+			wantTemplErr: true,
+		},
+		{
+			name: "unknown field",
+			args: args{
+				bootstrapData: []byte("[[[ .Unknown ]]]"),
+				data:          data,
+			},
+			wantErr:      true,
+			wantTemplErr: true,
+		},
+		{
+			name: "unsupported format",
+			args: args{
+				bootstrapData: []byte("[[[ .NodeIP ]]]"),
+				format:        "unknown",
+				data:          data,
+			},
+			wantErr: true,
+		},
+		{
+			name: "ignition: render units and url-encoded files",
+			args: args{
+				bootstrapData: []byte(`{"ignition":{"version":"3.3.0"},"storage":{"files":[{"path":"/etc/node-ip","mode":420,"contents":{"source":"data:,%5B%5B%5B%20.NodeIP%20%5D%5D%5D"}}]},"systemd":{"units":[{"name":"kubeadm.service","contents":"ExecStart=/bin/echo [[[ .ClusterName ]]]"}]}}`),
+				format:        BootstrapFormatIgnition,
+				data:          data,
+			},
+			want: `{"ignition":{"version":"3.3.0"},"storage":{"files":[{"contents":{"source":"data:;base64,MTAuMC4wLjI="},"mode":420,"path":"/etc/node-ip"}]},"systemd":{"units":[{"contents":"ExecStart=/bin/echo cluster","name":"kubeadm.service"}]}}`,
+		},
+		{
+			name: "ignition: render gzipped files",
+			args: args{
+				bootstrapData: []byte(`{"storage":{"files":[{"path":"/etc/config","contents":{"compression":"gzip","source":"` + gzippedSource + `"}}]}}`),
+				format:        BootstrapFormatIgnition,
+				data:          data,
+			},
+			want: `{"storage":{"files":[{"contents":{"source":"` + renderedSource + `"},"path":"/etc/config"}]}}`,
+		},
+		{
+			name: "ignition: files without template are not modified",
+			args: args{
+				bootstrapData: []byte(`{"storage":{"files":[{"path":"/etc/motd","contents":{"source":"data:,hello%20world","verification":{"hash":"sha512-abc"}}}]}}`),
+				format:        BootstrapFormatIgnition,
+				data:          data,
+			},
+			want: `{"storage":{"files":[{"contents":{"source":"data:,hello%20world","verification":{"hash":"sha512-abc"}},"path":"/etc/motd"}]}}`,
+		},
+		{
+			name: "ignition: cannot render files with a verification hash",
+			args: args{
+				bootstrapData: []byte(`{"storage":{"files":[{"path":"/etc/config","contents":{"compression":"gzip","source":"` + gzippedSource + `","verification":{"hash":"sha512-abc"}}}]}}`),
+				format:        BootstrapFormatIgnition,
+				data:          data,
+			},
+			wantErr:      true,
+			wantTemplErr: true,
+		},
+		{
+			name: "ignition: invalid json",
+			args: args{
+				bootstrapData: []byte("#cloud-config"),
+				format:        BootstrapFormatIgnition,
+				data:          data,
+			},
+			wantErr:      true,
+			wantTemplErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := RenderBootstrapData(tt.args.bootstrapData, tt.args.format, tt.args.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RenderBootstrapData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if IsBootstrapDataTemplateError(err) != tt.wantTemplErr {
+				t.Errorf("IsBootstrapDataTemplateError() = %v, want %v", IsBootstrapDataTemplateError(err), tt.wantTemplErr)
+			}
+			if got != tt.want {
+				t.Errorf("RenderBootstrapData() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewBootstrapData(t *testing.T) {
+	t.Parallel()
+	clusterScope := &scope.Cluster{
+		ScalewayCluster: &infrav1.ScalewayCluster{
+			Spec: infrav1.ScalewayClusterSpec{
+				Network: infrav1.ScalewayClusterNetwork{
+					PrivateNetwork: infrav1.PrivateNetworkSpec{
+						Enabled: ptr.To(true),
+					},
+				},
+				ControlPlaneEndpoint: clusterv1.APIEndpoint{
+					Host: "42.42.42.43",
+					Port: 6443,
+				},
+			},
+			Status: infrav1.ScalewayClusterStatus{
+				Network: infrav1.ScalewayClusterNetworkStatus{
+					PrivateNetworkID: "22222222-2222-2222-2222-222222222222",
+				},
+			},
+		},
+	}
+
+	got := NewBootstrapData(clusterScope, "cluster", &BootstrapServer{
+		ID:             "11111111-1111-1111-1111-111111111111",
+		ProviderID:     "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+		Zone:           scw.ZoneFrPar1,
+		CommercialType: "PRO2-S",
+		PublicIPs: []net.IP{
+			net.ParseIP("2001:db8::1"),
+			net.IPv4(42, 42, 42, 42),
+			net.IPv4(42, 42, 42, 44),
+		},
+		PrivateIPs: []*ipam.IP{
+			{IsIPv6: true, Address: scw.IPNet{IPNet: net.IPNet{IP: net.ParseIP("fd00::2"), Mask: net.CIDRMask(64, 128)}}},
+			{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 2), Mask: net.CIDRMask(22, 32)}}},
+		},
+		NodeIP: "10.0.0.2",
+	})
+
+	want := &BootstrapData{
+		NodeIP:               "10.0.0.2",
+		PublicIPv4:           "42.42.42.42",
+		PublicIPv6:           "2001:db8::1",
+		PrivateIP:            "10.0.0.2",
+		ServerID:             "11111111-1111-1111-1111-111111111111",
+		ProviderID:           "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+		Zone:                 "fr-par-1",
+		Region:               "fr-par",
+		CommercialType:       "PRO2-S",
+		ClusterName:          "cluster",
+		ControlPlaneEndpoint: clusterv1.APIEndpoint{Host: "42.42.42.43", Port: 6443},
+		PrivateNetworkID:     "22222222-2222-2222-2222-222222222222",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewBootstrapData() = %+v, want %+v", got, want)
+	}
+}
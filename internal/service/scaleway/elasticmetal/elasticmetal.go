@@ -0,0 +1,433 @@
+package elasticmetal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	baremetal "github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	baremetalpn "github.com/scaleway/scaleway-sdk-go/api/baremetal/v3"
+	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/common"
+	servicelb "github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/lb"
+)
+
+const (
+	// serverNotReadyRequeueAfter is the delay before checking again the status
+	// of a server that is being delivered or installed. Elastic Metal servers
+	// take several minutes to be delivered and installed.
+	serverNotReadyRequeueAfter = 30 * time.Second
+)
+
+type Service struct {
+	*scope.ElasticMetalMachine
+}
+
+func New(elasticMetalMachineScope *scope.ElasticMetalMachine) *Service {
+	return &Service{ElasticMetalMachine: elasticMetalMachineScope}
+}
+
+func (s *Service) Name() string {
+	return "elasticmetal"
+}
+
+func (s *Service) Reconcile(ctx context.Context) (retErr error) {
+	defer func() {
+		condition := metav1.Condition{
+			Type: infrav1.ScalewayElasticMetalMachineServerReadyCondition,
+		}
+		switch {
+		case common.IsBootstrapDataTemplateError(retErr):
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = infrav1.ScalewayElasticMetalMachineServerInvalidBootstrapDataTemplateReason
+			condition.Message = retErr.Error()
+		case retErr != nil:
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = infrav1.ScalewayElasticMetalMachineServerReconciliationFailedReason
+			condition.Message = retErr.Error()
+		default:
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = infrav1.ScalewayElasticMetalMachineServerReadyReason
+		}
+		conditions.Set(s.ScalewayElasticMetalMachine, condition)
+	}()
+
+	server, err := s.ensureServer(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to ensure server: %w", err)
+	}
+
+	if server.Status != baremetal.ServerStatusReady {
+		return scaleway.WithTransientError(fmt.Errorf("server is not ready yet, current status is %s", server.Status), serverNotReadyRequeueAfter)
+	}
+
+	// Ensure the server configuration when the node has never joined the cluster.
+	if !s.HasJoinedCluster() {
+		privateIPs, err := s.ensurePrivateNetwork(ctx, server)
+		if err != nil {
+			return fmt.Errorf("failed to ensure private network: %w", err)
+		}
+
+		lbs, err := servicelb.FindControlPlaneLBs(ctx, s.Cluster)
+		if err != nil {
+			return err
+		}
+
+		nodeIP, err := nodeIP(server, privateIPs)
+		if err != nil {
+			return err
+		}
+
+		if s.IsControlPlane() {
+			if err := servicelb.EnsureControlPlaneLBsBackendServer(ctx, s.Cluster, lbs, nodeIP, false); err != nil {
+				return fmt.Errorf("failed to ensure control-plane lbs: %w", err)
+			}
+		}
+
+		if err := servicelb.EnsureControlPlaneLBsACL(ctx, s.Cluster, lbs, s.ResourceName(), publicIPs(server), false); err != nil {
+			return fmt.Errorf("failed to ensure control-plane lbs acls: %w", err)
+		}
+
+		if err := s.ensureInstalled(ctx, server, privateIPs, nodeIP); err != nil {
+			return fmt.Errorf("failed to ensure server is installed: %w", err)
+		}
+
+		s.SetProviderID(providerID(server))
+		s.SetAddresses(machineAddresses(server, privateIPs))
+
+		return nil
+	}
+
+	// The node has already joined the cluster, we can safely remove cloud init userdata.
+	if err := s.ensureNoCloudInit(ctx, server); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) Delete(ctx context.Context) error {
+	zone, err := s.Zone()
+	if err != nil {
+		// If zone is invalid, it's highly probable that nothing was provisioned.
+		return nil
+	}
+
+	server, err := s.ScalewayClient.FindBaremetalServer(ctx, zone, s.ResourceTags())
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	lbs, err := servicelb.FindControlPlaneLBs(ctx, s.Cluster)
+	if err := utilerrors.FilterOut(err, client.IsNotFoundError); err != nil {
+		return err
+	}
+
+	if err := servicelb.EnsureControlPlaneLBsACL(ctx, s.Cluster, lbs, s.ResourceName(), nil, true); err != nil && !client.IsNotFoundError(err) {
+		return fmt.Errorf("failed to ensure control-plane lbs acls: %w", err)
+	}
+
+	// Remove this control-plane from the loadbalancer.
+	if s.IsControlPlane() && server.Status == baremetal.ServerStatusReady {
+		privateIPs, err := s.findPrivateIPs(ctx, server)
+		if err != nil {
+			return err
+		}
+
+		// nodeIP's error is ignored as it means the server no longer has an IP.
+		if nodeIP, err := nodeIP(server, privateIPs); err == nil {
+			if err := servicelb.EnsureControlPlaneLBsBackendServer(ctx, s.Cluster, lbs, nodeIP, true); err != nil {
+				return fmt.Errorf("failed to ensure control-plane lbs: %w", err)
+			}
+		}
+	}
+
+	if server.Status == baremetal.ServerStatusDeleting {
+		return scaleway.WithTransientError(errors.New("server is being deleted"), serverNotReadyRequeueAfter)
+	}
+
+	// Deleting the server wipes its disks and releases it.
+	if err := s.ScalewayClient.DeleteBaremetalServer(ctx, zone, server.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) ensureServer(ctx context.Context) (*baremetal.Server, error) {
+	zone, err := s.Zone()
+	if err != nil {
+		return nil, err
+	}
+
+	if server, err := s.ScalewayClient.FindBaremetalServer(ctx, zone, s.ResourceTags()); err == nil {
+		return server, nil
+	} else if !client.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	// Provider ID is already set, it's not normal that we didn't find the server.
+	if s.ScalewayElasticMetalMachine.Spec.ProviderID != "" {
+		return nil, errors.New("providerID is already set on ScalewayElasticMetalMachine, but no existing server was found")
+	}
+
+	// Server does not exist, let's order it.
+	logf.FromContext(ctx).Info("Creating Elastic Metal server", "serverName", s.ResourceName(), "zone", zone)
+
+	offer, err := s.ScalewayClient.FindBaremetalOffer(ctx, zone, s.ScalewayElasticMetalMachine.Spec.Offer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find offer, make sure it exists in zone %s: %w", zone, err)
+	}
+
+	var optionIDs []string
+
+	// Elastic Metal servers need the Private Network option to be attached to a Private Network.
+	if s.HasPrivateNetwork() {
+		optionIndex := slices.IndexFunc(offer.Options, func(option *baremetal.OfferOptionOffer) bool {
+			return option.PrivateNetwork != nil
+		})
+		if optionIndex == -1 {
+			return nil, fmt.Errorf("offer %s does not support private networks", offer.Name)
+		}
+
+		if !offer.Options[optionIndex].Enabled {
+			optionIDs = append(optionIDs, offer.Options[optionIndex].ID)
+		}
+	}
+
+	return s.ScalewayClient.CreateBaremetalServer(ctx, zone, s.ResourceName(), offer.ID, optionIDs, s.ResourceTags())
+}
+
+func (s *Service) ensurePrivateNetwork(ctx context.Context, server *baremetal.Server) ([]*ipam.IP, error) {
+	if !s.HasPrivateNetwork() {
+		return nil, nil
+	}
+
+	privateNetworkID, err := s.PrivateNetworkID()
+	if err != nil {
+		return nil, err
+	}
+
+	spn, err := s.ScalewayClient.FindBaremetalServerPrivateNetwork(ctx, server.Zone, server.ID, privateNetworkID)
+	switch {
+	case client.IsNotFoundError(err):
+		spn, err = s.ScalewayClient.AddBaremetalServerPrivateNetwork(ctx, server.Zone, server.ID, privateNetworkID)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	}
+
+	if spn.Status != baremetalpn.ServerPrivateNetworkStatusAttached {
+		return nil, scaleway.WithTransientError(fmt.Errorf("server is not attached to private network yet, current status is %s", spn.Status), 5*time.Second)
+	}
+
+	privateIPs, err := s.ScalewayClient.FindBaremetalPrivateNICIPs(ctx, spn.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(privateIPs) == 0 {
+		return nil, scaleway.WithTransientError(errors.New("no private IP available in IPAM yet"), time.Second)
+	}
+
+	return privateIPs, nil
+}
+
+// findPrivateIPs returns the private IPs of the server, if it is attached to
+// the Private Network of the cluster.
+func (s *Service) findPrivateIPs(ctx context.Context, server *baremetal.Server) ([]*ipam.IP, error) {
+	if !s.HasPrivateNetwork() {
+		return nil, nil
+	}
+
+	privateNetworkID, err := s.PrivateNetworkID()
+	if err != nil {
+		return nil, err
+	}
+
+	spn, err := s.ScalewayClient.FindBaremetalServerPrivateNetwork(ctx, server.Zone, server.ID, privateNetworkID)
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return s.ScalewayClient.FindBaremetalPrivateNICIPs(ctx, spn.ID)
+}
+
+func (s *Service) ensureInstalled(ctx context.Context, server *baremetal.Server, privateIPs []*ipam.IP, nodeIP string) error {
+	if server.Install != nil {
+		switch server.Install.Status {
+		case baremetal.ServerInstallStatusCompleted:
+			return nil
+		case baremetal.ServerInstallStatusError:
+			return fmt.Errorf("installation of server %s failed", server.ID)
+		default:
+			return scaleway.WithTransientError(fmt.Errorf("server is not installed yet, current status is %s", server.Install.Status), serverNotReadyRequeueAfter)
+		}
+	}
+
+	serverOS, err := s.findOS(ctx, server)
+	if err != nil {
+		return err
+	}
+
+	if !serverOS.CloudInitSupported {
+		return fmt.Errorf("OS %s (%s) does not support cloud-init", serverOS.Name, serverOS.Version)
+	}
+
+	bootstrapData, format, err := s.GetBootstrapData(ctx)
+	if err != nil {
+		return err
+	}
+
+	if format == common.BootstrapFormatIgnition {
+		return errors.New("ignition bootstrap format is not supported by Elastic Metal servers")
+	}
+
+	// Apply custom templating on cloud-init bootstrap data.
+	cloudInit, err := common.RenderBootstrapData(bootstrapData, format, s.bootstrapData(server, privateIPs, nodeIP))
+	if err != nil {
+		return err
+	}
+
+	if err := s.ScalewayClient.UpdateBaremetalServerUserData(ctx, server.Zone, server.ID, []byte(cloudInit)); err != nil {
+		return err
+	}
+
+	logf.FromContext(ctx).Info("Installing Elastic Metal server", "serverName", server.Name, "os", serverOS.Name, "version", serverOS.Version)
+
+	if err := s.ScalewayClient.InstallBaremetalServer(ctx, server.Zone, server.ID, serverOS.ID, s.ResourceName(), s.SSHKeyIDs()); err != nil {
+		return err
+	}
+
+	return scaleway.WithTransientError(errors.New("server installation has started"), serverNotReadyRequeueAfter)
+}
+
+// bootstrapData returns the values available in the bootstrap data template of the server.
+func (s *Service) bootstrapData(server *baremetal.Server, privateIPs []*ipam.IP, nodeIP string) *common.BootstrapData {
+	publicIPs := make([]net.IP, 0, len(server.IPs))
+	for _, ip := range server.IPs {
+		publicIPs = append(publicIPs, ip.Address)
+	}
+
+	return common.NewBootstrapData(s.Cluster, s.Machine.Spec.ClusterName, &common.BootstrapServer{
+		ID:             server.ID,
+		ProviderID:     providerID(server),
+		Zone:           server.Zone,
+		CommercialType: server.OfferName,
+		PublicIPs:      publicIPs,
+		PrivateIPs:     privateIPs,
+		NodeIP:         nodeIP,
+	})
+}
+
+func (s *Service) findOS(ctx context.Context, server *baremetal.Server) (*baremetal.OS, error) {
+	switch osRef := s.ScalewayElasticMetalMachine.Spec.OS; {
+	case osRef.ID != "":
+		return s.ScalewayClient.GetBaremetalOS(ctx, server.Zone, string(osRef.ID))
+	case osRef.Name != "":
+		found, err := s.ScalewayClient.FindBaremetalOS(ctx, server.Zone, server.OfferID, osRef.Name, osRef.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find OS by name, make sure it is compatible with offer %s: %w", server.OfferName, err)
+		}
+
+		return found, nil
+	}
+
+	return nil, errors.New("unable to find a valid OS in ScalewayElasticMetalMachine spec")
+}
+
+func (s *Service) ensureNoCloudInit(ctx context.Context, server *baremetal.Server) error {
+	if server.UserData == nil || len(*server.UserData) == 0 {
+		return nil
+	}
+
+	return s.ScalewayClient.UpdateBaremetalServerUserData(ctx, server.Zone, server.ID, []byte{})
+}
+
+func publicIPs(server *baremetal.Server) []string {
+	out := make([]string, 0, len(server.IPs))
+
+	for _, ip := range server.IPs {
+		out = append(out, ip.Address.String())
+	}
+
+	return out
+}
+
+func machineAddresses(server *baremetal.Server, privateIPs []*ipam.IP) []clusterv1.MachineAddress {
+	// The total number of addresses is len(server.IPs) + len(privateIPs) + ExternalDNS + Hostname.
+	addresses := make([]clusterv1.MachineAddress, 0, len(server.IPs)+len(privateIPs)+2)
+
+	addresses = append(addresses, clusterv1.MachineAddress{
+		Type:    clusterv1.MachineHostName,
+		Address: server.Install.Hostname,
+	})
+
+	for _, ip := range server.IPs {
+		addresses = append(addresses, clusterv1.MachineAddress{
+			Type:    clusterv1.MachineExternalIP,
+			Address: ip.Address.String(),
+		})
+	}
+
+	if server.Domain != "" {
+		addresses = append(addresses, clusterv1.MachineAddress{
+			Type:    clusterv1.MachineExternalDNS,
+			Address: server.Domain,
+		})
+	}
+
+	for _, privateIP := range privateIPs {
+		addresses = append(addresses, clusterv1.MachineAddress{
+			Type:    clusterv1.MachineInternalIP,
+			Address: privateIP.Address.IP.String(),
+		})
+	}
+
+	return addresses
+}
+
+func providerID(server *baremetal.Server) string {
+	return fmt.Sprintf("scaleway://baremetal/%s/%s", server.Zone, server.ID)
+}
+
+func nodeIP(server *baremetal.Server, privateIPs []*ipam.IP) (string, error) {
+	if len(privateIPs) > 0 {
+		v4Index := slices.IndexFunc(privateIPs, func(ip *ipam.IP) bool { return !ip.IsIPv6 })
+		if v4Index == -1 {
+			return "", errors.New("did not find a Private IPv4")
+		}
+
+		return privateIPs[v4Index].Address.IP.String(), nil
+	}
+
+	v4Index := slices.IndexFunc(server.IPs, func(ip *baremetal.IP) bool { return ip.Version == baremetal.IPVersionIPv4 })
+	if v4Index == -1 {
+		return "", errors.New("did not find a Public IPv4")
+	}
+
+	return server.IPs[v4Index].Address.String(), nil
+}
@@ -0,0 +1,463 @@
+package elasticmetal
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	baremetal "github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	baremetalpn "github.com/scaleway/scaleway-sdk-go/api/baremetal/v3"
+	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client/mock_client"
+	servicelb "github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/lb"
+)
+
+const (
+	privateNetworkID = "11111111-1111-1111-1111-111111111111"
+	serverPNID       = "11111111-1111-1111-1111-111111111111"
+	offerID          = "11111111-1111-1111-1111-111111111111"
+	optionID         = "22222222-2222-2222-2222-222222222222"
+	osID             = "11111111-1111-1111-1111-111111111111"
+	serverID         = "11111111-1111-1111-1111-111111111111"
+	frontendID       = "11111111-1111-1111-1111-111111111111"
+	backendID        = "11111111-1111-1111-1111-111111111111"
+	lbID             = "11111111-1111-1111-1111-111111111111"
+	lbACLID          = "11111111-1111-1111-1111-111111111111"
+
+	cloudInitBootstrap = `#cloud-config
+
+bootcmd:
+  - echo [[[ .NodeIP ]]]
+`
+	cloudInitData = `#cloud-config
+
+bootcmd:
+  - echo 10.0.0.1
+`
+)
+
+var (
+	clusterTags = []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+	machineTags = append(clusterTags, "caps-scalewayelasticmetalmachine=machine")
+)
+
+func newElasticMetalMachine(controlPlane, privateNetwork bool) *scope.ElasticMetalMachine {
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "machine",
+			Namespace: "default",
+		},
+		Spec: clusterv1.MachineSpec{
+			FailureDomain: "fr-par-1",
+			Bootstrap: clusterv1.Bootstrap{
+				DataSecretName: ptr.To("bootstrap"),
+			},
+		},
+	}
+
+	if controlPlane {
+		machine.Labels = map[string]string{clusterv1.MachineControlPlaneLabel: ""}
+	}
+
+	scalewayCluster := &infrav1.ScalewayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "default",
+		},
+	}
+
+	if privateNetwork {
+		scalewayCluster.Spec.Network.PrivateNetwork.Enabled = ptr.To(true)
+		scalewayCluster.Status.Network.PrivateNetworkID = privateNetworkID
+	}
+
+	return &scope.ElasticMetalMachine{
+		Machine: machine,
+		ScalewayElasticMetalMachine: &infrav1.ScalewayElasticMetalMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "machine",
+				Namespace: "default",
+			},
+			Spec: infrav1.ScalewayElasticMetalMachineSpec{
+				Offer: "EM-A116X-SSD",
+				OS: infrav1.ElasticMetalOS{
+					ID: osID,
+				},
+			},
+		},
+		Cluster: &scope.Cluster{
+			ScalewayCluster: scalewayCluster,
+		},
+	}
+}
+
+func expectControlPlaneLBs(i *mock_client.MockInterfaceMockRecorder) {
+	i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil) // Get main LB zone.
+	i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(&lb.LB{
+		ID:   lbID,
+		Zone: scw.ZoneFrPar1,
+	}, nil)
+	i.FindLBs(gomock.Any(), append(clusterTags, servicelb.CAPSExtraLBTag)).Return(nil, nil)
+}
+
+func TestService_Reconcile(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		ElasticMetalMachine *scope.ElasticMetalMachine
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		expect  func(i *mock_client.MockInterfaceMockRecorder)
+		objects []runtime.Object
+		asserts func(g *WithT, m *scope.ElasticMetalMachine)
+	}{
+		{
+			name: "order server with private network option",
+			fields: fields{
+				ElasticMetalMachine: newElasticMetalMachine(false, true),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindBaremetalServer(gomock.Any(), scw.ZoneFrPar1, machineTags).Return(nil, client.ErrNoItemFound)
+				i.FindBaremetalOffer(gomock.Any(), scw.ZoneFrPar1, "EM-A116X-SSD").Return(&baremetal.Offer{
+					ID:   offerID,
+					Name: "EM-A116X-SSD",
+					Options: []*baremetal.OfferOptionOffer{
+						{ID: optionID, PrivateNetwork: &baremetal.PrivateNetworkOption{}},
+					},
+				}, nil)
+				i.CreateBaremetalServer(gomock.Any(), scw.ZoneFrPar1, "machine", offerID, []string{optionID}, machineTags).Return(&baremetal.Server{
+					ID:     serverID,
+					Zone:   scw.ZoneFrPar1,
+					Status: baremetal.ServerStatusDelivering,
+				}, nil)
+			},
+			asserts: func(g *WithT, m *scope.ElasticMetalMachine) {
+				g.Expect(conditions.IsFalse(m.ScalewayElasticMetalMachine, infrav1.ScalewayElasticMetalMachineServerReadyCondition)).To(BeTrue())
+				g.Expect(m.ScalewayElasticMetalMachine.Spec.ProviderID).To(BeEmpty())
+			},
+		},
+		{
+			name: "install control-plane server",
+			fields: fields{
+				ElasticMetalMachine: newElasticMetalMachine(true, true),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			objects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "bootstrap",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"value": []byte(cloudInitBootstrap),
+					},
+				},
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				server := &baremetal.Server{
+					ID:      serverID,
+					Name:    "machine",
+					Zone:    scw.ZoneFrPar1,
+					OfferID: offerID,
+					Status:  baremetal.ServerStatusReady,
+					IPs: []*baremetal.IP{
+						{Address: net.IPv4(42, 42, 42, 42), Version: baremetal.IPVersionIPv4},
+					},
+				}
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindBaremetalServer(gomock.Any(), scw.ZoneFrPar1, machineTags).Return(server, nil)
+
+				// Private Network
+				i.FindBaremetalServerPrivateNetwork(gomock.Any(), scw.ZoneFrPar1, serverID, privateNetworkID).Return(nil, client.ErrNoItemFound)
+				i.AddBaremetalServerPrivateNetwork(gomock.Any(), scw.ZoneFrPar1, serverID, privateNetworkID).Return(&baremetalpn.ServerPrivateNetwork{
+					ID:     serverPNID,
+					Status: baremetalpn.ServerPrivateNetworkStatusAttached,
+				}, nil)
+				i.FindBaremetalPrivateNICIPs(gomock.Any(), serverPNID).Return([]*ipam.IP{
+					{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 1), Mask: net.CIDRMask(24, 32)}}},
+				}, nil)
+
+				// LB configuration
+				expectControlPlaneLBs(i)
+				i.ListBackends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Backend{
+					{
+						ID:   backendID,
+						Name: servicelb.APIServerPortName,
+					},
+				}, nil)
+				i.AddBackendServer(gomock.Any(), scw.ZoneFrPar1, backendID, "10.0.0.1")
+				i.ListFrontends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Frontend{
+					{
+						ID:   frontendID,
+						Name: servicelb.APIServerPortName,
+					},
+				}, nil)
+				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendID, "machine").Return(nil, client.ErrNoItemFound)
+				i.CreateLBACL(gomock.Any(), scw.ZoneFrPar1, frontendID, "machine", servicelb.MachineACLIndex, lb.ACLActionTypeAllow, []string{"42.42.42.42"})
+
+				// Installation
+				i.GetBaremetalOS(gomock.Any(), scw.ZoneFrPar1, osID).Return(&baremetal.OS{
+					ID:                 osID,
+					Name:               "Ubuntu",
+					Version:            "24.04 LTS (Noble Numbat)",
+					CloudInitSupported: true,
+				}, nil)
+				i.UpdateBaremetalServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID, []byte(cloudInitData))
+				i.InstallBaremetalServer(gomock.Any(), scw.ZoneFrPar1, serverID, osID, "machine", []string{})
+			},
+			asserts: func(g *WithT, m *scope.ElasticMetalMachine) {
+				g.Expect(conditions.IsFalse(m.ScalewayElasticMetalMachine, infrav1.ScalewayElasticMetalMachineServerReadyCondition)).To(BeTrue())
+				g.Expect(m.ScalewayElasticMetalMachine.Spec.ProviderID).To(BeEmpty())
+			},
+		},
+		{
+			name: "installed worker server",
+			fields: fields{
+				ElasticMetalMachine: newElasticMetalMachine(false, false),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindBaremetalServer(gomock.Any(), scw.ZoneFrPar1, machineTags).Return(&baremetal.Server{
+					ID:     serverID,
+					Name:   "machine",
+					Zone:   scw.ZoneFrPar1,
+					Domain: "11111111-1111-1111-1111-111111111111.fr-par-1.baremetal.scw.cloud",
+					Status: baremetal.ServerStatusReady,
+					IPs: []*baremetal.IP{
+						{Address: net.IPv4(42, 42, 42, 42), Version: baremetal.IPVersionIPv4},
+					},
+					Install: &baremetal.ServerInstall{
+						Hostname: "machine",
+						Status:   baremetal.ServerInstallStatusCompleted,
+					},
+				}, nil)
+
+				// LB configuration
+				expectControlPlaneLBs(i)
+				i.ListFrontends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Frontend{
+					{
+						ID:   frontendID,
+						Name: servicelb.APIServerPortName,
+					},
+				}, nil)
+				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendID, "machine").Return(&lb.ACL{
+					ID: lbACLID,
+					Match: &lb.ACLMatch{
+						IPSubnet: scw.StringSlicePtr([]string{"42.42.42.42"}),
+					},
+				}, nil)
+			},
+			asserts: func(g *WithT, m *scope.ElasticMetalMachine) {
+				g.Expect(conditions.IsTrue(m.ScalewayElasticMetalMachine, infrav1.ScalewayElasticMetalMachineServerReadyCondition)).To(BeTrue())
+				g.Expect(m.ScalewayElasticMetalMachine.Status.Addresses).To(Equal([]clusterv1.MachineAddress{
+					{Type: clusterv1.MachineHostName, Address: "machine"},
+					{Type: clusterv1.MachineExternalIP, Address: "42.42.42.42"},
+					{Type: clusterv1.MachineExternalDNS, Address: "11111111-1111-1111-1111-111111111111.fr-par-1.baremetal.scw.cloud"},
+				}))
+				g.Expect(m.ScalewayElasticMetalMachine.Spec.ProviderID).To(Equal("scaleway://baremetal/fr-par-1/11111111-1111-1111-1111-111111111111"))
+			},
+		},
+		{
+			name: "remove cloud-init user data once node has joined",
+			fields: fields{
+				ElasticMetalMachine: func() *scope.ElasticMetalMachine {
+					m := newElasticMetalMachine(false, false)
+					m.Machine.Status.NodeRef = clusterv1.MachineNodeReference{Name: "machine"}
+					return m
+				}(),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindBaremetalServer(gomock.Any(), scw.ZoneFrPar1, machineTags).Return(&baremetal.Server{
+					ID:       serverID,
+					Zone:     scw.ZoneFrPar1,
+					Status:   baremetal.ServerStatusReady,
+					UserData: ptr.To([]byte(cloudInitData)),
+				}, nil)
+				i.UpdateBaremetalServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID, []byte{})
+			},
+			asserts: func(g *WithT, m *scope.ElasticMetalMachine) {
+				g.Expect(conditions.IsTrue(m.ScalewayElasticMetalMachine, infrav1.ScalewayElasticMetalMachineServerReadyCondition)).To(BeTrue())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			scwMock := mock_client.NewMockInterface(mockCtrl)
+
+			tt.expect(scwMock.EXPECT())
+
+			s := &Service{
+				ElasticMetalMachine: tt.fields.ElasticMetalMachine,
+			}
+			s.ScalewayClient = scwMock
+			s.Client = fake.NewFakeClient(tt.objects...)
+			if err := s.Reconcile(tt.args.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("Service.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			tt.asserts(g, s.ElasticMetalMachine)
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		ElasticMetalMachine *scope.ElasticMetalMachine
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		expect  func(i *mock_client.MockInterfaceMockRecorder)
+	}{
+		{
+			name: "server not found",
+			fields: fields{
+				ElasticMetalMachine: newElasticMetalMachine(false, false),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindBaremetalServer(gomock.Any(), scw.ZoneFrPar1, machineTags).Return(nil, client.ErrNoItemFound)
+			},
+		},
+		{
+			name: "delete control-plane server",
+			fields: fields{
+				ElasticMetalMachine: newElasticMetalMachine(true, true),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindBaremetalServer(gomock.Any(), scw.ZoneFrPar1, machineTags).Return(&baremetal.Server{
+					ID:     serverID,
+					Zone:   scw.ZoneFrPar1,
+					Status: baremetal.ServerStatusReady,
+				}, nil)
+
+				// LB configuration
+				expectControlPlaneLBs(i)
+				i.ListFrontends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Frontend{
+					{
+						ID:   frontendID,
+						Name: servicelb.APIServerPortName,
+					},
+				}, nil)
+				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendID, "machine").Return(&lb.ACL{ID: lbACLID}, nil)
+				i.DeleteLBACL(gomock.Any(), scw.ZoneFrPar1, lbACLID)
+				i.FindBaremetalServerPrivateNetwork(gomock.Any(), scw.ZoneFrPar1, serverID, privateNetworkID).Return(&baremetalpn.ServerPrivateNetwork{
+					ID:     serverPNID,
+					Status: baremetalpn.ServerPrivateNetworkStatusAttached,
+				}, nil)
+				i.FindBaremetalPrivateNICIPs(gomock.Any(), serverPNID).Return([]*ipam.IP{
+					{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 1), Mask: net.CIDRMask(24, 32)}}},
+				}, nil)
+				i.ListBackends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Backend{
+					{
+						ID:   backendID,
+						Name: servicelb.APIServerPortName,
+						Pool: []string{"10.0.0.1"},
+					},
+				}, nil)
+				i.RemoveBackendServer(gomock.Any(), scw.ZoneFrPar1, backendID, "10.0.0.1")
+
+				// Server
+				i.DeleteBaremetalServer(gomock.Any(), scw.ZoneFrPar1, serverID)
+			},
+		},
+		{
+			name: "server is being deleted",
+			fields: fields{
+				ElasticMetalMachine: newElasticMetalMachine(false, false),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindBaremetalServer(gomock.Any(), scw.ZoneFrPar1, machineTags).Return(&baremetal.Server{
+					ID:     serverID,
+					Zone:   scw.ZoneFrPar1,
+					Status: baremetal.ServerStatusDeleting,
+				}, nil)
+
+				// LB configuration
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(nil, client.ErrNoItemFound)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			scwMock := mock_client.NewMockInterface(mockCtrl)
+
+			tt.expect(scwMock.EXPECT())
+
+			s := &Service{
+				ElasticMetalMachine: tt.fields.ElasticMetalMachine,
+			}
+			s.ScalewayClient = scwMock
+			if err := s.Delete(tt.args.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("Service.Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
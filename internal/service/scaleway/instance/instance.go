@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"net/netip"
 	"slices"
+	"strconv"
 	"strings"
-	"text/template"
 	"time"
 
 	"github.com/scaleway/scaleway-sdk-go/api/block/v1"
@@ -15,6 +17,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/marketplace/v2"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/utils/ptr"
@@ -26,8 +29,9 @@ import (
 	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
 	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
 	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/common"
 	servicelb "github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/lb"
-	lbutil "github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/lb/util"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/securitygroup"
 )
 
 const (
@@ -35,13 +39,30 @@ const (
 	// created with the instance API. If the user sets a different value, the volume
 	// will be updated to the desired value.
 	defaultRootVolumeIOPS = 5000
-	// machineACLIndex is the index of the ACL to use for the machine.
-	machineACLIndex = int32(1)
-	// cloudInitUserDataKey is the key used to store the cloud-init user data in the server.
+	// cloudInitUserDataKey is the key used to store the cloud-init (or Ignition) user data in the server.
 	cloudInitUserDataKey = "cloud-init"
 	// scratchVolumeType is the type of additional volume that is a scratch volume.
 	// Scratch volumes are automatically created with the instance and have a maximum size defined by the server type.
 	scratchVolumeType = "scratch"
+	// managedPlacementGroupTagPrefix is the prefix of the tag set on managed
+	// placement groups, followed by the name of the group of machines.
+	managedPlacementGroupTagPrefix = "caps-placementgroup="
+	// maxServersPerPlacementGroup is the maximum number of servers in a placement group.
+	maxServersPerPlacementGroup = 20
+	// maxPrivateIPReservationAttempts is the maximum number of IPs of an address
+	// range that are tried when reserving a private IP.
+	maxPrivateIPReservationAttempts = 5
+	// ipTypeNAT is the type of NAT IPs, it is no longer part of the IP types of the SDK
+	// but it is still used by the existing servers that were not migrated to routed IPs.
+	ipTypeNAT instance.IPType = "nat"
+	// retainedVolumeTag is the tag set on the additional volumes that are retained
+	// when their machine is deleted, instead of the tags of the machine.
+	retainedVolumeTag = "caps-retained=true"
+	// archivedServerTag is the tag set on archived servers, instead of the tags of the machine.
+	archivedServerTag = "caps-archived=true"
+	// snapshotParentVolumeTagPrefix is the prefix of the tag set on the snapshots created
+	// before a volume is deleted, instead of the tags of the machine.
+	snapshotParentVolumeTagPrefix = "caps-parent-volume="
 )
 
 // instanceVolumeTypeToMarketplaceType maps the instance volume type to the marketplace image type.
@@ -50,6 +71,11 @@ var instanceVolumeTypeToMarketplaceType = map[instance.VolumeVolumeType]marketpl
 	instance.VolumeVolumeTypeSbsVolume: marketplace.LocalImageTypeInstanceSbs,
 }
 
+// errOutOfStock is returned when all the commercial types of the machine are out of stock.
+var errOutOfStock = errors.New("commercial types are out of stock")
+
+var errServerMissing = errors.New("providerID is already set on ScalewayMachine, but no existing server was found")
+
 type Service struct {
 	*scope.Machine
 }
@@ -67,11 +93,24 @@ func (s *Service) Reconcile(ctx context.Context) (retErr error) {
 		condition := metav1.Condition{
 			Type: infrav1.ScalewayMachineInstanceReadyCondition,
 		}
-		if retErr != nil {
+		switch {
+		case common.IsBootstrapDataTemplateError(retErr):
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = infrav1.ScalewayMachineInstanceInvalidBootstrapDataTemplateReason
+			condition.Message = retErr.Error()
+		case errors.Is(retErr, errBootstrapDataTooLarge):
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = infrav1.ScalewayMachineInstanceBootstrapDataTooLargeReason
+			condition.Message = retErr.Error()
+		case errors.Is(retErr, errOutOfStock):
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = infrav1.ScalewayMachineInstanceOutOfStockReason
+			condition.Message = retErr.Error()
+		case retErr != nil:
 			condition.Status = metav1.ConditionFalse
 			condition.Reason = infrav1.ScalewayMachineInstanceReconciliationFailedReason
 			condition.Message = retErr.Error()
-		} else {
+		default:
 			condition.Status = metav1.ConditionTrue
 			condition.Reason = infrav1.ScalewayMachineInstanceReadyReason
 		}
@@ -80,25 +119,71 @@ func (s *Service) Reconcile(ctx context.Context) (retErr error) {
 
 	server, err := s.ensureServer(ctx)
 	if err != nil {
+		if errors.Is(err, errServerMissing) && s.HasJoinedCluster() {
+			s.setServerHealthyCondition(nil)
+		}
+
 		return fmt.Errorf("failed to ensure server: %w", err)
 	}
 
-	// Ensure the server configuration when the node has never joined the cluster.
+	s.SetServerStatus(server)
+
+	// The server state is monitored once the node has joined the cluster.
+	if s.HasJoinedCluster() {
+		s.setServerHealthyCondition(server)
+	}
+
+	// Tags are reconciled even after the node has joined the cluster.
+	if err := s.ensureTags(ctx, server); err != nil {
+		return fmt.Errorf("failed to ensure tags: %w", err)
+	}
+
+	// NAT IPs are migrated even after the node has joined the cluster.
+	if err := s.ensurePublicIPType(ctx, server); err != nil {
+		return fmt.Errorf("failed to ensure public IP type: %w", err)
+	}
+
+	s.SetPublicIPs(publicIPs(server))
+
+	// Additional volumes are only created before the node joins the cluster.
 	if !s.HasJoinedCluster() {
 		if err := s.ensureAdditionalVolumes(ctx, server); err != nil {
 			return fmt.Errorf("failed to ensure additional volumes: %w", err)
 		}
+	}
+
+	// The additional volumes are reported even after the node has joined the cluster.
+	volumesStatus, err := s.additionalVolumesStatus(ctx, server)
+	if err != nil {
+		return fmt.Errorf("failed to get additional volumes: %w", err)
+	}
+
+	s.SetAdditionalVolumes(volumesStatus)
 
+	// Ensure the server configuration when the node has never joined the cluster.
+	if !s.HasJoinedCluster() {
 		server, err = s.ensurePublicIPs(ctx, server)
 		if err != nil {
 			return err
 		}
 
+		s.SetPublicIPs(publicIPs(server))
+
 		privateIPs, err := s.ensurePrivateNIC(ctx, server)
 		if err != nil {
 			return fmt.Errorf("failed to ensure private nic: %w", err)
 		}
 
+		additionalPrivateIPs, err := s.ensureAdditionalPrivateNICs(ctx, server)
+		if err != nil {
+			return fmt.Errorf("failed to ensure additional private nics: %w", err)
+		}
+
+		privateNetworks, err := s.privateNetworks(privateIPs, additionalPrivateIPs)
+		if err != nil {
+			return err
+		}
+
 		lbs, err := s.findControlPlaneLBs(ctx)
 		if err != nil {
 			return err
@@ -117,12 +202,13 @@ func (s *Service) Reconcile(ctx context.Context) (retErr error) {
 			return fmt.Errorf("failed to ensure control-plane lbs acls: %w", err)
 		}
 
-		if err := s.ensureCloudInit(ctx, server, nodeIP); err != nil {
+		if err := s.ensureCloudInit(ctx, server, privateIPs, nodeIP); err != nil {
 			return fmt.Errorf("failed to ensure cloud-init: %w", err)
 		}
 
-		s.SetProviderID(providerID(server))
-		s.SetAddresses(machineAddresses(server, privateIPs))
+		s.SetProviderID(ProviderID(server))
+		s.SetAddresses(machineAddresses(server, privateIPs, additionalPrivateIPs))
+		s.SetPrivateNetworks(privateNetworks)
 
 		if err := s.ensureServerStarted(ctx, server); err != nil {
 			return fmt.Errorf("failed to ensure server started: %w", err)
@@ -131,6 +217,12 @@ func (s *Service) Reconcile(ctx context.Context) (retErr error) {
 		return nil
 	}
 
+	// Volumes are created with the desired size, they are only grown once the node
+	// has joined the cluster.
+	if err := s.ensureBlockVolumesResized(ctx, server); err != nil {
+		return fmt.Errorf("failed to ensure block volumes are resized: %w", err)
+	}
+
 	// The node has already joined the cluster, we can safely remove cloud init userdata.
 	if err := s.ensureNoCloudInit(ctx, server); err != nil {
 		return err
@@ -140,21 +232,45 @@ func (s *Service) Reconcile(ctx context.Context) (retErr error) {
 }
 
 func (s *Service) Delete(ctx context.Context) error {
-	zone, err := s.Zone()
+	zones, err := s.Zones()
 	if err != nil {
 		// If zone is invalid, it's highly probable that nothing was provisioned.
 		return nil
 	}
 
-	server, err := s.ScalewayClient.FindServer(ctx, zone, s.ResourceTags())
+	// The server is searched in all the zones where it may have been created.
+	server, err := s.findServer(ctx, zones)
 	if err != nil {
 		if client.IsNotFoundError(err) {
+			if err := s.ensureNoReservedPrivateIPs(ctx); err != nil {
+				return err
+			}
+
+			for _, zone := range zones {
+				if err := s.ensureNoEmptyPlacementGroups(ctx, zone); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		}
 
 		return err
 	}
 
+	zone := server.Zone
+
+	// A protected server is only deleted with its Machine, so that deleting the
+	// ScalewayMachine by mistake does not remove a server (e.g. an etcd member).
+	if server.Protected && s.Machine.Machine.DeletionTimestamp.IsZero() {
+		s.setDeletingCondition(infrav1.ScalewayMachineDeletingServerProtectedReason,
+			"Server %s is protected, it is only deleted when Machine %s is deleted", server.ID, s.Machine.Machine.Name)
+		return scaleway.WithTransientError(
+			fmt.Errorf("server %s is protected and Machine %s is not being deleted", server.ID, s.Machine.Machine.Name),
+			time.Minute,
+		)
+	}
+
 	lbs, err := s.findControlPlaneLBs(ctx)
 	if err := utilerrors.FilterOut(err, client.IsNotFoundError); err != nil {
 		return err
@@ -166,9 +282,9 @@ func (s *Service) Delete(ctx context.Context) error {
 
 	// Remove this control-plane from the loadbalancer.
 	if s.IsControlPlane() {
-		privateIPs, err := s.ensurePrivateNIC(ctx, server)
+		privateIPs, err := s.privateNICIPs(ctx, server)
 		if err != nil {
-			return fmt.Errorf("failed to ensure private nic: %w", err)
+			return fmt.Errorf("failed to get private ips: %w", err)
 		}
 
 		// nodeIP's error is ignored as it means the server no longer has an IP.
@@ -183,10 +299,36 @@ func (s *Service) Delete(ctx context.Context) error {
 		return err
 	}
 
-	if err := s.ensureServerStopped(ctx, server); err != nil {
+	if err := s.ensureServerShutdown(ctx, server); err != nil {
 		return err
 	}
 
+	if s.SnapshotRootVolume() {
+		if err := s.ensureRootVolumeSnapshot(ctx, server); err != nil {
+			return err
+		}
+	}
+
+	// The archived server is kept with its volumes, it is no longer managed by the provider.
+	if s.ArchiveOnDeletion() {
+		if err := s.archiveServer(ctx, server); err != nil {
+			return fmt.Errorf("failed to archive server: %w", err)
+		}
+
+		s.setDeletingCondition(infrav1.ScalewayMachineDeletingArchivedReason, "Server %s is stopped and kept", server.ID)
+
+		// The archived server no longer belongs to the managed placement group.
+		return s.ensureNoEmptyPlacementGroups(ctx, zone)
+	}
+
+	s.setDeletingCondition(infrav1.ScalewayMachineDeletingDeletingServerReason, "Deleting server %s and its volumes", server.ID)
+
+	if server.Protected {
+		if err := s.ScalewayClient.UpdateServerProtection(ctx, zone, server.ID, false); err != nil {
+			return fmt.Errorf("failed to lift server protection: %w", err)
+		}
+	}
+
 	if err := s.ensureSystemVolumesDeleted(ctx, server); err != nil {
 		return err
 	}
@@ -195,16 +337,59 @@ func (s *Service) Delete(ctx context.Context) error {
 		return err
 	}
 
-	return nil
+	if err := s.ensureNoReservedPrivateIPs(ctx); err != nil {
+		return err
+	}
+
+	return s.ensureNoEmptyPlacementGroups(ctx, zone)
+}
+
+// archiveServer detaches the server from the Private Networks, the security group
+// and the placement group of the machine, then replaces its tags so that it is no
+// longer found with the tags of the machine.
+func (s *Service) archiveServer(ctx context.Context, server *instance.Server) error {
+	for _, pnic := range server.PrivateNics {
+		if err := s.ScalewayClient.DeletePrivateNIC(ctx, server.Zone, server.ID, pnic.ID); err != nil {
+			return fmt.Errorf("failed to detach private nic %s: %w", pnic.ID, err)
+		}
+	}
+
+	if err := s.ensureNoReservedPrivateIPs(ctx); err != nil {
+		return err
+	}
+
+	if server.SecurityGroup != nil {
+		securityGroup, err := s.ScalewayClient.FindDefaultSecurityGroup(ctx, server.Zone)
+		if err != nil {
+			return fmt.Errorf("failed to find default security group: %w", err)
+		}
+
+		if server.SecurityGroup.ID != securityGroup.ID {
+			if err := s.ScalewayClient.UpdateServerSecurityGroup(ctx, server.Zone, server.ID, securityGroup.ID); err != nil {
+				return fmt.Errorf("failed to detach security group: %w", err)
+			}
+		}
+	}
+
+	if server.PlacementGroup != nil {
+		if err := s.ScalewayClient.RemoveServerPlacementGroup(ctx, server.Zone, server.ID); err != nil {
+			return fmt.Errorf("failed to detach placement group: %w", err)
+		}
+	}
+
+	// Tags are replaced last, the server is still found if one of the previous steps fails.
+	tags := append(slices.Clone(s.ScalewayMachine.Spec.AdditionalTags), archivedServerTag)
+
+	return s.ScalewayClient.UpdateServerTags(ctx, server.Zone, server.ID, tags)
 }
 
 func (s *Service) ensureServer(ctx context.Context) (*instance.Server, error) {
-	zone, err := s.Zone()
+	zones, err := s.Zones()
 	if err != nil {
 		return nil, err
 	}
 
-	if server, err := s.ScalewayClient.FindServer(ctx, zone, s.ResourceTags()); err == nil {
+	if server, err := s.findServer(ctx, zones); err == nil {
 		return server, nil
 	} else if !client.IsNotFoundError(err) {
 		return nil, err
@@ -212,11 +397,74 @@ func (s *Service) ensureServer(ctx context.Context) (*instance.Server, error) {
 
 	// Provider ID is already set, it's not normal that we didn't find the server.
 	if s.ScalewayMachine.Spec.ProviderID != "" {
-		return nil, errors.New("providerID is already set on ScalewayMachine, but no existing server was found")
+		return nil, errServerMissing
+	}
+
+	// Server does not exist, let's create it in the first zone where one of the
+	// commercial types is in stock.
+	for _, zone := range zones {
+		commercialTypes, err := s.availableCommercialTypes(ctx, zone)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, commercialType := range commercialTypes {
+			server, err := s.createServer(ctx, zone, commercialType)
+			if client.IsOutOfStockError(err) {
+				logf.FromContext(ctx).Info("Commercial type is out of stock", "commercialType", commercialType, "zone", zone)
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			// The zone of the server may not be the zone of the Machine, it is kept
+			// in the status and in the provider ID, which is set right away so that
+			// the server is still found if the status is lost.
+			s.SetServerStatus(server)
+			s.SetProviderID(ProviderID(server))
+
+			switch {
+			case zone != zones[0]:
+				s.Eventf(corev1.EventTypeWarning, "FallbackZone", "CreateServer",
+					"Commercial types %s are out of stock in zone %s, created server %s with commercial type %s in fallback zone %s",
+					strings.Join(s.commercialTypes(), ", "), zones[0], server.ID, commercialType, zone)
+			case commercialType != s.ScalewayMachine.Spec.CommercialType:
+				s.Eventf(corev1.EventTypeWarning, "FallbackCommercialType", "CreateServer",
+					"Commercial type %s is out of stock in zone %s, created server %s with fallback commercial type %s",
+					s.ScalewayMachine.Spec.CommercialType, zone, server.ID, commercialType)
+			default:
+				s.Eventf(corev1.EventTypeNormal, "ServerCreated", "CreateServer",
+					"Created server %s with commercial type %s in zone %s", server.ID, commercialType, zone)
+			}
+
+			return server, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w in zones %s: %s", errOutOfStock, joinZones(zones), strings.Join(s.commercialTypes(), ", "))
+}
+
+// findServer finds the server of the machine in the zones. It returns a not found
+// error if the server does not exist in any of the zones.
+func (s *Service) findServer(ctx context.Context, zones []scw.Zone) (*instance.Server, error) {
+	for _, zone := range zones {
+		server, err := s.ScalewayClient.FindServer(ctx, zone, s.ResourceTags())
+		if err == nil {
+			return server, nil
+		}
+
+		if !client.IsNotFoundError(err) {
+			return nil, err
+		}
 	}
 
-	// Server does not exist, let's create it.
-	logf.FromContext(ctx).Info("Creating instance server", "serverName", s.ResourceName(), "zone", zone)
+	return nil, client.ErrNoItemFound
+}
+
+// createServer creates the server of the machine in the zone with the commercial type.
+func (s *Service) createServer(ctx context.Context, zone scw.Zone, commercialType string) (*instance.Server, error) {
+	logf.FromContext(ctx).Info("Creating instance server", "serverName", s.ResourceName(), "zone", zone, "commercialType", commercialType)
 
 	// First, find an image ID.
 	volumeType, err := s.RootVolumeType()
@@ -234,7 +482,7 @@ func (s *Service) ensureServer(ctx context.Context) (*instance.Server, error) {
 			return nil, fmt.Errorf("did not find marketplace type for volume type %s", volumeType)
 		}
 
-		image, err := s.ScalewayClient.GetLocalImageByLabel(ctx, zone, s.ScalewayMachine.Spec.CommercialType, image.Label, marketplaceType)
+		image, err := s.ScalewayClient.GetLocalImageByLabel(ctx, zone, commercialType, image.Label, marketplaceType)
 		if err != nil {
 			return nil, err
 		}
@@ -275,14 +523,16 @@ func (s *Service) ensureServer(ctx context.Context) (*instance.Server, error) {
 		ctx,
 		zone,
 		s.ResourceName(),
-		s.ScalewayMachine.Spec.CommercialType,
+		commercialType,
 		imageID,
 		placementGroupID,
 		securityGroupID,
 		s.RootVolumeSize(),
 		volumeType,
 		scratchVolumeSizes,
-		s.ResourceTags(),
+		s.IsProtected(),
+		s.PublicIPType() == infrav1.PublicIPTypeNAT,
+		s.DesiredTags(),
 	)
 	if err != nil {
 		return nil, err
@@ -303,6 +553,44 @@ func (s *Service) ensureServer(ctx context.Context) (*instance.Server, error) {
 	return server, nil
 }
 
+// commercialTypes returns the commercial type of the spec followed by the
+// fallback commercial types, in order.
+func (s *Service) commercialTypes() []string {
+	return append([]string{s.ScalewayMachine.Spec.CommercialType}, s.ScalewayMachine.Spec.FallbackCommercialTypes...)
+}
+
+// availableCommercialTypes returns the commercial types of the machine that are
+// not out of stock in the zone, in order.
+func (s *Service) availableCommercialTypes(ctx context.Context, zone scw.Zone) ([]string, error) {
+	availability, err := s.ScalewayClient.GetServerTypesAvailability(ctx, zone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server types availability: %w", err)
+	}
+
+	var commercialTypes []string
+	for _, commercialType := range s.commercialTypes() {
+		// Commercial types that are missing from the response are considered available.
+		if availability[commercialType] == instance.ServerTypesAvailabilityShortage {
+			logf.FromContext(ctx).Info("Commercial type is out of stock", "commercialType", commercialType, "zone", zone)
+			continue
+		}
+
+		commercialTypes = append(commercialTypes, commercialType)
+	}
+
+	return commercialTypes, nil
+}
+
+// joinZones returns the zones as a comma-separated string.
+func joinZones(zones []scw.Zone) string {
+	out := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		out = append(out, zone.String())
+	}
+
+	return strings.Join(out, ", ")
+}
+
 func (s *Service) placementGroupID(ctx context.Context, zone scw.Zone) (*string, error) {
 	// If user has specified a placement group, get its ID.
 	switch pgref := s.ScalewayMachine.Spec.PlacementGroup; {
@@ -317,9 +605,124 @@ func (s *Service) placementGroupID(ctx context.Context, zone scw.Zone) (*string,
 		return &placementGroup.ID, nil
 	}
 
+	if s.HasManagedPlacementGroup() {
+		return s.ensureManagedPlacementGroup(ctx, zone)
+	}
+
 	return nil, nil
 }
 
+// ensureManagedPlacementGroup returns the ID of a managed placement group that
+// can accept a new server. A new placement group is created if all existing
+// placement groups with the desired policy are full.
+func (s *Service) ensureManagedPlacementGroup(ctx context.Context, zone scw.Zone) (*string, error) {
+	name, err := s.ManagedPlacementGroupName()
+	if err != nil {
+		return nil, err
+	}
+
+	policyType, policyMode := s.managedPlacementGroupPolicy()
+
+	placementGroups, err := s.ScalewayClient.FindPlacementGroups(ctx, zone, s.Cluster.ResourceTags(managedPlacementGroupTagPrefix+name))
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(placementGroups, func(a, b *instance.PlacementGroup) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, placementGroup := range placementGroups {
+		// Placement groups with a different policy are kept until they are empty.
+		if placementGroup.PolicyType != policyType || placementGroup.PolicyMode != policyMode {
+			continue
+		}
+
+		servers, err := s.ScalewayClient.ListPlacementGroupServers(ctx, zone, placementGroup.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(servers) < maxServersPerPlacementGroup {
+			return &placementGroup.ID, nil
+		}
+	}
+
+	// Find the first available index for the name of the new placement group.
+	var pgName string
+	for i := 0; pgName == ""; i++ {
+		candidate := s.Cluster.ResourceName(name, strconv.Itoa(i))
+		if !slices.ContainsFunc(placementGroups, func(pg *instance.PlacementGroup) bool { return pg.Name == candidate }) {
+			pgName = candidate
+		}
+	}
+
+	logf.FromContext(ctx).Info("Creating placement group", "placementGroupName", pgName, "zone", zone)
+
+	placementGroup, err := s.ScalewayClient.CreatePlacementGroup(
+		ctx,
+		zone,
+		pgName,
+		policyType,
+		policyMode,
+		s.Cluster.ResourceTags(managedPlacementGroupTagPrefix+name),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create placement group: %w", err)
+	}
+
+	return &placementGroup.ID, nil
+}
+
+// ensureNoEmptyPlacementGroups deletes the managed placement groups of the machine
+// that no longer contain any server.
+func (s *Service) ensureNoEmptyPlacementGroups(ctx context.Context, zone scw.Zone) error {
+	if !s.HasManagedPlacementGroup() {
+		return nil
+	}
+
+	name, err := s.ManagedPlacementGroupName()
+	if err != nil {
+		// Nothing could have been created for this machine.
+		return nil
+	}
+
+	placementGroups, err := s.ScalewayClient.FindPlacementGroups(ctx, zone, s.Cluster.ResourceTags(managedPlacementGroupTagPrefix+name))
+	if err != nil {
+		return err
+	}
+
+	for _, placementGroup := range placementGroups {
+		servers, err := s.ScalewayClient.ListPlacementGroupServers(ctx, zone, placementGroup.ID)
+		if err != nil {
+			if client.IsNotFoundError(err) {
+				continue
+			}
+
+			return err
+		}
+
+		if len(servers) > 0 {
+			continue
+		}
+
+		if err := s.ScalewayClient.DeletePlacementGroup(ctx, zone, placementGroup.ID); err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("failed to delete placement group: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) managedPlacementGroupPolicy() (instance.PlacementGroupPolicyType, instance.PlacementGroupPolicyMode) {
+	policyMode := instance.PlacementGroupPolicyModeOptional
+	if mode := s.ScalewayMachine.Spec.ManagedPlacementGroup.PolicyMode; mode != "" {
+		policyMode = instance.PlacementGroupPolicyMode(mode)
+	}
+
+	return instance.PlacementGroupPolicyType(s.ScalewayMachine.Spec.ManagedPlacementGroup.PolicyType), policyMode
+}
+
 func (s *Service) securityGroupID(ctx context.Context, zone scw.Zone) (*string, error) {
 	// If user has specified a security group, get its ID.
 	switch sgref := s.ScalewayMachine.Spec.SecurityGroup; {
@@ -334,6 +737,21 @@ func (s *Service) securityGroupID(ctx context.Context, zone scw.Zone) (*string,
 		return &securityGroup.ID, nil
 	}
 
+	// If the cluster manages security groups, use the one that matches the machine role.
+	if s.HasManagedSecurityGroups() {
+		tag := securitygroup.CAPSWorkerSGTag
+		if s.IsControlPlane() {
+			tag = securitygroup.CAPSControlPlaneSGTag
+		}
+
+		securityGroup, err := s.ScalewayClient.FindSecurityGroupByTags(ctx, zone, s.Cluster.ResourceTags(tag))
+		if err != nil {
+			return nil, fmt.Errorf("failed to find managed security group: %w", err)
+		}
+
+		return &securityGroup.ID, nil
+	}
+
 	return nil, nil
 }
 
@@ -369,7 +787,7 @@ func (s *Service) ensureAdditionalVolumes(ctx context.Context, server *instance.
 			}
 
 			if _, ok := instanceVolumesByName[volName]; !ok {
-				volume, err := s.ScalewayClient.CreateInstanceVolume(ctx, server.Zone, volName, volSize, s.ResourceTags())
+				volume, err := s.ScalewayClient.CreateInstanceVolume(ctx, server.Zone, volName, volSize, s.DesiredTags())
 				if err != nil {
 					return fmt.Errorf("failed to create instance volume: %w", err)
 				}
@@ -382,6 +800,7 @@ func (s *Service) ensureAdditionalVolumes(ctx context.Context, server *instance.
 					return fmt.Errorf("failed to attach server volume: %w", err)
 				}
 			}
+
 		case "block":
 			if blockVolumesByName == nil {
 				blockVolumes, err := s.ScalewayClient.FindVolumes(ctx, server.Zone, s.ResourceTags())
@@ -396,7 +815,7 @@ func (s *Service) ensureAdditionalVolumes(ctx context.Context, server *instance.
 			}
 
 			if _, ok := blockVolumesByName[volName]; !ok {
-				volume, err := s.ScalewayClient.CreateVolume(ctx, server.Zone, volName, volSize, vol.IOPS, s.ResourceTags())
+				volume, err := s.createBlockVolume(ctx, server.Zone, volName, volSize, vol)
 				if err != nil {
 					return fmt.Errorf("failed to create block volume: %w", err)
 				}
@@ -412,6 +831,7 @@ func (s *Service) ensureAdditionalVolumes(ctx context.Context, server *instance.
 					return fmt.Errorf("failed to attach block volume: %w", err)
 				}
 			}
+
 		default:
 			return fmt.Errorf("unsupported additional volume type: %s", vol.Type)
 		}
@@ -420,102 +840,841 @@ func (s *Service) ensureAdditionalVolumes(ctx context.Context, server *instance.
 	return nil
 }
 
-func (s *Service) ensurePublicIPs(ctx context.Context, server *instance.Server) (*instance.Server, error) {
-	if !s.HasPublicIPv4() && !s.HasPublicIPv6() {
-		return server, nil
-	}
-
-	ips, err := s.ScalewayClient.FindIPs(ctx, server.Zone, s.ResourceTags())
-	if err != nil {
-		return nil, err
-	}
+// additionalVolumesStatus returns the status of the additional volumes of the
+// machine that currently exist, in the order of the spec.
+func (s *Service) additionalVolumesStatus(ctx context.Context, server *instance.Server) ([]infrav1.AdditionalVolumeStatus, error) {
+	var (
+		instanceVolumes []*instance.Volume
+		blockVolumes    []*block.Volume
+		volumesStatus   []infrav1.AdditionalVolumeStatus
+	)
 
-	publicIPIDs := []string{}
-	updateServer := false
+	for i, vol := range s.ScalewayMachine.Spec.AdditionalVolumes {
+		volName := fmt.Sprintf("%s-%d", s.ResourceName(), i)
 
-	for _, version := range []struct {
-		ipType instance.IPType
-		want   bool
-	}{
-		{ipType: instance.IPTypeRoutedIPv4, want: s.HasPublicIPv4()},
-		{ipType: instance.IPTypeRoutedIPv6, want: s.HasPublicIPv6()},
-	} {
-		// Skip if we don't want this type of IP.
-		if !version.want {
+		switch {
+		case vol.Type == scratchVolumeType:
 			continue
-		}
+		case vol.Type == "local":
+			if instanceVolumes == nil {
+				var err error
+				instanceVolumes, err = s.ScalewayClient.FindInstanceVolumes(ctx, server.Zone, s.ResourceTags())
+				if err != nil {
+					return nil, err
+				}
+			}
 
-		// Skip if IP already exists.
-		ipIndex := slices.IndexFunc(ips, func(ip *instance.IP) bool { return ip.Type == version.ipType })
-		if ipIndex != -1 {
-			if ips[ipIndex].Server == nil {
-				updateServer = true
-			} else if ips[ipIndex].Server.ID != server.ID {
-				return nil, fmt.Errorf("expected IP %s to be attached to %s", ips[ipIndex].ID, server.ID)
+			if i := slices.IndexFunc(instanceVolumes, func(v *instance.Volume) bool { return v.Name == volName }); i != -1 {
+				volumesStatus = append(volumesStatus, infrav1.AdditionalVolumeStatus{
+					Name: volName,
+					ID:   infrav1.UUID(instanceVolumes[i].ID),
+				})
+			}
+		default:
+			if blockVolumes == nil {
+				var err error
+				blockVolumes, err = s.ScalewayClient.FindVolumes(ctx, server.Zone, s.ResourceTags())
+				if err != nil {
+					return nil, err
+				}
 			}
 
-			publicIPIDs = append(publicIPIDs, ips[ipIndex].ID)
-			continue
+			if i := slices.IndexFunc(blockVolumes, func(v *block.Volume) bool { return v.Name == volName }); i != -1 {
+				volumesStatus = append(volumesStatus, infrav1.AdditionalVolumeStatus{
+					Name: volName,
+					ID:   infrav1.UUID(blockVolumes[i].ID),
+				})
+			}
 		}
+	}
 
-		ip, err := s.ScalewayClient.CreateIP(ctx, server.Zone, version.ipType, s.ResourceTags())
-		if err != nil {
-			return nil, fmt.Errorf("failed to create IP: %w", err)
-		}
+	return volumesStatus, nil
+}
 
-		publicIPIDs = append(publicIPIDs, ip.ID)
-		updateServer = true
-	}
+// ensureTags ensures the server, the flexible IPs, the root volume and the additional
+// volumes created for the machine have the desired tags. Resources that are not created by the provider
+// (e.g. existing flexible IPs) are left untouched.
+func (s *Service) ensureTags(ctx context.Context, server *instance.Server) error {
+	desiredTags := s.DesiredTags()
+	resourceTags := s.ResourceTags()
 
-	if updateServer {
-		server, err = s.ScalewayClient.UpdateServerPublicIPs(ctx, server.Zone, server.ID, publicIPIDs)
-		if err != nil {
-			return nil, fmt.Errorf("failed to refresh server after updating IPs")
+	if !hasTags(server.Tags, desiredTags) {
+		if err := s.ScalewayClient.UpdateServerTags(ctx, server.Zone, server.ID, desiredTags); err != nil {
+			return err
+		}
+	}
+
+	for _, ip := range server.PublicIPs {
+		if !isOwnedBy(ip.Tags, resourceTags) || hasTags(ip.Tags, desiredTags) {
+			continue
+		}
+
+		if err := s.ScalewayClient.UpdateIPTags(ctx, server.Zone, ip.ID, desiredTags); err != nil {
+			return err
+		}
+	}
+
+	// The volumes to look up are decided from the volumes attached to the server, so
+	// that the volumes of the server are still retagged when the spec has changed.
+	var (
+		rootVolume                       *instance.VolumeServer
+		hasBlockVolumes, hasLocalVolumes bool
+	)
+
+	for _, vol := range server.Volumes {
+		if vol.Boot {
+			rootVolume = vol
+		}
+
+		switch vol.VolumeType {
+		case instance.VolumeServerVolumeTypeSbsVolume:
+			hasBlockVolumes = true
+		case instance.VolumeServerVolumeTypeLSSD:
+			hasLocalVolumes = true
+		}
+	}
+
+	if hasBlockVolumes {
+		volumes, err := s.ScalewayClient.FindVolumes(ctx, server.Zone, resourceTags)
+		if err != nil {
+			return err
+		}
+
+		// The root volume is not created with the tags of the machine, it is tagged
+		// when it is not found with them.
+		if rootVolume != nil && rootVolume.VolumeType == instance.VolumeServerVolumeTypeSbsVolume &&
+			!slices.ContainsFunc(volumes, func(volume *block.Volume) bool { return volume.ID == rootVolume.ID }) {
+			volumes = append(volumes, &block.Volume{ID: rootVolume.ID})
+		}
+
+		for _, volume := range volumes {
+			if hasTags(volume.Tags, desiredTags) {
+				continue
+			}
+
+			if err := s.ScalewayClient.UpdateVolumeTags(ctx, server.Zone, volume.ID, desiredTags); err != nil {
+				return err
+			}
+		}
+	}
+
+	if hasLocalVolumes {
+		volumes, err := s.ScalewayClient.FindInstanceVolumes(ctx, server.Zone, resourceTags)
+		if err != nil {
+			return err
+		}
+
+		if rootVolume != nil && rootVolume.VolumeType == instance.VolumeServerVolumeTypeLSSD &&
+			!slices.ContainsFunc(volumes, func(volume *instance.Volume) bool { return volume.ID == rootVolume.ID }) {
+			volumes = append(volumes, &instance.Volume{ID: rootVolume.ID})
+		}
+
+		for _, volume := range volumes {
+			if hasTags(volume.Tags, desiredTags) {
+				continue
+			}
+
+			if err := s.ScalewayClient.UpdateInstanceVolumeTags(ctx, server.Zone, volume.ID, desiredTags); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ensureBlockVolumesResized grows the block root volume and the additional block volumes
+// of the server and updates their IOPS when they differ from the spec. The filesystems
+// of the grown volumes must then be grown on the node, this is reported by the
+// FileSystemResizePending condition until the user acknowledges it with an annotation.
+func (s *Service) ensureBlockVolumesResized(ctx context.Context, server *instance.Server) error {
+	if _, ok := s.ScalewayMachine.Annotations[infrav1.ScalewayMachineFileSystemResizedAnnotation]; ok {
+		delete(s.ScalewayMachine.Annotations, infrav1.ScalewayMachineFileSystemResizedAnnotation)
+		conditions.Delete(s.ScalewayMachine, infrav1.ScalewayMachineFileSystemResizePendingCondition)
+	}
+
+	var resized []string
+
+	// Root volume.
+	rootVolumeSpec := s.ScalewayMachine.Spec.RootVolume
+	if rootVolumeSpec.Size != 0 || rootVolumeSpec.IOPS != 0 {
+		for _, vol := range server.Volumes {
+			if !vol.Boot || vol.VolumeType != instance.VolumeServerVolumeTypeSbsVolume {
+				continue
+			}
+
+			volume, err := s.ScalewayClient.GetVolume(ctx, server.Zone, vol.ID)
+			if err != nil {
+				return err
+			}
+
+			grown, err := s.ensureBlockVolumeSize(ctx, volume, s.RootVolumeSize(), rootVolumeSpec.IOPS)
+			if err != nil {
+				return err
+			}
+
+			if grown {
+				resized = append(resized, volume.ID)
+			}
+
+			break
+		}
+	}
+
+	// Additional volumes.
+	var volumesByName map[string]*block.Volume
+
+	for i, vol := range s.ScalewayMachine.Spec.AdditionalVolumes {
+		if vol.Type != "block" || vol.Size == 0 && vol.IOPS == 0 {
+			continue
+		}
+
+		if volumesByName == nil {
+			volumes, err := s.ScalewayClient.FindVolumes(ctx, server.Zone, s.ResourceTags())
+			if err != nil {
+				return err
+			}
+
+			volumesByName = make(map[string]*block.Volume, len(volumes))
+			for _, volume := range volumes {
+				volumesByName[volume.Name] = volume
+			}
+		}
+
+		// The volume is not created yet, it will be created with the desired size.
+		volume, ok := volumesByName[fmt.Sprintf("%s-%d", s.ResourceName(), i)]
+		if !ok {
+			continue
+		}
+
+		grown, err := s.ensureBlockVolumeSize(ctx, volume, scw.Size(vol.Size)*scw.GB, vol.IOPS)
+		if err != nil {
+			return err
+		}
+
+		if grown {
+			resized = append(resized, volume.ID)
+		}
+	}
+
+	if len(resized) == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("Volumes [%s] were grown, their filesystems must be grown on the node", strings.Join(resized, ", "))
+
+	conditions.Set(s.ScalewayMachine, metav1.Condition{
+		Type:    infrav1.ScalewayMachineFileSystemResizePendingCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  infrav1.ScalewayMachineVolumesResizedReason,
+		Message: message,
+	})
+
+	s.Eventf(corev1.EventTypeNormal, infrav1.ScalewayMachineVolumesResizedReason, "ResizeVolumes", "%s", message)
+
+	return nil
+}
+
+// ensureBlockVolumeSize grows the block volume to the desired size and updates its IOPS.
+// A size or IOPS of 0 is ignored. It returns true if the volume was grown. The IOPS and
+// the size are never updated in the same pass: the volume is grown once the update of
+// its IOPS is done.
+func (s *Service) ensureBlockVolumeSize(ctx context.Context, volume *block.Volume, size scw.Size, iops int64) (bool, error) {
+	updateIOPS := iops != 0 && (volume.Specs == nil || volume.Specs.PerfIops == nil || int64(*volume.Specs.PerfIops) != iops)
+
+	// Volumes cannot be shrunk, a smaller size is ignored.
+	grow := size > volume.Size
+
+	if !updateIOPS && !grow {
+		return false, nil
+	}
+
+	// The volume can only be updated when it is not already being updated.
+	if volume.Status != block.VolumeStatusAvailable && volume.Status != block.VolumeStatusInUse {
+		return false, scaleway.WithTransientError(
+			fmt.Errorf("volume %s cannot be updated while it is %s", volume.ID, volume.Status),
+			10*time.Second,
+		)
+	}
+
+	if updateIOPS {
+		if err := s.ScalewayClient.UpdateVolumeIOPS(ctx, volume.Zone, volume.ID, iops); err != nil {
+			return false, fmt.Errorf("failed to update iops of volume %s: %w", volume.ID, err)
+		}
+
+		if grow {
+			return false, scaleway.WithTransientError(
+				fmt.Errorf("iops of volume %s are being updated, it will be grown later", volume.ID),
+				10*time.Second,
+			)
+		}
+
+		return false, nil
+	}
+
+	if err := s.ScalewayClient.UpdateVolumeSize(ctx, volume.Zone, volume.ID, size); err != nil {
+		return false, fmt.Errorf("failed to grow volume %s: %w", volume.ID, err)
+	}
+
+	return true, nil
+}
+
+// hasTags returns true if tags are exactly the desired tags, the created-by tag is ignored.
+func hasTags(tags, desiredTags []string) bool {
+	return common.SlicesEqualIgnoreOrder(client.TagsWithoutCreatedBy(slices.Clone(tags)), desiredTags)
+}
+
+// isOwnedBy returns true if tags contain all the resource tags.
+func isOwnedBy(tags, resourceTags []string) bool {
+	for _, tag := range resourceTags {
+		if !slices.Contains(tags, tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// createBlockVolume creates an additional block volume, from a snapshot if the volume has one.
+func (s *Service) createBlockVolume(
+	ctx context.Context,
+	zone scw.Zone,
+	name string,
+	size scw.Size,
+	vol infrav1.AdditionalVolume,
+) (*block.Volume, error) {
+	if vol.Snapshot.ID == "" && vol.Snapshot.Name == "" {
+		return s.ScalewayClient.CreateVolume(ctx, zone, name, size, vol.IOPS, s.DesiredTags())
+	}
+
+	snapshotID := string(vol.Snapshot.ID)
+	if snapshotID == "" {
+		snapshot, err := s.ScalewayClient.FindSnapshotByName(ctx, zone, vol.Snapshot.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find snapshot %s: %w", vol.Snapshot.Name, err)
+		}
+
+		snapshotID = snapshot.ID
+	}
+
+	// The size of the snapshot is used when no size is specified.
+	return s.ScalewayClient.CreateVolumeFromSnapshot(ctx, zone, name, snapshotID, scw.Size(vol.Size)*scw.GB, vol.IOPS, s.DesiredTags())
+}
+
+func (s *Service) ensurePublicIPs(ctx context.Context, server *instance.Server) (*instance.Server, error) {
+	if !s.HasPublicIPv4() && !s.HasPublicIPv6() {
+		return server, nil
+	}
+
+	ips, err := s.ScalewayClient.FindIPs(ctx, server.Zone, s.ResourceTags())
+	if err != nil {
+		return nil, err
+	}
+
+	publicIPIDs := []string{}
+	updateServer := false
+
+	// Servers that were not migrated to routed IPs keep using NAT IPs.
+	ipv4Type := instance.IPTypeRoutedIPv4
+	if !routedIPEnabled(server) {
+		ipv4Type = ipTypeNAT
+	}
+
+	for _, version := range []struct {
+		ipType instance.IPType
+		want   bool
+		ref    *infrav1.FlexibleIPReference
+	}{
+		{ipType: ipv4Type, want: s.HasPublicIPv4(), ref: &s.ScalewayMachine.Spec.PublicNetwork.IPv4},
+		{ipType: instance.IPTypeRoutedIPv6, want: s.HasPublicIPv6(), ref: &s.ScalewayMachine.Spec.PublicNetwork.IPv6},
+	} {
+		// Skip if we don't want this type of IP.
+		if !version.want {
+			continue
+		}
+
+		// Use the existing flexible IP if the user has referenced one.
+		if version.ref.IsDefined() {
+			ip, err := s.flexibleIP(ctx, server, version.ipType, version.ref)
+			if err != nil {
+				return nil, err
+			}
+
+			if ip.Server == nil {
+				updateServer = true
+			}
+
+			publicIPIDs = append(publicIPIDs, ip.ID)
+			continue
+		}
+
+		// Skip if IP already exists.
+		ipIndex := slices.IndexFunc(ips, func(ip *instance.IP) bool { return ip.Type == version.ipType })
+		if ipIndex != -1 {
+			if ips[ipIndex].Server == nil {
+				updateServer = true
+			} else if ips[ipIndex].Server.ID != server.ID {
+				return nil, fmt.Errorf("expected IP %s to be attached to %s", ips[ipIndex].ID, server.ID)
+			}
+
+			publicIPIDs = append(publicIPIDs, ips[ipIndex].ID)
+			continue
+		}
+
+		// Routed IPs are not supported by all commercial types.
+		if version.ipType != ipTypeNAT {
+			if err := s.ensureIPTypeSupported(ctx, server, version.ipType); err != nil {
+				return nil, err
+			}
+		}
+
+		ip, err := s.ScalewayClient.CreateIP(ctx, server.Zone, version.ipType, s.DesiredTags())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create IP: %w", err)
+		}
+
+		publicIPIDs = append(publicIPIDs, ip.ID)
+		updateServer = true
+	}
+
+	if updateServer {
+		server, err = s.ScalewayClient.UpdateServerPublicIPs(ctx, server.Zone, server.ID, publicIPIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to refresh server after updating IPs")
 		}
 	}
 
 	return server, nil
 }
 
-func (s *Service) ensurePrivateNIC(ctx context.Context, server *instance.Server) ([]*ipam.IP, error) {
-	if !s.HasPrivateNetwork() {
+// ensurePublicIPType checks that the server uses the requested type of public IPs
+// and migrates its NAT IPs to routed IPs when the machine explicitly requests
+// routed IPs. Servers are left unchanged when no type is requested.
+func (s *Service) ensurePublicIPType(ctx context.Context, server *instance.Server) error {
+	routed := routedIPEnabled(server)
+
+	switch ipType := s.PublicIPType(); {
+	case ipType == infrav1.PublicIPTypeNAT && routed:
+		return fmt.Errorf("server %s uses routed IPs, they cannot be migrated to NAT IPs", server.ID)
+	case ipType != infrav1.PublicIPTypeRouted || routed:
+		return nil
+	}
+
+	switch server.State {
+	case instance.ServerStateRunning, instance.ServerStateStopped, instance.ServerStateStoppedInPlace:
+	default:
+		return scaleway.WithTransientError(
+			fmt.Errorf("server is %s, waiting before migrating NAT IPs", server.State),
+			10*time.Second,
+		)
+	}
+
+	if err := s.ensureIPTypeSupported(ctx, server, instance.IPTypeRoutedIPv4); err != nil {
+		return err
+	}
+
+	if err := s.ScalewayClient.ServerAction(ctx, server.Zone, server.ID, instance.ServerActionEnableRoutedIP); err != nil {
+		return fmt.Errorf("failed to migrate NAT IPs to routed IPs: %w", err)
+	}
+
+	s.Eventf(corev1.EventTypeNormal, "PublicIPsMigrated", "MigratePublicIPs",
+		"Migrated NAT IPs of server %s to routed IPs", server.ID)
+
+	return scaleway.WithTransientError(errors.New("NAT IPs are being migrated to routed IPs"), 10*time.Second)
+}
+
+// ensureIPTypeSupported checks that the commercial type of the server supports
+// the routed IP type. Commercial types that reached their end of service do not
+// support routed IPs, and not all commercial types support IPv6.
+func (s *Service) ensureIPTypeSupported(ctx context.Context, server *instance.Server, ipType instance.IPType) error {
+	serverType, err := s.ScalewayClient.GetServerType(ctx, server.Zone, server.CommercialType)
+	if err != nil {
+		return fmt.Errorf("failed to get server type: %w", err)
+	}
+
+	if serverType.EndOfService || serverType.Network == nil {
+		return fmt.Errorf("commercial type %s does not support routed IPs", server.CommercialType)
+	}
+
+	if ipType == instance.IPTypeRoutedIPv6 && !serverType.Network.IPv6Support {
+		return fmt.Errorf("commercial type %s does not support IPv6", server.CommercialType)
+	}
+
+	return nil
+}
+
+// routedIPEnabled returns true if the server uses routed IPs. The routed IP mode
+// is deprecated as all new servers use routed IPs, but it is the only way to know
+// if a server still uses NAT IPs.
+func routedIPEnabled(server *instance.Server) bool {
+	return ptr.Deref(server.RoutedIPEnabled, true) //nolint:staticcheck
+}
+
+// flexibleIP returns the existing flexible IP referenced by ref. The IP must
+// not be attached to another server.
+func (s *Service) flexibleIP(
+	ctx context.Context,
+	server *instance.Server,
+	ipType instance.IPType,
+	ref *infrav1.FlexibleIPReference,
+) (*instance.IP, error) {
+	if len(ref.Tags) == 0 {
+		ipIDOrAddress := string(ref.ID)
+		if ipIDOrAddress == "" {
+			ipIDOrAddress = ref.Address
+		}
+
+		ip, err := s.ScalewayClient.GetIP(ctx, server.Zone, ipIDOrAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get flexible IP %s: %w", ipIDOrAddress, err)
+		}
+
+		if ip.Type != ipType {
+			return nil, fmt.Errorf("flexible IP %s has type %s, expected %s", ip.ID, ip.Type, ipType)
+		}
+
+		if ip.Server != nil && ip.Server.ID != server.ID {
+			return nil, fmt.Errorf("flexible IP %s is already attached to server %s", ip.ID, ip.Server.ID)
+		}
+
+		return ip, nil
+	}
+
+	ips, err := s.ScalewayClient.FindIPs(ctx, server.Zone, ref.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find flexible IPs: %w", err)
+	}
+
+	ips = slices.DeleteFunc(ips, func(ip *instance.IP) bool { return ip.Type != ipType })
+
+	// Keep the IP of the pool that is already attached to the server.
+	if i := slices.IndexFunc(ips, func(ip *instance.IP) bool {
+		return ip.Server != nil && ip.Server.ID == server.ID
+	}); i != -1 {
+		return ips[i], nil
+	}
+
+	if i := slices.IndexFunc(ips, func(ip *instance.IP) bool { return ip.Server == nil }); i != -1 {
+		return ips[i], nil
+	}
+
+	return nil, scaleway.WithTransientError(
+		fmt.Errorf("no %s flexible IP available with tags %s", ipType, strings.Join(ref.Tags, ", ")),
+		30*time.Second,
+	)
+}
+
+func (s *Service) ensurePrivateNIC(ctx context.Context, server *instance.Server) ([]*ipam.IP, error) {
+	if !s.HasPrivateNetwork() {
+		if s.ScalewayMachine.Spec.PrivateNetwork != (infrav1.MachinePrivateNetwork{}) {
+			return nil, errors.New("privateNetwork is set but the cluster has no Private Network")
+		}
+
+		return nil, nil
+	}
+
+	privateNetworkID, err := s.PrivateNetworkID()
+	if err != nil {
+		return nil, err
+	}
+
+	// Do nothing if Private NIC is already present.
+	pnicIndex := slices.IndexFunc(server.PrivateNics, func(pnic *instance.PrivateNIC) bool {
+		return pnic.PrivateNetworkID == privateNetworkID
+	})
+
+	var pnic *instance.PrivateNIC
+	if pnicIndex == -1 {
+		ipamIPIDs, err := s.reservePrivateIP(ctx, privateNetworkID)
+		if err != nil {
+			return nil, err
+		}
+
+		pnic, err = s.ScalewayClient.CreatePrivateNIC(ctx, server.Zone, server.ID, privateNetworkID, ipamIPIDs)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		pnic = server.PrivateNics[pnicIndex]
+	}
+
+	privateIPs, err := s.ScalewayClient.FindPrivateNICIPs(ctx, pnic.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(privateIPs) == 0 {
+		return nil, scaleway.WithTransientError(errors.New("no private IP available in IPAM yet"), time.Second)
+	}
+
+	return privateIPs, nil
+}
+
+// privateNICIPs returns the private IPs of the server in the Private Network of
+// the cluster. Unlike ensurePrivateNIC, the private NIC is not created if it is missing.
+func (s *Service) privateNICIPs(ctx context.Context, server *instance.Server) ([]*ipam.IP, error) {
+	if !s.HasPrivateNetwork() {
+		return nil, nil
+	}
+
+	privateNetworkID, err := s.PrivateNetworkID()
+	if err != nil {
+		return nil, err
+	}
+
+	pnicIndex := slices.IndexFunc(server.PrivateNics, func(pnic *instance.PrivateNIC) bool {
+		return pnic.PrivateNetworkID == privateNetworkID
+	})
+	if pnicIndex == -1 {
+		return nil, nil
+	}
+
+	return s.ScalewayClient.FindPrivateNICIPs(ctx, server.PrivateNics[pnicIndex].ID)
+}
+
+// privateNetworkIPs contains the private IPs of a server in a Private Network.
+type privateNetworkIPs struct {
+	privateNetworkID string
+	ips              []*ipam.IP
+}
+
+// ensureAdditionalPrivateNICs ensures the server has a private NIC in each of its
+// additional Private Networks. It returns the private IPs of the server in each
+// of these Private Networks, in the order of the spec.
+func (s *Service) ensureAdditionalPrivateNICs(ctx context.Context, server *instance.Server) ([]privateNetworkIPs, error) {
+	refs := s.ScalewayMachine.Spec.AdditionalPrivateNetworks
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	var clusterPrivateNetworkID string
+	if s.HasPrivateNetwork() {
+		var err error
+		clusterPrivateNetworkID, err = s.PrivateNetworkID()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := make([]privateNetworkIPs, 0, len(refs))
+
+	for _, ref := range refs {
+		privateNetworkID, err := s.additionalPrivateNetworkID(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+
+		if privateNetworkID == clusterPrivateNetworkID {
+			return nil, fmt.Errorf("additional Private Network %s is already the Private Network of the cluster", privateNetworkID)
+		}
+
+		if slices.ContainsFunc(result, func(pn privateNetworkIPs) bool { return pn.privateNetworkID == privateNetworkID }) {
+			return nil, fmt.Errorf("additional Private Network %s is referenced more than once", privateNetworkID)
+		}
+
+		pnicIndex := slices.IndexFunc(server.PrivateNics, func(pnic *instance.PrivateNIC) bool {
+			return pnic.PrivateNetworkID == privateNetworkID
+		})
+
+		var pnic *instance.PrivateNIC
+		if pnicIndex == -1 {
+			pnic, err = s.ScalewayClient.CreatePrivateNIC(ctx, server.Zone, server.ID, privateNetworkID, nil)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			pnic = server.PrivateNics[pnicIndex]
+		}
+
+		ips, err := s.ScalewayClient.FindPrivateNICIPs(ctx, pnic.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ips) == 0 {
+			return nil, scaleway.WithTransientError(
+				fmt.Errorf("no private IP available in IPAM yet for Private Network %s", privateNetworkID),
+				time.Second,
+			)
+		}
+
+		result = append(result, privateNetworkIPs{privateNetworkID: privateNetworkID, ips: ips})
+	}
+
+	return result, nil
+}
+
+// additionalPrivateNetworkID returns the ID of the Private Network referenced by ref.
+func (s *Service) additionalPrivateNetworkID(ctx context.Context, ref infrav1.PrivateNetworkReference) (string, error) {
+	if ref.ID != "" {
+		return string(ref.ID), nil
+	}
+
+	pn, err := s.ScalewayClient.FindPrivateNetwork(ctx, ref.Tags, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to find Private Network with tags %s: %w", strings.Join(ref.Tags, ", "), err)
+	}
+
+	return pn.ID, nil
+}
+
+// privateNetworks returns the private addresses of the server in each Private Network.
+func (s *Service) privateNetworks(privateIPs []*ipam.IP, additionalPrivateIPs []privateNetworkIPs) ([]infrav1.MachinePrivateNetworkStatus, error) {
+	all := make([]privateNetworkIPs, 0, len(additionalPrivateIPs)+1)
+
+	if s.HasPrivateNetwork() {
+		privateNetworkID, err := s.PrivateNetworkID()
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, privateNetworkIPs{privateNetworkID: privateNetworkID, ips: privateIPs})
+	}
+
+	all = append(all, additionalPrivateIPs...)
+
+	if len(all) == 0 {
+		return nil, nil
+	}
+
+	privateNetworks := make([]infrav1.MachinePrivateNetworkStatus, 0, len(all))
+	for _, pn := range all {
+		addresses := make([]string, 0, len(pn.ips))
+		for _, ip := range pn.ips {
+			addresses = append(addresses, ip.Address.IP.String())
+		}
+
+		privateNetworks = append(privateNetworks, infrav1.MachinePrivateNetworkStatus{
+			ID:        infrav1.UUID(pn.privateNetworkID),
+			Addresses: addresses,
+		})
+	}
+
+	return privateNetworks, nil
+}
+
+// reservePrivateIP returns the IDs of the IPAM IPs that must be attached to the
+// private NIC of the server. The IP is reserved in IPAM if the machine has a
+// static address or an address range. No ID is returned if the IP is assigned by DHCP.
+func (s *Service) reservePrivateIP(ctx context.Context, privateNetworkID string) ([]string, error) {
+	spec := s.ScalewayMachine.Spec.PrivateNetwork
+
+	switch {
+	case spec.IPAMIPID != "":
+		return []string{string(spec.IPAMIPID)}, nil
+	case !s.hasReservedPrivateIP():
 		return nil, nil
 	}
 
-	privateNetworkID, err := s.PrivateNetworkID()
+	// Reuse the IP that is already reserved for the machine.
+	ips, err := s.ScalewayClient.FindPrivateNetworkIPs(ctx, privateNetworkID, s.ResourceTags())
 	if err != nil {
 		return nil, err
 	}
 
-	// Do nothing if Private NIC is already present.
-	pnicIndex := slices.IndexFunc(server.PrivateNics, func(pnic *instance.PrivateNIC) bool {
-		return pnic.PrivateNetworkID == privateNetworkID
-	})
+	if len(ips) > 0 {
+		return []string{ips[0].ID}, nil
+	}
 
-	var pnic *instance.PrivateNIC
-	if pnicIndex == -1 {
-		pnic, err = s.ScalewayClient.CreatePrivateNIC(ctx, server.Zone, server.ID, privateNetworkID)
+	if spec.Address != "" {
+		ip, err := s.ScalewayClient.BookPrivateNetworkIP(ctx, privateNetworkID, net.ParseIP(string(spec.Address)), s.ResourceTags())
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to reserve private IP %s: %w", spec.Address, err)
 		}
-	} else {
-		pnic = server.PrivateNics[pnicIndex]
+
+		return []string{ip.ID}, nil
 	}
 
-	privateIPs, err := s.ScalewayClient.FindPrivateNICIPs(ctx, pnic.ID)
+	addressRange, err := netip.ParsePrefix(string(spec.AddressRange))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse address range: %w", err)
+	}
+
+	addressRange = addressRange.Masked()
+
+	// Find the IPs that are already used in the Private Network.
+	usedIPs, err := s.ScalewayClient.FindPrivateNetworkIPs(ctx, privateNetworkID, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(privateIPs) == 0 {
-		return nil, scaleway.WithTransientError(errors.New("no private IP available in IPAM yet"), time.Second)
+	used := make(map[netip.Addr]struct{}, len(usedIPs))
+	for _, ip := range usedIPs {
+		if addr, ok := netip.AddrFromSlice(ip.Address.IP); ok {
+			used[addr.Unmap()] = struct{}{}
+		}
 	}
 
-	return privateIPs, nil
+	var (
+		attempts int
+		lastErr  error
+	)
+
+	for addr := addressRange.Addr(); addressRange.Contains(addr) && attempts < maxPrivateIPReservationAttempts; addr = addr.Next() {
+		// Skip the network and broadcast addresses of the range.
+		if addressRange.Bits() < 31 && (addr == addressRange.Addr() || !addressRange.Contains(addr.Next())) {
+			continue
+		}
+
+		if _, ok := used[addr]; ok {
+			continue
+		}
+
+		// The IP may be reserved by something that is not visible in IPAM (e.g. a gateway),
+		// so the next IPs of the range are tried if the reservation fails.
+		ip, err := s.ScalewayClient.BookPrivateNetworkIP(ctx, privateNetworkID, net.IP(addr.AsSlice()), s.ResourceTags())
+		if err == nil {
+			return []string{ip.ID}, nil
+		}
+
+		attempts++
+		lastErr = err
+	}
+
+	if lastErr != nil {
+		return nil, fmt.Errorf("failed to reserve private IP in range %s: %w", addressRange, lastErr)
+	}
+
+	return nil, fmt.Errorf("no private IP available in range %s", addressRange)
+}
+
+// hasReservedPrivateIP returns true if the provider reserves the private IP of the machine in IPAM.
+func (s *Service) hasReservedPrivateIP() bool {
+	return s.ScalewayMachine.Spec.PrivateNetwork.Address != "" || s.ScalewayMachine.Spec.PrivateNetwork.AddressRange != ""
+}
+
+// ensureNoReservedPrivateIPs releases the private IPs that were reserved in IPAM for the machine.
+func (s *Service) ensureNoReservedPrivateIPs(ctx context.Context) error {
+	if !s.HasPrivateNetwork() || !s.hasReservedPrivateIP() {
+		return nil
+	}
+
+	privateNetworkID, err := s.PrivateNetworkID()
+	if err != nil {
+		return err
+	}
+
+	ips, err := s.ScalewayClient.FindPrivateNetworkIPs(ctx, privateNetworkID, s.ResourceTags())
+	if err != nil {
+		return err
+	}
+
+	for _, ip := range ips {
+		if err := s.ScalewayClient.ReleaseIP(ctx, ip.ID); err != nil {
+			return fmt.Errorf("failed to release private IP: %w", err)
+		}
+	}
+
+	return nil
 }
 
-func machineAddresses(server *instance.Server, privateIPs []*ipam.IP) []clusterv1.MachineAddress {
-	// The total number of addresses is len(server.PublicIPs) + len(privateIPs) + ExternalDNS + Hostname.
+func machineAddresses(
+	server *instance.Server,
+	privateIPs []*ipam.IP,
+	additionalPrivateIPs []privateNetworkIPs,
+) []clusterv1.MachineAddress {
+	// The total number of addresses is len(server.PublicIPs) + len(privateIPs) + ExternalDNS + Hostname,
+	// without counting the private IPs of the additional Private Networks.
 	addresses := make([]clusterv1.MachineAddress, 0, len(server.PublicIPs)+len(privateIPs)+2)
 
 	addresses = append(addresses, clusterv1.MachineAddress{
@@ -544,10 +1703,43 @@ func machineAddresses(server *instance.Server, privateIPs []*ipam.IP) []clusterv
 		})
 	}
 
+	for _, pn := range additionalPrivateIPs {
+		for _, privateIP := range pn.ips {
+			addresses = append(addresses, clusterv1.MachineAddress{
+				Type:    clusterv1.MachineInternalIP,
+				Address: privateIP.Address.IP.String(),
+			})
+		}
+	}
+
 	return addresses
 }
 
-func providerID(server *instance.Server) string {
+// publicIPs returns the public addresses of the server with their type. All the
+// public IPs of a server have the same type.
+func publicIPs(server *instance.Server) []infrav1.MachinePublicIPStatus {
+	if len(server.PublicIPs) == 0 {
+		return nil
+	}
+
+	ipType := infrav1.PublicIPTypeRouted
+	if !routedIPEnabled(server) {
+		ipType = infrav1.PublicIPTypeNAT
+	}
+
+	publicIPs := make([]infrav1.MachinePublicIPStatus, 0, len(server.PublicIPs))
+	for _, publicIP := range server.PublicIPs {
+		publicIPs = append(publicIPs, infrav1.MachinePublicIPStatus{
+			Address: publicIP.Address.String(),
+			Type:    ipType,
+		})
+	}
+
+	return publicIPs
+}
+
+// ProviderID returns the provider ID of the node of an Instance server.
+func ProviderID(server *instance.Server) string {
 	return fmt.Sprintf("scaleway://instance/%s/%s", server.Zone, server.ID)
 }
 
@@ -570,149 +1762,23 @@ func nodeIP(server *instance.Server, privateIPs []*ipam.IP) (string, error) {
 }
 
 func (s *Service) findControlPlaneLBs(ctx context.Context) ([]*lb.LB, error) {
-	zone, err := s.ScalewayClient.GetZoneOrDefault(string(s.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.LoadBalancer.Zone))
-	if err != nil {
-		return nil, err
-	}
-
-	mainLB, err := s.ScalewayClient.FindLB(ctx, zone, s.Cluster.ResourceTags(servicelb.CAPSMainLBTag))
-	if err != nil {
-		return nil, err
-	}
-
-	extraLBs, err := s.ScalewayClient.FindLBs(ctx, s.Cluster.ResourceTags(servicelb.CAPSExtraLBTag))
-	if err != nil {
-		return nil, err
-	}
-
-	return append(extraLBs, mainLB), nil
+	return servicelb.FindControlPlaneLBs(ctx, s.Cluster)
 }
 
 func (s *Service) ensureControlPlaneLBs(ctx context.Context, lbs []*lb.LB, nodeIP string, deletion bool) error {
-	if !s.IsControlPlane() {
+	if !s.IsControlPlane() || s.ControlPlaneLoadBalancerExternallyManaged() {
 		return nil
 	}
 
-	for _, loadbalancer := range lbs {
-		if loadbalancer.Status == lb.LBStatusDeleting {
-			continue
-		}
-
-		backends, err := s.ScalewayClient.ListBackends(ctx, loadbalancer.Zone, loadbalancer.ID)
-		if err != nil {
-			return err
-		}
-
-		// Make sure we have the expected number of backends: 1 per additional port + 1 for the API server port.
-		if len(backends) != len(s.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.AdditionalPorts)+1 {
-			return fmt.Errorf("unexpected number of backends found on loadbalancer %s: expected %d, got %d",
-				loadbalancer.ID,
-				len(s.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.AdditionalPorts)+1,
-				len(backends),
-			)
-		}
-
-		// Sort backends by name.
-		slices.SortFunc(backends, func(a, b *lb.Backend) int {
-			return strings.Compare(a.Name, b.Name)
-		})
-
-		for _, backend := range backends {
-			switch {
-			case deletion && slices.Contains(backend.Pool, nodeIP):
-				if err := s.ScalewayClient.RemoveBackendServer(ctx, loadbalancer.Zone, backend.ID, nodeIP); err != nil {
-					return err
-				}
-			case !deletion && !slices.Contains(backend.Pool, nodeIP):
-				if err := s.ScalewayClient.AddBackendServer(ctx, loadbalancer.Zone, backend.ID, nodeIP); err != nil {
-					return err
-				}
-			}
-		}
-	}
-
-	return nil
+	return servicelb.EnsureControlPlaneLBsBackendServer(ctx, s.Cluster, lbs, nodeIP, deletion)
 }
 
 func (s *Service) ensureControlPlaneLBsACL(ctx context.Context, lbs []*lb.LB, publicIPs []string, delete bool) error {
-	for _, loadbalancer := range lbs {
-		if loadbalancer.Status == lb.LBStatusDeleting {
-			continue
-		}
-
-		frontends, err := s.ScalewayClient.ListFrontends(ctx, loadbalancer.Zone, loadbalancer.ID)
-		if err != nil {
-			// If the LB is not found, we can skip it when reconciling a deletion.
-			if delete && client.IsNotFoundError(err) {
-				continue
-			}
-
-			return err
-		}
-
-		// Make sure we have the expected number of frontends: 1 per additional port + 1 for the API server port.
-		if len(frontends) != len(s.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.AdditionalPorts)+1 {
-			return fmt.Errorf("unexpected number of frontends found on loadbalancer %s: expected %d, got %d",
-				loadbalancer.ID,
-				len(s.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.AdditionalPorts)+1,
-				len(frontends),
-			)
-		}
-
-		// Sort frontends by name.
-		slices.SortFunc(frontends, func(a, b *lb.Frontend) int {
-			return strings.Compare(a.Name, b.Name)
-		})
-
-		for _, frontend := range frontends {
-			acl, err := s.ScalewayClient.FindLBACLByName(ctx, loadbalancer.Zone, frontend.ID, s.ResourceName())
-			if err := utilerrors.FilterOut(err, client.IsNotFoundError); err != nil {
-				return err
-			}
-
-			// If no publicIP is set, we either delete the existing ACL or do nothing.
-			if len(publicIPs) == 0 {
-				if acl != nil {
-					if err := s.ScalewayClient.DeleteLBACL(ctx, loadbalancer.Zone, acl.ID); err != nil {
-						return err
-					}
-				}
-
-				continue
-			}
-
-			if acl == nil {
-				if err := s.ScalewayClient.CreateLBACL(ctx,
-					loadbalancer.Zone,
-					frontend.ID,
-					s.ResourceName(),
-					machineACLIndex,
-					lb.ACLActionTypeAllow,
-					publicIPs,
-				); err != nil {
-					return err
-				}
-
-				continue
-			}
-
-			if acl.Match == nil || !lbutil.IPsEqual(acl.Match.IPSubnet, scw.StringSlicePtr(publicIPs)) {
-				if err := s.ScalewayClient.UpdateLBACL(
-					ctx,
-					loadbalancer.Zone,
-					acl.ID,
-					s.ResourceName(),
-					machineACLIndex,
-					lb.ACLActionTypeAllow,
-					publicIPs,
-				); err != nil {
-					return err
-				}
-			}
-		}
+	if s.ControlPlaneLoadBalancerExternallyManaged() {
+		return nil
 	}
 
-	return nil
+	return servicelb.EnsureControlPlaneLBsACL(ctx, s.Cluster, lbs, s.ResourceName(), publicIPs, delete)
 }
 
 func instanceIPsToStrings(ips []*instance.ServerIP) []string {
@@ -725,7 +1791,7 @@ func instanceIPsToStrings(ips []*instance.ServerIP) []string {
 	return out
 }
 
-func (s *Service) ensureCloudInit(ctx context.Context, server *instance.Server, nodeIP string) error {
+func (s *Service) ensureCloudInit(ctx context.Context, server *instance.Server, privateIPs []*ipam.IP, nodeIP string) error {
 	if server.State != instance.ServerStateStopped {
 		return nil
 	}
@@ -736,49 +1802,123 @@ func (s *Service) ensureCloudInit(ctx context.Context, server *instance.Server,
 	}
 
 	if _, ok := userData[cloudInitUserDataKey]; !ok {
-		bootstrapData, err := s.GetBootstrapData(ctx)
+		bootstrapData, format, err := s.GetBootstrapData(ctx)
 		if err != nil {
 			return err
 		}
 
-		// Apply custom templating on cloud-init bootstrap data.
-		tmpl, err := template.New("").Delims("[[[", "]]]").Parse(string(bootstrapData))
+		// Apply custom templating on bootstrap data. Ignition configs are also
+		// stored in the cloud-init key, which is read by Ignition on Scaleway.
+		rendered, err := common.RenderBootstrapData(bootstrapData, format, s.bootstrapData(server, privateIPs, nodeIP))
 		if err != nil {
-			return fmt.Errorf("failed to parse bootstrap data as template: %w", err)
+			return err
 		}
 
-		tmplExec := &strings.Builder{} // tmplExec will contain the executed template.
-		tmplData := struct{ NodeIP string }{nodeIP}
-
-		if err := tmpl.ExecuteTemplate(tmplExec, "", tmplData); err != nil {
-			return fmt.Errorf("failed to execute bootstrap data template: %w", err)
+		entries, err := encodeUserData(rendered, format, s.ScalewayMachine.Spec.BootstrapData)
+		if err != nil {
+			return err
 		}
 
-		if err := s.ScalewayClient.SetServerUserData(
-			ctx,
-			server.Zone,
-			server.ID,
-			cloudInitUserDataKey,
-			tmplExec.String(),
-		); err != nil {
-			return err
+		for _, entry := range entries {
+			if err := s.ScalewayClient.SetServerUserData(
+				ctx,
+				server.Zone,
+				server.ID,
+				entry.key,
+				entry.content,
+			); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
+// bootstrapData returns the values available in the bootstrap data template of the server.
+func (s *Service) bootstrapData(server *instance.Server, privateIPs []*ipam.IP, nodeIP string) *common.BootstrapData {
+	publicIPs := make([]net.IP, 0, len(server.PublicIPs))
+	for _, publicIP := range server.PublicIPs {
+		publicIPs = append(publicIPs, publicIP.Address)
+	}
+
+	return common.NewBootstrapData(s.Cluster, s.Machine.Machine.Spec.ClusterName, &common.BootstrapServer{
+		ID:             server.ID,
+		ProviderID:     ProviderID(server),
+		Zone:           server.Zone,
+		CommercialType: server.CommercialType,
+		PublicIPs:      publicIPs,
+		PrivateIPs:     privateIPs,
+		NodeIP:         nodeIP,
+	})
+}
+
 func (s *Service) ensureNoCloudInit(ctx context.Context, server *instance.Server) error {
 	userData, err := s.ScalewayClient.GetAllServerUserData(ctx, server.Zone, server.ID)
 	if err != nil {
 		return err
 	}
 
-	if _, ok := userData["cloud-init"]; !ok {
-		return nil
+	for key := range userData {
+		if !isUserDataKey(key) {
+			continue
+		}
+
+		if err := s.ScalewayClient.DeleteServerUserData(ctx, server.Zone, server.ID, key); err != nil {
+			return err
+		}
 	}
 
-	return s.ScalewayClient.DeleteServerUserData(ctx, server.Zone, server.ID, cloudInitUserDataKey)
+	return nil
+}
+
+// setServerHealthyCondition sets the ServerHealthy condition from the state of the server
+// and emits an event when the condition reason changes. A nil server means the server
+// is missing.
+func (s *Service) setServerHealthyCondition(server *instance.Server) {
+	condition := metav1.Condition{
+		Type:   infrav1.ScalewayMachineServerHealthyCondition,
+		Status: metav1.ConditionFalse,
+	}
+
+	switch {
+	case server == nil:
+		condition.Reason = infrav1.ScalewayMachineServerMissingReason
+		condition.Message = "Server can no longer be found"
+	case server.State == instance.ServerStateRunning:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = infrav1.ScalewayMachineServerRunningReason
+	case server.State == instance.ServerStateLocked:
+		condition.Reason = infrav1.ScalewayMachineServerLockedReason
+		condition.Message = "Server is locked by Scaleway"
+	case server.State == instance.ServerStateStopped || server.State == instance.ServerStateStoppedInPlace:
+		condition.Reason = infrav1.ScalewayMachineServerStoppedReason
+		condition.Message = fmt.Sprintf("Server is in state %q", server.State)
+	default:
+		// The server is starting or stopping, it is not considered unhealthy yet.
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = infrav1.ScalewayMachineServerChangingStateReason
+		condition.Message = fmt.Sprintf("Server is in state %q", server.State)
+	}
+
+	previous := conditions.Get(s.ScalewayMachine, infrav1.ScalewayMachineServerHealthyCondition)
+
+	conditions.Set(s.ScalewayMachine, condition)
+
+	// Only report transitions, a healthy server is not worth an event on the first check.
+	if previous == nil && condition.Status == metav1.ConditionTrue ||
+		previous != nil && previous.Reason == condition.Reason {
+		return
+	}
+
+	switch condition.Status {
+	case metav1.ConditionTrue:
+		s.Eventf(corev1.EventTypeNormal, condition.Reason, "CheckServerHealth", "Server is running again")
+	case metav1.ConditionUnknown:
+		s.Eventf(corev1.EventTypeNormal, condition.Reason, "CheckServerHealth", "%s", condition.Message)
+	default:
+		s.Eventf(corev1.EventTypeWarning, condition.Reason, "CheckServerHealth", "%s", condition.Message)
+	}
 }
 
 func (s *Service) ensureServerStarted(ctx context.Context, server *instance.Server) error {
@@ -803,6 +1943,112 @@ func (s *Service) ensureServerStopped(ctx context.Context, server *instance.Serv
 	return scaleway.WithTransientError(errors.New("server is not stopped yet"), 10*time.Second)
 }
 
+// ensureServerShutdown stops the server before it is deleted or archived. The Instance
+// API has no action to ask the operating system of the server to shut down: there is no
+// graceful path. When a graceful shutdown timeout is set, the server is first stopped in
+// place and powered off once it is stopped in place or when the timeout is reached. The
+// timeout only depends on the time the shutdown started, which is kept in the status.
+func (s *Service) ensureServerShutdown(ctx context.Context, server *instance.Server) error {
+	if server.State == instance.ServerStateStopped {
+		return nil
+	}
+
+	deletion := &s.ScalewayMachine.Status.Deletion
+	timeout := s.GracefulShutdownTimeout()
+
+	if deletion.GracefulShutdownStartTime.IsZero() && timeout > 0 && server.State == instance.ServerStateRunning {
+		if err := s.ScalewayClient.ServerAction(ctx, server.Zone, server.ID, instance.ServerActionStopInPlace); err != nil {
+			return err
+		}
+
+		deletion.GracefulShutdownStartTime = metav1.Now()
+		s.setDeletingCondition(infrav1.ScalewayMachineDeletingShuttingDownReason, "Waiting up to %s for server to stop in place", timeout)
+
+		return scaleway.WithTransientError(errors.New("server is shutting down"), 10*time.Second)
+	}
+
+	if !deletion.GracefulShutdownStartTime.IsZero() && server.State != instance.ServerStateStoppedInPlace {
+		if remaining := time.Until(deletion.GracefulShutdownStartTime.Add(timeout)); remaining > 0 {
+			return scaleway.WithTransientError(errors.New("server is shutting down"), min(remaining, 10*time.Second))
+		}
+
+		s.setDeletingCondition(infrav1.ScalewayMachineDeletingForcingPoweroffReason, "Server did not stop in place within %s, powering it off", timeout)
+
+		return s.ensureServerStopped(ctx, server)
+	}
+
+	s.setDeletingCondition(infrav1.ScalewayMachineDeletingPoweringOffReason, "Powering off server %s", server.ID)
+
+	return s.ensureServerStopped(ctx, server)
+}
+
+// ensureRootVolumeSnapshot ensures a snapshot of the block root volume of the server
+// exists and is available.
+func (s *Service) ensureRootVolumeSnapshot(ctx context.Context, server *instance.Server) error {
+	for _, vol := range server.Volumes {
+		if !vol.Boot {
+			continue
+		}
+
+		if vol.VolumeType != instance.VolumeServerVolumeTypeSbsVolume {
+			return fmt.Errorf("cannot snapshot root volume with type %s", vol.VolumeType)
+		}
+
+		snapshotted, err := s.ensureVolumeSnapshot(ctx, &block.Volume{
+			ID:   vol.ID,
+			Name: s.ResourceName() + "-root",
+			Zone: server.Zone,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !snapshotted {
+			s.setDeletingCondition(infrav1.ScalewayMachineDeletingSnapshottingRootVolumeReason, "Waiting for snapshot of root volume %s", vol.ID)
+			return scaleway.WithTransientError(errors.New("snapshot of root volume is not available yet"), 5*time.Second)
+		}
+
+		break
+	}
+
+	return nil
+}
+
+// deletingReason returns the reason of the Deleting condition, if any.
+func (s *Service) deletingReason() string {
+	if condition := conditions.Get(s.ScalewayMachine, infrav1.ScalewayMachineDeletingCondition); condition != nil {
+		return condition.Reason
+	}
+
+	return ""
+}
+
+// setDeletingCondition sets the Deleting condition with the given reason and emits
+// an event when the reason changes.
+func (s *Service) setDeletingCondition(reason, messageFormat string, args ...any) {
+	message := fmt.Sprintf(messageFormat, args...)
+	previousReason := s.deletingReason()
+
+	conditions.Set(s.ScalewayMachine, metav1.Condition{
+		Type:    infrav1.ScalewayMachineDeletingCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  reason,
+		Message: message,
+	})
+
+	if previousReason == reason {
+		return
+	}
+
+	eventType := corev1.EventTypeNormal
+	if reason == infrav1.ScalewayMachineDeletingForcingPoweroffReason ||
+		reason == infrav1.ScalewayMachineDeletingServerProtectedReason {
+		eventType = corev1.EventTypeWarning
+	}
+
+	s.Eventf(eventType, reason, "DeleteServer", "%s", message)
+}
+
 func (s *Service) ensureNoPublicIPs(ctx context.Context, server *instance.Server) error {
 	ips, err := s.ScalewayClient.FindIPs(ctx, server.Zone, s.ResourceTags())
 	if err != nil {
@@ -815,6 +2061,15 @@ func (s *Service) ensureNoPublicIPs(ctx context.Context, server *instance.Server
 		}
 	}
 
+	// Flexible IPs that were not created for the machine are detached from the server, but not deleted.
+	if slices.ContainsFunc(server.PublicIPs, func(serverIP *instance.ServerIP) bool {
+		return !slices.ContainsFunc(ips, func(ip *instance.IP) bool { return ip.ID == serverIP.ID })
+	}) {
+		if _, err := s.ScalewayClient.UpdateServerPublicIPs(ctx, server.Zone, server.ID, []string{}); err != nil {
+			return fmt.Errorf("failed to detach flexible IPs: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -849,6 +2104,8 @@ func (s *Service) ensureSystemVolumesDeleted(ctx context.Context, server *instan
 		return err
 	}
 
+	deletionPolicies := s.additionalVolumeDeletionPolicies()
+
 	for _, volume := range volumes {
 		if isVolumeAttached(server, volume.ID) {
 			if err := s.ScalewayClient.DetachServerVolume(ctx, server.Zone, server.ID, volume.ID); err != nil {
@@ -856,6 +2113,25 @@ func (s *Service) ensureSystemVolumesDeleted(ctx context.Context, server *instan
 			}
 		}
 
+		switch deletionPolicies[volume.Name] {
+		case infrav1.VolumeDeletionPolicyRetain:
+			if err := s.ScalewayClient.UpdateVolumeTags(ctx, server.Zone, volume.ID, retainedVolumeTags(volume.Tags, s.ResourceTags())); err != nil {
+				return fmt.Errorf("failed to update tags of retained volume %s: %w", volume.ID, err)
+			}
+
+			continue
+		case infrav1.VolumeDeletionPolicySnapshot:
+			snapshotted, err := s.ensureVolumeSnapshot(ctx, volume)
+			if err != nil {
+				return err
+			}
+
+			if !snapshotted {
+				volumesNotDeleted = append(volumesNotDeleted, volume.ID)
+				continue
+			}
+		}
+
 		if volume.Status != block.VolumeStatusAvailable {
 			volumesNotDeleted = append(volumesNotDeleted, volume.ID)
 			continue
@@ -896,6 +2172,66 @@ func (s *Service) ensureSystemVolumesDeleted(ctx context.Context, server *instan
 	return nil
 }
 
+// retainedVolumeTags returns the tags of a retained volume: the tags of the machine
+// are replaced with the retained tag, so that the volume is no longer found with
+// the tags of the machine. The created-by tag is added back by the client.
+func retainedVolumeTags(tags, machineTags []string) []string {
+	tags = slices.DeleteFunc(client.TagsWithoutCreatedBy(slices.Clone(tags)), func(tag string) bool {
+		return slices.Contains(machineTags, tag)
+	})
+
+	return append(tags, retainedVolumeTag)
+}
+
+// additionalVolumeDeletionPolicies returns the deletion policies of the additional
+// volumes, indexed by volume name.
+func (s *Service) additionalVolumeDeletionPolicies() map[string]string {
+	policies := make(map[string]string, len(s.ScalewayMachine.Spec.AdditionalVolumes))
+
+	for i, vol := range s.ScalewayMachine.Spec.AdditionalVolumes {
+		if vol.DeletionPolicy != "" {
+			policies[fmt.Sprintf("%s-%d", s.ResourceName(), i)] = vol.DeletionPolicy
+		}
+	}
+
+	return policies
+}
+
+// ensureVolumeSnapshot ensures a snapshot of the volume exists. It returns true
+// when the snapshot is available and the volume can be deleted. The snapshot does
+// not have the tags of the machine, so that it is never adopted by a machine with
+// the same name: it is found with a tag that contains the ID of the volume.
+func (s *Service) ensureVolumeSnapshot(ctx context.Context, volume *block.Volume) (bool, error) {
+	parentVolumeTag := snapshotParentVolumeTagPrefix + volume.ID
+
+	snapshots, err := s.ScalewayClient.FindSnapshots(ctx, volume.Zone, []string{parentVolumeTag})
+	if err != nil {
+		return false, err
+	}
+
+	index := slices.IndexFunc(snapshots, func(snapshot *block.Snapshot) bool {
+		return snapshot.ParentVolume != nil && snapshot.ParentVolume.ID == volume.ID
+	})
+	if index == -1 {
+		tags := append(slices.Clone(s.ScalewayMachine.Spec.AdditionalTags), parentVolumeTag)
+
+		if _, err := s.ScalewayClient.CreateSnapshot(ctx, volume.Zone, volume.ID, volume.Name, tags); err != nil {
+			return false, fmt.Errorf("failed to create snapshot of volume %s: %w", volume.ID, err)
+		}
+
+		return false, nil
+	}
+
+	switch snapshots[index].Status {
+	case block.SnapshotStatusAvailable:
+		return true, nil
+	case block.SnapshotStatusError:
+		return false, fmt.Errorf("snapshot %s of volume %s is in error", snapshots[index].ID, volume.ID)
+	default:
+		return false, nil
+	}
+}
+
 // isVolumeAttached checks if the volume with the given ID is attached to the server.
 func isVolumeAttached(server *instance.Server, volumeID string) bool {
 	for _, vol := range server.Volumes {
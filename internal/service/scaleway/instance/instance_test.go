@@ -5,8 +5,10 @@ import (
 	"errors"
 	"io"
 	"net"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 
@@ -14,6 +16,8 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/marketplace/v2"
+	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
@@ -21,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
@@ -28,12 +33,15 @@ import (
 	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client"
 	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client/mock_client"
 	servicelb "github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/lb"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/securitygroup"
 )
 
 const (
 	extraVolumeID    = "22222222-2222-2222-2222-222222222222"
 	blockVolumeID    = "33333333-3333-3333-3333-333333333333"
 	localVolumeID    = "44444444-4444-4444-4444-444444444444"
+	dataVolumeID     = "55555555-5555-5555-5555-555555555555"
+	snapshotID       = "66666666-6666-6666-6666-666666666666"
 	bootVolumeID     = "11111111-1111-1111-1111-111111111111"
 	privateNetworkID = "11111111-1111-1111-1111-111111111111"
 	privateNICID     = "11111111-1111-1111-1111-111111111111"
@@ -47,6 +55,16 @@ const (
 	ipv6ID           = "11111111-1111-1111-1111-111111111111"
 	lbID             = "11111111-1111-1111-1111-111111111111"
 	lbACLID          = "11111111-1111-1111-1111-111111111111"
+	securityGroupID  = "11111111-1111-1111-1111-111111111111"
+	placementGroupID = "33333333-3333-3333-3333-333333333333"
+	flexibleIPv4ID   = "44444444-4444-4444-4444-444444444444"
+	flexibleIPv6ID   = "55555555-5555-5555-5555-555555555555"
+	ipamIPID         = "66666666-6666-6666-6666-666666666666"
+
+	additionalPrivateNetworkID  = "77777777-7777-7777-7777-777777777777"
+	additionalPrivateNetworkID2 = "88888888-8888-8888-8888-888888888888"
+	additionalPrivateNICID      = "77777777-7777-7777-7777-777777777777"
+	additionalPrivateNICID2     = "88888888-8888-8888-8888-888888888888"
 
 	cloudInitBootstrap = `#cloud-config
 
@@ -163,6 +181,7 @@ func TestService_Reconcile(t *testing.T) {
 
 				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
 				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
+				i.GetServerTypesAvailability(gomock.Any(), scw.ZoneFrPar1).Return(map[string]instance.ServerTypesAvailability{}, nil)
 				i.CreateServer(
 					gomock.Any(),
 					scw.ZoneFrPar1,
@@ -174,15 +193,22 @@ func TestService_Reconcile(t *testing.T) {
 					42*scw.GB,
 					instance.VolumeVolumeTypeSbsVolume,
 					nil,
+					true,
+					false,
 					tags,
 				).Return(&instance.Server{
-					Name:     "machine",
-					Hostname: "machine",
-					ID:       serverID,
-					Zone:     scw.ZoneFrPar1,
-					State:    instance.ServerStateStopped,
+					Name:           "machine",
+					Hostname:       "machine",
+					ID:             serverID,
+					Zone:           scw.ZoneFrPar1,
+					CommercialType: "DEV1-S",
+					State:          instance.ServerStateStopped,
+					Tags:           tags,
 				}, nil)
 				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.IP{}, nil)
+				i.GetServerType(gomock.Any(), scw.ZoneFrPar1, "DEV1-S").Return(&instance.ServerType{
+					Network: &instance.ServerTypeNetwork{IPv6Support: true},
+				}, nil).Times(2)
 				i.CreateIP(gomock.Any(), scw.ZoneFrPar1, instance.IPTypeRoutedIPv4, tags).Return(&instance.IP{
 					ID:      ipv4ID,
 					Address: net.IPv4(42, 42, 42, 42),
@@ -202,7 +228,7 @@ func TestService_Reconcile(t *testing.T) {
 						{Address: net.IP{42, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42}},
 					},
 				}, nil)
-				i.CreatePrivateNIC(gomock.Any(), scw.ZoneFrPar1, serverID, privateNetworkID).Return(&instance.PrivateNIC{
+				i.CreatePrivateNIC(gomock.Any(), scw.ZoneFrPar1, serverID, privateNetworkID, nil).Return(&instance.PrivateNIC{
 					ID: privateNICID,
 				}, nil)
 				i.FindPrivateNICIPs(gomock.Any(), privateNICID).Return([]*ipam.IP{
@@ -239,9 +265,9 @@ func TestService_Reconcile(t *testing.T) {
 					},
 				}, nil)
 				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendID, "machine").Return(nil, client.ErrNoItemFound)
-				i.CreateLBACL(gomock.Any(), scw.ZoneFrPar1, frontendID, "machine", machineACLIndex, lb.ACLActionTypeAllow, []string{"42.42.42.42", "2a00::2a"})
+				i.CreateLBACL(gomock.Any(), scw.ZoneFrPar1, frontendID, "machine", servicelb.MachineACLIndex, lb.ACLActionTypeAllow, []string{"42.42.42.42", "2a00::2a"})
 				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendID2, "machine").Return(nil, client.ErrNoItemFound)
-				i.CreateLBACL(gomock.Any(), scw.ZoneFrPar1, frontendID2, "machine", machineACLIndex, lb.ACLActionTypeAllow, []string{"42.42.42.42", "2a00::2a"})
+				i.CreateLBACL(gomock.Any(), scw.ZoneFrPar1, frontendID2, "machine", servicelb.MachineACLIndex, lb.ACLActionTypeAllow, []string{"42.42.42.42", "2a00::2a"})
 
 				// Cloud Init
 				i.GetAllServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID).Return(map[string]io.Reader{}, nil)
@@ -258,11 +284,18 @@ func TestService_Reconcile(t *testing.T) {
 					{Type: clusterv1.MachineExternalDNS, Address: "11111111-1111-1111-1111-111111111111.pub.instances.scw.cloud"},
 					{Type: clusterv1.MachineInternalIP, Address: "10.0.0.1"},
 				}))
+				g.Expect(m.ScalewayMachine.Status.PublicIPs).To(Equal([]infrav1.MachinePublicIPStatus{
+					{Address: "42.42.42.42", Type: infrav1.PublicIPTypeRouted},
+					{Address: "2a00::2a", Type: infrav1.PublicIPTypeRouted},
+				}))
+				g.Expect(m.ScalewayMachine.Status.PrivateNetworks).To(Equal([]infrav1.MachinePrivateNetworkStatus{
+					{ID: privateNetworkID, Addresses: []string{"10.0.0.1"}},
+				}))
 				g.Expect(m.ScalewayMachine.Spec.ProviderID).To(Equal("scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111"))
 			},
 		},
 		{
-			name: "create machine with additional block, local and scratch volumes",
+			name: "invalid bootstrap data template",
 			fields: fields{
 				Machine: &scope.Machine{
 					Machine: &clusterv1.Machine{
@@ -282,23 +315,6 @@ func TestService_Reconcile(t *testing.T) {
 							Name:      "machine",
 							Namespace: "default",
 						},
-						Spec: infrav1.ScalewayMachineSpec{
-							CommercialType: "DEV1-S",
-							Image: infrav1.Image{
-								IDOrName: infrav1.IDOrName{
-									ID: imageID,
-								},
-							},
-							RootVolume: infrav1.RootVolume{
-								Type: "block",
-								Size: 42,
-							},
-							AdditionalVolumes: []infrav1.AdditionalVolume{
-								{Type: "block", Size: 20, IOPS: 5000},
-								{Type: "local", Size: 10},
-								{Type: "scratch", Size: 50},
-							},
-						},
 					},
 					Cluster: &scope.Cluster{
 						ScalewayCluster: &infrav1.ScalewayCluster{
@@ -332,99 +348,57 @@ func TestService_Reconcile(t *testing.T) {
 						Namespace: "default",
 					},
 					Data: map[string][]byte{
-						"value": []byte(cloudInitBootstrap),
+						"value": []byte("[[[ .UnknownField ]]]"),
 					},
 				},
 			},
+			wantErr: true,
 			expect: func(i *mock_client.MockInterfaceMockRecorder) {
 				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
 				tags := append(clusterTags, "caps-scalewaymachine=machine")
 
 				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
-				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
-				i.CreateServer(
-					gomock.Any(),
-					scw.ZoneFrPar1,
-					"machine",
-					"DEV1-S",
-					imageID,
-					nil,
-					nil,
-					42*scw.GB,
-					instance.VolumeVolumeTypeSbsVolume,
-					[]scw.Size{50 * scw.GB},
-					tags,
-				).Return(&instance.Server{
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
 					Name:     "machine",
 					Hostname: "machine",
 					ID:       serverID,
 					Zone:     scw.ZoneFrPar1,
 					State:    instance.ServerStateStopped,
-				}, nil)
-
-				// Additional volumes: block (index 0), local (index 1), scratch (index 2, skipped).
-				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{}, nil)
-				i.CreateVolume(gomock.Any(), scw.ZoneFrPar1, "machine-0", 20*scw.GB, int64(5000), tags).Return(&block.Volume{
-					ID:   blockVolumeID,
-					Name: "machine-0",
-				}, nil)
-				i.AttachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, blockVolumeID, false)
-				i.FindInstanceVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.Volume{}, nil)
-				i.CreateInstanceVolume(gomock.Any(), scw.ZoneFrPar1, "machine-1", 10*scw.GB, tags).Return(&instance.Volume{
-					ID:   localVolumeID,
-					Name: "machine-1",
-				}, nil)
-				i.AttachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, localVolumeID, true)
-
-				// Private NIC (no public IPs).
-				i.CreatePrivateNIC(gomock.Any(), scw.ZoneFrPar1, serverID, privateNetworkID).Return(&instance.PrivateNIC{
-					ID: privateNICID,
+					PrivateNics: []*instance.PrivateNIC{
+						{ID: privateNICID, PrivateNetworkID: privateNetworkID},
+					},
+					Tags: tags,
 				}, nil)
 				i.FindPrivateNICIPs(gomock.Any(), privateNICID).Return([]*ipam.IP{
 					{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 1), Mask: net.CIDRMask(24, 32)}}},
 				}, nil)
-
-				// LB: worker node, so no backend or ACL changes.
 				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
 				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(&lb.LB{
-					ID:   lbID,
-					Zone: scw.ZoneFrPar1,
+					ID:     lbID,
+					Zone:   scw.ZoneFrPar1,
+					Status: lb.LBStatusDeleting,
 				}, nil)
 				i.FindLBs(gomock.Any(), append(clusterTags, servicelb.CAPSExtraLBTag)).Return(nil, nil)
-				i.ListFrontends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Frontend{{ID: frontendID}}, nil)
-				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendID, "machine").Return(nil, client.ErrNoItemFound)
-
-				// Cloud Init
 				i.GetAllServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID).Return(map[string]io.Reader{}, nil)
-				i.SetServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID, cloudInitUserDataKey, cloudInitData)
-
-				// Start
-				i.ServerAction(gomock.Any(), scw.ZoneFrPar1, serverID, instance.ServerActionPoweron)
 			},
 			asserts: func(g *WithT, m *scope.Machine) {
-				g.Expect(m.ScalewayMachine.Spec.ProviderID).To(Equal("scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111"))
+				condition := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineInstanceReadyCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(condition.Reason).To(Equal(infrav1.ScalewayMachineInstanceInvalidBootstrapDataTemplateReason))
 			},
 		},
 		{
-			name: "node has joined cluster: need to clean userdata",
+			name: "create worker machine with managed security group",
 			fields: fields{
 				Machine: &scope.Machine{
 					Machine: &clusterv1.Machine{
 						ObjectMeta: metav1.ObjectMeta{
 							Name:      "machine",
 							Namespace: "default",
-							Labels:    map[string]string{clusterv1.MachineControlPlaneLabel: ""},
 						},
 						Spec: clusterv1.MachineSpec{
 							FailureDomain: "fr-par-1",
-							Bootstrap: clusterv1.Bootstrap{
-								DataSecretName: ptr.To("bootstrap"),
-							},
-						},
-						Status: clusterv1.MachineStatus{
-							NodeRef: clusterv1.MachineNodeReference{
-								Name: "cluster",
-							},
 						},
 					},
 					ScalewayMachine: &infrav1.ScalewayMachine{
@@ -439,14 +413,9 @@ func TestService_Reconcile(t *testing.T) {
 									ID: imageID,
 								},
 							},
-							PublicNetwork: infrav1.PublicNetwork{
-								EnableIPv4: ptr.To(true),
-								EnableIPv6: ptr.To(true),
-							},
 							RootVolume: infrav1.RootVolume{
 								Size: 42,
 							},
-							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
 						},
 					},
 					Cluster: &scope.Cluster{
@@ -460,11 +429,9 @@ func TestService_Reconcile(t *testing.T) {
 									PrivateNetwork: infrav1.PrivateNetworkSpec{
 										Enabled: ptr.To(true),
 									},
-								},
-							},
-							Status: infrav1.ScalewayClusterStatus{
-								Network: infrav1.ScalewayClusterNetworkStatus{
-									PrivateNetworkID: privateNetworkID,
+									SecurityGroups: infrav1.SecurityGroupsSpec{
+										Enabled: ptr.To(true),
+									},
 								},
 							},
 						},
@@ -474,110 +441,48 @@ func TestService_Reconcile(t *testing.T) {
 			args: args{
 				ctx: context.TODO(),
 			},
-			objects: []runtime.Object{},
+			wantErr: true,
 			expect: func(i *mock_client.MockInterfaceMockRecorder) {
 				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
 				tags := append(clusterTags, "caps-scalewaymachine=machine")
 
 				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
-				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
-					Name:     "machine",
-					Hostname: "machine",
-					ID:       serverID,
-					Zone:     scw.ZoneFrPar1,
-					State:    instance.ServerStateStopped,
-					PublicIPs: []*instance.ServerIP{
-						{Address: net.IPv4(42, 42, 42, 42)},
-						{Address: net.IP{42, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42}},
-					},
-				}, nil)
-				i.GetAllServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID).Return(map[string]io.Reader{
-					cloudInitUserDataKey: strings.NewReader(cloudInitData),
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
+				i.GetServerTypesAvailability(gomock.Any(), scw.ZoneFrPar1).Return(map[string]instance.ServerTypesAvailability{}, nil)
+				i.FindSecurityGroupByTags(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, securitygroup.CAPSWorkerSGTag)).Return(&instance.SecurityGroup{
+					ID: securityGroupID,
 				}, nil)
-				i.DeleteServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID, cloudInitUserDataKey)
-			},
-			asserts: func(g *WithT, m *scope.Machine) {},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			g := NewWithT(t)
-
-			mockCtrl := gomock.NewController(t)
-			defer mockCtrl.Finish()
-
-			scwMock := mock_client.NewMockInterface(mockCtrl)
-
-			tt.expect(scwMock.EXPECT())
-
-			s := &Service{
-				Machine: tt.fields.Machine,
-			}
-			s.ScalewayClient = scwMock
-			s.Client = fake.NewFakeClient(tt.objects...)
-			if err := s.Reconcile(tt.args.ctx); (err != nil) != tt.wantErr {
-				t.Errorf("Service.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
-			}
-			tt.asserts(g, s.Machine)
-		})
-	}
-}
-
-func TestService_Delete(t *testing.T) {
-	t.Parallel()
-	type fields struct {
-		Machine *scope.Machine
-	}
-	type args struct {
-		ctx context.Context
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
-		expect  func(i *mock_client.MockInterfaceMockRecorder)
-	}{
-		{
-			name: "invalid zone, do nothing",
-			fields: fields{
-				Machine: &scope.Machine{
-					Machine: &clusterv1.Machine{
-						Spec: clusterv1.MachineSpec{
-							FailureDomain: "invalidvalue",
-						},
-					},
-					Cluster: &scope.Cluster{},
-				},
-			},
-			args: args{
-				ctx: context.TODO(),
+				i.CreateServer(
+					gomock.Any(),
+					scw.ZoneFrPar1,
+					"machine",
+					"DEV1-S",
+					imageID,
+					nil,
+					ptr.To(securityGroupID),
+					42*scw.GB,
+					instance.VolumeVolumeTypeSbsVolume,
+					nil,
+					false,
+					false,
+					tags,
+				).Return(nil, errors.New("quota exceeded"))
 			},
-			expect: func(i *mock_client.MockInterfaceMockRecorder) {
-				i.GetZoneOrDefault("invalidvalue").Return(scw.Zone(""), errors.New("invalid zone"))
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Spec.ProviderID).To(BeEmpty())
 			},
 		},
 		{
-			name: "delete control-plane machine",
+			name: "create server with NAT IPs",
 			fields: fields{
 				Machine: &scope.Machine{
 					Machine: &clusterv1.Machine{
 						ObjectMeta: metav1.ObjectMeta{
 							Name:      "machine",
 							Namespace: "default",
-							Labels:    map[string]string{clusterv1.MachineControlPlaneLabel: ""},
 						},
 						Spec: clusterv1.MachineSpec{
 							FailureDomain: "fr-par-1",
-							Bootstrap: clusterv1.Bootstrap{
-								DataSecretName: ptr.To("bootstrap"),
-							},
-						},
-						Status: clusterv1.MachineStatus{
-							NodeRef: clusterv1.MachineNodeReference{
-								Name: "cluster",
-							},
 						},
 					},
 					ScalewayMachine: &infrav1.ScalewayMachine{
@@ -592,14 +497,12 @@ func TestService_Delete(t *testing.T) {
 									ID: imageID,
 								},
 							},
-							PublicNetwork: infrav1.PublicNetwork{
-								EnableIPv4: ptr.To(true),
-								EnableIPv6: ptr.To(true),
-							},
 							RootVolume: infrav1.RootVolume{
 								Size: 42,
 							},
-							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+							PublicNetwork: infrav1.PublicNetwork{
+								IPType: infrav1.PublicIPTypeNAT,
+							},
 						},
 					},
 					Cluster: &scope.Cluster{
@@ -613,11 +516,9 @@ func TestService_Delete(t *testing.T) {
 									PrivateNetwork: infrav1.PrivateNetworkSpec{
 										Enabled: ptr.To(true),
 									},
-								},
-							},
-							Status: infrav1.ScalewayClusterStatus{
-								Network: infrav1.ScalewayClusterNetworkStatus{
-									PrivateNetworkID: privateNetworkID,
+									SecurityGroups: infrav1.SecurityGroupsSpec{
+										Enabled: ptr.To(true),
+									},
 								},
 							},
 						},
@@ -627,85 +528,3236 @@ func TestService_Delete(t *testing.T) {
 			args: args{
 				ctx: context.TODO(),
 			},
+			wantErr: true,
 			expect: func(i *mock_client.MockInterfaceMockRecorder) {
 				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
 				tags := append(clusterTags, "caps-scalewaymachine=machine")
 
 				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
-				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
-					Name:     "machine",
-					Hostname: "machine",
-					ID:       serverID,
-					Zone:     scw.ZoneFrPar1,
-					State:    instance.ServerStateStopped,
-					PublicIPs: []*instance.ServerIP{
-						{ID: ipv4ID, Address: net.IPv4(42, 42, 42, 42)},
-						{ID: ipv6ID, Address: net.IP{42, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42}},
-					},
-					PrivateNics: []*instance.PrivateNIC{
-						{ID: privateNICID, PrivateNetworkID: privateNetworkID},
-					},
-					Volumes: map[string]*instance.VolumeServer{
-						"0": {
-							ID:         bootVolumeID,
-							Boot:       true,
-							VolumeType: instance.VolumeServerVolumeTypeLSSD,
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
+				i.GetServerTypesAvailability(gomock.Any(), scw.ZoneFrPar1).Return(map[string]instance.ServerTypesAvailability{}, nil)
+				i.FindSecurityGroupByTags(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, securitygroup.CAPSWorkerSGTag)).Return(&instance.SecurityGroup{
+					ID: securityGroupID,
+				}, nil)
+				i.CreateServer(
+					gomock.Any(),
+					scw.ZoneFrPar1,
+					"machine",
+					"DEV1-S",
+					imageID,
+					nil,
+					ptr.To(securityGroupID),
+					42*scw.GB,
+					instance.VolumeVolumeTypeSbsVolume,
+					nil,
+					false,
+					true,
+					tags,
+				).Return(nil, errors.New("quota exceeded"))
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Spec.ProviderID).To(BeEmpty())
+			},
+		},
+		{
+			name: "create worker machine with fallback commercial type",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
 						},
-						"1": {
-							ID:         extraVolumeID,
-							VolumeType: instance.VolumeServerVolumeTypeLSSD,
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
 						},
 					},
-				}, nil)
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType:          "PRO2-S",
+							FallbackCommercialTypes: []string{"PRO2-M", "POP2-4C-16G"},
+							Image: infrav1.Image{
+								Label: "ubuntu_noble",
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
+				i.GetServerTypesAvailability(gomock.Any(), scw.ZoneFrPar1).Return(map[string]instance.ServerTypesAvailability{
+					"PRO2-S":      instance.ServerTypesAvailabilityShortage,
+					"PRO2-M":      instance.ServerTypesAvailabilityScarce,
+					"POP2-4C-16G": instance.ServerTypesAvailabilityAvailable,
+				}, nil)
+				i.GetLocalImageByLabel(gomock.Any(), scw.ZoneFrPar1, "PRO2-M", "ubuntu_noble", marketplace.LocalImageTypeInstanceSbs).Return(&marketplace.LocalImage{
+					ID: imageID,
+				}, nil)
+				i.CreateServer(
+					gomock.Any(),
+					scw.ZoneFrPar1,
+					"machine",
+					"PRO2-M",
+					imageID,
+					nil,
+					nil,
+					20*scw.GB,
+					instance.VolumeVolumeTypeSbsVolume,
+					nil,
+					false,
+					false,
+					tags,
+				).Return(nil, errors.New("quota exceeded"))
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Spec.ProviderID).To(BeEmpty())
+			},
+		},
+		{
+			name: "all commercial types are out of stock",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType:          "PRO2-S",
+							FallbackCommercialTypes: []string{"PRO2-M", "POP2-4C-16G"},
+							Image: infrav1.Image{
+								Label: "ubuntu_noble",
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
+				i.GetServerTypesAvailability(gomock.Any(), scw.ZoneFrPar1).Return(map[string]instance.ServerTypesAvailability{
+					"PRO2-S":      instance.ServerTypesAvailabilityShortage,
+					"PRO2-M":      instance.ServerTypesAvailabilityShortage,
+					"POP2-4C-16G": instance.ServerTypesAvailabilityShortage,
+				}, nil)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				condition := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineInstanceReadyCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(condition.Reason).To(Equal(infrav1.ScalewayMachineInstanceOutOfStockReason))
+			},
+		},
+		{
+			name: "attach existing flexible IPs",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							PublicNetwork: infrav1.PublicNetwork{
+								IPv4: infrav1.FlexibleIPReference{Address: "42.42.42.42"},
+								IPv6: infrav1.FlexibleIPReference{Tags: []string{"egress"}},
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:  "machine",
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateStopped,
+					Tags:  tags,
+				}, nil)
+				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.IP{}, nil)
+				i.GetIP(gomock.Any(), scw.ZoneFrPar1, "42.42.42.42").Return(&instance.IP{
+					ID:      flexibleIPv4ID,
+					Address: net.IPv4(42, 42, 42, 42),
+					Type:    instance.IPTypeRoutedIPv4,
+				}, nil)
+				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, []string{"egress"}).Return([]*instance.IP{
+					{ID: "66666666-6666-6666-6666-666666666666", Type: instance.IPTypeRoutedIPv4},
+					{ID: "77777777-7777-7777-7777-777777777777", Type: instance.IPTypeRoutedIPv6, Server: &instance.ServerSummary{ID: "other"}},
+					{ID: flexibleIPv6ID, Type: instance.IPTypeRoutedIPv6},
+				}, nil)
+				i.UpdateServerPublicIPs(gomock.Any(), scw.ZoneFrPar1, serverID, []string{flexibleIPv4ID, flexibleIPv6ID}).Return(nil, errors.New("api error"))
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Spec.ProviderID).To(BeEmpty())
+			},
+		},
+		{
+			name: "reserve private IP from address range",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							PrivateNetwork: infrav1.MachinePrivateNetwork{
+								AddressRange: "10.0.0.0/29",
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+							Spec: infrav1.ScalewayClusterSpec{
+								Network: infrav1.ScalewayClusterNetwork{
+									PrivateNetwork: infrav1.PrivateNetworkSpec{
+										Enabled: ptr.To(true),
+									},
+								},
+							},
+							Status: infrav1.ScalewayClusterStatus{
+								Network: infrav1.ScalewayClusterNetworkStatus{
+									PrivateNetworkID: privateNetworkID,
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:  "machine",
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateStopped,
+					Tags:  tags,
+				}, nil)
+				i.FindPrivateNetworkIPs(gomock.Any(), privateNetworkID, tags).Return([]*ipam.IP{}, nil)
+				i.FindPrivateNetworkIPs(gomock.Any(), privateNetworkID, nil).Return([]*ipam.IP{
+					{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 2), Mask: net.CIDRMask(22, 32)}}},
+				}, nil)
+				i.BookPrivateNetworkIP(gomock.Any(), privateNetworkID, net.IP(net.IPv4(10, 0, 0, 1).To4()), tags).Return(nil, errors.New("ip is already reserved"))
+				i.BookPrivateNetworkIP(gomock.Any(), privateNetworkID, net.IP(net.IPv4(10, 0, 0, 3).To4()), tags).Return(&ipam.IP{ID: ipamIPID}, nil)
+				i.CreatePrivateNIC(gomock.Any(), scw.ZoneFrPar1, serverID, privateNetworkID, []string{ipamIPID}).Return(nil, errors.New("api error"))
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Spec.ProviderID).To(BeEmpty())
+			},
+		},
+		{
+			name: "attach additional private networks",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+							Bootstrap: clusterv1.Bootstrap{
+								DataSecretName: ptr.To("bootstrap"),
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							AdditionalPrivateNetworks: []infrav1.PrivateNetworkReference{
+								{ID: additionalPrivateNetworkID},
+								{Tags: []string{"storage"}},
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+							Spec: infrav1.ScalewayClusterSpec{
+								Network: infrav1.ScalewayClusterNetwork{
+									PrivateNetwork: infrav1.PrivateNetworkSpec{
+										Enabled: ptr.To(true),
+									},
+								},
+							},
+							Status: infrav1.ScalewayClusterStatus{
+								Network: infrav1.ScalewayClusterNetworkStatus{
+									PrivateNetworkID: privateNetworkID,
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			objects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "bootstrap",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"value": []byte(cloudInitBootstrap),
+					},
+				},
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:     "machine",
+					Hostname: "machine",
+					ID:       serverID,
+					Zone:     scw.ZoneFrPar1,
+					State:    instance.ServerStateStopped,
+					PrivateNics: []*instance.PrivateNIC{
+						{ID: privateNICID, PrivateNetworkID: privateNetworkID},
+						{ID: additionalPrivateNICID2, PrivateNetworkID: additionalPrivateNetworkID2},
+					},
+					Tags: tags,
+				}, nil)
+
+				// Private NIC of the cluster.
+				i.FindPrivateNICIPs(gomock.Any(), privateNICID).Return([]*ipam.IP{
+					{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 1), Mask: net.CIDRMask(24, 32)}}},
+				}, nil)
+
+				// Additional private NICs.
+				i.CreatePrivateNIC(gomock.Any(), scw.ZoneFrPar1, serverID, additionalPrivateNetworkID, nil).Return(&instance.PrivateNIC{
+					ID: additionalPrivateNICID,
+				}, nil)
+				i.FindPrivateNICIPs(gomock.Any(), additionalPrivateNICID).Return([]*ipam.IP{
+					{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(172, 16, 0, 2), Mask: net.CIDRMask(24, 32)}}},
+				}, nil)
+				i.FindPrivateNetwork(gomock.Any(), []string{"storage"}, nil).Return(&vpc.PrivateNetwork{
+					ID: additionalPrivateNetworkID2,
+				}, nil)
+				i.FindPrivateNICIPs(gomock.Any(), additionalPrivateNICID2).Return([]*ipam.IP{
+					{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(192, 168, 0, 2), Mask: net.CIDRMask(24, 32)}}},
+				}, nil)
+
+				// LB: worker node, so no backend or ACL changes.
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(&lb.LB{
+					ID:   lbID,
+					Zone: scw.ZoneFrPar1,
+				}, nil)
+				i.FindLBs(gomock.Any(), append(clusterTags, servicelb.CAPSExtraLBTag)).Return(nil, nil)
+				i.ListFrontends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Frontend{{ID: frontendID}}, nil)
+				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendID, "machine").Return(nil, client.ErrNoItemFound)
+
+				// Cloud Init
+				i.GetAllServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID).Return(map[string]io.Reader{}, nil)
+				i.SetServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID, cloudInitUserDataKey, cloudInitData)
+
+				// Start
+				i.ServerAction(gomock.Any(), scw.ZoneFrPar1, serverID, instance.ServerActionPoweron)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Status.Addresses).To(Equal([]clusterv1.MachineAddress{
+					{Type: clusterv1.MachineHostName, Address: "machine"},
+					{Type: clusterv1.MachineInternalIP, Address: "10.0.0.1"},
+					{Type: clusterv1.MachineInternalIP, Address: "172.16.0.2"},
+					{Type: clusterv1.MachineInternalIP, Address: "192.168.0.2"},
+				}))
+				g.Expect(m.ScalewayMachine.Status.PrivateNetworks).To(Equal([]infrav1.MachinePrivateNetworkStatus{
+					{ID: privateNetworkID, Addresses: []string{"10.0.0.1"}},
+					{ID: additionalPrivateNetworkID, Addresses: []string{"172.16.0.2"}},
+					{ID: additionalPrivateNetworkID2, Addresses: []string{"192.168.0.2"}},
+				}))
+			},
+		},
+		{
+			name: "private network without cluster private network",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							PrivateNetwork: infrav1.MachinePrivateNetwork{
+								Address: "10.0.0.10",
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:  "machine",
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateStopped,
+					Tags:  tags,
+				}, nil)
+				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.IP{
+					{ID: ipv4ID, Type: instance.IPTypeRoutedIPv4, Server: &instance.ServerSummary{ID: serverID}},
+				}, nil)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				condition := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineInstanceReadyCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Message).To(ContainSubstring("the cluster has no Private Network"))
+			},
+		},
+		{
+			name: "out of stock in the default zone, create machine in another zone",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType:          "PRO2-S",
+							FallbackCommercialTypes: []string{"PRO2-M"},
+							Image: infrav1.Image{
+								Label: "ubuntu_noble",
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2})
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar2, tags).Return(nil, client.ErrNoItemFound)
+				i.GetServerTypesAvailability(gomock.Any(), scw.ZoneFrPar1).Return(map[string]instance.ServerTypesAvailability{
+					"PRO2-S": instance.ServerTypesAvailabilityShortage,
+					"PRO2-M": instance.ServerTypesAvailabilityShortage,
+				}, nil)
+				i.GetServerTypesAvailability(gomock.Any(), scw.ZoneFrPar2).Return(map[string]instance.ServerTypesAvailability{
+					"PRO2-S": instance.ServerTypesAvailabilityAvailable,
+				}, nil)
+				i.GetLocalImageByLabel(gomock.Any(), scw.ZoneFrPar2, "PRO2-S", "ubuntu_noble", marketplace.LocalImageTypeInstanceSbs).Return(&marketplace.LocalImage{
+					ID: imageID,
+				}, nil)
+				i.CreateServer(
+					gomock.Any(),
+					scw.ZoneFrPar2,
+					"machine",
+					"PRO2-S",
+					imageID,
+					nil,
+					nil,
+					20*scw.GB,
+					instance.VolumeVolumeTypeSbsVolume,
+					nil,
+					false,
+					false,
+					tags,
+				).Return(&instance.Server{
+					ID:             serverID,
+					Zone:           scw.ZoneFrPar2,
+					CommercialType: "PRO2-S",
+				}, nil)
+				i.UpdateServerTags(gomock.Any(), scw.ZoneFrPar2, serverID, tags).Return(errors.New("internal error"))
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Status.Zone).To(BeEquivalentTo("fr-par-2"))
+				g.Expect(m.ScalewayMachine.Status.ServerID).To(BeEquivalentTo(serverID))
+			},
+		},
+		{
+			name: "commercial type out of stock on creation, try fallback commercial type",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType:          "PRO2-S",
+							FallbackCommercialTypes: []string{"PRO2-M"},
+							Image: infrav1.Image{
+								Label: "ubuntu_noble",
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
+				i.GetServerTypesAvailability(gomock.Any(), scw.ZoneFrPar1).Return(map[string]instance.ServerTypesAvailability{}, nil)
+				i.GetLocalImageByLabel(gomock.Any(), scw.ZoneFrPar1, "PRO2-S", "ubuntu_noble", marketplace.LocalImageTypeInstanceSbs).Return(&marketplace.LocalImage{
+					ID: imageID,
+				}, nil)
+				i.CreateServer(
+					gomock.Any(), scw.ZoneFrPar1, "machine", "PRO2-S", imageID, nil, nil,
+					20*scw.GB, instance.VolumeVolumeTypeSbsVolume, nil, false, false, tags,
+				).Return(nil, &scw.OutOfStockError{Resource: "server"})
+				i.GetLocalImageByLabel(gomock.Any(), scw.ZoneFrPar1, "PRO2-M", "ubuntu_noble", marketplace.LocalImageTypeInstanceSbs).Return(&marketplace.LocalImage{
+					ID: imageID,
+				}, nil)
+				i.CreateServer(
+					gomock.Any(), scw.ZoneFrPar1, "machine", "PRO2-M", imageID, nil, nil,
+					20*scw.GB, instance.VolumeVolumeTypeSbsVolume, nil, false, false, tags,
+				).Return(nil, &scw.OutOfStockError{Resource: "server"})
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				condition := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineInstanceReadyCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Reason).To(Equal(infrav1.ScalewayMachineInstanceOutOfStockReason))
+			},
+		},
+		{
+			name: "create worker machine with managed placement group",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+							Labels:    map[string]string{clusterv1.MachineDeploymentNameLabel: "workers"},
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							RootVolume: infrav1.RootVolume{
+								Size: 42,
+							},
+							ManagedPlacementGroup: infrav1.ManagedPlacementGroup{
+								PolicyType: "max_availability",
+								PolicyMode: "enforced",
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+				pgTags := append(clusterTags, "caps-placementgroup=md-workers")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
+				i.GetServerTypesAvailability(gomock.Any(), scw.ZoneFrPar1).Return(map[string]instance.ServerTypesAvailability{}, nil)
+				i.FindPlacementGroups(gomock.Any(), scw.ZoneFrPar1, pgTags).Return([]*instance.PlacementGroup{
+					{
+						ID:         "22222222-2222-2222-2222-222222222222",
+						Name:       "cluster-md-workers-1",
+						PolicyType: instance.PlacementGroupPolicyTypeLowLatency,
+						PolicyMode: instance.PlacementGroupPolicyModeEnforced,
+					},
+					{
+						ID:         "11111111-1111-1111-1111-111111111111",
+						Name:       "cluster-md-workers-0",
+						PolicyType: instance.PlacementGroupPolicyTypeMaxAvailability,
+						PolicyMode: instance.PlacementGroupPolicyModeEnforced,
+					},
+				}, nil)
+				i.ListPlacementGroupServers(gomock.Any(), scw.ZoneFrPar1, "11111111-1111-1111-1111-111111111111").Return(
+					make([]*instance.PlacementGroupServer, 20), nil,
+				)
+				i.CreatePlacementGroup(
+					gomock.Any(),
+					scw.ZoneFrPar1,
+					"cluster-md-workers-2",
+					instance.PlacementGroupPolicyTypeMaxAvailability,
+					instance.PlacementGroupPolicyModeEnforced,
+					pgTags,
+				).Return(&instance.PlacementGroup{ID: placementGroupID}, nil)
+				i.CreateServer(
+					gomock.Any(),
+					scw.ZoneFrPar1,
+					"machine",
+					"DEV1-S",
+					imageID,
+					ptr.To(placementGroupID),
+					nil,
+					42*scw.GB,
+					instance.VolumeVolumeTypeSbsVolume,
+					nil,
+					false,
+					false,
+					tags,
+				).Return(nil, errors.New("quota exceeded"))
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Spec.ProviderID).To(BeEmpty())
+			},
+		},
+		{
+			name: "create machine with additional block, local and scratch volumes",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+							Bootstrap: clusterv1.Bootstrap{
+								DataSecretName: ptr.To("bootstrap"),
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							RootVolume: infrav1.RootVolume{
+								Type: "block",
+								Size: 42,
+							},
+							AdditionalVolumes: []infrav1.AdditionalVolume{
+								{Type: "block", Size: 20, IOPS: 5000},
+								{Type: "local", Size: 10},
+								{Type: "scratch", Size: 50},
+								{Type: "block", Snapshot: infrav1.IDOrName{Name: "data"}, DeletionPolicy: "Retain"},
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+							Spec: infrav1.ScalewayClusterSpec{
+								Network: infrav1.ScalewayClusterNetwork{
+									PrivateNetwork: infrav1.PrivateNetworkSpec{
+										Enabled: ptr.To(true),
+									},
+								},
+							},
+							Status: infrav1.ScalewayClusterStatus{
+								Network: infrav1.ScalewayClusterNetworkStatus{
+									PrivateNetworkID: privateNetworkID,
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			objects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "bootstrap",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"value": []byte(cloudInitBootstrap),
+					},
+				},
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
+				i.GetServerTypesAvailability(gomock.Any(), scw.ZoneFrPar1).Return(map[string]instance.ServerTypesAvailability{}, nil)
+				i.CreateServer(
+					gomock.Any(),
+					scw.ZoneFrPar1,
+					"machine",
+					"DEV1-S",
+					imageID,
+					nil,
+					nil,
+					42*scw.GB,
+					instance.VolumeVolumeTypeSbsVolume,
+					[]scw.Size{50 * scw.GB},
+					false,
+					false,
+					tags,
+				).Return(&instance.Server{
+					Name:     "machine",
+					Hostname: "machine",
+					ID:       serverID,
+					Zone:     scw.ZoneFrPar1,
+					State:    instance.ServerStateStopped,
+					Tags:     tags,
+				}, nil)
+
+				// No volumes are attached to the new server yet, their tags are not checked.
+				// Additional volumes: block (index 0), local (index 1), scratch (index 2, skipped),
+				// block from snapshot (index 3).
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{}, nil)
+				i.CreateVolume(gomock.Any(), scw.ZoneFrPar1, "machine-0", 20*scw.GB, int64(5000), tags).Return(&block.Volume{
+					ID:   blockVolumeID,
+					Name: "machine-0",
+				}, nil)
+				i.AttachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, blockVolumeID, false)
+				i.FindInstanceVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.Volume{}, nil)
+				i.CreateInstanceVolume(gomock.Any(), scw.ZoneFrPar1, "machine-1", 10*scw.GB, tags).Return(&instance.Volume{
+					ID:   localVolumeID,
+					Name: "machine-1",
+				}, nil)
+				i.AttachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, localVolumeID, true)
+				i.FindSnapshotByName(gomock.Any(), scw.ZoneFrPar1, "data").Return(&block.Snapshot{ID: snapshotID}, nil)
+				i.CreateVolumeFromSnapshot(gomock.Any(), scw.ZoneFrPar1, "machine-3", snapshotID, scw.Size(0), int64(0), tags).Return(&block.Volume{
+					ID:   dataVolumeID,
+					Name: "machine-3",
+				}, nil)
+				i.AttachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, dataVolumeID, false)
+
+				// Status of the additional volumes.
+				i.FindInstanceVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.Volume{
+					{ID: localVolumeID, Name: "machine-1"},
+				}, nil)
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{ID: dataVolumeID, Name: "machine-3"},
+					{ID: blockVolumeID, Name: "machine-0"},
+				}, nil)
+
+				// Private NIC (no public IPs).
+				i.CreatePrivateNIC(gomock.Any(), scw.ZoneFrPar1, serverID, privateNetworkID, nil).Return(&instance.PrivateNIC{
+					ID: privateNICID,
+				}, nil)
+				i.FindPrivateNICIPs(gomock.Any(), privateNICID).Return([]*ipam.IP{
+					{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 1), Mask: net.CIDRMask(24, 32)}}},
+				}, nil)
+
+				// LB: worker node, so no backend or ACL changes.
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(&lb.LB{
+					ID:   lbID,
+					Zone: scw.ZoneFrPar1,
+				}, nil)
+				i.FindLBs(gomock.Any(), append(clusterTags, servicelb.CAPSExtraLBTag)).Return(nil, nil)
+				i.ListFrontends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Frontend{{ID: frontendID}}, nil)
+				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendID, "machine").Return(nil, client.ErrNoItemFound)
+
+				// Cloud Init
+				i.GetAllServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID).Return(map[string]io.Reader{}, nil)
+				i.SetServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID, cloudInitUserDataKey, cloudInitData)
+
+				// Start
+				i.ServerAction(gomock.Any(), scw.ZoneFrPar1, serverID, instance.ServerActionPoweron)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Spec.ProviderID).To(Equal("scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111"))
+				g.Expect(m.ScalewayMachine.Status.AdditionalVolumes).To(Equal([]infrav1.AdditionalVolumeStatus{
+					{Name: "machine-0", ID: blockVolumeID},
+					{Name: "machine-1", ID: localVolumeID},
+					{Name: "machine-3", ID: dataVolumeID},
+				}))
+			},
+		},
+		{
+			name: "node has joined cluster: need to clean userdata",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+							Labels:    map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+							Bootstrap: clusterv1.Bootstrap{
+								DataSecretName: ptr.To("bootstrap"),
+							},
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "cluster",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							PublicNetwork: infrav1.PublicNetwork{
+								EnableIPv4: ptr.To(true),
+								EnableIPv6: ptr.To(true),
+							},
+							RootVolume: infrav1.RootVolume{
+								Size: 42,
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+							Spec: infrav1.ScalewayClusterSpec{
+								Network: infrav1.ScalewayClusterNetwork{
+									PrivateNetwork: infrav1.PrivateNetworkSpec{
+										Enabled: ptr.To(true),
+									},
+								},
+							},
+							Status: infrav1.ScalewayClusterStatus{
+								Network: infrav1.ScalewayClusterNetworkStatus{
+									PrivateNetworkID: privateNetworkID,
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			objects: []runtime.Object{},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:     "machine",
+					Hostname: "machine",
+					ID:       serverID,
+					Zone:     scw.ZoneFrPar1,
+					State:    instance.ServerStateRunning,
+					PublicIPs: []*instance.ServerIP{
+						{Address: net.IPv4(42, 42, 42, 42)},
+						{Address: net.IP{42, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42}},
+					},
+					CommercialType: "DEV1-S",
+					Image: &instance.Image{
+						ID:   imageID,
+						Name: "ubuntu",
+					},
+					Volumes: map[string]*instance.VolumeServer{
+						"0": {
+							ID:         bootVolumeID,
+							Boot:       true,
+							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+						},
+					},
+					Tags: tags,
+				}, nil)
+
+				// Root volume already has the tags of the machine.
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{ID: bootVolumeID, Tags: tags},
+				}, nil)
+
+				// Root volume already has the desired size.
+				i.GetVolume(gomock.Any(), scw.ZoneFrPar1, bootVolumeID).Return(&block.Volume{
+					ID:     bootVolumeID,
+					Size:   42 * scw.GB,
+					Status: block.VolumeStatusInUse,
+					Zone:   scw.ZoneFrPar1,
+				}, nil)
+
+				i.GetAllServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID).Return(map[string]io.Reader{
+					cloudInitUserDataKey: strings.NewReader(cloudInitData),
+				}, nil)
+				i.DeleteServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID, cloudInitUserDataKey)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Status.ServerID).To(BeEquivalentTo(serverID))
+				g.Expect(m.ScalewayMachine.Status.Zone).To(BeEquivalentTo(scw.ZoneFrPar1))
+				g.Expect(m.ScalewayMachine.Status.State).To(Equal("running"))
+				g.Expect(m.ScalewayMachine.Status.CommercialType).To(Equal("DEV1-S"))
+				g.Expect(m.ScalewayMachine.Status.Image).To(Equal(infrav1.ImageStatus{ID: imageID, Name: "ubuntu"}))
+				g.Expect(m.ScalewayMachine.Status.RootVolume).To(Equal(infrav1.RootVolumeStatus{ID: bootVolumeID, Type: "block"}))
+				condition := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineServerHealthyCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(condition.Reason).To(Equal(infrav1.ScalewayMachineServerRunningReason))
+			},
+		},
+		{
+			name: "node has joined cluster: server is locked",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "cluster",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+						Status: infrav1.ScalewayMachineStatus{
+							Conditions: []metav1.Condition{
+								{
+									Type:   infrav1.ScalewayMachineServerHealthyCondition,
+									Status: metav1.ConditionTrue,
+									Reason: infrav1.ScalewayMachineServerRunningReason,
+								},
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			objects: []runtime.Object{},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:     "machine",
+					Hostname: "machine",
+					ID:       serverID,
+					Zone:     scw.ZoneFrPar1,
+					State:    instance.ServerStateLocked,
+					Tags:     tags,
+				}, nil)
+				i.GetAllServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID).Return(map[string]io.Reader{}, nil)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Status.State).To(Equal("locked"))
+				condition := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineServerHealthyCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(condition.Reason).To(Equal(infrav1.ScalewayMachineServerLockedReason))
+			},
+		},
+		{
+			name: "node has joined cluster: server is stopped in place",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "cluster",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+						Status: infrav1.ScalewayMachineStatus{
+							Conditions: []metav1.Condition{
+								{
+									Type:   infrav1.ScalewayMachineServerHealthyCondition,
+									Status: metav1.ConditionTrue,
+									Reason: infrav1.ScalewayMachineServerRunningReason,
+								},
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			objects: []runtime.Object{},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:     "machine",
+					Hostname: "machine",
+					ID:       serverID,
+					Zone:     scw.ZoneFrPar1,
+					State:    instance.ServerStateStoppedInPlace,
+					Tags:     tags,
+				}, nil)
+				i.GetAllServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID).Return(map[string]io.Reader{}, nil)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Status.State).To(Equal("stopped in place"))
+				condition := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineServerHealthyCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(condition.Reason).To(Equal(infrav1.ScalewayMachineServerStoppedReason))
+			},
+		},
+		{
+			name: "node has joined cluster: server is stopping",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "cluster",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+						Status: infrav1.ScalewayMachineStatus{
+							Conditions: []metav1.Condition{
+								{
+									Type:   infrav1.ScalewayMachineServerHealthyCondition,
+									Status: metav1.ConditionTrue,
+									Reason: infrav1.ScalewayMachineServerRunningReason,
+								},
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			objects: []runtime.Object{},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:     "machine",
+					Hostname: "machine",
+					ID:       serverID,
+					Zone:     scw.ZoneFrPar1,
+					State:    instance.ServerStateStopping,
+					Tags:     tags,
+				}, nil)
+				i.GetAllServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID).Return(map[string]io.Reader{}, nil)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Status.State).To(Equal("stopping"))
+				condition := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineServerHealthyCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(metav1.ConditionUnknown))
+				g.Expect(condition.Reason).To(Equal(infrav1.ScalewayMachineServerChangingStateReason))
+			},
+		},
+		{
+			name: "node has joined cluster: server is missing",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "cluster",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			objects: []runtime.Object{},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				condition := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineServerHealthyCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(condition.Reason).To(Equal(infrav1.ScalewayMachineServerMissingReason))
+			},
+		},
+		{
+			name: "node has joined cluster: reconcile tag drift",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "cluster",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							PublicNetwork: infrav1.PublicNetwork{
+								EnableIPv4: ptr.To(true),
+								IPv6: infrav1.FlexibleIPReference{
+									ID: flexibleIPv6ID,
+								},
+							},
+							AdditionalVolumes: []infrav1.AdditionalVolume{
+								{Type: "block", Size: 20},
+							},
+							AdditionalTags: []string{"env=prod"},
+							ProviderID:     "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			objects: []runtime.Object{},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+				desiredTags := append(slices.Clone(tags), "env=prod")
+				oldTags := append(slices.Clone(tags), "env=dev", "created-by=cluster-api-provider-scaleway")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:     "machine",
+					Hostname: "machine",
+					ID:       serverID,
+					Zone:     scw.ZoneFrPar1,
+					State:    instance.ServerStateRunning,
+					PublicIPs: []*instance.ServerIP{
+						{ID: ipv4ID, Address: net.IPv4(42, 42, 42, 42), Tags: oldTags},
+						{ID: flexibleIPv6ID, Address: net.IP{42, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42}, Tags: []string{"pool"}},
+					},
+					Volumes: map[string]*instance.VolumeServer{
+						"0": {
+							ID:         bootVolumeID,
+							Boot:       true,
+							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+						},
+						"1": {
+							ID:         blockVolumeID,
+							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+						},
+					},
+					Tags: oldTags,
+				}, nil)
+
+				// Tags of the server, of the IPv4 created by the provider, of the additional
+				// volume and of the root volume, which does not have the tags of the machine yet.
+				i.UpdateServerTags(gomock.Any(), scw.ZoneFrPar1, serverID, desiredTags)
+				i.UpdateIPTags(gomock.Any(), scw.ZoneFrPar1, ipv4ID, desiredTags)
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{ID: blockVolumeID, Name: "machine-0", Tags: oldTags},
+				}, nil)
+				i.UpdateVolumeTags(gomock.Any(), scw.ZoneFrPar1, blockVolumeID, desiredTags)
+				i.UpdateVolumeTags(gomock.Any(), scw.ZoneFrPar1, bootVolumeID, desiredTags)
+
+				// Status of the additional volume.
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{ID: blockVolumeID, Name: "machine-0", Tags: desiredTags},
+				}, nil)
+
+				// Additional volume already has the desired size.
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{ID: blockVolumeID, Name: "machine-0", Size: 20 * scw.GB, Status: block.VolumeStatusInUse, Tags: desiredTags},
+				}, nil)
+
+				i.GetAllServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID).Return(map[string]io.Reader{}, nil)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {},
+		},
+		{
+			name: "node has joined cluster: migrate NAT IPs",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "cluster",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							PublicNetwork: infrav1.PublicNetwork{
+								IPType: infrav1.PublicIPTypeRouted,
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			objects: []runtime.Object{},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:            "machine",
+					Hostname:        "machine",
+					ID:              serverID,
+					Zone:            scw.ZoneFrPar1,
+					CommercialType:  "DEV1-S",
+					State:           instance.ServerStateRunning,
+					RoutedIPEnabled: ptr.To(false),
+					PublicIPs: []*instance.ServerIP{
+						{ID: ipv4ID, Address: net.IPv4(42, 42, 42, 42), Tags: tags},
+					},
+					Tags: tags,
+				}, nil)
+				i.GetServerType(gomock.Any(), scw.ZoneFrPar1, "DEV1-S").Return(&instance.ServerType{
+					Network: &instance.ServerTypeNetwork{},
+				}, nil)
+				i.ServerAction(gomock.Any(), scw.ZoneFrPar1, serverID, instance.ServerActionEnableRoutedIP)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {},
+		},
+		{
+			name: "node has joined cluster: commercial type does not support routed IPs",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "cluster",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							PublicNetwork: infrav1.PublicNetwork{
+								IPType: infrav1.PublicIPTypeRouted,
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			objects: []runtime.Object{},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:            "machine",
+					Hostname:        "machine",
+					ID:              serverID,
+					Zone:            scw.ZoneFrPar1,
+					CommercialType:  "DEV1-S",
+					State:           instance.ServerStateRunning,
+					RoutedIPEnabled: ptr.To(false),
+					PublicIPs: []*instance.ServerIP{
+						{ID: ipv4ID, Address: net.IPv4(42, 42, 42, 42), Tags: tags},
+					},
+					Tags: tags,
+				}, nil)
+				i.GetServerType(gomock.Any(), scw.ZoneFrPar1, "DEV1-S").Return(&instance.ServerType{
+					Network:      &instance.ServerTypeNetwork{},
+					EndOfService: true,
+				}, nil)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {},
+		},
+		{
+			name: "node has joined cluster: NAT IPs are kept when ipType is not set",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "cluster",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			objects: []runtime.Object{},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:            "machine",
+					Hostname:        "machine",
+					ID:              serverID,
+					Zone:            scw.ZoneFrPar1,
+					State:           instance.ServerStateRunning,
+					RoutedIPEnabled: ptr.To(false),
+					PublicIPs: []*instance.ServerIP{
+						{ID: ipv4ID, Address: net.IPv4(42, 42, 42, 42), Tags: tags},
+					},
+					Tags: tags,
+				}, nil)
+				i.GetAllServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID).Return(map[string]io.Reader{}, nil)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Status.PublicIPs).To(Equal([]infrav1.MachinePublicIPStatus{
+					{Address: "42.42.42.42", Type: infrav1.PublicIPTypeNAT},
+				}))
+			},
+		},
+		{
+			name: "node has joined cluster: routed IPs cannot be migrated to NAT IPs",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "cluster",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							PublicNetwork: infrav1.PublicNetwork{
+								IPType: infrav1.PublicIPTypeNAT,
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			objects: []runtime.Object{},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:     "machine",
+					Hostname: "machine",
+					ID:       serverID,
+					Zone:     scw.ZoneFrPar1,
+					State:    instance.ServerStateRunning,
+					PublicIPs: []*instance.ServerIP{
+						{ID: ipv4ID, Address: net.IPv4(42, 42, 42, 42), Tags: tags},
+					},
+					Tags: tags,
+				}, nil)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {},
+		},
+		{
+			name: "node has joined cluster: grow block volumes",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "machine",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							RootVolume: infrav1.RootVolume{
+								Size: 50,
+								IOPS: 15000,
+							},
+							AdditionalVolumes: []infrav1.AdditionalVolume{
+								{Type: "block", Size: 100},
+								{Type: "local", Size: 10},
+								{Type: "block", Size: 20},
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			objects: []runtime.Object{},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:     "machine",
+					Hostname: "machine",
+					ID:       serverID,
+					Zone:     scw.ZoneFrPar1,
+					State:    instance.ServerStateRunning,
+					Volumes: map[string]*instance.VolumeServer{
+						"0": {
+							ID:         bootVolumeID,
+							Boot:       true,
+							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+						},
+					},
+					Tags: tags,
+				}, nil)
+
+				// Tags of the root volume are up to date, no local volume is attached.
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{ID: bootVolumeID, Tags: tags},
+				}, nil)
+
+				// Status of the additional volumes is reported after the node has joined the cluster.
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{ID: blockVolumeID, Name: "machine-0"},
+					{ID: dataVolumeID, Name: "machine-2"},
+				}, nil)
+				i.FindInstanceVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.Volume{
+					{ID: localVolumeID, Name: "machine-1"},
+				}, nil)
+
+				// Root volume is grown, its IOPS are already up to date.
+				i.GetVolume(gomock.Any(), scw.ZoneFrPar1, bootVolumeID).Return(&block.Volume{
+					ID:     bootVolumeID,
+					Size:   20 * scw.GB,
+					Status: block.VolumeStatusInUse,
+					Zone:   scw.ZoneFrPar1,
+					Specs:  &block.VolumeSpecifications{PerfIops: scw.Uint32Ptr(15000)},
+				}, nil)
+				i.UpdateVolumeSize(gomock.Any(), scw.ZoneFrPar1, bootVolumeID, 50*scw.GB)
+
+				// First additional volume is grown, the second one already has the desired size.
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{ID: blockVolumeID, Name: "machine-0", Size: 20 * scw.GB, Status: block.VolumeStatusInUse, Zone: scw.ZoneFrPar1},
+					{ID: dataVolumeID, Name: "machine-2", Size: 20 * scw.GB, Status: block.VolumeStatusInUse, Zone: scw.ZoneFrPar1},
+				}, nil)
+				i.UpdateVolumeSize(gomock.Any(), scw.ZoneFrPar1, blockVolumeID, 100*scw.GB)
+
+				i.GetAllServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID).Return(map[string]io.Reader{}, nil)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				condition := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineFileSystemResizePendingCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(condition.Reason).To(Equal(infrav1.ScalewayMachineVolumesResizedReason))
+				g.Expect(condition.Message).To(ContainSubstring(bootVolumeID))
+				g.Expect(condition.Message).To(ContainSubstring(blockVolumeID))
+				g.Expect(m.ScalewayMachine.Status.AdditionalVolumes).To(Equal([]infrav1.AdditionalVolumeStatus{
+					{Name: "machine-0", ID: blockVolumeID},
+					{Name: "machine-1", ID: localVolumeID},
+					{Name: "machine-2", ID: dataVolumeID},
+				}))
+			},
+		},
+		{
+			name: "node has joined cluster: update IOPS before growing root volume",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "machine",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							RootVolume: infrav1.RootVolume{
+								Size: 50,
+								IOPS: 15000,
+							},
+							AdditionalVolumes: []infrav1.AdditionalVolume{
+								{Type: "block", Size: 100},
+								{Type: "local", Size: 10},
+								{Type: "block", Size: 20},
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			objects: []runtime.Object{},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:     "machine",
+					Hostname: "machine",
+					ID:       serverID,
+					Zone:     scw.ZoneFrPar1,
+					State:    instance.ServerStateRunning,
+					Volumes: map[string]*instance.VolumeServer{
+						"0": {
+							ID:         bootVolumeID,
+							Boot:       true,
+							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+						},
+					},
+					Tags: tags,
+				}, nil)
+
+				// Tags of the root volume are up to date, no local volume is attached.
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{ID: bootVolumeID, Tags: tags},
+				}, nil)
+
+				// Status of the additional volumes is reported after the node has joined the cluster.
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{ID: blockVolumeID, Name: "machine-0"},
+					{ID: dataVolumeID, Name: "machine-2"},
+				}, nil)
+				i.FindInstanceVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.Volume{
+					{ID: localVolumeID, Name: "machine-1"},
+				}, nil)
+
+				// IOPS of the root volume are updated first, it is not grown in the same pass.
+				i.GetVolume(gomock.Any(), scw.ZoneFrPar1, bootVolumeID).Return(&block.Volume{
+					ID:     bootVolumeID,
+					Size:   20 * scw.GB,
+					Status: block.VolumeStatusInUse,
+					Zone:   scw.ZoneFrPar1,
+					Specs:  &block.VolumeSpecifications{PerfIops: scw.Uint32Ptr(5000)},
+				}, nil)
+				i.UpdateVolumeIOPS(gomock.Any(), scw.ZoneFrPar1, bootVolumeID, int64(15000))
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				// The root volume is grown once its IOPS are updated.
+				condition := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineFileSystemResizePendingCondition)
+				g.Expect(condition).To(BeNil())
+			},
+		},
+		{
+			name: "node has joined cluster: filesystem resize acknowledged",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "machine",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+							Annotations: map[string]string{
+								infrav1.ScalewayMachineFileSystemResizedAnnotation: "",
+							},
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+						Status: infrav1.ScalewayMachineStatus{
+							Conditions: []metav1.Condition{
+								{
+									Type:   infrav1.ScalewayMachineFileSystemResizePendingCondition,
+									Status: metav1.ConditionTrue,
+									Reason: infrav1.ScalewayMachineVolumesResizedReason,
+								},
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			objects: []runtime.Object{},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:     "machine",
+					Hostname: "machine",
+					ID:       serverID,
+					Zone:     scw.ZoneFrPar1,
+					State:    instance.ServerStateRunning,
+					Tags:     tags,
+				}, nil)
+				i.GetAllServerUserData(gomock.Any(), scw.ZoneFrPar1, serverID).Return(map[string]io.Reader{}, nil)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Annotations).NotTo(HaveKey(infrav1.ScalewayMachineFileSystemResizedAnnotation))
+				g.Expect(conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineFileSystemResizePendingCondition)).To(BeNil())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			scwMock := mock_client.NewMockInterface(mockCtrl)
+
+			tt.expect(scwMock.EXPECT())
+
+			s := &Service{
+				Machine: tt.fields.Machine,
+			}
+			s.ScalewayClient = scwMock
+			s.Client = fake.NewFakeClient(tt.objects...)
+			if err := s.Reconcile(tt.args.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("Service.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			tt.asserts(g, s.Machine)
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		Machine *scope.Machine
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		expect  func(i *mock_client.MockInterfaceMockRecorder)
+		asserts func(g *WithT, m *scope.Machine)
+	}{
+		{
+			name: "invalid zone, do nothing",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "invalidvalue",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{},
+					Cluster:         &scope.Cluster{},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetZoneOrDefault("invalidvalue").Return(scw.Zone(""), errors.New("invalid zone"))
+			},
+		},
+		{
+			name: "server already deleted, delete empty managed placement groups",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+							Labels:    map[string]string{clusterv1.MachineDeploymentNameLabel: "workers"},
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							ManagedPlacementGroup: infrav1.ManagedPlacementGroup{
+								PolicyType: "max_availability",
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+				pgTags := append(clusterTags, "caps-placementgroup=md-workers")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
+				i.FindPlacementGroups(gomock.Any(), scw.ZoneFrPar1, pgTags).Return([]*instance.PlacementGroup{
+					{ID: "11111111-1111-1111-1111-111111111111"},
+					{ID: "22222222-2222-2222-2222-222222222222"},
+				}, nil)
+				i.ListPlacementGroupServers(gomock.Any(), scw.ZoneFrPar1, "11111111-1111-1111-1111-111111111111").Return(
+					[]*instance.PlacementGroupServer{{ID: serverID}}, nil,
+				)
+				i.ListPlacementGroupServers(gomock.Any(), scw.ZoneFrPar1, "22222222-2222-2222-2222-222222222222").Return(nil, nil)
+				i.DeletePlacementGroup(gomock.Any(), scw.ZoneFrPar1, "22222222-2222-2222-2222-222222222222").Return(nil)
+			},
+		},
+		{
+			name: "server already deleted, release reserved private IP",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							PrivateNetwork: infrav1.MachinePrivateNetwork{
+								AddressRange: "10.0.0.0/29",
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+							Spec: infrav1.ScalewayClusterSpec{
+								Network: infrav1.ScalewayClusterNetwork{
+									PrivateNetwork: infrav1.PrivateNetworkSpec{
+										Enabled: ptr.To(true),
+									},
+								},
+							},
+							Status: infrav1.ScalewayClusterStatus{
+								Network: infrav1.ScalewayClusterNetworkStatus{
+									PrivateNetworkID: privateNetworkID,
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
+				i.FindPrivateNetworkIPs(gomock.Any(), privateNetworkID, tags).Return([]*ipam.IP{{ID: ipamIPID}}, nil)
+				i.ReleaseIP(gomock.Any(), ipamIPID)
+			},
+		},
+		{
+			name: "delete control-plane machine",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              "machine",
+							Namespace:         "default",
+							Labels:            map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+							DeletionTimestamp: &metav1.Time{Time: time.Now()},
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+							Bootstrap: clusterv1.Bootstrap{
+								DataSecretName: ptr.To("bootstrap"),
+							},
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "cluster",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							PublicNetwork: infrav1.PublicNetwork{
+								EnableIPv4: ptr.To(true),
+								EnableIPv6: ptr.To(true),
+							},
+							RootVolume: infrav1.RootVolume{
+								Size: 42,
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+							Spec: infrav1.ScalewayClusterSpec{
+								Network: infrav1.ScalewayClusterNetwork{
+									PrivateNetwork: infrav1.PrivateNetworkSpec{
+										Enabled: ptr.To(true),
+									},
+								},
+							},
+							Status: infrav1.ScalewayClusterStatus{
+								Network: infrav1.ScalewayClusterNetworkStatus{
+									PrivateNetworkID: privateNetworkID,
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:      "machine",
+					Hostname:  "machine",
+					ID:        serverID,
+					Zone:      scw.ZoneFrPar1,
+					State:     instance.ServerStateStopped,
+					Protected: true,
+					PublicIPs: []*instance.ServerIP{
+						{ID: ipv4ID, Address: net.IPv4(42, 42, 42, 42)},
+						{ID: ipv6ID, Address: net.IP{42, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42}},
+					},
+					PrivateNics: []*instance.PrivateNIC{
+						{ID: privateNICID, PrivateNetworkID: privateNetworkID},
+					},
+					Volumes: map[string]*instance.VolumeServer{
+						"0": {
+							ID:         bootVolumeID,
+							Boot:       true,
+							VolumeType: instance.VolumeServerVolumeTypeLSSD,
+						},
+						"1": {
+							ID:         extraVolumeID,
+							VolumeType: instance.VolumeServerVolumeTypeLSSD,
+						},
+					},
+				}, nil)
+
+				// LB config
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(&lb.LB{
+					ID:   lbID,
+					Zone: scw.ZoneFrPar1,
+				}, nil)
+				i.FindLBs(gomock.Any(), append(clusterTags, servicelb.CAPSExtraLBTag)).Return(nil, nil)
+				i.ListFrontends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Frontend{{
+					ID:   frontendID,
+					Name: servicelb.APIServerPortName,
+				}}, nil)
+				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendID, "machine").Return(&lb.ACL{
+					ID:   lbACLID,
+					Name: "machine",
+				}, nil)
+				i.DeleteLBACL(gomock.Any(), scw.ZoneFrPar1, lbACLID)
+				i.FindPrivateNICIPs(gomock.Any(), privateNICID).Return([]*ipam.IP{
+					{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 1), Mask: net.CIDRMask(24, 32)}}},
+				}, nil)
+				i.ListBackends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Backend{{
+					ID:   backendID,
+					Pool: []string{"10.0.0.1"},
+				}}, nil)
+				i.RemoveBackendServer(gomock.Any(), scw.ZoneFrPar1, backendID, "10.0.0.1")
+
+				// Cleanup public IPs
+				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.IP{{ID: ipv4ID}, {ID: ipv6ID}}, nil)
+				i.DeleteIP(gomock.Any(), scw.ZoneFrPar1, ipv4ID)
+				i.DeleteIP(gomock.Any(), scw.ZoneFrPar1, ipv6ID)
+
+				// Volumes detach and remove
+				i.UpdateInstanceVolumeTags(gomock.Any(), scw.ZoneFrPar1, bootVolumeID, tags)
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{
+						ID:     bootVolumeID,
+						Status: block.VolumeStatusAvailable,
+					},
+				}, nil)
+				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, bootVolumeID)
+				i.DeleteVolume(gomock.Any(), scw.ZoneFrPar1, bootVolumeID)
+				i.FindInstanceVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.Volume{}, nil)
+
+				// Lift protection and delete server
+				i.UpdateServerProtection(gomock.Any(), scw.ZoneFrPar1, serverID, false)
+				i.DeleteServer(gomock.Any(), scw.ZoneFrPar1, serverID)
+			},
+		},
+		{
+			name: "delete control-plane machine behind externally managed loadbalancer",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              "machine",
+							Namespace:         "default",
+							Labels:            map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+							DeletionTimestamp: &metav1.Time{Time: time.Now()},
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+							Bootstrap: clusterv1.Bootstrap{
+								DataSecretName: ptr.To("bootstrap"),
+							},
+						},
+						Status: clusterv1.MachineStatus{
+							NodeRef: clusterv1.MachineNodeReference{
+								Name: "cluster",
+							},
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							PublicNetwork: infrav1.PublicNetwork{
+								EnableIPv4: ptr.To(true),
+								EnableIPv6: ptr.To(true),
+							},
+							RootVolume: infrav1.RootVolume{
+								Size: 42,
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+							Spec: infrav1.ScalewayClusterSpec{
+								Network: infrav1.ScalewayClusterNetwork{
+									PrivateNetwork: infrav1.PrivateNetworkSpec{
+										Enabled: ptr.To(true),
+									},
+									ControlPlaneLoadBalancer: infrav1.ControlPlaneLoadBalancer{
+										ExternallyManaged: ptr.To(true),
+									},
+								},
+								ControlPlaneEndpoint: clusterv1.APIEndpoint{
+									Host: "10.0.0.100",
+									Port: 6443,
+								},
+							},
+							Status: infrav1.ScalewayClusterStatus{
+								Network: infrav1.ScalewayClusterNetworkStatus{
+									PrivateNetworkID: privateNetworkID,
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:      "machine",
+					Hostname:  "machine",
+					ID:        serverID,
+					Zone:      scw.ZoneFrPar1,
+					State:     instance.ServerStateStopped,
+					Protected: true,
+					PublicIPs: []*instance.ServerIP{
+						{ID: ipv4ID, Address: net.IPv4(42, 42, 42, 42)},
+						{ID: ipv6ID, Address: net.IP{42, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 42}},
+					},
+					PrivateNics: []*instance.PrivateNIC{
+						{ID: privateNICID, PrivateNetworkID: privateNetworkID},
+					},
+					Volumes: map[string]*instance.VolumeServer{
+						"0": {
+							ID:         bootVolumeID,
+							Boot:       true,
+							VolumeType: instance.VolumeServerVolumeTypeLSSD,
+						},
+						"1": {
+							ID:         extraVolumeID,
+							VolumeType: instance.VolumeServerVolumeTypeLSSD,
+						},
+					},
+				}, nil)
+
+				// Node IP, the LB is externally managed
+				i.FindPrivateNICIPs(gomock.Any(), privateNICID).Return([]*ipam.IP{
+					{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 1), Mask: net.CIDRMask(24, 32)}}},
+				}, nil)
+
+				// Cleanup public IPs
+				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.IP{{ID: ipv4ID}, {ID: ipv6ID}}, nil)
+				i.DeleteIP(gomock.Any(), scw.ZoneFrPar1, ipv4ID)
+				i.DeleteIP(gomock.Any(), scw.ZoneFrPar1, ipv6ID)
+
+				// Volumes detach and remove
+				i.UpdateInstanceVolumeTags(gomock.Any(), scw.ZoneFrPar1, bootVolumeID, tags)
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{
+						ID:     bootVolumeID,
+						Status: block.VolumeStatusAvailable,
+					},
+				}, nil)
+				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, bootVolumeID)
+				i.DeleteVolume(gomock.Any(), scw.ZoneFrPar1, bootVolumeID)
+				i.FindInstanceVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.Volume{}, nil)
+
+				// Lift protection and delete server
+				i.UpdateServerProtection(gomock.Any(), scw.ZoneFrPar1, serverID, false)
+				i.DeleteServer(gomock.Any(), scw.ZoneFrPar1, serverID)
+			},
+		},
+		{
+			name: "protected server is not deleted without its Machine",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+							Labels:    map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:      "machine",
+					ID:        serverID,
+					Zone:      scw.ZoneFrPar1,
+					State:     instance.ServerStateRunning,
+					Protected: true,
+				}, nil)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				c := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineDeletingCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Reason).To(Equal(infrav1.ScalewayMachineDeletingServerProtectedReason))
+			},
+		},
+		{
+			name: "delete machine with flexible IP",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							PublicNetwork: infrav1.PublicNetwork{
+								IPv4: infrav1.FlexibleIPReference{ID: flexibleIPv4ID},
+							},
+							RootVolume: infrav1.RootVolume{
+								Type: "local",
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:  "machine",
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateStopped,
+					PublicIPs: []*instance.ServerIP{
+						{ID: flexibleIPv4ID, Address: net.IPv4(42, 42, 42, 42)},
+					},
+					Volumes: map[string]*instance.VolumeServer{
+						"0": {
+							ID:         bootVolumeID,
+							Boot:       true,
+							VolumeType: instance.VolumeServerVolumeTypeLSSD,
+						},
+					},
+				}, nil)
+
+				// No LB found (filtered).
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(nil, client.ErrNoItemFound)
+
+				// Flexible IP is detached but not deleted.
+				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.IP{}, nil)
+				i.UpdateServerPublicIPs(gomock.Any(), scw.ZoneFrPar1, serverID, []string{}).Return(&instance.Server{}, nil)
+
+				// Volumes detach and remove.
+				i.UpdateInstanceVolumeTags(gomock.Any(), scw.ZoneFrPar1, bootVolumeID, tags)
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{}, nil)
+				i.FindInstanceVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.Volume{
+					{
+						ID:    bootVolumeID,
+						State: instance.VolumeStateAvailable,
+					},
+				}, nil)
+				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, bootVolumeID)
+				i.DeleteInstanceVolume(gomock.Any(), scw.ZoneFrPar1, bootVolumeID)
+
+				// Delete server.
+				i.DeleteServer(gomock.Any(), scw.ZoneFrPar1, serverID)
+			},
+		},
+		{
+			name: "delete server created in a fallback zone without status",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							PublicNetwork: infrav1.PublicNetwork{
+								IPv4: infrav1.FlexibleIPReference{ID: flexibleIPv4ID},
+							},
+							RootVolume: infrav1.RootVolume{
+								Type: "local",
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				// Server is searched in all zones of the region.
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2, scw.ZoneFrPar3})
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(nil, client.ErrNoItemFound)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar2, tags).Return(&instance.Server{
+					Name:  "machine",
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar2,
+					State: instance.ServerStateStopped,
+					PublicIPs: []*instance.ServerIP{
+						{ID: flexibleIPv4ID, Address: net.IPv4(42, 42, 42, 42)},
+					},
+					Volumes: map[string]*instance.VolumeServer{
+						"0": {
+							ID:         bootVolumeID,
+							Boot:       true,
+							VolumeType: instance.VolumeServerVolumeTypeLSSD,
+						},
+					},
+				}, nil)
+
+				// No LB found (filtered).
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(nil, client.ErrNoItemFound)
+
+				// Flexible IP is detached but not deleted.
+				i.FindIPs(gomock.Any(), scw.ZoneFrPar2, tags).Return([]*instance.IP{}, nil)
+				i.UpdateServerPublicIPs(gomock.Any(), scw.ZoneFrPar2, serverID, []string{}).Return(&instance.Server{}, nil)
+
+				// Volumes detach and remove.
+				i.UpdateInstanceVolumeTags(gomock.Any(), scw.ZoneFrPar2, bootVolumeID, tags)
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar2, tags).Return([]*block.Volume{}, nil)
+				i.FindInstanceVolumes(gomock.Any(), scw.ZoneFrPar2, tags).Return([]*instance.Volume{
+					{
+						ID:    bootVolumeID,
+						State: instance.VolumeStateAvailable,
+					},
+				}, nil)
+				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar2, serverID, bootVolumeID)
+				i.DeleteInstanceVolume(gomock.Any(), scw.ZoneFrPar2, bootVolumeID)
+
+				// Delete server.
+				i.DeleteServer(gomock.Any(), scw.ZoneFrPar2, serverID)
+			},
+		},
+		{
+			name: "delete machine with additional block and local volumes",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							RootVolume: infrav1.RootVolume{
+								Type: "local",
+								Size: 42,
+							},
+							AdditionalVolumes: []infrav1.AdditionalVolume{
+								{Type: "block", Size: 20, IOPS: 5000},
+								{Type: "local", Size: 10},
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:  "machine",
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateStopped,
+					Volumes: map[string]*instance.VolumeServer{
+						"0": {
+							ID:         bootVolumeID,
+							Boot:       true,
+							VolumeType: instance.VolumeServerVolumeTypeLSSD,
+						},
+						"1": {
+							ID:         blockVolumeID,
+							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+						},
+						"2": {
+							ID:         localVolumeID,
+							VolumeType: instance.VolumeServerVolumeTypeLSSD,
+						},
+					},
+				}, nil)
+
+				// No LB found (filtered).
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(nil, client.ErrNoItemFound)
+
+				// No public IPs to clean up.
+				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.IP{}, nil)
+
+				// Volumes detach and remove.
+				i.UpdateInstanceVolumeTags(gomock.Any(), scw.ZoneFrPar1, bootVolumeID, tags)
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{
+						ID:     blockVolumeID,
+						Status: block.VolumeStatusAvailable,
+					},
+				}, nil)
+				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, blockVolumeID)
+				i.DeleteVolume(gomock.Any(), scw.ZoneFrPar1, blockVolumeID)
+				i.FindInstanceVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.Volume{
+					{
+						ID:    bootVolumeID,
+						State: instance.VolumeStateAvailable,
+					},
+					{
+						ID:    localVolumeID,
+						State: instance.VolumeStateAvailable,
+					},
+				}, nil)
+				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, bootVolumeID)
+				i.DeleteInstanceVolume(gomock.Any(), scw.ZoneFrPar1, bootVolumeID)
+				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, localVolumeID)
+				i.DeleteInstanceVolume(gomock.Any(), scw.ZoneFrPar1, localVolumeID)
+
+				// Delete server.
+				i.DeleteServer(gomock.Any(), scw.ZoneFrPar1, serverID)
+			},
+		},
+		{
+			name: "delete machine with retained and snapshotted block volumes",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							AdditionalVolumes: []infrav1.AdditionalVolume{
+								{Type: "block", Size: 20, DeletionPolicy: infrav1.VolumeDeletionPolicyRetain},
+								{Type: "block", Size: 20, DeletionPolicy: infrav1.VolumeDeletionPolicySnapshot},
+								{Type: "block", Size: 20, DeletionPolicy: infrav1.VolumeDeletionPolicySnapshot},
+							},
+							AdditionalTags: []string{"team=storage"},
+							ProviderID:     "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:  "machine",
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateStopped,
+					Volumes: map[string]*instance.VolumeServer{
+						"0": {
+							ID:         bootVolumeID,
+							Boot:       true,
+							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+						},
+						"1": {
+							ID:         extraVolumeID,
+							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+						},
+						"2": {
+							ID:         blockVolumeID,
+							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+						},
+						"3": {
+							ID:         dataVolumeID,
+							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+						},
+					},
+				}, nil)
+
+				// No LB found (filtered).
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(nil, client.ErrNoItemFound)
+
+				// No public IPs to clean up.
+				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.IP{}, nil)
+
+				// Volumes detach and remove.
+				i.UpdateVolumeTags(gomock.Any(), scw.ZoneFrPar1, bootVolumeID, tags)
+				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
+					{
+						ID:     bootVolumeID,
+						Status: block.VolumeStatusAvailable,
+						Zone:   scw.ZoneFrPar1,
+					},
+					{
+						ID:     extraVolumeID,
+						Name:   "machine-0",
+						Status: block.VolumeStatusAvailable,
+						Zone:   scw.ZoneFrPar1,
+						Tags:   append(slices.Clone(tags), "created-by=cluster-api-provider-scaleway", "team=storage"),
+					},
+					{
+						ID:     blockVolumeID,
+						Name:   "machine-1",
+						Status: block.VolumeStatusAvailable,
+						Zone:   scw.ZoneFrPar1,
+					},
+					{
+						ID:     dataVolumeID,
+						Name:   "machine-2",
+						Status: block.VolumeStatusAvailable,
+						Zone:   scw.ZoneFrPar1,
+					},
+				}, nil)
+				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, bootVolumeID)
+				i.DeleteVolume(gomock.Any(), scw.ZoneFrPar1, bootVolumeID)
+
+				// Retained volume is detached and its machine tags are replaced.
+				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, extraVolumeID)
+				i.UpdateVolumeTags(gomock.Any(), scw.ZoneFrPar1, extraVolumeID, []string{
+					"team=storage", "caps-retained=true",
+				})
+
+				// Snapshot of the volume is created without the machine tags, the volume will be deleted later.
+				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, blockVolumeID)
+				i.FindSnapshots(gomock.Any(), scw.ZoneFrPar1, []string{"caps-parent-volume=" + blockVolumeID}).Return([]*block.Snapshot{}, nil)
+				i.CreateSnapshot(gomock.Any(), scw.ZoneFrPar1, blockVolumeID, "machine-1", []string{
+					"team=storage", "caps-parent-volume=" + blockVolumeID,
+				})
+
+				// Snapshot of the volume is available, the volume is deleted.
+				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, dataVolumeID)
+				i.FindSnapshots(gomock.Any(), scw.ZoneFrPar1, []string{"caps-parent-volume=" + dataVolumeID}).Return([]*block.Snapshot{
+					{
+						ID:           snapshotID,
+						ParentVolume: &block.SnapshotParentVolume{ID: dataVolumeID},
+						Status:       block.SnapshotStatusAvailable,
+					},
+				}, nil)
+				i.DeleteVolume(gomock.Any(), scw.ZoneFrPar1, dataVolumeID)
+
+				i.FindInstanceVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.Volume{}, nil)
+			},
+		},
+		{
+			name: "graceful shutdown of running server",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							DeletionStrategy: infrav1.DeletionStrategy{
+								GracefulShutdownTimeoutSeconds: 60,
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
 
-				// LB config
-				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
-				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(&lb.LB{
-					ID:   lbID,
-					Zone: scw.ZoneFrPar1,
-				}, nil)
-				i.FindLBs(gomock.Any(), append(clusterTags, servicelb.CAPSExtraLBTag)).Return(nil, nil)
-				i.ListFrontends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Frontend{{
-					ID:   frontendID,
-					Name: servicelb.APIServerPortName,
-				}}, nil)
-				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendID, "machine").Return(&lb.ACL{
-					ID:   lbACLID,
-					Name: "machine",
-				}, nil)
-				i.DeleteLBACL(gomock.Any(), scw.ZoneFrPar1, lbACLID)
-				i.FindPrivateNICIPs(gomock.Any(), privateNICID).Return([]*ipam.IP{
-					{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 1), Mask: net.CIDRMask(24, 32)}}},
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:  "machine",
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateRunning,
+					Volumes: map[string]*instance.VolumeServer{
+						"0": {
+							ID:         bootVolumeID,
+							Boot:       true,
+							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+						},
+					},
 				}, nil)
-				i.ListBackends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Backend{{
-					ID:   backendID,
-					Pool: []string{"10.0.0.1"},
-				}}, nil)
-				i.RemoveBackendServer(gomock.Any(), scw.ZoneFrPar1, backendID, "10.0.0.1")
 
-				// Cleanup public IPs
-				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.IP{{ID: ipv4ID}, {ID: ipv6ID}}, nil)
-				i.DeleteIP(gomock.Any(), scw.ZoneFrPar1, ipv4ID)
-				i.DeleteIP(gomock.Any(), scw.ZoneFrPar1, ipv6ID)
+				// No LB found (filtered).
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(nil, client.ErrNoItemFound)
 
-				// Volumes detach and remove
-				i.UpdateInstanceVolumeTags(gomock.Any(), scw.ZoneFrPar1, bootVolumeID, tags)
-				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
-					{
-						ID:     bootVolumeID,
-						Status: block.VolumeStatusAvailable,
+				// No public IPs to clean up.
+				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.IP{}, nil)
+
+				// Operating system is asked to shut down.
+				i.ServerAction(gomock.Any(), scw.ZoneFrPar1, serverID, instance.ServerActionStopInPlace)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				g.Expect(m.ScalewayMachine.Status.Deletion.GracefulShutdownStartTime.IsZero()).To(BeFalse())
+				c := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineDeletingCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Reason).To(Equal(infrav1.ScalewayMachineDeletingShuttingDownReason))
+			},
+		},
+		{
+			name: "graceful shutdown timed out, force poweroff",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
+					},
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							DeletionStrategy: infrav1.DeletionStrategy{
+								GracefulShutdownTimeoutSeconds: 60,
+							},
+							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+						Status: infrav1.ScalewayMachineStatus{
+							Deletion: infrav1.ScalewayMachineDeletionStatus{
+								GracefulShutdownStartTime: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+							},
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:  "machine",
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateRunning,
+					Volumes: map[string]*instance.VolumeServer{
+						"0": {
+							ID:         bootVolumeID,
+							Boot:       true,
+							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+						},
 					},
 				}, nil)
-				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, bootVolumeID)
-				i.DeleteVolume(gomock.Any(), scw.ZoneFrPar1, bootVolumeID)
-				i.FindInstanceVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.Volume{}, nil)
 
-				// Delete server
-				i.DeleteServer(gomock.Any(), scw.ZoneFrPar1, serverID)
+				// No LB found (filtered).
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(nil, client.ErrNoItemFound)
+
+				// No public IPs to clean up.
+				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.IP{}, nil)
+
+				// Server did not stop in place in time, it is powered off.
+				i.ServerAction(gomock.Any(), scw.ZoneFrPar1, serverID, instance.ServerActionPoweroff)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				c := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineDeletingCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Reason).To(Equal(infrav1.ScalewayMachineDeletingForcingPoweroffReason))
 			},
 		},
 		{
-			name: "delete machine with additional block and local volumes",
+			name: "snapshot root volume before deletion",
 			fields: fields{
 				Machine: &scope.Machine{
 					Machine: &clusterv1.Machine{
@@ -729,13 +3781,8 @@ func TestService_Delete(t *testing.T) {
 									ID: imageID,
 								},
 							},
-							RootVolume: infrav1.RootVolume{
-								Type: "local",
-								Size: 42,
-							},
-							AdditionalVolumes: []infrav1.AdditionalVolume{
-								{Type: "block", Size: 20, IOPS: 5000},
-								{Type: "local", Size: 10},
+							DeletionStrategy: infrav1.DeletionStrategy{
+								SnapshotRootVolume: ptr.To(true),
 							},
 							ProviderID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
 						},
@@ -753,6 +3800,7 @@ func TestService_Delete(t *testing.T) {
 			args: args{
 				ctx: context.TODO(),
 			},
+			wantErr: true,
 			expect: func(i *mock_client.MockInterfaceMockRecorder) {
 				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
 				tags := append(clusterTags, "caps-scalewaymachine=machine")
@@ -767,16 +3815,8 @@ func TestService_Delete(t *testing.T) {
 						"0": {
 							ID:         bootVolumeID,
 							Boot:       true,
-							VolumeType: instance.VolumeServerVolumeTypeLSSD,
-						},
-						"1": {
-							ID:         blockVolumeID,
 							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
 						},
-						"2": {
-							ID:         localVolumeID,
-							VolumeType: instance.VolumeServerVolumeTypeLSSD,
-						},
 					},
 				}, nil)
 
@@ -787,33 +3827,144 @@ func TestService_Delete(t *testing.T) {
 				// No public IPs to clean up.
 				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.IP{}, nil)
 
-				// Volumes detach and remove.
-				i.UpdateInstanceVolumeTags(gomock.Any(), scw.ZoneFrPar1, bootVolumeID, tags)
-				i.FindVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*block.Volume{
-					{
-						ID:     blockVolumeID,
-						Status: block.VolumeStatusAvailable,
+				// Snapshot of the root volume is created.
+				i.FindSnapshots(gomock.Any(), scw.ZoneFrPar1, []string{"caps-parent-volume=" + bootVolumeID}).Return([]*block.Snapshot{}, nil)
+				i.CreateSnapshot(gomock.Any(), scw.ZoneFrPar1, bootVolumeID, "machine-root", []string{"caps-parent-volume=" + bootVolumeID})
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				c := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineDeletingCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Reason).To(Equal(infrav1.ScalewayMachineDeletingSnapshottingRootVolumeReason))
+			},
+		},
+		{
+			name: "archive server after root volume snapshot",
+			fields: fields{
+				Machine: &scope.Machine{
+					Machine: &clusterv1.Machine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+							Labels:    map[string]string{clusterv1.MachineDeploymentNameLabel: "workers"},
+						},
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: "fr-par-1",
+						},
 					},
-				}, nil)
-				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, blockVolumeID)
-				i.DeleteVolume(gomock.Any(), scw.ZoneFrPar1, blockVolumeID)
-				i.FindInstanceVolumes(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.Volume{
-					{
-						ID:    bootVolumeID,
-						State: instance.VolumeStateAvailable,
+					ScalewayMachine: &infrav1.ScalewayMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "machine",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayMachineSpec{
+							CommercialType: "DEV1-S",
+							Image: infrav1.Image{
+								IDOrName: infrav1.IDOrName{
+									ID: imageID,
+								},
+							},
+							DeletionStrategy: infrav1.DeletionStrategy{
+								Policy:             infrav1.DeletionPolicyArchive,
+								SnapshotRootVolume: ptr.To(true),
+							},
+							PrivateNetwork: infrav1.MachinePrivateNetwork{
+								Address: "10.0.0.10",
+							},
+							ManagedPlacementGroup: infrav1.ManagedPlacementGroup{
+								PolicyType: "max_availability",
+							},
+							AdditionalTags: []string{"env=prod"},
+							ProviderID:     "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+						},
+					},
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "cluster",
+								Namespace: "default",
+							},
+							Spec: infrav1.ScalewayClusterSpec{
+								Network: infrav1.ScalewayClusterNetwork{
+									PrivateNetwork: infrav1.PrivateNetworkSpec{
+										Enabled: ptr.To(true),
+									},
+								},
+							},
+							Status: infrav1.ScalewayClusterStatus{
+								Network: infrav1.ScalewayClusterNetworkStatus{
+									PrivateNetworkID: privateNetworkID,
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				clusterTags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+				tags := append(clusterTags, "caps-scalewaymachine=machine")
+
+				i.GetZoneOrDefault("fr-par-1").Return(scw.ZoneFrPar1, nil)
+				i.FindServer(gomock.Any(), scw.ZoneFrPar1, tags).Return(&instance.Server{
+					Name:  "machine",
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateStopped,
+					Volumes: map[string]*instance.VolumeServer{
+						"0": {
+							ID:         bootVolumeID,
+							Boot:       true,
+							VolumeType: instance.VolumeServerVolumeTypeSbsVolume,
+						},
+					},
+					PrivateNics: []*instance.PrivateNIC{
+						{ID: privateNICID, PrivateNetworkID: privateNetworkID},
 					},
+					SecurityGroup:  &instance.SecurityGroupSummary{ID: securityGroupID},
+					PlacementGroup: &instance.PlacementGroup{ID: placementGroupID},
+				}, nil)
+
+				// No LB found (filtered).
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(nil, client.ErrNoItemFound)
+
+				// No public IPs to clean up.
+				i.FindIPs(gomock.Any(), scw.ZoneFrPar1, tags).Return([]*instance.IP{}, nil)
+
+				// Snapshot of the root volume is available.
+				i.FindSnapshots(gomock.Any(), scw.ZoneFrPar1, []string{"caps-parent-volume=" + bootVolumeID}).Return([]*block.Snapshot{
 					{
-						ID:    localVolumeID,
-						State: instance.VolumeStateAvailable,
+						ID:           snapshotID,
+						ParentVolume: &block.SnapshotParentVolume{ID: bootVolumeID},
+						Status:       block.SnapshotStatusAvailable,
 					},
 				}, nil)
-				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, bootVolumeID)
-				i.DeleteInstanceVolume(gomock.Any(), scw.ZoneFrPar1, bootVolumeID)
-				i.DetachServerVolume(gomock.Any(), scw.ZoneFrPar1, serverID, localVolumeID)
-				i.DeleteInstanceVolume(gomock.Any(), scw.ZoneFrPar1, localVolumeID)
 
-				// Delete server.
-				i.DeleteServer(gomock.Any(), scw.ZoneFrPar1, serverID)
+				// Server and volumes are kept, the server is detached from the resources of the cluster.
+				i.DeletePrivateNIC(gomock.Any(), scw.ZoneFrPar1, serverID, privateNICID)
+				i.FindPrivateNetworkIPs(gomock.Any(), privateNetworkID, tags).Return([]*ipam.IP{{ID: ipamIPID}}, nil)
+				i.ReleaseIP(gomock.Any(), ipamIPID)
+				i.FindDefaultSecurityGroup(gomock.Any(), scw.ZoneFrPar1).Return(&instance.SecurityGroup{
+					ID:             "22222222-2222-2222-2222-222222222222",
+					ProjectDefault: true,
+				}, nil)
+				i.UpdateServerSecurityGroup(gomock.Any(), scw.ZoneFrPar1, serverID, "22222222-2222-2222-2222-222222222222")
+				i.RemoveServerPlacementGroup(gomock.Any(), scw.ZoneFrPar1, serverID)
+				i.UpdateServerTags(gomock.Any(), scw.ZoneFrPar1, serverID, []string{"env=prod", "caps-archived=true"})
+
+				// The managed placement group is empty once the server is detached.
+				i.FindPlacementGroups(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, "caps-placementgroup=md-workers")).Return([]*instance.PlacementGroup{
+					{ID: placementGroupID},
+				}, nil)
+				i.ListPlacementGroupServers(gomock.Any(), scw.ZoneFrPar1, placementGroupID).Return(nil, nil)
+				i.DeletePlacementGroup(gomock.Any(), scw.ZoneFrPar1, placementGroupID).Return(nil)
+			},
+			asserts: func(g *WithT, m *scope.Machine) {
+				c := conditions.Get(m.ScalewayMachine, infrav1.ScalewayMachineDeletingCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Reason).To(Equal(infrav1.ScalewayMachineDeletingArchivedReason))
 			},
 		},
 	}
@@ -835,6 +3986,10 @@ func TestService_Delete(t *testing.T) {
 			if err := s.Delete(tt.args.ctx); (err != nil) != tt.wantErr {
 				t.Errorf("Service.Delete() error = %v, wantErr %v", err, tt.wantErr)
 			}
+
+			if tt.asserts != nil {
+				tt.asserts(NewWithT(t), tt.fields.Machine)
+			}
 		})
 	}
 }
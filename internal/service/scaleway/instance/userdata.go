@@ -0,0 +1,192 @@
+package instance
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/common"
+)
+
+const (
+	// maxUserDataSize is the maximum size of the content of a user data key.
+	maxUserDataSize = 1 << 20
+	// userDataPartKeyPrefix is the prefix of the user data keys that contain
+	// a part of the split bootstrap data.
+	userDataPartKeyPrefix = "cloud-init-part-"
+	// mimeBoundary is the boundary of the MIME multi-part user data. It cannot
+	// appear in base64 encoded content or in the loader script.
+	mimeBoundary = "caps-bootstrap-data"
+	// base64LineLength is the maximum length of base64 lines in MIME parts.
+	base64LineLength = 76
+)
+
+// errBootstrapDataTooLarge is returned when the bootstrap data does not fit in the user data.
+var errBootstrapDataTooLarge = errors.New("bootstrap data is too large")
+
+// userDataEntry is the content of a user data key.
+type userDataEntry struct {
+	key     string
+	content string
+}
+
+// loaderScript is a cloud-init boothook that downloads the bootstrap data parts
+// from the metadata API (which requires a privileged source port), then writes
+// the rendered bootstrap data as cloud-init configuration. The bootstrap data
+// contains secrets: the configuration is only readable by root and it is removed
+// once cloud-init is done. The first placeholder is the list of user data keys,
+// the second one is the command that decodes the parts.
+const loaderScript = `#!/bin/sh
+# Loads the bootstrap data that is split across multiple user data keys.
+set -eu
+umask 077
+target=/etc/cloud/cloud.cfg.d/99-caps-bootstrap-data.cfg
+marker=/var/lib/cloud/instance/caps-bootstrap-data-loaded
+[ -e "$marker" ] && exit 0
+tmp=$(mktemp -d)
+trap 'rm -rf "$tmp"' EXIT
+for key in %s; do
+  curl -sSf --retry 10 --local-port 1-1023 "http://169.254.42.42/user_data/$key" >> "$tmp/encoded"
+done
+{ %s; } < "$tmp/encoded" > "$tmp/user-data"
+if head -n 1 "$tmp/user-data" | grep -q '^## template: *jinja'; then
+  cloud-init devel render "$tmp/user-data" > "$tmp/rendered"
+else
+  cp "$tmp/user-data" "$tmp/rendered"
+fi
+mv "$tmp/rendered" "$target"
+touch "$marker"
+systemd-run --no-block sh -c "cloud-init status --wait >/dev/null 2>&1; rm -f $target" || true
+`
+
+const (
+	// decodeGzipBase64 decodes the parts of compressed bootstrap data.
+	decodeGzipBase64 = "base64 -d | gunzip"
+	// decodePlain decodes the parts of plain bootstrap data.
+	decodePlain = "cat"
+)
+
+// encodeUserData returns the user data entries that must be set on the server
+// for the provided bootstrap data. The cloud-init key is always the last entry.
+func encodeUserData(bootstrapData, format string, spec infrav1.BootstrapData) ([]userDataEntry, error) {
+	// Ignition bootstrap data is always stored as is.
+	if format == common.BootstrapFormatIgnition {
+		if len(bootstrapData) > maxUserDataSize {
+			return nil, fmt.Errorf("%w: ignition bootstrap data is %d bytes, maximum is %d bytes", errBootstrapDataTooLarge, len(bootstrapData), maxUserDataSize)
+		}
+
+		return []userDataEntry{{key: cloudInitUserDataKey, content: bootstrapData}}, nil
+	}
+
+	plain := spec.Encoding == infrav1.BootstrapDataEncodingPlain
+	content := bootstrapData
+
+	if spec.Encoding == infrav1.BootstrapDataEncodingGzip || (!plain && len(content) > maxUserDataSize) {
+		compressed, err := gzipBase64(bootstrapData)
+		if err != nil {
+			return nil, err
+		}
+
+		content = mimeMultipart("application/x-gzip", "base64", compressed)
+	}
+
+	if len(content) <= maxUserDataSize {
+		return []userDataEntry{{key: cloudInitUserDataKey, content: content}}, nil
+	}
+
+	if !ptr.Deref(spec.Split, false) {
+		return nil, fmt.Errorf("%w: encoded bootstrap data is %d bytes, maximum is %d bytes", errBootstrapDataTooLarge, len(content), maxUserDataSize)
+	}
+
+	encoded, decode := bootstrapData, decodePlain
+	if !plain {
+		compressed, err := gzipBase64(bootstrapData)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded, decode = compressed, decodeGzipBase64
+	}
+
+	var (
+		entries []userDataEntry
+		keys    []string
+	)
+
+	for i := 0; len(encoded) > 0; i++ {
+		size := min(len(encoded), maxUserDataSize)
+		key := fmt.Sprintf("%s%d", userDataPartKeyPrefix, i)
+
+		entries = append(entries, userDataEntry{key: key, content: encoded[:size]})
+		keys = append(keys, key)
+		encoded = encoded[size:]
+	}
+
+	entries = append(entries, userDataEntry{
+		key:     cloudInitUserDataKey,
+		content: mimeMultipart("text/cloud-boothook", "", fmt.Sprintf(loaderScript, strings.Join(keys, " "), decode)),
+	})
+
+	return entries, nil
+}
+
+// isUserDataKey returns true if the key contains bootstrap data.
+func isUserDataKey(key string) bool {
+	return key == cloudInitUserDataKey || strings.HasPrefix(key, userDataPartKeyPrefix)
+}
+
+// gzipBase64 compresses the data with gzip and encodes it in base64.
+func gzipBase64(data string) (string, error) {
+	buf := &bytes.Buffer{}
+
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write([]byte(data)); err != nil {
+		return "", fmt.Errorf("failed to compress bootstrap data: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("failed to compress bootstrap data: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// mimeMultipart returns a MIME multi-part document that contains a single part.
+func mimeMultipart(contentType, transferEncoding, content string) string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "Content-Type: multipart/mixed; boundary=%q\r\nMIME-Version: 1.0\r\n\r\n", mimeBoundary)
+
+	// Errors are ignored as writing to a bytes.Buffer never fails.
+	w := multipart.NewWriter(buf)
+	_ = w.SetBoundary(mimeBoundary)
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType)
+	header.Set("MIME-Version", "1.0")
+
+	if transferEncoding != "" {
+		header.Set("Content-Transfer-Encoding", transferEncoding)
+	}
+
+	part, _ := w.CreatePart(header)
+
+	if transferEncoding == "base64" {
+		for len(content) > base64LineLength {
+			_, _ = fmt.Fprintf(part, "%s\r\n", content[:base64LineLength])
+			content = content[base64LineLength:]
+		}
+	}
+
+	_, _ = part.Write([]byte(content))
+	_ = w.Close()
+
+	return buf.String()
+}
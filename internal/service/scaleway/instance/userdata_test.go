@@ -0,0 +1,216 @@
+package instance
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/common"
+)
+
+// randomData returns data of the provided size that does not compress well.
+func randomData(size int) string {
+	r := rand.New(rand.NewPCG(1, 2))
+	buf := make([]byte, size*3/4)
+	for i := range buf {
+		buf[i] = byte(r.UintN(256))
+	}
+
+	return base64.StdEncoding.EncodeToString(buf)
+}
+
+// decodeMIMEPart returns the content type and decoded content of the single part of a MIME document.
+func decodeMIMEPart(g *WithT, content string) (string, string) {
+	header, body, ok := strings.Cut(content, "\r\n\r\n")
+	g.Expect(ok).To(BeTrue())
+
+	_, params, err := mime.ParseMediaType(strings.TrimPrefix(strings.Split(header, "\r\n")[0], "Content-Type: "))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	part, err := multipart.NewReader(strings.NewReader(body), params["boundary"]).NextPart()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	data, err := io.ReadAll(part)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	if part.Header.Get("Content-Transfer-Encoding") == "base64" {
+		data, err = base64.StdEncoding.DecodeString(strings.ReplaceAll(string(data), "\r\n", ""))
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+
+	return part.Header.Get("Content-Type"), string(data)
+}
+
+func gunzip(g *WithT, data []byte) string {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	out, err := io.ReadAll(r)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return string(out)
+}
+
+func Test_encodeUserData(t *testing.T) {
+	t.Parallel()
+
+	largeData := randomData(3 * maxUserDataSize)
+
+	type args struct {
+		bootstrapData string
+		format        string
+		spec          infrav1.BootstrapData
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+		asserts func(g *WithT, entries []userDataEntry)
+	}{
+		{
+			name: "small bootstrap data is stored as is",
+			args: args{
+				bootstrapData: cloudInitData,
+			},
+			asserts: func(g *WithT, entries []userDataEntry) {
+				g.Expect(entries).To(Equal([]userDataEntry{{key: cloudInitUserDataKey, content: cloudInitData}}))
+			},
+		},
+		{
+			name: "gzip encoding",
+			args: args{
+				bootstrapData: cloudInitData,
+				spec:          infrav1.BootstrapData{Encoding: infrav1.BootstrapDataEncodingGzip},
+			},
+			asserts: func(g *WithT, entries []userDataEntry) {
+				g.Expect(entries).To(HaveLen(1))
+				g.Expect(entries[0].key).To(Equal(cloudInitUserDataKey))
+
+				contentType, data := decodeMIMEPart(g, entries[0].content)
+				g.Expect(contentType).To(Equal("application/x-gzip"))
+				g.Expect(gunzip(g, []byte(data))).To(Equal(cloudInitData))
+			},
+		},
+		{
+			name: "large compressible bootstrap data is compressed",
+			args: args{
+				bootstrapData: strings.Repeat("a", 2*maxUserDataSize),
+			},
+			asserts: func(g *WithT, entries []userDataEntry) {
+				g.Expect(entries).To(HaveLen(1))
+
+				contentType, data := decodeMIMEPart(g, entries[0].content)
+				g.Expect(contentType).To(Equal("application/x-gzip"))
+				g.Expect(gunzip(g, []byte(data))).To(Equal(strings.Repeat("a", 2*maxUserDataSize)))
+			},
+		},
+		{
+			name: "plain encoding with large bootstrap data",
+			args: args{
+				bootstrapData: strings.Repeat("a", 2*maxUserDataSize),
+				spec:          infrav1.BootstrapData{Encoding: infrav1.BootstrapDataEncodingPlain},
+			},
+			wantErr: errBootstrapDataTooLarge,
+		},
+		{
+			name: "bootstrap data is too large",
+			args: args{
+				bootstrapData: largeData,
+			},
+			wantErr: errBootstrapDataTooLarge,
+		},
+		{
+			name: "ignition bootstrap data is too large",
+			args: args{
+				bootstrapData: largeData,
+				format:        common.BootstrapFormatIgnition,
+				spec:          infrav1.BootstrapData{Split: ptr.To(true)},
+			},
+			wantErr: errBootstrapDataTooLarge,
+		},
+		{
+			name: "split bootstrap data",
+			args: args{
+				bootstrapData: largeData,
+				spec:          infrav1.BootstrapData{Split: ptr.To(true)},
+			},
+			asserts: func(g *WithT, entries []userDataEntry) {
+				g.Expect(len(entries)).To(BeNumerically(">", 2))
+
+				encoded := &strings.Builder{}
+				for _, entry := range entries[:len(entries)-1] {
+					g.Expect(entry.key).To(HavePrefix(userDataPartKeyPrefix))
+					g.Expect(len(entry.content)).To(BeNumerically("<=", maxUserDataSize))
+					encoded.WriteString(entry.content)
+				}
+
+				data, err := base64.StdEncoding.DecodeString(encoded.String())
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(gunzip(g, data)).To(Equal(largeData))
+
+				loader := entries[len(entries)-1]
+				g.Expect(loader.key).To(Equal(cloudInitUserDataKey))
+
+				contentType, script := decodeMIMEPart(g, loader.content)
+				g.Expect(contentType).To(Equal("text/cloud-boothook"))
+				g.Expect(script).To(ContainSubstring("for key in cloud-init-part-0 cloud-init-part-1"))
+				g.Expect(script).To(ContainSubstring("{ base64 -d | gunzip; }"))
+				g.Expect(script).To(ContainSubstring("umask 077"))
+				g.Expect(script).To(ContainSubstring(`rm -f $target`))
+			},
+		},
+		{
+			name: "split plain bootstrap data",
+			args: args{
+				bootstrapData: strings.Repeat("a", 2*maxUserDataSize+1),
+				spec: infrav1.BootstrapData{
+					Encoding: infrav1.BootstrapDataEncodingPlain,
+					Split:    ptr.To(true),
+				},
+			},
+			asserts: func(g *WithT, entries []userDataEntry) {
+				g.Expect(entries).To(HaveLen(4))
+
+				data := &strings.Builder{}
+				for _, entry := range entries[:len(entries)-1] {
+					g.Expect(entry.key).To(HavePrefix(userDataPartKeyPrefix))
+					g.Expect(len(entry.content)).To(BeNumerically("<=", maxUserDataSize))
+					data.WriteString(entry.content)
+				}
+
+				g.Expect(data.String()).To(Equal(strings.Repeat("a", 2*maxUserDataSize+1)))
+
+				contentType, script := decodeMIMEPart(g, entries[len(entries)-1].content)
+				g.Expect(contentType).To(Equal("text/cloud-boothook"))
+				g.Expect(script).To(ContainSubstring("for key in cloud-init-part-0 cloud-init-part-1 cloud-init-part-2"))
+				g.Expect(script).To(ContainSubstring("{ cat; }"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			entries, err := encodeUserData(tt.args.bootstrapData, tt.args.format, tt.args.spec)
+			if tt.wantErr != nil {
+				g.Expect(errors.Is(err, tt.wantErr)).To(BeTrue())
+				return
+			}
+
+			g.Expect(err).NotTo(HaveOccurred())
+			tt.asserts(g, entries)
+		})
+	}
+}
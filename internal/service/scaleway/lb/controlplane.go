@@ -0,0 +1,172 @@
+package lb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	lbutil "github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/lb/util"
+)
+
+// MachineACLIndex is the index of the ACL that allows the public IPs of a machine.
+const MachineACLIndex = int32(1)
+
+// FindControlPlaneLBs returns the extra and main control-plane loadbalancers of the cluster.
+// No loadbalancer is returned when the control-plane loadbalancer is externally managed.
+func FindControlPlaneLBs(ctx context.Context, clusterScope *scope.Cluster) ([]*lb.LB, error) {
+	if clusterScope.ControlPlaneLoadBalancerExternallyManaged() {
+		return nil, nil
+	}
+
+	zone, err := clusterScope.ScalewayClient.GetZoneOrDefault(string(clusterScope.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.LoadBalancer.Zone))
+	if err != nil {
+		return nil, err
+	}
+
+	mainLB, err := clusterScope.ScalewayClient.FindLB(ctx, zone, clusterScope.ResourceTags(CAPSMainLBTag))
+	if err != nil {
+		return nil, err
+	}
+
+	extraLBs, err := clusterScope.ScalewayClient.FindLBs(ctx, clusterScope.ResourceTags(CAPSExtraLBTag))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(extraLBs, mainLB), nil
+}
+
+// EnsureControlPlaneLBsBackendServer adds the nodeIP to all backends of the
+// control-plane loadbalancers. When deletion is true, the nodeIP is removed instead.
+func EnsureControlPlaneLBsBackendServer(ctx context.Context, clusterScope *scope.Cluster, lbs []*lb.LB, nodeIP string, deletion bool) error {
+	for _, loadbalancer := range lbs {
+		if loadbalancer.Status == lb.LBStatusDeleting {
+			continue
+		}
+
+		backends, err := clusterScope.ScalewayClient.ListBackends(ctx, loadbalancer.Zone, loadbalancer.ID)
+		if err != nil {
+			return err
+		}
+
+		// Make sure we have the expected number of backends: 1 per additional port + 1 for the API server port.
+		if len(backends) != len(clusterScope.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.AdditionalPorts)+1 {
+			return fmt.Errorf("unexpected number of backends found on loadbalancer %s: expected %d, got %d",
+				loadbalancer.ID,
+				len(clusterScope.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.AdditionalPorts)+1,
+				len(backends),
+			)
+		}
+
+		// Sort backends by name.
+		slices.SortFunc(backends, func(a, b *lb.Backend) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+
+		for _, backend := range backends {
+			switch {
+			case deletion && slices.Contains(backend.Pool, nodeIP):
+				if err := clusterScope.ScalewayClient.RemoveBackendServer(ctx, loadbalancer.Zone, backend.ID, nodeIP); err != nil {
+					return err
+				}
+			case !deletion && !slices.Contains(backend.Pool, nodeIP):
+				if err := clusterScope.ScalewayClient.AddBackendServer(ctx, loadbalancer.Zone, backend.ID, nodeIP); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// EnsureControlPlaneLBsACL ensures an ACL with the provided name allows the publicIPs
+// of a machine on all frontends of the control-plane loadbalancers. If no publicIP
+// is provided, the ACL is removed.
+func EnsureControlPlaneLBsACL(ctx context.Context, clusterScope *scope.Cluster, lbs []*lb.LB, name string, publicIPs []string, delete bool) error {
+	for _, loadbalancer := range lbs {
+		if loadbalancer.Status == lb.LBStatusDeleting {
+			continue
+		}
+
+		frontends, err := clusterScope.ScalewayClient.ListFrontends(ctx, loadbalancer.Zone, loadbalancer.ID)
+		if err != nil {
+			// If the LB is not found, we can skip it when reconciling a deletion.
+			if delete && client.IsNotFoundError(err) {
+				continue
+			}
+
+			return err
+		}
+
+		// Make sure we have the expected number of frontends: 1 per additional port + 1 for the API server port.
+		if len(frontends) != len(clusterScope.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.AdditionalPorts)+1 {
+			return fmt.Errorf("unexpected number of frontends found on loadbalancer %s: expected %d, got %d",
+				loadbalancer.ID,
+				len(clusterScope.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.AdditionalPorts)+1,
+				len(frontends),
+			)
+		}
+
+		// Sort frontends by name.
+		slices.SortFunc(frontends, func(a, b *lb.Frontend) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+
+		for _, frontend := range frontends {
+			acl, err := clusterScope.ScalewayClient.FindLBACLByName(ctx, loadbalancer.Zone, frontend.ID, name)
+			if err := utilerrors.FilterOut(err, client.IsNotFoundError); err != nil {
+				return err
+			}
+
+			// If no publicIP is set, we either delete the existing ACL or do nothing.
+			if len(publicIPs) == 0 {
+				if acl != nil {
+					if err := clusterScope.ScalewayClient.DeleteLBACL(ctx, loadbalancer.Zone, acl.ID); err != nil {
+						return err
+					}
+				}
+
+				continue
+			}
+
+			if acl == nil {
+				if err := clusterScope.ScalewayClient.CreateLBACL(ctx,
+					loadbalancer.Zone,
+					frontend.ID,
+					name,
+					MachineACLIndex,
+					lb.ACLActionTypeAllow,
+					publicIPs,
+				); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if acl.Match == nil || !lbutil.IPsEqual(acl.Match.IPSubnet, scw.StringSlicePtr(publicIPs)) {
+				if err := clusterScope.ScalewayClient.UpdateLBACL(
+					ctx,
+					loadbalancer.Zone,
+					acl.ID,
+					name,
+					MachineACLIndex,
+					lb.ACLActionTypeAllow,
+					publicIPs,
+				); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
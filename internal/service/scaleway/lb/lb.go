@@ -1,10 +1,12 @@
 package lb
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"math"
+	"net/http"
 	"net/netip"
 	"slices"
 	"strconv"
@@ -16,6 +18,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -34,7 +37,7 @@ const (
 	capsManagedIPTag = "caps-lb-ip=managed"
 
 	// Backend port, must match port of apiservers.
-	backendControlPlanePort = int32(6443)
+	BackendControlPlanePort = int32(6443)
 
 	APIServerPortName = "kube-apiserver"
 
@@ -46,6 +49,10 @@ const (
 	allowedRangesACLName = "allowed-ranges"
 	publicGatewayACLName = "public-gateway"
 	denyAllACLName       = "deny-all"
+
+	// defaultHealthCheckMaxRetries is the default number of consecutive unsuccessful
+	// health checks after which a backend server is considered dead.
+	defaultHealthCheckMaxRetries = int32(5)
 )
 
 type Service struct {
@@ -61,6 +68,18 @@ func (s *Service) Name() string {
 }
 
 func (s *Service) Reconcile(ctx context.Context) (retErr error) {
+	// The control plane endpoint is served by a load balancer that is not
+	// managed by the provider, there is nothing to reconcile.
+	if s.ControlPlaneLoadBalancerExternallyManaged() {
+		conditions.Set(s.ScalewayCluster, metav1.Condition{
+			Type:   infrav1.ScalewayClusterLoadBalancersReadyCondition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.ScalewayClusterLoadBalancersExternallyManagedReason,
+		})
+
+		return nil
+	}
+
 	condition := metav1.Condition{
 		Type:   infrav1.ScalewayClusterLoadBalancersReadyCondition,
 		Reason: infrav1.ScalewayClusterLoadBalancersInternalErrorReason,
@@ -148,6 +167,10 @@ func (s *Service) Reconcile(ctx context.Context) (retErr error) {
 }
 
 func (s *Service) Delete(ctx context.Context) error {
+	if s.ControlPlaneLoadBalancerExternallyManaged() {
+		return nil
+	}
+
 	if err := s.ensureDeleteMainLB(ctx); err != nil {
 		return err
 	}
@@ -521,6 +544,26 @@ func (s *Service) ensurePrivateNetwork(ctx context.Context, lbs []*lbWithPrivate
 	return nil
 }
 
+// proxyProtocols maps the PROXY protocol versions of the API to the ones of the load balancer.
+var proxyProtocols = map[infrav1.LoadBalancerProxyProtocol]lb.ProxyProtocol{
+	infrav1.LoadBalancerProxyProtocolNone: lb.ProxyProtocolProxyProtocolNone,
+	infrav1.LoadBalancerProxyProtocolV1:   lb.ProxyProtocolProxyProtocolV1,
+	infrav1.LoadBalancerProxyProtocolV2:   lb.ProxyProtocolProxyProtocolV2,
+}
+
+// forwardAlgorithms maps the forward algorithms of the API to the ones of the load balancer.
+var forwardAlgorithms = map[infrav1.LoadBalancerForwardAlgorithm]lb.ForwardPortAlgorithm{
+	infrav1.LoadBalancerForwardAlgorithmRoundRobin: lb.ForwardPortAlgorithmRoundrobin,
+	infrav1.LoadBalancerForwardAlgorithmLeastConn:  lb.ForwardPortAlgorithmLeastconn,
+	infrav1.LoadBalancerForwardAlgorithmFirst:      lb.ForwardPortAlgorithmFirst,
+}
+
+// onMarkedDownActions maps the on marked down actions of the API to the ones of the load balancer.
+var onMarkedDownActions = map[infrav1.LoadBalancerOnMarkedDownAction]lb.OnMarkedDownAction{
+	infrav1.LoadBalancerOnMarkedDownActionNone:             lb.OnMarkedDownActionOnMarkedDownActionNone,
+	infrav1.LoadBalancerOnMarkedDownActionShutdownSessions: lb.OnMarkedDownActionShutdownSessions,
+}
+
 type lbPort struct {
 	*infrav1.LoadBalancerPort
 
@@ -541,8 +584,10 @@ func (s *Service) reconcilePorts(ctx context.Context, mainLB *lbWithPrivateIP, e
 		lbPorts[APIServerPortName] = &lbPort{
 			Name: APIServerPortName,
 			LoadBalancerPort: &infrav1.LoadBalancerPort{
-				Port:       s.ControlPlaneLoadBalancerPort(),
-				TargetPort: backendControlPlanePort,
+				Port:        s.ControlPlaneLoadBalancerPort(),
+				TargetPort:  BackendControlPlanePort,
+				HealthCheck: s.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.HealthCheck,
+				Backend:     s.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.Backend,
 			},
 		}
 
@@ -652,16 +697,11 @@ func (s *Service) ensureBackend(
 ) (*lb.Backend, error) {
 	servers = slices.Sorted(slices.Values(servers))
 
+	desired := desiredBackend(lbPort, servers)
+
 	backend := lbPort.Backend
 	if backend == nil {
-		return s.ScalewayClient.CreateBackend(
-			ctx,
-			lbWithPrivateIP.Zone,
-			lbWithPrivateIP.ID,
-			lbPort.Name,
-			servers,
-			lbPort.TargetPort,
-		)
+		return s.ScalewayClient.CreateBackend(ctx, lbWithPrivateIP.Zone, lbWithPrivateIP.ID, desired)
 	}
 
 	var err error
@@ -673,15 +713,15 @@ func (s *Service) ensureBackend(
 		}
 	}
 
-	if backend.ForwardPort != lbPort.TargetPort {
-		backend, err = s.ScalewayClient.UpdateBackend(ctx, lbWithPrivateIP.Zone, backend.ID, backend.Name, lbPort.TargetPort)
+	if backendNeedsUpdate(backend, desired) {
+		backend, err = s.ScalewayClient.UpdateBackend(ctx, lbWithPrivateIP.Zone, backend.ID, desired)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if backend.HealthCheck != nil && backend.HealthCheck.Port != lbPort.TargetPort {
-		healthcheck, err := s.ScalewayClient.UpdateHealthCheck(ctx, lbWithPrivateIP.Zone, backend.ID, lbPort.TargetPort)
+	if backend.HealthCheck != nil && healthCheckNeedsUpdate(backend.HealthCheck, desired.HealthCheck) {
+		healthcheck, err := s.ScalewayClient.UpdateHealthCheck(ctx, lbWithPrivateIP.Zone, backend.ID, desired.HealthCheck)
 		if err != nil {
 			return nil, err
 		}
@@ -692,6 +732,99 @@ func (s *Service) ensureBackend(
 	return backend, nil
 }
 
+// desiredBackend returns the desired backend of a port with the provided servers.
+func desiredBackend(port *lbPort, servers []string) *lb.Backend {
+	spec := port.LoadBalancerPort.Backend
+
+	backend := &lb.Backend{
+		Name:                 port.Name,
+		Pool:                 servers,
+		ForwardPort:          port.TargetPort,
+		ForwardPortAlgorithm: forwardAlgorithms[cmp.Or(spec.ForwardAlgorithm, infrav1.LoadBalancerForwardAlgorithmRoundRobin)],
+		ProxyProtocol:        proxyProtocols[cmp.Or(spec.ProxyProtocol, infrav1.LoadBalancerProxyProtocolNone)],
+		OnMarkedDownAction:   onMarkedDownActions[cmp.Or(spec.OnMarkedDownAction, infrav1.LoadBalancerOnMarkedDownActionNone)],
+		HealthCheck:          healthCheck(port.TargetPort, port.HealthCheck),
+	}
+
+	if spec.ServerTimeoutSeconds != 0 {
+		backend.TimeoutServer = ptr.To(time.Duration(spec.ServerTimeoutSeconds) * time.Second)
+	}
+
+	if spec.ConnectTimeoutSeconds != 0 {
+		backend.TimeoutConnect = ptr.To(time.Duration(spec.ConnectTimeoutSeconds) * time.Second)
+	}
+
+	if spec.TunnelTimeoutSeconds != 0 {
+		backend.TimeoutTunnel = ptr.To(time.Duration(spec.TunnelTimeoutSeconds) * time.Second)
+	}
+
+	return backend
+}
+
+// backendNeedsUpdate returns true if the settings of the current backend differ
+// from the desired ones. The timeouts are only compared when they are set in the
+// desired backend, otherwise the defaults of the load balancer are kept.
+func backendNeedsUpdate(current, desired *lb.Backend) bool {
+	return current.ForwardPort != desired.ForwardPort ||
+		current.ForwardPortAlgorithm != desired.ForwardPortAlgorithm ||
+		current.ProxyProtocol != desired.ProxyProtocol ||
+		current.OnMarkedDownAction != desired.OnMarkedDownAction ||
+		desired.TimeoutServer != nil && !ptr.Equal(current.TimeoutServer, desired.TimeoutServer) ||
+		desired.TimeoutConnect != nil && !ptr.Equal(current.TimeoutConnect, desired.TimeoutConnect) ||
+		desired.TimeoutTunnel != nil && !ptr.Equal(current.TimeoutTunnel, desired.TimeoutTunnel)
+}
+
+// healthCheck returns the desired health check of a backend from its target port
+// and the health check spec.
+func healthCheck(targetPort int32, spec infrav1.LoadBalancerHealthCheck) *lb.HealthCheck {
+	hc := &lb.HealthCheck{
+		Port:            targetPort,
+		CheckMaxRetries: cmp.Or(spec.MaxRetries, defaultHealthCheckMaxRetries),
+	}
+
+	if spec.IntervalSeconds != 0 {
+		hc.CheckDelay = ptr.To(time.Duration(spec.IntervalSeconds) * time.Second)
+	}
+
+	if spec.TimeoutSeconds != 0 {
+		hc.CheckTimeout = ptr.To(time.Duration(spec.TimeoutSeconds) * time.Second)
+	}
+
+	switch spec.Type {
+	case infrav1.LoadBalancerHealthCheckTypeHTTPS:
+		hc.HTTPSConfig = &lb.HealthCheckHTTPSConfig{
+			URI:    cmp.Or(spec.HTTPS.Path, "/"),
+			Method: http.MethodGet,
+			Code:   ptr.To(cmp.Or(spec.HTTPS.Code, http.StatusOK)),
+		}
+	default:
+		hc.TCPConfig = &lb.HealthCheckTCPConfig{}
+	}
+
+	return hc
+}
+
+// healthCheckNeedsUpdate returns true if the current health check of a backend
+// differs from the desired one. The delay and timeout are only compared when they
+// are set in the desired health check, otherwise the defaults of the load balancer are kept.
+func healthCheckNeedsUpdate(current, desired *lb.HealthCheck) bool {
+	switch {
+	case current.Port != desired.Port,
+		current.CheckMaxRetries != desired.CheckMaxRetries,
+		desired.CheckDelay != nil && !ptr.Equal(current.CheckDelay, desired.CheckDelay),
+		desired.CheckTimeout != nil && !ptr.Equal(current.CheckTimeout, desired.CheckTimeout),
+		(current.TCPConfig == nil) != (desired.TCPConfig == nil),
+		(current.HTTPSConfig == nil) != (desired.HTTPSConfig == nil):
+		return true
+	case desired.HTTPSConfig != nil:
+		return current.HTTPSConfig.URI != desired.HTTPSConfig.URI ||
+			current.HTTPSConfig.Method != desired.HTTPSConfig.Method ||
+			!ptr.Equal(current.HTTPSConfig.Code, desired.HTTPSConfig.Code)
+	}
+
+	return false
+}
+
 func (s *Service) ensureACLs(
 	ctx context.Context,
 	mainLB *lbWithPrivateIP,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 
@@ -15,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
 
 	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
 	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
@@ -107,7 +109,18 @@ func TestService_Reconcile(t *testing.T) {
 				// Ports (backend + frontend)
 				i.ListFrontends(gomock.Any(), scw.ZoneFrPar1, lbID).Return(nil, nil)
 				i.ListBackends(gomock.Any(), scw.ZoneFrPar1, lbID).Return(nil, nil)
-				i.CreateBackend(gomock.Any(), scw.ZoneFrPar1, lbID, APIServerPortName, nil, backendControlPlanePort).Return(&lb.Backend{
+				i.CreateBackend(gomock.Any(), scw.ZoneFrPar1, lbID, &lb.Backend{
+					Name:                 APIServerPortName,
+					ForwardPort:          BackendControlPlanePort,
+					ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
+					ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+					OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
+					HealthCheck: &lb.HealthCheck{
+						Port:            BackendControlPlanePort,
+						CheckMaxRetries: 5,
+						TCPConfig:       &lb.HealthCheckTCPConfig{},
+					},
+				}).Return(&lb.Backend{
 					ID:   backendID,
 					Name: APIServerPortName,
 					LB: &lb.LB{
@@ -134,6 +147,245 @@ func TestService_Reconcile(t *testing.T) {
 				g.Expect(c.ScalewayCluster.Status.Network.LoadBalancerIP).To(BeEquivalentTo("42.42.42.42"))
 			},
 		},
+		{
+			name: "public LB with HTTPS health check: update drifted health check",
+			fields: fields{
+				Cluster: &scope.Cluster{
+					ScalewayCluster: &infrav1.ScalewayCluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "cluster",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayClusterSpec{
+							Network: infrav1.ScalewayClusterNetwork{
+								ControlPlaneLoadBalancer: infrav1.ControlPlaneLoadBalancer{
+									HealthCheck: infrav1.LoadBalancerHealthCheck{
+										Type: infrav1.LoadBalancerHealthCheckTypeHTTPS,
+										HTTPS: infrav1.HTTPSHealthCheck{
+											Path: "/readyz",
+										},
+										IntervalSeconds: 10,
+										MaxRetries:      3,
+									},
+								},
+							},
+						},
+					},
+					Cluster: &clusterv1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "cluster",
+							Namespace: "default",
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				tags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+
+				// Main LB
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(tags, CAPSMainLBTag)).Return(&lb.LB{
+					ID:     lbID,
+					Name:   "cluster",
+					Status: lb.LBStatusReady,
+					Zone:   scw.ZoneFrPar1,
+					Type:   "LB-S",
+					IP:     []*lb.IP{{IPAddress: "42.42.42.42"}},
+					Tags:   append(tags, CAPSMainLBTag),
+				}, nil)
+
+				// Extra LBs
+				i.FindLBs(gomock.Any(), append(tags, CAPSExtraLBTag)).Return([]*lb.LB{}, nil)
+
+				// Ports (backend + frontend)
+				i.ListFrontends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Frontend{
+					{
+						ID:   frontendLB0ID,
+						Name: APIServerPortName,
+						LB: &lb.LB{
+							ID:   lbID,
+							Zone: scw.ZoneFrPar1,
+						},
+						Backend: &lb.Backend{
+							ID:   backendID,
+							Name: APIServerPortName,
+						},
+					},
+				}, nil)
+				i.ListBackends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Backend{
+					{
+						ID:   backendID,
+						Name: APIServerPortName,
+						LB: &lb.LB{
+							ID:   lbID,
+							Zone: scw.ZoneFrPar1,
+						},
+						ForwardPort:          BackendControlPlanePort,
+						ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
+						ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+						OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
+						HealthCheck: &lb.HealthCheck{
+							Port:            BackendControlPlanePort,
+							CheckDelay:      ptr.To(time.Minute),
+							CheckMaxRetries: 5,
+							TCPConfig:       &lb.HealthCheckTCPConfig{},
+						},
+					},
+				}, nil)
+				i.UpdateHealthCheck(gomock.Any(), scw.ZoneFrPar1, backendID, &lb.HealthCheck{
+					Port:            BackendControlPlanePort,
+					CheckDelay:      ptr.To(10 * time.Second),
+					CheckMaxRetries: 3,
+					HTTPSConfig: &lb.HealthCheckHTTPSConfig{
+						URI:    "/readyz",
+						Method: "GET",
+						Code:   ptr.To(int32(200)),
+					},
+				}).Return(&lb.HealthCheck{
+					Port:            BackendControlPlanePort,
+					CheckDelay:      ptr.To(10 * time.Second),
+					CheckMaxRetries: 3,
+					HTTPSConfig: &lb.HealthCheckHTTPSConfig{
+						URI:    "/readyz",
+						Method: "GET",
+						Code:   ptr.To(int32(200)),
+					},
+				}, nil)
+
+				// ACL
+				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendLB0ID, allowedRangesACLName).Return(nil, client.ErrNoItemFound)
+				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendLB0ID, publicGatewayACLName).Return(nil, client.ErrNoItemFound)
+				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendLB0ID, denyAllACLName).Return(nil, client.ErrNoItemFound)
+			},
+			asserts: func(g *WithT, c *scope.Cluster) {
+				g.Expect(c.ScalewayCluster.Status.Network.LoadBalancerIP).To(BeEquivalentTo("42.42.42.42"))
+			},
+		},
+		{
+			name: "public LB with backend settings: update drifted backend",
+			fields: fields{
+				Cluster: &scope.Cluster{
+					ScalewayCluster: &infrav1.ScalewayCluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "cluster",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayClusterSpec{
+							Network: infrav1.ScalewayClusterNetwork{
+								ControlPlaneLoadBalancer: infrav1.ControlPlaneLoadBalancer{
+									Backend: infrav1.LoadBalancerBackend{
+										ProxyProtocol:        infrav1.LoadBalancerProxyProtocolV2,
+										ForwardAlgorithm:     infrav1.LoadBalancerForwardAlgorithmLeastConn,
+										OnMarkedDownAction:   infrav1.LoadBalancerOnMarkedDownActionShutdownSessions,
+										TunnelTimeoutSeconds: 3600,
+									},
+								},
+							},
+						},
+					},
+					Cluster: &clusterv1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "cluster",
+							Namespace: "default",
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				tags := []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+
+				// Main LB
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(tags, CAPSMainLBTag)).Return(&lb.LB{
+					ID:     lbID,
+					Name:   "cluster",
+					Status: lb.LBStatusReady,
+					Zone:   scw.ZoneFrPar1,
+					Type:   "LB-S",
+					IP:     []*lb.IP{{IPAddress: "42.42.42.42"}},
+					Tags:   append(tags, CAPSMainLBTag),
+				}, nil)
+
+				// Extra LBs
+				i.FindLBs(gomock.Any(), append(tags, CAPSExtraLBTag)).Return([]*lb.LB{}, nil)
+
+				// Ports (backend + frontend)
+				i.ListFrontends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Frontend{
+					{
+						ID:   frontendLB0ID,
+						Name: APIServerPortName,
+						LB: &lb.LB{
+							ID:   lbID,
+							Zone: scw.ZoneFrPar1,
+						},
+						Backend: &lb.Backend{
+							ID:   backendID,
+							Name: APIServerPortName,
+						},
+					},
+				}, nil)
+				i.ListBackends(gomock.Any(), scw.ZoneFrPar1, lbID).Return([]*lb.Backend{
+					{
+						ID:   backendID,
+						Name: APIServerPortName,
+						LB: &lb.LB{
+							ID:   lbID,
+							Zone: scw.ZoneFrPar1,
+						},
+						ForwardPort:          BackendControlPlanePort,
+						ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
+						ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+						OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
+						TimeoutTunnel:        ptr.To(15 * time.Minute),
+						HealthCheck: &lb.HealthCheck{
+							Port:            BackendControlPlanePort,
+							CheckMaxRetries: 5,
+							TCPConfig:       &lb.HealthCheckTCPConfig{},
+						},
+					},
+				}, nil)
+				i.UpdateBackend(gomock.Any(), scw.ZoneFrPar1, backendID, &lb.Backend{
+					Name:                 APIServerPortName,
+					ForwardPort:          BackendControlPlanePort,
+					ForwardPortAlgorithm: lb.ForwardPortAlgorithmLeastconn,
+					ProxyProtocol:        lb.ProxyProtocolProxyProtocolV2,
+					OnMarkedDownAction:   lb.OnMarkedDownActionShutdownSessions,
+					TimeoutTunnel:        ptr.To(time.Hour),
+					HealthCheck: &lb.HealthCheck{
+						Port:            BackendControlPlanePort,
+						CheckMaxRetries: 5,
+						TCPConfig:       &lb.HealthCheckTCPConfig{},
+					},
+				}).Return(&lb.Backend{
+					ID:                   backendID,
+					Name:                 APIServerPortName,
+					ForwardPort:          BackendControlPlanePort,
+					ForwardPortAlgorithm: lb.ForwardPortAlgorithmLeastconn,
+					ProxyProtocol:        lb.ProxyProtocolProxyProtocolV2,
+					OnMarkedDownAction:   lb.OnMarkedDownActionShutdownSessions,
+					TimeoutTunnel:        ptr.To(time.Hour),
+					HealthCheck: &lb.HealthCheck{
+						Port:            BackendControlPlanePort,
+						CheckMaxRetries: 5,
+						TCPConfig:       &lb.HealthCheckTCPConfig{},
+					},
+				}, nil)
+
+				// ACL
+				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendLB0ID, allowedRangesACLName).Return(nil, client.ErrNoItemFound)
+				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendLB0ID, publicGatewayACLName).Return(nil, client.ErrNoItemFound)
+				i.FindLBACLByName(gomock.Any(), scw.ZoneFrPar1, frontendLB0ID, denyAllACLName).Return(nil, client.ErrNoItemFound)
+			},
+			asserts: func(g *WithT, c *scope.Cluster) {
+				g.Expect(c.ScalewayCluster.Status.Network.LoadBalancerIP).To(BeEquivalentTo("42.42.42.42"))
+			},
+		},
 		{
 			name: "custom public LB, no extra LB, no Private Network, no ACL: create",
 			fields: fields{
@@ -180,7 +432,18 @@ func TestService_Reconcile(t *testing.T) {
 				// Ports (backend + frontend)
 				i.ListFrontends(gomock.Any(), scw.ZoneFrPar1, lbID).Return(nil, nil)
 				i.ListBackends(gomock.Any(), scw.ZoneFrPar1, lbID).Return(nil, nil)
-				i.CreateBackend(gomock.Any(), scw.ZoneFrPar1, lbID, APIServerPortName, nil, backendControlPlanePort).Return(&lb.Backend{
+				i.CreateBackend(gomock.Any(), scw.ZoneFrPar1, lbID, &lb.Backend{
+					Name:                 APIServerPortName,
+					ForwardPort:          BackendControlPlanePort,
+					ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
+					ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+					OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
+					HealthCheck: &lb.HealthCheck{
+						Port:            BackendControlPlanePort,
+						CheckMaxRetries: 5,
+						TCPConfig:       &lb.HealthCheckTCPConfig{},
+					},
+				}).Return(&lb.Backend{
 					ID: backendID,
 					LB: &lb.LB{
 						ID:   lbID,
@@ -432,9 +695,14 @@ func TestService_Reconcile(t *testing.T) {
 							ID:   lbID,
 							Zone: scw.ZoneFrPar1,
 						},
-						ForwardPort: backendControlPlanePort,
+						ForwardPort:          BackendControlPlanePort,
+						ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
+						ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+						OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
 						HealthCheck: &lb.HealthCheck{
-							Port: backendControlPlanePort,
+							Port:            BackendControlPlanePort,
+							CheckMaxRetries: 5,
+							TCPConfig:       &lb.HealthCheckTCPConfig{},
 						},
 					},
 					{
@@ -444,9 +712,14 @@ func TestService_Reconcile(t *testing.T) {
 							ID:   lbID,
 							Zone: scw.ZoneFrPar1,
 						},
-						ForwardPort: 9345,
+						ForwardPort:          9345,
+						ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
+						ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+						OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
 						HealthCheck: &lb.HealthCheck{
-							Port: 9345,
+							Port:            9345,
+							CheckMaxRetries: 5,
+							TCPConfig:       &lb.HealthCheckTCPConfig{},
 						},
 					},
 				}, nil)
@@ -458,9 +731,14 @@ func TestService_Reconcile(t *testing.T) {
 							ID:   lbID1,
 							Zone: scw.ZoneFrPar1,
 						},
-						ForwardPort: backendControlPlanePort,
+						ForwardPort:          BackendControlPlanePort,
+						ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
+						ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+						OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
 						HealthCheck: &lb.HealthCheck{
-							Port: backendControlPlanePort,
+							Port:            BackendControlPlanePort,
+							CheckMaxRetries: 5,
+							TCPConfig:       &lb.HealthCheckTCPConfig{},
 						},
 					},
 					{
@@ -470,9 +748,14 @@ func TestService_Reconcile(t *testing.T) {
 							ID:   lbID1,
 							Zone: scw.ZoneFrPar1,
 						},
-						ForwardPort: 9345,
+						ForwardPort:          9345,
+						ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
+						ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+						OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
 						HealthCheck: &lb.HealthCheck{
-							Port: 9345,
+							Port:            9345,
+							CheckMaxRetries: 5,
+							TCPConfig:       &lb.HealthCheckTCPConfig{},
 						},
 					},
 				}, nil)
@@ -484,9 +767,14 @@ func TestService_Reconcile(t *testing.T) {
 							ID:   lbID2,
 							Zone: scw.ZoneFrPar1,
 						},
-						ForwardPort: backendControlPlanePort,
+						ForwardPort:          BackendControlPlanePort,
+						ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
+						ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+						OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
 						HealthCheck: &lb.HealthCheck{
-							Port: backendControlPlanePort,
+							Port:            BackendControlPlanePort,
+							CheckMaxRetries: 5,
+							TCPConfig:       &lb.HealthCheckTCPConfig{},
 						},
 					},
 					{
@@ -496,9 +784,14 @@ func TestService_Reconcile(t *testing.T) {
 							ID:   lbID1,
 							Zone: scw.ZoneFrPar1,
 						},
-						ForwardPort: 9345,
+						ForwardPort:          9345,
+						ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
+						ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+						OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
 						HealthCheck: &lb.HealthCheck{
-							Port: 9345,
+							Port:            9345,
+							CheckMaxRetries: 5,
+							TCPConfig:       &lb.HealthCheckTCPConfig{},
 						},
 					},
 				}, nil)
@@ -510,9 +803,14 @@ func TestService_Reconcile(t *testing.T) {
 							ID:   lbID3,
 							Zone: scw.ZoneFrPar2,
 						},
-						ForwardPort: backendControlPlanePort,
+						ForwardPort:          BackendControlPlanePort,
+						ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
+						ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+						OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
 						HealthCheck: &lb.HealthCheck{
-							Port: backendControlPlanePort,
+							Port:            BackendControlPlanePort,
+							CheckMaxRetries: 5,
+							TCPConfig:       &lb.HealthCheckTCPConfig{},
 						},
 					},
 					{
@@ -522,9 +820,14 @@ func TestService_Reconcile(t *testing.T) {
 							ID:   lbID3,
 							Zone: scw.ZoneFrPar2,
 						},
-						ForwardPort: 9345,
+						ForwardPort:          9345,
+						ForwardPortAlgorithm: lb.ForwardPortAlgorithmRoundrobin,
+						ProxyProtocol:        lb.ProxyProtocolProxyProtocolNone,
+						OnMarkedDownAction:   lb.OnMarkedDownActionOnMarkedDownActionNone,
 						HealthCheck: &lb.HealthCheck{
-							Port: 9345,
+							Port:            9345,
+							CheckMaxRetries: 5,
+							TCPConfig:       &lb.HealthCheckTCPConfig{},
 						},
 					},
 				}, nil)
@@ -718,6 +1021,41 @@ func TestService_Reconcile(t *testing.T) {
 				g.Expect(c.ScalewayCluster.Status.Network.ExtraLoadBalancerIPs).To(Equal([]infrav1.IPv4{lbIP1, lbIP2, lbIP3}))
 			},
 		},
+		{
+			name: "externally managed LB: nothing to reconcile",
+			fields: fields{
+				Cluster: &scope.Cluster{
+					ScalewayCluster: &infrav1.ScalewayCluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "cluster",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayClusterSpec{
+							Network: infrav1.ScalewayClusterNetwork{
+								ControlPlaneLoadBalancer: infrav1.ControlPlaneLoadBalancer{
+									ExternallyManaged: ptr.To(true),
+								},
+							},
+							ControlPlaneEndpoint: clusterv1.APIEndpoint{
+								Host: "10.0.0.1",
+								Port: 6443,
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {},
+			asserts: func(g *WithT, c *scope.Cluster) {
+				condition := conditions.Get(c.ScalewayCluster, infrav1.ScalewayClusterLoadBalancersReadyCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(condition.Reason).To(Equal(infrav1.ScalewayClusterLoadBalancersExternallyManagedReason))
+				g.Expect(c.ScalewayCluster.Status.Network.LoadBalancerIP).To(BeEmpty())
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -841,6 +1179,30 @@ func TestService_Delete(t *testing.T) {
 				i.DeleteLB(gomock.Any(), scw.ZoneFrPar2, lbID3, true)
 			},
 		},
+		{
+			name: "externally managed LB: nothing to delete",
+			fields: fields{
+				Cluster: &scope.Cluster{
+					ScalewayCluster: &infrav1.ScalewayCluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "cluster",
+							Namespace: "default",
+						},
+						Spec: infrav1.ScalewayClusterSpec{
+							Network: infrav1.ScalewayClusterNetwork{
+								ControlPlaneLoadBalancer: infrav1.ControlPlaneLoadBalancer{
+									ExternallyManaged: ptr.To(true),
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
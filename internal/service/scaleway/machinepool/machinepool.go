@@ -0,0 +1,337 @@
+package machinepool
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/instance"
+)
+
+// machineNameSuffixLength is the length of the random suffix of the machine names.
+const machineNameSuffixLength = 5
+
+// instanceServiceCreator is a function that creates the service that reconciles
+// the Instance server of a machine.
+type instanceServiceCreator func(*scope.Machine) scaleway.ServiceReconciler
+
+type Service struct {
+	*scope.MachinePool
+
+	createInstanceService instanceServiceCreator
+}
+
+func New(machinePoolScope *scope.MachinePool) *Service {
+	return &Service{
+		MachinePool: machinePoolScope,
+		createInstanceService: func(m *scope.Machine) scaleway.ServiceReconciler {
+			return instance.New(m)
+		},
+	}
+}
+
+func (s *Service) Name() string {
+	return "machinepool"
+}
+
+// machine is a machine of the pool, backed by an Instance server.
+type machine struct {
+	name         string
+	templateHash string
+	zone         scw.Zone
+	creationDate time.Time
+	joined       bool
+	// providerID is the provider ID of the server of an existing machine.
+	providerID string
+}
+
+func (s *Service) Reconcile(ctx context.Context) (retErr error) {
+	condition := metav1.Condition{
+		Type:   infrav1.ScalewayMachinePoolInstancesReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.ScalewayMachinePoolInstancesReadyReason,
+	}
+
+	defer func() {
+		if retErr != nil {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = infrav1.ScalewayMachinePoolInstancesReconciliationFailedReason
+			condition.Message = retErr.Error()
+		}
+
+		conditions.Set(s.ScalewayMachinePool, condition)
+	}()
+
+	templateHash, err := s.TemplateHash()
+	if err != nil {
+		return err
+	}
+
+	zones, err := s.Zones()
+	if err != nil {
+		return err
+	}
+
+	machines, err := s.findMachines(ctx)
+	if err != nil {
+		return err
+	}
+
+	var upToDate, outdated []*machine
+	for _, m := range machines {
+		if m.templateHash == templateHash {
+			upToDate = append(upToDate, m)
+		} else {
+			outdated = append(outdated, m)
+		}
+	}
+
+	toDelete := s.machinesToDelete(upToDate, outdated)
+	toCreate := s.machinesToCreate(upToDate, outdated, toDelete)
+
+	var (
+		errs        []error
+		providerIDs []string
+		remaining   []*machine
+	)
+
+	for _, m := range machines {
+		machineScope := s.NewMachine(m.name, m.zone, m.templateHash, m.joined)
+		// The instance service only sets the provider ID before the node joins the cluster.
+		machineScope.SetProviderID(m.providerID)
+
+		if slices.Contains(toDelete, m) {
+			logf.FromContext(ctx).Info("Deleting machine pool machine", "machine", m.name, "zone", m.zone)
+
+			if err := s.createInstanceService(machineScope).Delete(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete machine %s: %w", m.name, err))
+			}
+
+			continue
+		}
+
+		remaining = append(remaining, m)
+
+		if err := s.createInstanceService(machineScope).Reconcile(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile machine %s: %w", m.name, err))
+		}
+
+		if providerID := machineScope.ScalewayMachine.Spec.ProviderID; providerID != "" {
+			providerIDs = append(providerIDs, providerID)
+		}
+	}
+
+	for range toCreate {
+		m := &machine{
+			name:         s.ResourceName(utilrand.String(machineNameSuffixLength)),
+			templateHash: templateHash,
+			zone:         nextZone(zones, remaining),
+		}
+		remaining = append(remaining, m)
+
+		logf.FromContext(ctx).Info("Creating machine pool machine", "machine", m.name, "zone", m.zone)
+
+		machineScope := s.NewMachine(m.name, m.zone, m.templateHash, false)
+		if err := s.createInstanceService(machineScope).Reconcile(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile machine %s: %w", m.name, err))
+		}
+
+		if providerID := machineScope.ScalewayMachine.Spec.ProviderID; providerID != "" {
+			providerIDs = append(providerIDs, providerID)
+		}
+	}
+
+	s.SetProviderIDs(providerIDs)
+	s.SetStatusReplicas(len(providerIDs))
+
+	if err := joinErrors(errs); err != nil {
+		return err
+	}
+
+	switch {
+	case len(outdated) > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = infrav1.ScalewayMachinePoolInstancesRollingUpdateReason
+		condition.Message = fmt.Sprintf("%d outdated instances are being replaced", len(outdated))
+	case len(toDelete) > 0 || toCreate > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = infrav1.ScalewayMachinePoolInstancesScalingReason
+		condition.Message = fmt.Sprintf("scaling from %d to %d instances", len(machines), s.Replicas())
+	}
+
+	return nil
+}
+
+func (s *Service) Delete(ctx context.Context) error {
+	machines, err := s.findMachines(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, m := range machines {
+		machineScope := s.NewMachine(m.name, m.zone, m.templateHash, m.joined)
+
+		if err := s.createInstanceService(machineScope).Delete(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete machine %s: %w", m.name, err))
+		}
+	}
+
+	return joinErrors(errs)
+}
+
+// findMachines finds the machines of the pool. All the zones of the region are
+// searched so that machines are found even if the failure domains of the pool change.
+func (s *Service) findMachines(ctx context.Context) ([]*machine, error) {
+	var machines []*machine
+
+	for _, zone := range s.ScalewayClient.GetControlPlaneZones() {
+		servers, err := s.ScalewayClient.FindServers(ctx, zone, s.ResourceTags())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, server := range servers {
+			name, templateHash, ok := s.ParseMachineTags(server.Tags)
+			if !ok {
+				continue
+			}
+
+			machines = append(machines, &machine{
+				name:         name,
+				templateHash: templateHash,
+				zone:         server.Zone,
+				creationDate: ptr.Deref(server.CreationDate, time.Time{}),
+				joined:       s.HasNode(name),
+				providerID:   instance.ProviderID(server),
+			})
+		}
+	}
+
+	return machines, nil
+}
+
+// machinesToDelete returns the machines that must be deleted. Outdated machines
+// that have not joined the cluster are always deleted, other outdated machines
+// are deleted (oldest first) as long as enough machines remain available.
+// Finally, up-to-date machines above the desired number of replicas are deleted,
+// starting with the ones that have not joined the cluster and the newest ones.
+func (s *Service) machinesToDelete(upToDate, outdated []*machine) []*machine {
+	var toDelete []*machine
+
+	available := 0
+	for _, m := range slices.Concat(upToDate, outdated) {
+		if m.joined {
+			available++
+		}
+	}
+
+	minAvailable := s.Replicas() - s.MaxUnavailable()
+
+	outdated = slices.Clone(outdated)
+	slices.SortStableFunc(outdated, func(a, b *machine) int {
+		return a.creationDate.Compare(b.creationDate)
+	})
+
+	for _, m := range outdated {
+		switch {
+		case !m.joined:
+			toDelete = append(toDelete, m)
+		case available > minAvailable:
+			toDelete = append(toDelete, m)
+			available--
+		}
+	}
+
+	if excess := len(upToDate) - s.Replicas(); excess > 0 {
+		upToDate = slices.Clone(upToDate)
+		slices.SortStableFunc(upToDate, func(a, b *machine) int {
+			if a.joined != b.joined {
+				if !a.joined {
+					return -1
+				}
+
+				return 1
+			}
+
+			return b.creationDate.Compare(a.creationDate)
+		})
+
+		toDelete = append(toDelete, upToDate[:excess]...)
+	}
+
+	return toDelete
+}
+
+// machinesToCreate returns the number of machines to create. During a rolling
+// replacement, the total number of machines cannot exceed the desired number
+// of replicas plus the max surge.
+func (s *Service) machinesToCreate(upToDate, outdated, toDelete []*machine) int {
+	remainingUpToDate := len(upToDate)
+	remainingOutdated := len(outdated)
+
+	for _, m := range toDelete {
+		if slices.Contains(upToDate, m) {
+			remainingUpToDate--
+		} else {
+			remainingOutdated--
+		}
+	}
+
+	toCreate := s.Replicas() - remainingUpToDate
+
+	if remainingOutdated > 0 {
+		toCreate = min(toCreate, s.Replicas()+s.MaxSurge()-remainingUpToDate-remainingOutdated)
+	}
+
+	return max(toCreate, 0)
+}
+
+// nextZone returns the zone with the least machines.
+func nextZone(zones []scw.Zone, machines []*machine) scw.Zone {
+	count := make(map[scw.Zone]int, len(zones))
+	for _, m := range machines {
+		count[m.zone]++
+	}
+
+	return slices.MinFunc(zones, func(a, b scw.Zone) int {
+		return cmp.Compare(count[a], count[b])
+	})
+}
+
+// joinErrors joins the provided errors. If all errors are transient, the
+// transient error with the shortest requeue delay is returned.
+func joinErrors(errs []error) error {
+	var (
+		transientErr error
+		requeueAfter time.Duration
+	)
+
+	for _, err := range errs {
+		var reconcileErr *scaleway.ReconcileError
+		if !errors.As(err, &reconcileErr) || reconcileErr.RequeueAfter() == 0 {
+			return errors.Join(errs...)
+		}
+
+		if transientErr == nil || reconcileErr.RequeueAfter() < requeueAfter {
+			transientErr = err
+			requeueAfter = reconcileErr.RequeueAfter()
+		}
+	}
+
+	return transientErr
+}
@@ -0,0 +1,373 @@
+package machinepool
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client/mock_client"
+)
+
+const outdatedHash = "outdated00"
+
+var (
+	poolTags = []string{
+		"caps-namespace=default",
+		"caps-scalewaycluster=cluster",
+		"caps-scalewaymachinepool=pool",
+	}
+	creationDate = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// fakeInstanceService records the calls made to the instance service.
+type fakeInstanceService struct {
+	machine *scope.Machine
+	calls   *[]string
+}
+
+func (f *fakeInstanceService) Name() string { return "instance" }
+
+func (f *fakeInstanceService) Reconcile(context.Context) error {
+	*f.calls = append(*f.calls, "reconcile:"+f.machine.ScalewayMachine.Name+":"+f.machine.Machine.Spec.FailureDomain)
+
+	// Like the instance service, the provider ID is only set before the node joins the cluster.
+	if !f.machine.HasJoinedCluster() {
+		f.machine.SetProviderID(fmt.Sprintf("scaleway://instance/%s/%s", f.machine.Machine.Spec.FailureDomain, f.machine.ScalewayMachine.Name))
+	}
+
+	return nil
+}
+
+func (f *fakeInstanceService) Delete(context.Context) error {
+	*f.calls = append(*f.calls, "delete:"+f.machine.ScalewayMachine.Name)
+
+	return nil
+}
+
+func newMachinePoolScope(replicas int32, failureDomains []string, nodes ...string) *scope.MachinePool {
+	nodeRefs := make([]corev1.ObjectReference, 0, len(nodes))
+	for _, node := range nodes {
+		nodeRefs = append(nodeRefs, corev1.ObjectReference{Name: node})
+	}
+
+	return &scope.MachinePool{
+		Cluster: &scope.Cluster{
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"},
+			},
+			ScalewayCluster: &infrav1.ScalewayCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"},
+			},
+		},
+		MachinePool: &clusterv1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+			Spec: clusterv1.MachinePoolSpec{
+				ClusterName:    "cluster",
+				Replicas:       &replicas,
+				FailureDomains: failureDomains,
+				Template: clusterv1.MachineTemplateSpec{
+					Spec: clusterv1.MachineSpec{
+						Bootstrap: clusterv1.Bootstrap{DataSecretName: ptr.To("bootstrap")},
+					},
+				},
+			},
+			Status: clusterv1.MachinePoolStatus{NodeRefs: nodeRefs},
+		},
+		ScalewayMachinePool: &infrav1.ScalewayMachinePool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+			Spec: infrav1.ScalewayMachinePoolSpec{
+				Template: infrav1.ScalewayMachineSpec{
+					CommercialType: "DEV1-S",
+					Image:          infrav1.Image{IDOrName: infrav1.IDOrName{Name: "image"}},
+				},
+			},
+		},
+	}
+}
+
+func poolServer(name, templateHash string, zone scw.Zone, age time.Duration) *instance.Server {
+	return &instance.Server{
+		ID:           name,
+		Name:         name,
+		Zone:         zone,
+		CreationDate: ptr.To(creationDate.Add(-age)),
+		Tags: append(
+			append([]string{}, poolTags...),
+			"caps-templatehash="+templateHash,
+			"caps-scalewaymachinepoolmachine="+name,
+		),
+	}
+}
+
+func TestService_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	currentHash, err := newMachinePoolScope(1, nil).TemplateHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		scope           *scope.MachinePool
+		servers         map[scw.Zone][]*instance.Server
+		wantCalls       []string
+		wantCreated     []scw.Zone
+		wantReplicas    int32
+		wantProviderIDs []string
+		wantCondition   metav1.Condition
+	}{
+		{
+			name:         "create machines in all failure domains",
+			scope:        newMachinePoolScope(3, []string{"fr-par-1", "fr-par-2"}),
+			wantCreated:  []scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2, scw.ZoneFrPar1},
+			wantReplicas: 3,
+			wantCondition: metav1.Condition{
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.ScalewayMachinePoolInstancesScalingReason,
+			},
+		},
+		{
+			name:  "machines are up-to-date",
+			scope: newMachinePoolScope(2, nil, "pool-aaaaa", "pool-bbbbb"),
+			servers: map[scw.Zone][]*instance.Server{
+				scw.ZoneFrPar1: {
+					poolServer("pool-aaaaa", currentHash, scw.ZoneFrPar1, 2*time.Hour),
+					poolServer("pool-bbbbb", currentHash, scw.ZoneFrPar1, time.Hour),
+				},
+			},
+			wantCalls: []string{
+				"reconcile:pool-aaaaa:fr-par-1",
+				"reconcile:pool-bbbbb:fr-par-1",
+			},
+			wantReplicas: 2,
+			wantCondition: metav1.Condition{
+				Status: metav1.ConditionTrue,
+				Reason: infrav1.ScalewayMachinePoolInstancesReadyReason,
+			},
+		},
+		{
+			name:  "joined machines keep their provider ID",
+			scope: newMachinePoolScope(2, nil, "pool-aaaaa"),
+			servers: map[scw.Zone][]*instance.Server{
+				scw.ZoneFrPar1: {
+					poolServer("pool-aaaaa", currentHash, scw.ZoneFrPar1, 2*time.Hour),
+				},
+				scw.ZoneFrPar2: {
+					poolServer("pool-bbbbb", currentHash, scw.ZoneFrPar2, time.Hour),
+				},
+			},
+			wantCalls: []string{
+				"reconcile:pool-aaaaa:fr-par-1",
+				"reconcile:pool-bbbbb:fr-par-2",
+			},
+			wantReplicas: 2,
+			wantProviderIDs: []string{
+				"scaleway://instance/fr-par-1/pool-aaaaa",
+				"scaleway://instance/fr-par-2/pool-bbbbb",
+			},
+			wantCondition: metav1.Condition{
+				Status: metav1.ConditionTrue,
+				Reason: infrav1.ScalewayMachinePoolInstancesReadyReason,
+			},
+		},
+		{
+			name:  "rolling update surges a new machine",
+			scope: newMachinePoolScope(2, nil, "pool-aaaaa", "pool-bbbbb"),
+			servers: map[scw.Zone][]*instance.Server{
+				scw.ZoneFrPar1: {
+					poolServer("pool-aaaaa", outdatedHash, scw.ZoneFrPar1, 2*time.Hour),
+					poolServer("pool-bbbbb", outdatedHash, scw.ZoneFrPar1, time.Hour),
+				},
+			},
+			wantCalls: []string{
+				"reconcile:pool-aaaaa:fr-par-1",
+				"reconcile:pool-bbbbb:fr-par-1",
+			},
+			wantCreated:  []scw.Zone{scw.ZoneFrPar1},
+			wantReplicas: 3,
+			wantCondition: metav1.Condition{
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.ScalewayMachinePoolInstancesRollingUpdateReason,
+			},
+		},
+		{
+			name:  "rolling update deletes the oldest outdated machine",
+			scope: newMachinePoolScope(2, nil, "pool-aaaaa", "pool-bbbbb", "pool-ccccc"),
+			servers: map[scw.Zone][]*instance.Server{
+				scw.ZoneFrPar1: {
+					poolServer("pool-bbbbb", outdatedHash, scw.ZoneFrPar1, time.Hour),
+					poolServer("pool-aaaaa", outdatedHash, scw.ZoneFrPar1, 2*time.Hour),
+					poolServer("pool-ccccc", currentHash, scw.ZoneFrPar1, time.Minute),
+				},
+			},
+			wantCalls: []string{
+				"reconcile:pool-bbbbb:fr-par-1",
+				"delete:pool-aaaaa",
+				"reconcile:pool-ccccc:fr-par-1",
+			},
+			wantCreated:  []scw.Zone{scw.ZoneFrPar1},
+			wantReplicas: 3,
+			wantCondition: metav1.Condition{
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.ScalewayMachinePoolInstancesRollingUpdateReason,
+			},
+		},
+		{
+			name:  "outdated machines that have not joined are deleted",
+			scope: newMachinePoolScope(1, nil, "pool-bbbbb"),
+			servers: map[scw.Zone][]*instance.Server{
+				scw.ZoneFrPar2: {
+					poolServer("pool-aaaaa", outdatedHash, scw.ZoneFrPar2, time.Hour),
+					poolServer("pool-bbbbb", currentHash, scw.ZoneFrPar2, time.Minute),
+				},
+			},
+			wantCalls: []string{
+				"delete:pool-aaaaa",
+				"reconcile:pool-bbbbb:fr-par-2",
+			},
+			wantReplicas: 1,
+			wantCondition: metav1.Condition{
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.ScalewayMachinePoolInstancesRollingUpdateReason,
+			},
+		},
+		{
+			name:  "scale down deletes the newest machines",
+			scope: newMachinePoolScope(1, nil, "pool-aaaaa", "pool-bbbbb", "pool-ccccc"),
+			servers: map[scw.Zone][]*instance.Server{
+				scw.ZoneFrPar1: {
+					poolServer("pool-aaaaa", currentHash, scw.ZoneFrPar1, 3*time.Hour),
+					poolServer("pool-bbbbb", currentHash, scw.ZoneFrPar1, time.Hour),
+				},
+				scw.ZoneFrPar2: {
+					poolServer("pool-ccccc", currentHash, scw.ZoneFrPar2, 2*time.Hour),
+				},
+			},
+			wantCalls: []string{
+				"reconcile:pool-aaaaa:fr-par-1",
+				"delete:pool-bbbbb",
+				"delete:pool-ccccc",
+			},
+			wantReplicas: 1,
+			wantCondition: metav1.Condition{
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.ScalewayMachinePoolInstancesScalingReason,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			scwMock := mock_client.NewMockInterface(mockCtrl)
+			scwMock.EXPECT().GetZoneOrDefault(gomock.Any()).DoAndReturn(func(zone string) (scw.Zone, error) {
+				if zone == "" {
+					return scw.ZoneFrPar1, nil
+				}
+
+				return scw.ParseZone(zone)
+			}).AnyTimes()
+			scwMock.EXPECT().GetControlPlaneZones().Return(scw.RegionFrPar.GetZones())
+			for _, zone := range scw.RegionFrPar.GetZones() {
+				scwMock.EXPECT().FindServers(gomock.Any(), zone, poolTags).Return(tt.servers[zone], nil)
+			}
+
+			var calls []string
+			tt.scope.ScalewayClient = scwMock
+			s := &Service{
+				MachinePool: tt.scope,
+				createInstanceService: func(m *scope.Machine) scaleway.ServiceReconciler {
+					return &fakeInstanceService{machine: m, calls: &calls}
+				},
+			}
+
+			g.Expect(s.Reconcile(context.TODO())).To(Succeed())
+
+			existingNames := make(map[string]bool)
+			for _, servers := range tt.servers {
+				for _, server := range servers {
+					existingNames[server.Name] = true
+				}
+			}
+
+			// Created machines have a random name, check their zone only.
+			var created []scw.Zone
+			var existing []string
+			for _, call := range calls {
+				name, zone, _ := strings.Cut(strings.TrimPrefix(call, "reconcile:"), ":")
+				if strings.HasPrefix(call, "reconcile:") && !existingNames[name] {
+					g.Expect(name).To(HavePrefix("pool-"))
+					created = append(created, scw.Zone(zone))
+					continue
+				}
+
+				existing = append(existing, call)
+			}
+
+			g.Expect(existing).To(Equal(tt.wantCalls))
+			g.Expect(created).To(Equal(tt.wantCreated))
+			g.Expect(tt.scope.ScalewayMachinePool.Spec.ProviderIDList).To(HaveLen(int(tt.wantReplicas)))
+			if tt.wantProviderIDs != nil {
+				g.Expect(tt.scope.ScalewayMachinePool.Spec.ProviderIDList).To(Equal(tt.wantProviderIDs))
+			}
+			g.Expect(tt.scope.ScalewayMachinePool.Status.Replicas).To(Equal(ptr.To(tt.wantReplicas)))
+
+			condition := conditions.Get(tt.scope.ScalewayMachinePool, infrav1.ScalewayMachinePoolInstancesReadyCondition)
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantCondition.Status))
+			g.Expect(condition.Reason).To(Equal(tt.wantCondition.Reason))
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	scwMock := mock_client.NewMockInterface(mockCtrl)
+	scwMock.EXPECT().GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2})
+	scwMock.EXPECT().FindServers(gomock.Any(), scw.ZoneFrPar1, poolTags).Return([]*instance.Server{
+		poolServer("pool-aaaaa", outdatedHash, scw.ZoneFrPar1, time.Hour),
+		{Name: "not-a-pool-machine", Zone: scw.ZoneFrPar1, Tags: poolTags},
+	}, nil)
+	scwMock.EXPECT().FindServers(gomock.Any(), scw.ZoneFrPar2, poolTags).Return([]*instance.Server{
+		poolServer("pool-bbbbb", outdatedHash, scw.ZoneFrPar2, time.Hour),
+	}, nil)
+
+	var calls []string
+	machinePoolScope := newMachinePoolScope(2, nil)
+	machinePoolScope.ScalewayClient = scwMock
+	s := &Service{
+		MachinePool: machinePoolScope,
+		createInstanceService: func(m *scope.Machine) scaleway.ServiceReconciler {
+			return &fakeInstanceService{machine: m, calls: &calls}
+		},
+	}
+
+	g.Expect(s.Delete(context.TODO())).To(Succeed())
+	g.Expect(calls).To(Equal([]string{"delete:pool-aaaaa", "delete:pool-bbbbb"}))
+}
@@ -0,0 +1,106 @@
+package machinetemplate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client"
+)
+
+// gpuResourceName is the name of the resource that exposes the GPUs of a node.
+const gpuResourceName corev1.ResourceName = "nvidia.com/gpu"
+
+// operatingSystemLinux is the operating system of all the nodes.
+const operatingSystemLinux = "linux"
+
+var archToArchitecture = map[instance.Arch]infrav1.Architecture{
+	instance.ArchX86_64: infrav1.ArchitectureAmd64,
+	instance.ArchArm64:  infrav1.ArchitectureArm64,
+	instance.ArchArm:    infrav1.ArchitectureArm,
+}
+
+type Service struct {
+	*scope.MachineTemplate
+}
+
+func New(machineTemplateScope *scope.MachineTemplate) *Service {
+	return &Service{MachineTemplate: machineTemplateScope}
+}
+
+func (s *Service) Name() string {
+	return "machinetemplate"
+}
+
+func (s *Service) Delete(_ context.Context) error {
+	return nil
+}
+
+func (s *Service) Reconcile(ctx context.Context) error {
+	serverType, err := s.findServerType(ctx)
+	if err != nil {
+		return err
+	}
+
+	capacity := corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewQuantity(int64(serverType.Ncpus), resource.DecimalSI),
+		corev1.ResourceMemory: *resource.NewQuantity(int64(serverType.RAM), resource.BinarySI),
+	}
+
+	if serverType.Gpu != nil && *serverType.Gpu > 0 {
+		capacity[gpuResourceName] = *resource.NewQuantity(int64(*serverType.Gpu), resource.DecimalSI)
+	}
+
+	s.ScalewayMachineTemplate.Status.Capacity = capacity
+	s.ScalewayMachineTemplate.Status.NodeInfo = infrav1.NodeInfo{
+		Architecture:    archToArchitecture[serverType.Arch],
+		OperatingSystem: operatingSystemLinux,
+	}
+
+	return nil
+}
+
+// findServerType looks up the commercial type of the template. Some server types
+// (e.g. GPU types) are only available in some zones of the region: the failure
+// domains of the cluster are tried first, then the other zones of the region.
+func (s *Service) findServerType(ctx context.Context) (*instance.ServerType, error) {
+	for _, zone := range s.zones() {
+		serverType, err := s.ScalewayClient.GetServerType(ctx, zone, s.CommercialType())
+		if err != nil {
+			if client.IsNotFoundError(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to get server type %s in zone %s: %w", s.CommercialType(), zone, err)
+		}
+
+		return serverType, nil
+	}
+
+	return nil, fmt.Errorf("server type %s was not found in any zone of the region", s.CommercialType())
+}
+
+// zones returns the failure domains of the cluster followed by the other zones of the region.
+func (s *Service) zones() []scw.Zone {
+	var zones []scw.Zone
+	if s.ScalewayCluster != nil {
+		for _, fd := range s.ScalewayCluster.Spec.FailureDomains {
+			zones = append(zones, scw.Zone(fd))
+		}
+	}
+
+	for _, zone := range s.ScalewayClient.GetControlPlaneZones() {
+		if !slices.Contains(zones, zone) {
+			zones = append(zones, zone)
+		}
+	}
+
+	return zones
+}
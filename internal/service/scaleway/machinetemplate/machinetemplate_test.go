@@ -0,0 +1,267 @@
+package machinetemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client/mock_client"
+)
+
+func TestService_Reconcile(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		MachineTemplate *scope.MachineTemplate
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		expect  func(i *mock_client.MockInterfaceMockRecorder)
+		asserts func(g *WithT, s *infrav1.ScalewayMachineTemplateStatus)
+	}{
+		{
+			name: "set capacity and node info",
+			fields: fields{
+				MachineTemplate: &scope.MachineTemplate{
+					Cluster: &scope.Cluster{},
+					ScalewayMachineTemplate: &infrav1.ScalewayMachineTemplate{
+						Spec: infrav1.ScalewayMachineTemplateSpec{
+							Template: infrav1.ScalewayMachineTemplateResource{
+								Spec: infrav1.ScalewayMachineSpec{
+									CommercialType: "COPARM1-2C-8G",
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2})
+				i.GetServerType(gomock.Any(), scw.ZoneFrPar1, "COPARM1-2C-8G").Return(&instance.ServerType{
+					Ncpus: 2,
+					RAM:   8 * 1024 * 1024 * 1024,
+					Arch:  instance.ArchArm64,
+				}, nil)
+			},
+			asserts: func(g *WithT, s *infrav1.ScalewayMachineTemplateStatus) {
+				g.Expect(s.Capacity).To(HaveLen(2))
+				g.Expect(s.Capacity.Cpu().Equal(resource.MustParse("2"))).To(BeTrue())
+				g.Expect(s.Capacity.Memory().Equal(resource.MustParse("8Gi"))).To(BeTrue())
+				g.Expect(s.NodeInfo).To(Equal(infrav1.NodeInfo{
+					Architecture:    infrav1.ArchitectureArm64,
+					OperatingSystem: "linux",
+				}))
+			},
+		},
+		{
+			name: "set gpu capacity",
+			fields: fields{
+				MachineTemplate: &scope.MachineTemplate{
+					Cluster: &scope.Cluster{},
+					ScalewayMachineTemplate: &infrav1.ScalewayMachineTemplate{
+						Spec: infrav1.ScalewayMachineTemplateSpec{
+							Template: infrav1.ScalewayMachineTemplateResource{
+								Spec: infrav1.ScalewayMachineSpec{
+									CommercialType: "GPU-3070-S",
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2})
+				i.GetServerType(gomock.Any(), scw.ZoneFrPar1, "GPU-3070-S").Return(&instance.ServerType{
+					Ncpus: 8,
+					RAM:   16 * 1024 * 1024 * 1024,
+					Gpu:   ptr.To[uint64](1),
+					Arch:  instance.ArchX86_64,
+				}, nil)
+			},
+			asserts: func(g *WithT, s *infrav1.ScalewayMachineTemplateStatus) {
+				g.Expect(s.Capacity).To(HaveLen(3))
+				g.Expect(s.Capacity.Cpu().Equal(resource.MustParse("8"))).To(BeTrue())
+				g.Expect(s.Capacity.Memory().Equal(resource.MustParse("16Gi"))).To(BeTrue())
+				g.Expect(s.Capacity.Name(corev1.ResourceName("nvidia.com/gpu"), resource.DecimalSI).Equal(resource.MustParse("1"))).To(BeTrue())
+				g.Expect(s.NodeInfo.Architecture).To(Equal(infrav1.ArchitectureAmd64))
+			},
+		},
+		{
+			name: "server type only available in a failure domain",
+			fields: fields{
+				MachineTemplate: &scope.MachineTemplate{
+					Cluster: &scope.Cluster{
+						ScalewayCluster: &infrav1.ScalewayCluster{
+							Spec: infrav1.ScalewayClusterSpec{
+								FailureDomains: []infrav1.ScalewayZone{"fr-par-2"},
+							},
+						},
+					},
+					ScalewayMachineTemplate: &infrav1.ScalewayMachineTemplate{
+						Spec: infrav1.ScalewayMachineTemplateSpec{
+							Template: infrav1.ScalewayMachineTemplateResource{
+								Spec: infrav1.ScalewayMachineSpec{
+									CommercialType: "H100-1-80G",
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2, scw.ZoneFrPar3})
+				i.GetServerType(gomock.Any(), scw.ZoneFrPar2, "H100-1-80G").Return(&instance.ServerType{
+					Ncpus: 24,
+					RAM:   240 * 1024 * 1024 * 1024,
+					Gpu:   ptr.To[uint64](1),
+					Arch:  instance.ArchX86_64,
+				}, nil)
+			},
+			asserts: func(g *WithT, s *infrav1.ScalewayMachineTemplateStatus) {
+				g.Expect(s.Capacity.Cpu().Equal(resource.MustParse("24"))).To(BeTrue())
+				g.Expect(s.Capacity.Name(corev1.ResourceName("nvidia.com/gpu"), resource.DecimalSI).Equal(resource.MustParse("1"))).To(BeTrue())
+			},
+		},
+		{
+			name: "server type found in another zone of the region",
+			fields: fields{
+				MachineTemplate: &scope.MachineTemplate{
+					Cluster: &scope.Cluster{},
+					ScalewayMachineTemplate: &infrav1.ScalewayMachineTemplate{
+						Spec: infrav1.ScalewayMachineTemplateSpec{
+							Template: infrav1.ScalewayMachineTemplateResource{
+								Spec: infrav1.ScalewayMachineSpec{
+									CommercialType: "GPU-3070-S",
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2})
+				i.GetServerType(gomock.Any(), scw.ZoneFrPar1, "GPU-3070-S").Return(nil, fmt.Errorf("%w: server type GPU-3070-S not found in zone fr-par-1", client.ErrNoItemFound))
+				i.GetServerType(gomock.Any(), scw.ZoneFrPar2, "GPU-3070-S").Return(&instance.ServerType{
+					Ncpus: 8,
+					RAM:   16 * 1024 * 1024 * 1024,
+					Gpu:   ptr.To[uint64](1),
+					Arch:  instance.ArchX86_64,
+				}, nil)
+			},
+			asserts: func(g *WithT, s *infrav1.ScalewayMachineTemplateStatus) {
+				g.Expect(s.Capacity).To(HaveLen(3))
+				g.Expect(s.NodeInfo.Architecture).To(Equal(infrav1.ArchitectureAmd64))
+			},
+		},
+		{
+			name: "server type not found in any zone",
+			fields: fields{
+				MachineTemplate: &scope.MachineTemplate{
+					Cluster: &scope.Cluster{},
+					ScalewayMachineTemplate: &infrav1.ScalewayMachineTemplate{
+						Spec: infrav1.ScalewayMachineTemplateSpec{
+							Template: infrav1.ScalewayMachineTemplateResource{
+								Spec: infrav1.ScalewayMachineSpec{
+									CommercialType: "UNKNOWN",
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2})
+				i.GetServerType(gomock.Any(), scw.ZoneFrPar1, "UNKNOWN").Return(nil, fmt.Errorf("%w: server type UNKNOWN not found in zone fr-par-1", client.ErrNoItemFound))
+				i.GetServerType(gomock.Any(), scw.ZoneFrPar2, "UNKNOWN").Return(nil, fmt.Errorf("%w: server type UNKNOWN not found in zone fr-par-2", client.ErrNoItemFound))
+			},
+			asserts: func(g *WithT, s *infrav1.ScalewayMachineTemplateStatus) {
+				g.Expect(s.Capacity).To(BeEmpty())
+			},
+		},
+		{
+			name: "server type lookup failed",
+			fields: fields{
+				MachineTemplate: &scope.MachineTemplate{
+					Cluster: &scope.Cluster{},
+					ScalewayMachineTemplate: &infrav1.ScalewayMachineTemplate{
+						Spec: infrav1.ScalewayMachineTemplateSpec{
+							Template: infrav1.ScalewayMachineTemplateResource{
+								Spec: infrav1.ScalewayMachineSpec{
+									CommercialType: "UNKNOWN",
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2})
+				i.GetServerType(gomock.Any(), scw.ZoneFrPar1, "UNKNOWN").Return(nil, errors.New("internal error"))
+			},
+			asserts: func(g *WithT, s *infrav1.ScalewayMachineTemplateStatus) {
+				g.Expect(s.Capacity).To(BeEmpty())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			scwMock := mock_client.NewMockInterface(mockCtrl)
+
+			tt.expect(scwMock.EXPECT())
+
+			s := &Service{
+				MachineTemplate: tt.fields.MachineTemplate,
+			}
+			s.ScalewayClient = scwMock
+			if err := s.Reconcile(tt.args.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("Service.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			tt.asserts(g, &s.ScalewayMachineTemplate.Status)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package remediation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client"
+)
+
+// serverActionRetryInterval is the interval at which the server state is checked
+// while the server is changing state.
+const serverActionRetryInterval = 10 * time.Second
+
+type Service struct {
+	*scope.Remediation
+}
+
+func New(remediationScope *scope.Remediation) *Service {
+	return &Service{Remediation: remediationScope}
+}
+
+func (s *Service) Name() string {
+	return "remediation"
+}
+
+func (s *Service) Delete(_ context.Context) error {
+	return nil
+}
+
+func (s *Service) Reconcile(ctx context.Context) error {
+	status := &s.ScalewayRemediation.Status
+
+	switch status.Phase {
+	case infrav1.RemediationPhaseDeleting:
+		return s.ensureMachineDeleted(ctx)
+	case infrav1.RemediationPhaseWaiting, infrav1.RemediationPhasePoweringOff:
+		remaining := time.Until(status.LastRemediated.Add(s.Timeout()))
+
+		switch {
+		case remaining <= 0 && status.RetryCount >= s.RetryLimit():
+			s.Eventf(corev1.EventTypeWarning, "RemediationFailed", "Remediate",
+				"Machine is still unhealthy after %d remediation attempts, deleting it", status.RetryCount)
+			status.Phase = infrav1.RemediationPhaseDeleting
+			return s.ensureMachineDeleted(ctx)
+		case remaining <= 0:
+			// Start a new remediation attempt.
+			status.Phase = infrav1.RemediationPhaseRunning
+		case status.Phase == infrav1.RemediationPhaseWaiting:
+			// The Machine is still unhealthy, otherwise the MachineHealthCheck would have
+			// deleted the remediation.
+			return scaleway.WithTransientError(errors.New("waiting for machine to become healthy"), remaining)
+		}
+	default:
+		status.Phase = infrav1.RemediationPhaseRunning
+	}
+
+	zone, serverID, err := s.Server()
+	if err != nil {
+		s.Eventf(corev1.EventTypeWarning, "RemediationFailed", "Remediate", "Unable to reboot server, deleting Machine: %s", err)
+		status.Phase = infrav1.RemediationPhaseDeleting
+		return s.ensureMachineDeleted(ctx)
+	}
+
+	server, err := s.ScalewayClient.GetServer(ctx, zone, serverID)
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			s.Eventf(corev1.EventTypeWarning, "RemediationFailed", "Remediate", "Server %s not found, deleting Machine", serverID)
+			status.Phase = infrav1.RemediationPhaseDeleting
+			return s.ensureMachineDeleted(ctx)
+		}
+
+		return err
+	}
+
+	if server.State == instance.ServerStateLocked {
+		s.Eventf(corev1.EventTypeWarning, "RemediationFailed", "Remediate", "Server %s is locked, deleting Machine", serverID)
+		status.Phase = infrav1.RemediationPhaseDeleting
+		return s.ensureMachineDeleted(ctx)
+	}
+
+	if status.Phase == infrav1.RemediationPhasePoweringOff {
+		return s.powerOnStoppedServer(ctx, server)
+	}
+
+	return s.rebootServer(ctx, server)
+}
+
+// rebootServer starts a new remediation attempt: it reboots the server with the
+// remediation strategy. Every attempt counts against the retry limit.
+func (s *Service) rebootServer(ctx context.Context, server *instance.Server) error {
+	status := &s.ScalewayRemediation.Status
+
+	switch server.State {
+	case instance.ServerStateStarting, instance.ServerStateStopping:
+		// An action is already in progress on the server, wait for its outcome.
+		s.startAttempt(infrav1.RemediationPhaseWaiting)
+		s.Eventf(corev1.EventTypeNormal, "ServerRebooting", "Remediate",
+			"Server %s is already %s (attempt %d/%d)", server.ID, server.State, status.RetryCount, s.RetryLimit())
+
+		return scaleway.WithTransientError(errors.New("waiting for machine to become healthy"), s.Timeout())
+	case instance.ServerStateStopped, instance.ServerStateStoppedInPlace:
+		if err := s.ScalewayClient.ServerAction(ctx, server.Zone, server.ID, instance.ServerActionPoweron); err != nil {
+			return err
+		}
+	default:
+		if s.Strategy() == infrav1.RemediationStrategyPowerCycle {
+			if err := s.ScalewayClient.ServerAction(ctx, server.Zone, server.ID, instance.ServerActionStopInPlace); err != nil {
+				return err
+			}
+
+			// The server is powered on once it is stopped.
+			s.startAttempt(infrav1.RemediationPhasePoweringOff)
+			s.Eventf(corev1.EventTypeNormal, "ServerPoweringOff", "Remediate",
+				"Server %s is being stopped in place (attempt %d/%d)", server.ID, status.RetryCount, s.RetryLimit())
+
+			return scaleway.WithTransientError(errors.New("waiting for server to stop"), serverActionRetryInterval)
+		}
+
+		if err := s.ScalewayClient.ServerAction(ctx, server.Zone, server.ID, instance.ServerActionReboot); err != nil {
+			return err
+		}
+	}
+
+	s.startAttempt(infrav1.RemediationPhaseWaiting)
+	s.Eventf(corev1.EventTypeNormal, "ServerRebooted", "Remediate",
+		"Server %s rebooted with strategy %s (attempt %d/%d)", server.ID, s.Strategy(), status.RetryCount, s.RetryLimit())
+
+	return scaleway.WithTransientError(errors.New("waiting for machine to become healthy"), s.Timeout())
+}
+
+// powerOnStoppedServer powers on the server once the stop requested by a PowerCycle
+// remediation is complete. The wait is bounded by the remediation timeout.
+func (s *Service) powerOnStoppedServer(ctx context.Context, server *instance.Server) error {
+	status := &s.ScalewayRemediation.Status
+
+	if server.State != instance.ServerStateStopped && server.State != instance.ServerStateStoppedInPlace {
+		return scaleway.WithTransientError(fmt.Errorf("server is %s, waiting for it to stop", server.State), serverActionRetryInterval)
+	}
+
+	if err := s.ScalewayClient.ServerAction(ctx, server.Zone, server.ID, instance.ServerActionPoweron); err != nil {
+		return err
+	}
+
+	status.LastRemediated = metav1.Now()
+	status.Phase = infrav1.RemediationPhaseWaiting
+
+	s.Eventf(corev1.EventTypeNormal, "ServerRebooted", "Remediate",
+		"Server %s rebooted with strategy %s (attempt %d/%d)", server.ID, s.Strategy(), status.RetryCount, s.RetryLimit())
+
+	return scaleway.WithTransientError(errors.New("waiting for machine to become healthy"), s.Timeout())
+}
+
+// startAttempt records the start of a new remediation attempt.
+func (s *Service) startAttempt(phase string) {
+	status := &s.ScalewayRemediation.Status
+	status.RetryCount++
+	status.LastRemediated = metav1.Now()
+	status.Phase = phase
+}
+
+// ensureMachineDeleted deletes the Machine so that it is replaced by its owner.
+func (s *Service) ensureMachineDeleted(ctx context.Context) error {
+	if !s.Machine.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	logf.FromContext(ctx).Info("Deleting unhealthy Machine", "machine", s.Machine.Name)
+
+	if err := s.Client.Delete(ctx, s.Machine); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete machine: %w", err)
+	}
+
+	return nil
+}
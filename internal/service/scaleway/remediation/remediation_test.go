@@ -0,0 +1,463 @@
+package remediation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	scwclient "github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client/mock_client"
+)
+
+const (
+	serverID   = "11111111-1111-1111-1111-111111111111"
+	providerID = "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111"
+)
+
+func newMachine() *clusterv1.Machine {
+	return &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "machine",
+			Namespace: "default",
+		},
+	}
+}
+
+func newScalewayMachine(providerID string) *infrav1.ScalewayMachine {
+	return &infrav1.ScalewayMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "machine",
+			Namespace: "default",
+		},
+		Spec: infrav1.ScalewayMachineSpec{
+			ProviderID: providerID,
+		},
+	}
+}
+
+func TestService_Reconcile(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		Remediation *scope.Remediation
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		expect  func(i *mock_client.MockInterfaceMockRecorder)
+		asserts func(g *WithT, r *scope.Remediation, c client.Client)
+	}{
+		{
+			name: "reboot running server",
+			fields: fields{
+				Remediation: &scope.Remediation{
+					Machine:         newMachine(),
+					ScalewayMachine: newScalewayMachine(providerID),
+					ScalewayRemediation: &infrav1.ScalewayRemediation{
+						Spec: infrav1.ScalewayRemediationSpec{
+							Strategy:       infrav1.RemediationStrategyReboot,
+							RetryLimit:     2,
+							TimeoutSeconds: 60,
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetServer(gomock.Any(), scw.ZoneFrPar1, serverID).Return(&instance.Server{
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateRunning,
+				}, nil)
+				i.ServerAction(gomock.Any(), scw.ZoneFrPar1, serverID, instance.ServerActionReboot)
+			},
+			asserts: func(g *WithT, r *scope.Remediation, c client.Client) {
+				status := r.ScalewayRemediation.Status
+				g.Expect(status.Phase).To(Equal(infrav1.RemediationPhaseWaiting))
+				g.Expect(status.RetryCount).To(BeEquivalentTo(1))
+				g.Expect(status.LastRemediated.IsZero()).To(BeFalse())
+			},
+		},
+		{
+			name: "power cycle: stop running server",
+			fields: fields{
+				Remediation: &scope.Remediation{
+					Machine:         newMachine(),
+					ScalewayMachine: newScalewayMachine(providerID),
+					ScalewayRemediation: &infrav1.ScalewayRemediation{
+						Spec: infrav1.ScalewayRemediationSpec{
+							Strategy: infrav1.RemediationStrategyPowerCycle,
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetServer(gomock.Any(), scw.ZoneFrPar1, serverID).Return(&instance.Server{
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateRunning,
+				}, nil)
+				i.ServerAction(gomock.Any(), scw.ZoneFrPar1, serverID, instance.ServerActionStopInPlace)
+			},
+			asserts: func(g *WithT, r *scope.Remediation, c client.Client) {
+				status := r.ScalewayRemediation.Status
+				g.Expect(status.Phase).To(Equal(infrav1.RemediationPhasePoweringOff))
+				g.Expect(status.RetryCount).To(BeEquivalentTo(1))
+				g.Expect(status.LastRemediated.IsZero()).To(BeFalse())
+			},
+		},
+		{
+			name: "power cycle: wait for server to stop",
+			fields: fields{
+				Remediation: &scope.Remediation{
+					Machine:         newMachine(),
+					ScalewayMachine: newScalewayMachine(providerID),
+					ScalewayRemediation: &infrav1.ScalewayRemediation{
+						Spec: infrav1.ScalewayRemediationSpec{
+							Strategy:       infrav1.RemediationStrategyPowerCycle,
+							RetryLimit:     1,
+							TimeoutSeconds: 300,
+						},
+						Status: infrav1.ScalewayRemediationStatus{
+							Phase:          infrav1.RemediationPhasePoweringOff,
+							RetryCount:     1,
+							LastRemediated: metav1.Now(),
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetServer(gomock.Any(), scw.ZoneFrPar1, serverID).Return(&instance.Server{
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateStopping,
+				}, nil)
+			},
+			asserts: func(g *WithT, r *scope.Remediation, c client.Client) {
+				status := r.ScalewayRemediation.Status
+				g.Expect(status.Phase).To(Equal(infrav1.RemediationPhasePoweringOff))
+				g.Expect(status.RetryCount).To(BeEquivalentTo(1))
+			},
+		},
+		{
+			name: "power cycle: stop server again after timeout",
+			fields: fields{
+				Remediation: &scope.Remediation{
+					Machine:         newMachine(),
+					ScalewayMachine: newScalewayMachine(providerID),
+					ScalewayRemediation: &infrav1.ScalewayRemediation{
+						Spec: infrav1.ScalewayRemediationSpec{
+							Strategy:       infrav1.RemediationStrategyPowerCycle,
+							RetryLimit:     2,
+							TimeoutSeconds: 60,
+						},
+						Status: infrav1.ScalewayRemediationStatus{
+							Phase:          infrav1.RemediationPhasePoweringOff,
+							RetryCount:     1,
+							LastRemediated: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetServer(gomock.Any(), scw.ZoneFrPar1, serverID).Return(&instance.Server{
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateRunning,
+				}, nil)
+				i.ServerAction(gomock.Any(), scw.ZoneFrPar1, serverID, instance.ServerActionStopInPlace)
+			},
+			asserts: func(g *WithT, r *scope.Remediation, c client.Client) {
+				status := r.ScalewayRemediation.Status
+				g.Expect(status.Phase).To(Equal(infrav1.RemediationPhasePoweringOff))
+				g.Expect(status.RetryCount).To(BeEquivalentTo(2))
+			},
+		},
+		{
+			name: "power cycle: delete machine when server does not stop",
+			fields: fields{
+				Remediation: &scope.Remediation{
+					Machine:         newMachine(),
+					ScalewayMachine: newScalewayMachine(providerID),
+					ScalewayRemediation: &infrav1.ScalewayRemediation{
+						Spec: infrav1.ScalewayRemediationSpec{
+							Strategy:       infrav1.RemediationStrategyPowerCycle,
+							RetryLimit:     1,
+							TimeoutSeconds: 60,
+						},
+						Status: infrav1.ScalewayRemediationStatus{
+							Phase:          infrav1.RemediationPhasePoweringOff,
+							RetryCount:     1,
+							LastRemediated: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {},
+			asserts: func(g *WithT, r *scope.Remediation, c client.Client) {
+				g.Expect(r.ScalewayRemediation.Status.Phase).To(Equal(infrav1.RemediationPhaseDeleting))
+				g.Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(r.Machine), &clusterv1.Machine{})).NotTo(Succeed())
+			},
+		},
+		{
+			name: "server is already changing state",
+			fields: fields{
+				Remediation: &scope.Remediation{
+					Machine:         newMachine(),
+					ScalewayMachine: newScalewayMachine(providerID),
+					ScalewayRemediation: &infrav1.ScalewayRemediation{
+						Spec: infrav1.ScalewayRemediationSpec{
+							Strategy: infrav1.RemediationStrategyReboot,
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetServer(gomock.Any(), scw.ZoneFrPar1, serverID).Return(&instance.Server{
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateStarting,
+				}, nil)
+			},
+			asserts: func(g *WithT, r *scope.Remediation, c client.Client) {
+				status := r.ScalewayRemediation.Status
+				g.Expect(status.Phase).To(Equal(infrav1.RemediationPhaseWaiting))
+				g.Expect(status.RetryCount).To(BeEquivalentTo(1))
+			},
+		},
+		{
+			name: "power cycle: power on stopped server",
+			fields: fields{
+				Remediation: &scope.Remediation{
+					Machine:         newMachine(),
+					ScalewayMachine: newScalewayMachine(providerID),
+					ScalewayRemediation: &infrav1.ScalewayRemediation{
+						Spec: infrav1.ScalewayRemediationSpec{
+							Strategy: infrav1.RemediationStrategyPowerCycle,
+						},
+						Status: infrav1.ScalewayRemediationStatus{
+							Phase:          infrav1.RemediationPhasePoweringOff,
+							RetryCount:     1,
+							LastRemediated: metav1.Now(),
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetServer(gomock.Any(), scw.ZoneFrPar1, serverID).Return(&instance.Server{
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateStoppedInPlace,
+				}, nil)
+				i.ServerAction(gomock.Any(), scw.ZoneFrPar1, serverID, instance.ServerActionPoweron)
+			},
+			asserts: func(g *WithT, r *scope.Remediation, c client.Client) {
+				status := r.ScalewayRemediation.Status
+				g.Expect(status.Phase).To(Equal(infrav1.RemediationPhaseWaiting))
+				g.Expect(status.RetryCount).To(BeEquivalentTo(1))
+			},
+		},
+		{
+			name: "wait for machine to become healthy",
+			fields: fields{
+				Remediation: &scope.Remediation{
+					Machine:         newMachine(),
+					ScalewayMachine: newScalewayMachine(providerID),
+					ScalewayRemediation: &infrav1.ScalewayRemediation{
+						Spec: infrav1.ScalewayRemediationSpec{
+							RetryLimit:     1,
+							TimeoutSeconds: 300,
+						},
+						Status: infrav1.ScalewayRemediationStatus{
+							Phase:          infrav1.RemediationPhaseWaiting,
+							RetryCount:     1,
+							LastRemediated: metav1.Now(),
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect:  func(i *mock_client.MockInterfaceMockRecorder) {},
+			asserts: func(g *WithT, r *scope.Remediation, c client.Client) {
+				g.Expect(r.ScalewayRemediation.Status.Phase).To(Equal(infrav1.RemediationPhaseWaiting))
+				g.Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(r.Machine), &clusterv1.Machine{})).To(Succeed())
+			},
+		},
+		{
+			name: "retry after timeout",
+			fields: fields{
+				Remediation: &scope.Remediation{
+					Machine:         newMachine(),
+					ScalewayMachine: newScalewayMachine(providerID),
+					ScalewayRemediation: &infrav1.ScalewayRemediation{
+						Spec: infrav1.ScalewayRemediationSpec{
+							RetryLimit:     2,
+							TimeoutSeconds: 60,
+						},
+						Status: infrav1.ScalewayRemediationStatus{
+							Phase:          infrav1.RemediationPhaseWaiting,
+							RetryCount:     1,
+							LastRemediated: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetServer(gomock.Any(), scw.ZoneFrPar1, serverID).Return(&instance.Server{
+					ID:    serverID,
+					Zone:  scw.ZoneFrPar1,
+					State: instance.ServerStateRunning,
+				}, nil)
+				i.ServerAction(gomock.Any(), scw.ZoneFrPar1, serverID, instance.ServerActionReboot)
+			},
+			asserts: func(g *WithT, r *scope.Remediation, c client.Client) {
+				status := r.ScalewayRemediation.Status
+				g.Expect(status.Phase).To(Equal(infrav1.RemediationPhaseWaiting))
+				g.Expect(status.RetryCount).To(BeEquivalentTo(2))
+			},
+		},
+		{
+			name: "delete machine when retries are exhausted",
+			fields: fields{
+				Remediation: &scope.Remediation{
+					Machine:         newMachine(),
+					ScalewayMachine: newScalewayMachine(providerID),
+					ScalewayRemediation: &infrav1.ScalewayRemediation{
+						Spec: infrav1.ScalewayRemediationSpec{
+							RetryLimit:     1,
+							TimeoutSeconds: 60,
+						},
+						Status: infrav1.ScalewayRemediationStatus{
+							Phase:          infrav1.RemediationPhaseWaiting,
+							RetryCount:     1,
+							LastRemediated: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+						},
+					},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {},
+			asserts: func(g *WithT, r *scope.Remediation, c client.Client) {
+				g.Expect(r.ScalewayRemediation.Status.Phase).To(Equal(infrav1.RemediationPhaseDeleting))
+				g.Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(r.Machine), &clusterv1.Machine{})).NotTo(Succeed())
+			},
+		},
+		{
+			name: "delete machine when server is not found",
+			fields: fields{
+				Remediation: &scope.Remediation{
+					Machine:             newMachine(),
+					ScalewayMachine:     newScalewayMachine(providerID),
+					ScalewayRemediation: &infrav1.ScalewayRemediation{},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetServer(gomock.Any(), scw.ZoneFrPar1, serverID).Return(nil, scwclient.ErrNoItemFound)
+			},
+			asserts: func(g *WithT, r *scope.Remediation, c client.Client) {
+				g.Expect(r.ScalewayRemediation.Status.Phase).To(Equal(infrav1.RemediationPhaseDeleting))
+				g.Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(r.Machine), &clusterv1.Machine{})).NotTo(Succeed())
+			},
+		},
+		{
+			name: "delete machine that is not backed by a ScalewayMachine",
+			fields: fields{
+				Remediation: &scope.Remediation{
+					Machine:             newMachine(),
+					ScalewayRemediation: &infrav1.ScalewayRemediation{},
+				},
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {},
+			asserts: func(g *WithT, r *scope.Remediation, c client.Client) {
+				g.Expect(r.ScalewayRemediation.Status.Phase).To(Equal(infrav1.RemediationPhaseDeleting))
+				g.Expect(c.Get(context.TODO(), client.ObjectKeyFromObject(r.Machine), &clusterv1.Machine{})).NotTo(Succeed())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			scwMock := mock_client.NewMockInterface(mockCtrl)
+
+			tt.expect(scwMock.EXPECT())
+
+			sch := runtime.NewScheme()
+			g.Expect(clusterv1.AddToScheme(sch)).To(Succeed())
+
+			c := fake.NewClientBuilder().WithScheme(sch).WithObjects(tt.fields.Remediation.Machine).Build()
+
+			s := &Service{
+				Remediation: tt.fields.Remediation,
+			}
+			s.Cluster = &scope.Cluster{ScalewayClient: scwMock}
+			s.Client = c
+			if err := s.Reconcile(tt.args.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("Service.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			tt.asserts(g, s.Remediation, c)
+		})
+	}
+}
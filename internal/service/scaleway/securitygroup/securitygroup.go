@@ -0,0 +1,377 @@
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	servicelb "github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/lb"
+)
+
+const (
+	// Security Group Tags.
+	CAPSControlPlaneSGTag = "caps-sg=control-plane"
+	CAPSWorkerSGTag       = "caps-sg=worker"
+
+	// NodePort range of Kubernetes services.
+	nodePortFrom = uint32(30000)
+	nodePortTo   = uint32(32767)
+
+	// anyIPv4Range is used when no NodePort allowed range is set.
+	anyIPv4Range = "0.0.0.0/0"
+)
+
+type Service struct {
+	*scope.Cluster
+}
+
+func New(clusterScope *scope.Cluster) *Service {
+	return &Service{Cluster: clusterScope}
+}
+
+func (s *Service) Name() string {
+	return "securitygroup"
+}
+
+func (s *Service) Reconcile(ctx context.Context) (retErr error) {
+	if !s.HasManagedSecurityGroups() {
+		conditions.Set(s.ScalewayCluster, metav1.Condition{
+			Type:   infrav1.ScalewayClusterSecurityGroupsReadyCondition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.ScalewayClusterNoSecurityGroupsReason,
+		})
+		return nil
+	}
+
+	defer func() {
+		condition := metav1.Condition{
+			Type: infrav1.ScalewayClusterSecurityGroupsReadyCondition,
+		}
+		if retErr != nil {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = infrav1.ScalewayClusterSecurityGroupsReconciliationFailedReason
+			condition.Message = retErr.Error()
+		} else {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = infrav1.ScalewayClusterSecurityGroupsReadyReason
+		}
+		conditions.Set(s.ScalewayCluster, condition)
+	}()
+
+	controlPlaneRules, workerRules, err := s.desiredRules(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, zone := range s.ScalewayClient.GetControlPlaneZones() {
+		if err := s.ensureSecurityGroup(ctx, zone, CAPSControlPlaneSGTag, "control-plane", controlPlaneRules); err != nil {
+			return fmt.Errorf("failed to ensure control-plane security group in zone %s: %w", zone, err)
+		}
+
+		if err := s.ensureSecurityGroup(ctx, zone, CAPSWorkerSGTag, "worker", workerRules); err != nil {
+			return fmt.Errorf("failed to ensure worker security group in zone %s: %w", zone, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) Delete(ctx context.Context) error {
+	if !s.HasManagedSecurityGroups() {
+		return nil
+	}
+
+	for _, zone := range s.ScalewayClient.GetControlPlaneZones() {
+		for _, tag := range []string{CAPSControlPlaneSGTag, CAPSWorkerSGTag} {
+			sg, err := s.ScalewayClient.FindSecurityGroupByTags(ctx, zone, s.ResourceTags(tag))
+			if err != nil {
+				if client.IsNotFoundError(err) {
+					continue
+				}
+
+				return err
+			}
+
+			if err := s.ScalewayClient.DeleteSecurityGroup(ctx, zone, sg.ID); err != nil {
+				// The security group may still be attached to servers that are being deleted.
+				if client.IsPreconditionFailedError(err) {
+					return scaleway.WithTransientError(err, 5*time.Second)
+				}
+
+				return fmt.Errorf("failed to delete security group: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// desiredRules returns the inbound rules of the control-plane and worker security groups.
+// Security groups filter the traffic of the public interface of the servers (public
+// IPv4 and IPv6). Traffic coming from the Private Network subnets is accepted on all
+// protocols and ports on both groups, so that intra-cluster traffic (CNI overlay,
+// admission webhooks, etcd, kubelet, etc.) is never dropped.
+func (s *Service) desiredRules(ctx context.Context) (controlPlane, worker []*instance.SetSecurityGroupRulesRequestRule, err error) {
+	pnID, err := s.PrivateNetworkID()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pn, err := s.ScalewayClient.GetPrivateNetwork(ctx, pnID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pnRanges []string
+	for _, subnet := range pn.Subnets {
+		if subnet.Subnet.IP.To4() != nil {
+			pnRanges = append(pnRanges, subnet.Subnet.String())
+		}
+	}
+
+	lbIPs, err := s.controlPlaneLBIPs(ctx, pnID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	apiServerPorts := []uint32{uint32(servicelb.BackendControlPlanePort)}
+	for _, port := range s.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.AdditionalPorts {
+		apiServerPorts = append(apiServerPorts, uint32(port.TargetPort))
+	}
+
+	spec := s.ScalewayCluster.Spec.Network.SecurityGroups
+
+	// Control-plane rules.
+	for _, lbIP := range lbIPs {
+		for _, port := range apiServerPorts {
+			controlPlane, err = appendRule(controlPlane, instance.SecurityGroupRuleProtocolTCP, lbIP.Address.IP.String()+"/32", port, port)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	controlPlane, err = appendPrivateNetworkRules(controlPlane, pnRanges)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	controlPlane, err = appendUserRules(controlPlane, spec.ControlPlaneRules)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Worker rules.
+	worker, err = appendPrivateNetworkRules(worker, pnRanges)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodePortRanges := []string{anyIPv4Range}
+	if len(spec.NodePortAllowedRanges) > 0 {
+		nodePortRanges = nodePortRanges[:0]
+		for _, cidr := range spec.NodePortAllowedRanges {
+			nodePortRanges = append(nodePortRanges, string(cidr))
+		}
+	}
+
+	for _, nodePortRange := range nodePortRanges {
+		for _, protocol := range []instance.SecurityGroupRuleProtocol{
+			instance.SecurityGroupRuleProtocolTCP,
+			instance.SecurityGroupRuleProtocolUDP,
+		} {
+			worker, err = appendRule(worker, protocol, nodePortRange, nodePortFrom, nodePortTo)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	worker, err = appendUserRules(worker, spec.WorkerRules)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return controlPlane, worker, nil
+}
+
+// controlPlaneLBIPs returns the private IPs of the control-plane loadbalancers.
+// When the control-plane loadbalancer is externally managed, no IP is returned
+// and the rules allowing it must be set by the user.
+func (s *Service) controlPlaneLBIPs(ctx context.Context, pnID string) ([]*ipam.IP, error) {
+	if s.ControlPlaneLoadBalancerExternallyManaged() {
+		return nil, nil
+	}
+
+	lbs, err := servicelb.FindControlPlaneLBs(ctx, s.Cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	lbIDs := make([]string, 0, len(lbs))
+	for _, loadbalancer := range lbs {
+		lbIDs = append(lbIDs, loadbalancer.ID)
+	}
+
+	lbIPs, err := s.ScalewayClient.FindLBServersIPs(ctx, pnID, lbIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lbIPs) == 0 {
+		return nil, scaleway.WithTransientError(fmt.Errorf("private IPs of loadbalancers are not yet available in IPAM"), 3*time.Second)
+	}
+
+	return lbIPs, nil
+}
+
+func (s *Service) ensureSecurityGroup(
+	ctx context.Context,
+	zone scw.Zone,
+	tag, suffix string,
+	desired []*instance.SetSecurityGroupRulesRequestRule,
+) error {
+	sg, err := s.ScalewayClient.FindSecurityGroupByTags(ctx, zone, s.ResourceTags(tag))
+	switch {
+	case client.IsNotFoundError(err):
+		sg, err = s.ScalewayClient.CreateSecurityGroup(ctx, zone, s.ResourceName(suffix), s.ResourceTags(tag))
+		if err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	}
+
+	current, err := s.ScalewayClient.ListSecurityGroupRules(ctx, zone, sg.ID)
+	if err != nil {
+		return err
+	}
+
+	if rulesMatch(current, desired) {
+		return nil
+	}
+
+	return s.ScalewayClient.SetSecurityGroupRules(ctx, zone, sg.ID, desired)
+}
+
+// rulesMatch returns true if the editable rules of the security group are the desired rules.
+func rulesMatch(current []*instance.SecurityGroupRule, desired []*instance.SetSecurityGroupRulesRequestRule) bool {
+	currentKeys := make([]string, 0, len(current))
+	for _, rule := range current {
+		if !rule.Editable {
+			continue
+		}
+
+		currentKeys = append(currentKeys, ruleKey(rule.Direction, rule.Action, rule.Protocol, rule.IPRange, rule.DestPortFrom, rule.DestPortTo))
+	}
+
+	desiredKeys := make([]string, 0, len(desired))
+	for _, rule := range desired {
+		desiredKeys = append(desiredKeys, ruleKey(rule.Direction, rule.Action, rule.Protocol, rule.IPRange, rule.DestPortFrom, rule.DestPortTo))
+	}
+
+	slices.Sort(currentKeys)
+	slices.Sort(desiredKeys)
+
+	return slices.Equal(currentKeys, desiredKeys)
+}
+
+func ruleKey(
+	direction instance.SecurityGroupRuleDirection,
+	action instance.SecurityGroupRuleAction,
+	protocol instance.SecurityGroupRuleProtocol,
+	ipRange scw.IPNet,
+	portFrom, portTo *uint32,
+) string {
+	// The API may omit portTo when it is equal to portFrom.
+	if portTo == nil {
+		portTo = portFrom
+	}
+
+	return fmt.Sprintf("%s/%s/%s/%s/%d-%d", direction, action, protocol, ipRange.String(), ptr.Deref(portFrom, 0), ptr.Deref(portTo, 0))
+}
+
+// appendRule appends an inbound rule that accepts traffic from ipRange.
+// If portFrom is 0, all ports are allowed.
+func appendRule(
+	rules []*instance.SetSecurityGroupRulesRequestRule,
+	protocol instance.SecurityGroupRuleProtocol,
+	ipRange string,
+	portFrom, portTo uint32,
+) ([]*instance.SetSecurityGroupRulesRequestRule, error) {
+	_, ipNet, err := net.ParseCIDR(ipRange)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse IP range %s: %w", ipRange, err)
+	}
+
+	rule := &instance.SetSecurityGroupRulesRequestRule{
+		Action:    instance.SecurityGroupRuleActionAccept,
+		Protocol:  protocol,
+		Direction: instance.SecurityGroupRuleDirectionInbound,
+		IPRange:   scw.IPNet{IPNet: *ipNet},
+		Position:  uint32(len(rules) + 1),
+		Editable:  ptr.To(true),
+	}
+
+	if portFrom != 0 {
+		rule.DestPortFrom = &portFrom
+
+		if portTo > portFrom {
+			rule.DestPortTo = &portTo
+		}
+	}
+
+	return append(rules, rule), nil
+}
+
+// appendPrivateNetworkRules appends rules that accept all traffic from the Private Network subnets.
+func appendPrivateNetworkRules(
+	rules []*instance.SetSecurityGroupRulesRequestRule,
+	pnRanges []string,
+) ([]*instance.SetSecurityGroupRulesRequestRule, error) {
+	var err error
+
+	for _, pnRange := range pnRanges {
+		rules, err = appendRule(rules, instance.SecurityGroupRuleProtocolANY, pnRange, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rules, nil
+}
+
+func appendUserRules(
+	rules []*instance.SetSecurityGroupRulesRequestRule,
+	userRules []infrav1.SecurityGroupRule,
+) ([]*instance.SetSecurityGroupRulesRequestRule, error) {
+	var err error
+
+	for _, userRule := range userRules {
+		rules, err = appendRule(
+			rules,
+			instance.SecurityGroupRuleProtocol(userRule.Protocol),
+			string(userRule.IPRange),
+			uint32(userRule.PortFrom),
+			uint32(userRule.PortTo),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rules, nil
+}
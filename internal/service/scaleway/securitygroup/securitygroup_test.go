@@ -0,0 +1,462 @@
+package securitygroup
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/client/mock_client"
+	servicelb "github.com/scaleway/cluster-api-provider-scaleway/internal/service/scaleway/lb"
+)
+
+const (
+	privateNetworkID  = "11111111-1111-1111-1111-111111111111"
+	lbID              = "11111111-1111-1111-1111-111111111111"
+	controlPlaneSGID  = "11111111-1111-1111-1111-111111111111"
+	workerSGID        = "22222222-2222-2222-2222-222222222222"
+	nonEditableRuleID = "33333333-3333-3333-3333-333333333333"
+)
+
+var clusterTags = []string{"caps-namespace=default", "caps-scalewaycluster=cluster"}
+
+func newCluster(securityGroups infrav1.SecurityGroupsSpec) *scope.Cluster {
+	return &scope.Cluster{
+		ScalewayCluster: &infrav1.ScalewayCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1.ScalewayClusterSpec{
+				Network: infrav1.ScalewayClusterNetwork{
+					PrivateNetwork: infrav1.PrivateNetworkSpec{
+						Enabled: ptr.To(true),
+					},
+					ControlPlaneLoadBalancer: infrav1.ControlPlaneLoadBalancer{
+						AdditionalPorts: []infrav1.LoadBalancerPort{{
+							Port:       9345,
+							TargetPort: 9345,
+						}},
+					},
+					SecurityGroups: securityGroups,
+				},
+			},
+			Status: infrav1.ScalewayClusterStatus{
+				Network: infrav1.ScalewayClusterNetworkStatus{
+					PrivateNetworkID: privateNetworkID,
+				},
+			},
+		},
+	}
+}
+
+func inboundRule(position uint32, protocol instance.SecurityGroupRuleProtocol, cidr string, portFrom, portTo uint32) *instance.SetSecurityGroupRulesRequestRule {
+	_, ipNet, _ := net.ParseCIDR(cidr)
+
+	rule := &instance.SetSecurityGroupRulesRequestRule{
+		Action:    instance.SecurityGroupRuleActionAccept,
+		Protocol:  protocol,
+		Direction: instance.SecurityGroupRuleDirectionInbound,
+		IPRange:   scw.IPNet{IPNet: *ipNet},
+		Position:  position,
+		Editable:  ptr.To(true),
+	}
+
+	if portFrom != 0 {
+		rule.DestPortFrom = ptr.To(portFrom)
+	}
+
+	if portTo != 0 {
+		rule.DestPortTo = ptr.To(portTo)
+	}
+
+	return rule
+}
+
+var (
+	controlPlaneRules = []*instance.SetSecurityGroupRulesRequestRule{
+		inboundRule(1, instance.SecurityGroupRuleProtocolTCP, "10.0.0.2/32", 6443, 0),
+		inboundRule(2, instance.SecurityGroupRuleProtocolTCP, "10.0.0.2/32", 9345, 0),
+		inboundRule(3, instance.SecurityGroupRuleProtocolANY, "10.0.0.0/22", 0, 0),
+		inboundRule(4, instance.SecurityGroupRuleProtocolTCP, "42.42.42.0/24", 22, 0),
+	}
+	workerRules = []*instance.SetSecurityGroupRulesRequestRule{
+		inboundRule(1, instance.SecurityGroupRuleProtocolANY, "10.0.0.0/22", 0, 0),
+		inboundRule(2, instance.SecurityGroupRuleProtocolTCP, "0.0.0.0/0", 30000, 32767),
+		inboundRule(3, instance.SecurityGroupRuleProtocolUDP, "0.0.0.0/0", 30000, 32767),
+		inboundRule(4, instance.SecurityGroupRuleProtocolICMP, "0.0.0.0/0", 0, 0),
+	}
+	securityGroupsSpec = infrav1.SecurityGroupsSpec{
+		Enabled: ptr.To(true),
+		ControlPlaneRules: []infrav1.SecurityGroupRule{
+			{Protocol: "TCP", PortFrom: 22, IPRange: "42.42.42.0/24"},
+		},
+		WorkerRules: []infrav1.SecurityGroupRule{
+			{Protocol: "ICMP", IPRange: "0.0.0.0/0"},
+		},
+	}
+)
+
+// toCurrentRules converts desired rules to rules as returned by the API.
+func toCurrentRules(rules []*instance.SetSecurityGroupRulesRequestRule) []*instance.SecurityGroupRule {
+	out := []*instance.SecurityGroupRule{
+		{
+			ID:           nonEditableRuleID,
+			Protocol:     instance.SecurityGroupRuleProtocolTCP,
+			Direction:    instance.SecurityGroupRuleDirectionOutbound,
+			Action:       instance.SecurityGroupRuleActionDrop,
+			DestPortFrom: ptr.To(uint32(25)),
+			Editable:     false,
+		},
+	}
+
+	for _, rule := range rules {
+		out = append(out, &instance.SecurityGroupRule{
+			Protocol:     rule.Protocol,
+			Direction:    rule.Direction,
+			Action:       rule.Action,
+			IPRange:      rule.IPRange,
+			DestPortFrom: rule.DestPortFrom,
+			DestPortTo:   rule.DestPortTo,
+			Position:     rule.Position,
+			Editable:     true,
+		})
+	}
+
+	return out
+}
+
+func expectDesiredRules(i *mock_client.MockInterfaceMockRecorder) {
+	i.GetPrivateNetwork(gomock.Any(), privateNetworkID).Return(&vpc.PrivateNetwork{
+		ID: privateNetworkID,
+		Subnets: []*vpc.Subnet{
+			{Subnet: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 0).To4(), Mask: net.CIDRMask(22, 32)}}},
+			{Subnet: scw.IPNet{IPNet: net.IPNet{IP: net.ParseIP("fd00::"), Mask: net.CIDRMask(64, 128)}}},
+		},
+	}, nil)
+	i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+	i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(&lb.LB{
+		ID:   lbID,
+		Zone: scw.ZoneFrPar1,
+	}, nil)
+	i.FindLBs(gomock.Any(), append(clusterTags, servicelb.CAPSExtraLBTag)).Return(nil, nil)
+	i.FindLBServersIPs(gomock.Any(), privateNetworkID, []string{lbID}).Return([]*ipam.IP{
+		{Address: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 2), Mask: net.CIDRMask(22, 32)}}},
+	}, nil)
+}
+
+func TestService_Reconcile(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		Cluster *scope.Cluster
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		expect  func(i *mock_client.MockInterfaceMockRecorder)
+		asserts func(g *WithT, c *scope.Cluster)
+	}{
+		{
+			name: "no managed security groups",
+			fields: fields{
+				Cluster: newCluster(infrav1.SecurityGroupsSpec{}),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {},
+			asserts: func(g *WithT, c *scope.Cluster) {
+				condition := conditions.Get(c.ScalewayCluster, infrav1.ScalewayClusterSecurityGroupsReadyCondition)
+				g.Expect(condition).NotTo(BeNil())
+				g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(condition.Reason).To(Equal(infrav1.ScalewayClusterNoSecurityGroupsReason))
+			},
+		},
+		{
+			name: "create security groups",
+			fields: fields{
+				Cluster: newCluster(securityGroupsSpec),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				expectDesiredRules(i)
+				i.GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1})
+
+				i.FindSecurityGroupByTags(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, CAPSControlPlaneSGTag)).Return(nil, client.ErrNoItemFound)
+				i.CreateSecurityGroup(gomock.Any(), scw.ZoneFrPar1, "cluster-control-plane", append(clusterTags, CAPSControlPlaneSGTag)).Return(&instance.SecurityGroup{
+					ID: controlPlaneSGID,
+				}, nil)
+				i.ListSecurityGroupRules(gomock.Any(), scw.ZoneFrPar1, controlPlaneSGID).Return(toCurrentRules(nil), nil)
+				i.SetSecurityGroupRules(gomock.Any(), scw.ZoneFrPar1, controlPlaneSGID, controlPlaneRules)
+
+				i.FindSecurityGroupByTags(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, CAPSWorkerSGTag)).Return(nil, client.ErrNoItemFound)
+				i.CreateSecurityGroup(gomock.Any(), scw.ZoneFrPar1, "cluster-worker", append(clusterTags, CAPSWorkerSGTag)).Return(&instance.SecurityGroup{
+					ID: workerSGID,
+				}, nil)
+				i.ListSecurityGroupRules(gomock.Any(), scw.ZoneFrPar1, workerSGID).Return(toCurrentRules(nil), nil)
+				i.SetSecurityGroupRules(gomock.Any(), scw.ZoneFrPar1, workerSGID, workerRules)
+			},
+			asserts: func(g *WithT, c *scope.Cluster) {
+				g.Expect(conditions.IsTrue(c.ScalewayCluster, infrav1.ScalewayClusterSecurityGroupsReadyCondition)).To(BeTrue())
+			},
+		},
+		{
+			name: "security groups are up to date",
+			fields: fields{
+				Cluster: newCluster(securityGroupsSpec),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				expectDesiredRules(i)
+				i.GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1})
+
+				i.FindSecurityGroupByTags(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, CAPSControlPlaneSGTag)).Return(&instance.SecurityGroup{
+					ID: controlPlaneSGID,
+				}, nil)
+				i.ListSecurityGroupRules(gomock.Any(), scw.ZoneFrPar1, controlPlaneSGID).Return(toCurrentRules(controlPlaneRules), nil)
+
+				i.FindSecurityGroupByTags(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, CAPSWorkerSGTag)).Return(&instance.SecurityGroup{
+					ID: workerSGID,
+				}, nil)
+				i.ListSecurityGroupRules(gomock.Any(), scw.ZoneFrPar1, workerSGID).Return(toCurrentRules(workerRules), nil)
+			},
+			asserts: func(g *WithT, c *scope.Cluster) {
+				g.Expect(conditions.IsTrue(c.ScalewayCluster, infrav1.ScalewayClusterSecurityGroupsReadyCondition)).To(BeTrue())
+			},
+		},
+		{
+			name: "externally managed loadbalancer: no loadbalancer rules",
+			fields: fields{
+				Cluster: func() *scope.Cluster {
+					c := newCluster(securityGroupsSpec)
+					c.ScalewayCluster.Spec.Network.ControlPlaneLoadBalancer.ExternallyManaged = ptr.To(true)
+					return c
+				}(),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetPrivateNetwork(gomock.Any(), privateNetworkID).Return(&vpc.PrivateNetwork{
+					ID: privateNetworkID,
+					Subnets: []*vpc.Subnet{
+						{Subnet: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(10, 0, 0, 0).To4(), Mask: net.CIDRMask(22, 32)}}},
+					},
+				}, nil)
+				i.GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1})
+
+				i.FindSecurityGroupByTags(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, CAPSControlPlaneSGTag)).Return(&instance.SecurityGroup{
+					ID: controlPlaneSGID,
+				}, nil)
+				i.ListSecurityGroupRules(gomock.Any(), scw.ZoneFrPar1, controlPlaneSGID).Return(toCurrentRules(controlPlaneRules), nil)
+				i.SetSecurityGroupRules(gomock.Any(), scw.ZoneFrPar1, controlPlaneSGID, []*instance.SetSecurityGroupRulesRequestRule{
+					inboundRule(1, instance.SecurityGroupRuleProtocolANY, "10.0.0.0/22", 0, 0),
+					inboundRule(2, instance.SecurityGroupRuleProtocolTCP, "42.42.42.0/24", 22, 0),
+				})
+
+				i.FindSecurityGroupByTags(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, CAPSWorkerSGTag)).Return(&instance.SecurityGroup{
+					ID: workerSGID,
+				}, nil)
+				i.ListSecurityGroupRules(gomock.Any(), scw.ZoneFrPar1, workerSGID).Return(toCurrentRules(workerRules), nil)
+			},
+			asserts: func(g *WithT, c *scope.Cluster) {
+				g.Expect(conditions.IsTrue(c.ScalewayCluster, infrav1.ScalewayClusterSecurityGroupsReadyCondition)).To(BeTrue())
+			},
+		},
+		{
+			name: "loadbalancer private IP not available",
+			fields: fields{
+				Cluster: newCluster(securityGroupsSpec),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetPrivateNetwork(gomock.Any(), privateNetworkID).Return(&vpc.PrivateNetwork{
+					ID: privateNetworkID,
+				}, nil)
+				i.GetZoneOrDefault("").Return(scw.ZoneFrPar1, nil)
+				i.FindLB(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, servicelb.CAPSMainLBTag)).Return(&lb.LB{
+					ID:   lbID,
+					Zone: scw.ZoneFrPar1,
+				}, nil)
+				i.FindLBs(gomock.Any(), append(clusterTags, servicelb.CAPSExtraLBTag)).Return(nil, nil)
+				i.FindLBServersIPs(gomock.Any(), privateNetworkID, []string{lbID}).Return(nil, nil)
+			},
+			asserts: func(g *WithT, c *scope.Cluster) {
+				g.Expect(conditions.IsFalse(c.ScalewayCluster, infrav1.ScalewayClusterSecurityGroupsReadyCondition)).To(BeTrue())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			scwMock := mock_client.NewMockInterface(mockCtrl)
+
+			tt.expect(scwMock.EXPECT())
+
+			s := &Service{
+				Cluster: tt.fields.Cluster,
+			}
+			s.ScalewayClient = scwMock
+			if err := s.Reconcile(tt.args.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("Service.Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			tt.asserts(g, s.Cluster)
+		})
+	}
+}
+
+func TestService_desiredRules(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	scwMock := mock_client.NewMockInterface(mockCtrl)
+	expectDesiredRules(scwMock.EXPECT())
+
+	s := New(newCluster(infrav1.SecurityGroupsSpec{Enabled: ptr.To(true)}))
+	s.ScalewayClient = scwMock
+
+	controlPlane, worker, err := s.desiredRules(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// Nodes must be able to reach each other on all protocols and ports through
+	// the Private Network (CNI overlay, admission webhooks, kubelet, etcd, etc.).
+	intraCluster := inboundRule(0, instance.SecurityGroupRuleProtocolANY, "10.0.0.0/22", 0, 0)
+	for _, rules := range [][]*instance.SetSecurityGroupRulesRequestRule{controlPlane, worker} {
+		g.Expect(rules).To(ContainElement(And(
+			HaveField("Protocol", intraCluster.Protocol),
+			HaveField("Direction", intraCluster.Direction),
+			HaveField("Action", intraCluster.Action),
+			HaveField("IPRange", intraCluster.IPRange),
+			HaveField("DestPortFrom", BeNil()),
+			HaveField("DestPortTo", BeNil()),
+		)))
+	}
+
+	// IPv6 subnets of the Private Network are not added to the rules.
+	for _, rule := range append(controlPlane, worker...) {
+		g.Expect(rule.IPRange.IP.To4()).NotTo(BeNil())
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		Cluster *scope.Cluster
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+		expect  func(i *mock_client.MockInterfaceMockRecorder)
+	}{
+		{
+			name: "no managed security groups",
+			fields: fields{
+				Cluster: newCluster(infrav1.SecurityGroupsSpec{}),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {},
+		},
+		{
+			name: "delete security groups",
+			fields: fields{
+				Cluster: newCluster(securityGroupsSpec),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2})
+
+				i.FindSecurityGroupByTags(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, CAPSControlPlaneSGTag)).Return(&instance.SecurityGroup{
+					ID: controlPlaneSGID,
+				}, nil)
+				i.DeleteSecurityGroup(gomock.Any(), scw.ZoneFrPar1, controlPlaneSGID)
+				i.FindSecurityGroupByTags(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, CAPSWorkerSGTag)).Return(&instance.SecurityGroup{
+					ID: workerSGID,
+				}, nil)
+				i.DeleteSecurityGroup(gomock.Any(), scw.ZoneFrPar1, workerSGID)
+				i.FindSecurityGroupByTags(gomock.Any(), scw.ZoneFrPar2, append(clusterTags, CAPSControlPlaneSGTag)).Return(nil, client.ErrNoItemFound)
+				i.FindSecurityGroupByTags(gomock.Any(), scw.ZoneFrPar2, append(clusterTags, CAPSWorkerSGTag)).Return(nil, client.ErrNoItemFound)
+			},
+		},
+		{
+			name: "security group still in use",
+			fields: fields{
+				Cluster: newCluster(securityGroupsSpec),
+			},
+			args: args{
+				ctx: context.TODO(),
+			},
+			wantErr: true,
+			expect: func(i *mock_client.MockInterfaceMockRecorder) {
+				i.GetControlPlaneZones().Return([]scw.Zone{scw.ZoneFrPar1})
+
+				i.FindSecurityGroupByTags(gomock.Any(), scw.ZoneFrPar1, append(clusterTags, CAPSControlPlaneSGTag)).Return(&instance.SecurityGroup{
+					ID: controlPlaneSGID,
+				}, nil)
+				i.DeleteSecurityGroup(gomock.Any(), scw.ZoneFrPar1, controlPlaneSGID).Return(&scw.PreconditionFailedError{})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			scwMock := mock_client.NewMockInterface(mockCtrl)
+
+			tt.expect(scwMock.EXPECT())
+
+			s := &Service{
+				Cluster: tt.fields.Cluster,
+			}
+			s.ScalewayClient = scwMock
+			if err := s.Delete(tt.args.ctx); (err != nil) != tt.wantErr {
+				t.Errorf("Service.Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,8 +1,13 @@
 package v1alpha2
 
 import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
 )
@@ -11,7 +16,70 @@ import (
 // log is for logging in this package.
 var scalewayclusterlog = logf.Log.WithName("scalewaycluster-resource")
 
+// ScalewayClusterCustomValidator struct is responsible for validating the ScalewayCluster resource
+// when it is created, updated, or deleted.
+//
+// NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
+// as this struct is used only for temporary operations and does not need to be deeply copied.
+type ScalewayClusterCustomValidator struct{}
+
 // SetupScalewayClusterWebhookWithManager registers the webhook for ScalewayCluster in the manager.
 func SetupScalewayClusterWebhookWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewWebhookManagedBy(mgr, &infrav1.ScalewayCluster{}).Complete()
+	return ctrl.NewWebhookManagedBy(mgr, &infrav1.ScalewayCluster{}).
+		WithValidator(&ScalewayClusterCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha2-scalewaycluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=scalewayclusters,verbs=create;update,versions=v1alpha2,name=vscalewaycluster-v1alpha2.kb.io,admissionReviewVersions=v1
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ScalewayCluster.
+func (webhook *ScalewayClusterCustomValidator) ValidateCreate(_ context.Context, obj *infrav1.ScalewayCluster) (admission.Warnings, error) {
+	scalewayclusterlog.Info("Validation for ScalewayCluster upon creation", "name", obj.GetName())
+	return nil, validateScalewayCluster(obj)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ScalewayCluster.
+func (webhook *ScalewayClusterCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj *infrav1.ScalewayCluster) (admission.Warnings, error) {
+	scalewayclusterlog.Info("Validation for ScalewayCluster upon update", "name", newObj.GetName())
+
+	// An existing cluster that already has an invalid backend can still be updated
+	// (e.g. to remove its finalizer), as long as the backend is not changed.
+	if oldObj.Spec.Network.ControlPlaneLoadBalancer.Backend == newObj.Spec.Network.ControlPlaneLoadBalancer.Backend {
+		return nil, nil
+	}
+
+	return nil, validateScalewayCluster(newObj)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ScalewayCluster.
+func (webhook *ScalewayClusterCustomValidator) ValidateDelete(_ context.Context, obj *infrav1.ScalewayCluster) (admission.Warnings, error) {
+	scalewayclusterlog.Info("Validation for ScalewayCluster upon deletion", "name", obj.GetName())
+	return nil, nil
+}
+
+func validateScalewayCluster(obj *infrav1.ScalewayCluster) error {
+	allErrs := validateControlPlaneLoadBalancerBackend(obj.Spec.Network.ControlPlaneLoadBalancer)
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(infrav1.GroupVersion.WithKind("ScalewayCluster").GroupKind(), obj.Name, allErrs)
+}
+
+// validateControlPlaneLoadBalancerBackend validates that the PROXY protocol is not
+// enabled on the kube-apiserver backend: the kube-apiserver does not support it, the
+// control plane endpoint would no longer work. It can only be enabled on the backends
+// of the additional ports.
+func validateControlPlaneLoadBalancerBackend(spec infrav1.ControlPlaneLoadBalancer) field.ErrorList {
+	proxyProtocol := spec.Backend.ProxyProtocol
+	if proxyProtocol == "" || proxyProtocol == infrav1.LoadBalancerProxyProtocolNone {
+		return nil
+	}
+
+	return field.ErrorList{
+		field.Forbidden(
+			field.NewPath("spec", "network", "controlPlaneLoadBalancer", "backend", "proxyProtocol"),
+			"the PROXY protocol is not supported by the kube-apiserver, it can only be enabled on additionalPorts",
+		),
+	}
 }
@@ -0,0 +1,71 @@
+package v1alpha2
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+)
+
+var _ = Describe("ScalewayCluster Webhook", func() {
+	var (
+		obj       *infrav1.ScalewayCluster
+		oldObj    *infrav1.ScalewayCluster
+		validator ScalewayClusterCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &infrav1.ScalewayCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-cluster",
+			},
+			Spec: infrav1.ScalewayClusterSpec{
+				Region:             "fr-par",
+				ProjectID:          "11111111-1111-1111-1111-111111111111",
+				ScalewaySecretName: "secret",
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = ScalewayClusterCustomValidator{}
+		Expect(validator).NotTo(BeNil(), "Expected validator to be initialized")
+	})
+
+	Context("When creating or updating ScalewayCluster under Validating Webhook", func() {
+		It("Should allow a cluster without backend settings", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).To(BeNil())
+		})
+
+		It("Should allow the PROXY protocol on additional ports", func() {
+			obj.Spec.Network.ControlPlaneLoadBalancer.AdditionalPorts = []infrav1.LoadBalancerPort{
+				{
+					Port:       443,
+					TargetPort: 30443,
+					Backend: infrav1.LoadBalancerBackend{
+						ProxyProtocol: infrav1.LoadBalancerProxyProtocolV2,
+					},
+				},
+			}
+			obj.Spec.Network.ControlPlaneLoadBalancer.Backend.ProxyProtocol = infrav1.LoadBalancerProxyProtocolNone
+			Expect(validator.ValidateCreate(ctx, obj)).To(BeNil())
+		})
+
+		It("Should reject the PROXY protocol on the kube-apiserver backend", func() {
+			obj.Spec.Network.ControlPlaneLoadBalancer.Backend.ProxyProtocol = infrav1.LoadBalancerProxyProtocolV2
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.network.controlPlaneLoadBalancer.backend.proxyProtocol"))
+
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should allow updating a cluster whose kube-apiserver backend is unchanged", func() {
+			oldObj.Spec.Network.ControlPlaneLoadBalancer.Backend.ProxyProtocol = infrav1.LoadBalancerProxyProtocolV2
+			obj.Spec.Network.ControlPlaneLoadBalancer.Backend.ProxyProtocol = infrav1.LoadBalancerProxyProtocolV2
+			obj.Finalizers = nil
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,82 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/util/compare"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var scalewayelasticmetalmachinelog = logf.Log.WithName("scalewayelasticmetalmachine-resource")
+
+// ScalewayElasticMetalMachineCustomValidator struct is responsible for validating the ScalewayElasticMetalMachine resource
+// when it is created, updated, or deleted.
+//
+// NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
+// as this struct is used only for temporary operations and does not need to be deeply copied.
+type ScalewayElasticMetalMachineCustomValidator struct{}
+
+// SetupScalewayElasticMetalMachineWebhookWithManager registers the webhook for ScalewayElasticMetalMachine in the manager.
+func SetupScalewayElasticMetalMachineWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &infrav1.ScalewayElasticMetalMachine{}).
+		WithValidator(&ScalewayElasticMetalMachineCustomValidator{}).
+		Complete()
+}
+
+func ignoreElasticMetalProviderID(old infrav1.ScalewayElasticMetalMachineSpec) cmp.Option {
+	if old.ProviderID == "" {
+		return cmpopts.IgnoreFields(infrav1.ScalewayElasticMetalMachineSpec{}, "ProviderID")
+	}
+	return cmp.AllowUnexported()
+}
+
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha2-scalewayelasticmetalmachine,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=scalewayelasticmetalmachines,verbs=create;update,versions=v1alpha2,name=vscalewayelasticmetalmachine-v1alpha2.kb.io,admissionReviewVersions=v1
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ScalewayElasticMetalMachine .
+func (webhook *ScalewayElasticMetalMachineCustomValidator) ValidateCreate(_ context.Context, obj *infrav1.ScalewayElasticMetalMachine) (admission.Warnings, error) {
+	scalewayelasticmetalmachinelog.Info("Validation for ScalewayElasticMetalMachine upon creation", "name", obj.GetName())
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ScalewayElasticMetalMachine.
+func (webhook *ScalewayElasticMetalMachineCustomValidator) ValidateUpdate(_ context.Context, oldObj *infrav1.ScalewayElasticMetalMachine, newObj *infrav1.ScalewayElasticMetalMachine) (admission.Warnings, error) {
+	scalewayelasticmetalmachinelog.Info("Validation for ScalewayElasticMetalMachine upon update", "name", oldObj.GetName())
+
+	var allErrs field.ErrorList
+
+	opts := []cmp.Option{}
+	opts = append(opts, ignoreElasticMetalProviderID(oldObj.Spec))
+
+	equal, diff, err := compare.Diff(oldObj.Spec, newObj.Spec, opts...)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("failed to compare old and new ScalewayElasticMetalMachine: %v", err))
+	}
+	if !equal {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec"), newObj, fmt.Sprintf("ScalewayElasticMetalMachine spec field is immutable. Please create a new resource instead. Diff: %s", diff)),
+		)
+	}
+
+	if len(allErrs) == 0 {
+		return nil, nil
+	}
+
+	return nil, apierrors.NewInvalid(infrav1.GroupVersion.WithKind("ScalewayElasticMetalMachine").GroupKind(), newObj.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ScalewayElasticMetalMachine.
+func (webhook *ScalewayElasticMetalMachineCustomValidator) ValidateDelete(_ context.Context, obj *infrav1.ScalewayElasticMetalMachine) (admission.Warnings, error) {
+	scalewayelasticmetalmachinelog.Info("Validation for ScalewayElasticMetalMachine upon deletion", "name", obj.GetName())
+	return nil, nil
+}
@@ -0,0 +1,86 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/cluster-api/util/topology"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+	"github.com/scaleway/cluster-api-provider-scaleway/internal/util/compare"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var scalewayelasticmetalmachinetemplatelog = logf.Log.WithName("scalewayelasticmetalmachinetemplate-resource")
+
+// ScalewayElasticMetalMachineTemplateCustomValidator struct is responsible for validating the ScalewayElasticMetalMachineTemplate resource
+// when it is created, updated, or deleted.
+//
+// NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
+// as this struct is used only for temporary operations and does not need to be deeply copied.
+type ScalewayElasticMetalMachineTemplateCustomValidator struct{}
+
+// SetupScalewayElasticMetalMachineTemplateWebhookWithManager registers the webhook for ScalewayElasticMetalMachineTemplate in the manager.
+func SetupScalewayElasticMetalMachineTemplateWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &infrav1.ScalewayElasticMetalMachineTemplate{}).
+		WithValidator(&ScalewayElasticMetalMachineTemplateCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha2-scalewayelasticmetalmachinetemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=scalewayelasticmetalmachinetemplates,verbs=create;update,versions=v1alpha2,name=vscalewayelasticmetalmachinetemplate-v1alpha2.kb.io,admissionReviewVersions=v1
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ScalewayElasticMetalMachineTemplate.
+func (v *ScalewayElasticMetalMachineTemplateCustomValidator) ValidateCreate(_ context.Context, obj *infrav1.ScalewayElasticMetalMachineTemplate) (admission.Warnings, error) {
+	scalewayelasticmetalmachinetemplatelog.Info("Validation for ScalewayElasticMetalMachineTemplate upon creation", "name", obj.GetName())
+	// Validate the metadata of the template.
+	allErrs := obj.Spec.Template.ObjectMeta.Validate(field.NewPath("spec", "template", "metadata"))
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(infrav1.GroupVersion.WithKind("ScalewayElasticMetalMachineTemplate").GroupKind(), obj.Name, allErrs)
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ScalewayElasticMetalMachineTemplate.
+func (v *ScalewayElasticMetalMachineTemplateCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *infrav1.ScalewayElasticMetalMachineTemplate) (admission.Warnings, error) {
+	scalewayelasticmetalmachinetemplatelog.Info("Validation for ScalewayElasticMetalMachineTemplate upon update", "name", newObj.GetName())
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a admission.Request inside context: %v", err))
+	}
+
+	var allErrs field.ErrorList
+	if !topology.IsDryRunRequest(req, newObj) {
+		scalewayelasticmetalmachinetemplatelog.Info("ValidateUpdate ScalewayElasticMetalMachineTemplateValidator Not DryRun", "name", oldObj.GetName())
+		equal, diff, err := compare.Diff(oldObj.Spec.Template.Spec, newObj.Spec.Template.Spec)
+		if err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("failed to compare old and new ScalewayElasticMetalMachineTemplate: %v", err))
+		}
+		if !equal {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "template", "spec"), newObj, fmt.Sprintf("ScalewayElasticMetalMachineTemplate spec.template.spec field is immutable. Please create a new resource instead. Diff: %s", diff)),
+			)
+		}
+	} else {
+		scalewayelasticmetalmachinetemplatelog.Info("ValidateUpdate ScalewayElasticMetalMachineTemplateValidator DryRun", "name", oldObj.GetName())
+	}
+
+	// Validate the metadata of the template.
+	allErrs = append(allErrs, newObj.Spec.Template.ObjectMeta.Validate(field.NewPath("spec", "template", "metadata"))...)
+
+	if len(allErrs) == 0 {
+		return nil, nil
+	}
+	return nil, apierrors.NewInvalid(infrav1.GroupVersion.WithKind("ScalewayElasticMetalMachineTemplate").GroupKind(), newObj.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ScalewayElasticMetalMachineTemplate.
+func (v *ScalewayElasticMetalMachineTemplateCustomValidator) ValidateDelete(_ context.Context, obj *infrav1.ScalewayElasticMetalMachineTemplate) (admission.Warnings, error) {
+	scalewayelasticmetalmachinetemplatelog.Info("Validation for ScalewayElasticMetalMachineTemplate upon deletion", "name", obj.GetName())
+	return nil, nil
+}
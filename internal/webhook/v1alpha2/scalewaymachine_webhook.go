@@ -57,6 +57,17 @@ func (webhook *ScalewayMachineCustomValidator) ValidateUpdate(_ context.Context,
 
 	opts := []cmp.Option{}
 	opts = append(opts, ignoreProviderID(oldObj.Spec))
+	// Tags are reconciled on existing resources, they can be updated.
+	opts = append(opts, cmpopts.IgnoreFields(infrav1.ScalewayMachineSpec{}, "AdditionalTags"))
+	// The unhealthy server policy only changes how the server health is reported.
+	opts = append(opts, cmpopts.IgnoreFields(infrav1.ScalewayMachineSpec{}, "UnhealthyServerPolicy"))
+	// The deletion strategy is only used when the server is deleted.
+	opts = append(opts, cmpopts.IgnoreFields(infrav1.ScalewayMachineSpec{}, "DeletionStrategy"))
+	// Block volumes can be grown and their IOPS updated, this is validated below.
+	opts = append(opts, cmpopts.IgnoreFields(infrav1.RootVolume{}, "Size", "IOPS"))
+	opts = append(opts, cmpopts.IgnoreFields(infrav1.AdditionalVolume{}, "Size", "IOPS"))
+	// NAT IPs can be migrated to routed IPs, this is validated below.
+	opts = append(opts, cmpopts.IgnoreFields(infrav1.PublicNetwork{}, "IPType"))
 
 	equal, diff, err := compare.Diff(oldObj.Spec, newObj.Spec, opts...)
 	if err != nil {
@@ -68,6 +79,9 @@ func (webhook *ScalewayMachineCustomValidator) ValidateUpdate(_ context.Context,
 		)
 	}
 
+	allErrs = append(allErrs, validateVolumesUpdate(oldObj.Spec, newObj.Spec)...)
+	allErrs = append(allErrs, validatePublicIPTypeUpdate(oldObj, newObj)...)
+
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -75,6 +89,62 @@ func (webhook *ScalewayMachineCustomValidator) ValidateUpdate(_ context.Context,
 	return nil, apierrors.NewInvalid(infrav1.GroupVersion.WithKind("ScalewayMachine").GroupKind(), newObj.Name, allErrs)
 }
 
+// validateVolumesUpdate validates that only the size and IOPS of block volumes are
+// updated and that volumes are not shrunk.
+func validateVolumesUpdate(oldSpec, newSpec infrav1.ScalewayMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	rootPath := field.NewPath("spec", "rootVolume")
+	oldRoot, newRoot := oldSpec.RootVolume, newSpec.RootVolume
+	if oldRoot.Size != newRoot.Size || oldRoot.IOPS != newRoot.IOPS {
+		if newRoot.Type != "" && newRoot.Type != "block" {
+			allErrs = append(allErrs, field.Forbidden(rootPath, "only block root volumes can be resized"))
+		} else if newRoot.Size < oldRoot.Size {
+			allErrs = append(allErrs, field.Invalid(rootPath.Child("size"), newRoot.Size, "root volume cannot be shrunk"))
+		}
+	}
+
+	for i := range min(len(oldSpec.AdditionalVolumes), len(newSpec.AdditionalVolumes)) {
+		volPath := field.NewPath("spec", "additionalVolumes").Index(i)
+		oldVol, newVol := oldSpec.AdditionalVolumes[i], newSpec.AdditionalVolumes[i]
+		if oldVol.Size == newVol.Size && oldVol.IOPS == newVol.IOPS {
+			continue
+		}
+
+		if newVol.Type != "block" {
+			allErrs = append(allErrs, field.Forbidden(volPath, "only block volumes can be resized"))
+		} else if newVol.Size < oldVol.Size {
+			allErrs = append(allErrs, field.Invalid(volPath.Child("size"), newVol.Size, "volume cannot be shrunk"))
+		}
+	}
+
+	return allErrs
+}
+
+// validatePublicIPTypeUpdate validates that routed IPs are not migrated to NAT IPs.
+// ipType can be set to NAT on an existing machine only when it does not already
+// use routed IPs, e.g. to pin the type of the NAT IPs of the machine.
+func validatePublicIPTypeUpdate(oldObj, newObj *infrav1.ScalewayMachine) field.ErrorList {
+	if newObj.Spec.PublicNetwork.IPType != infrav1.PublicIPTypeNAT || oldObj.Spec.PublicNetwork.IPType == infrav1.PublicIPTypeNAT {
+		return nil
+	}
+
+	routed := oldObj.Spec.PublicNetwork.IPType == infrav1.PublicIPTypeRouted
+	for _, ip := range oldObj.Status.PublicIPs {
+		if ip.Type == infrav1.PublicIPTypeRouted {
+			routed = true
+		}
+	}
+
+	if !routed {
+		return nil
+	}
+
+	return field.ErrorList{
+		field.Forbidden(field.NewPath("spec", "publicNetwork", "ipType"), "routed IPs cannot be migrated to NAT IPs"),
+	}
+}
+
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ScalewayMachine.
 func (webhook *ScalewayMachineCustomValidator) ValidateDelete(_ context.Context, obj *infrav1.ScalewayMachine) (admission.Warnings, error) {
 	scalewaymachinelog.Info("Validation for ScalewayMachine upon deletion", "name", obj.GetName())
@@ -39,4 +39,88 @@ var _ = Describe("ScalewayMachine Webhook", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+	Context("When creating or updating ScalewayMachine under Validating Webhook", func() {
+		It("Should allow updating additional tags", func() {
+			By("simulating an update of the additional tags")
+			oldObj.Spec.AdditionalTags = []string{"env=dev"}
+			obj.Spec.AdditionalTags = []string{"env=prod"}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).To(BeNil())
+		})
+	})
+	Context("When creating or updating ScalewayMachine under Validating Webhook", func() {
+		It("Should allow updating the unhealthy server policy", func() {
+			By("simulating an update of the unhealthy server policy")
+			obj.Spec.UnhealthyServerPolicy = "Fail"
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).To(BeNil())
+		})
+
+		It("Should allow updating the deletion strategy", func() {
+			By("simulating an update of the deletion strategy")
+			obj.Spec.DeletionStrategy = infrav1.DeletionStrategy{
+				Policy:                         infrav1.DeletionPolicyArchive,
+				GracefulShutdownTimeoutSeconds: 60,
+			}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).To(BeNil())
+		})
+	})
+	Context("When creating or updating ScalewayMachine under Validating Webhook", func() {
+		It("Should allow growing block volumes", func() {
+			By("simulating an increase of the size and IOPS of block volumes")
+			oldObj.Spec.RootVolume = infrav1.RootVolume{Type: "block", Size: 20}
+			oldObj.Spec.AdditionalVolumes = []infrav1.AdditionalVolume{{Type: "block", Size: 20, IOPS: 5000}}
+			obj.Spec.RootVolume = infrav1.RootVolume{Type: "block", Size: 50, IOPS: 15000}
+			obj.Spec.AdditionalVolumes = []infrav1.AdditionalVolume{{Type: "block", Size: 100, IOPS: 15000}}
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).To(BeNil())
+		})
+
+		It("Should reject shrinking block volumes", func() {
+			By("simulating a decrease of the size of a block volume")
+			oldObj.Spec.AdditionalVolumes = []infrav1.AdditionalVolume{{Type: "block", Size: 20}}
+			obj.Spec.AdditionalVolumes = []infrav1.AdditionalVolume{{Type: "block", Size: 10}}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should reject resizing local volumes", func() {
+			By("simulating an increase of the size of a local root volume")
+			oldObj.Spec.RootVolume = infrav1.RootVolume{Type: "local", Size: 20}
+			obj.Spec.RootVolume = infrav1.RootVolume{Type: "local", Size: 50}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should allow migrating NAT IPs to routed IPs", func() {
+			By("simulating an update of the public IP type from NAT to Routed")
+			oldObj.Spec.PublicNetwork.IPType = infrav1.PublicIPTypeNAT
+			obj.Spec.PublicNetwork.IPType = infrav1.PublicIPTypeRouted
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).To(BeNil())
+		})
+
+		It("Should reject migrating routed IPs to NAT IPs", func() {
+			By("simulating an update of the public IP type from Routed to NAT")
+			oldObj.Spec.PublicNetwork.IPType = infrav1.PublicIPTypeRouted
+			obj.Spec.PublicNetwork.IPType = infrav1.PublicIPTypeNAT
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should reject setting NAT IPs on a machine with routed IPs", func() {
+			By("simulating an update of the public IP type from unset to NAT")
+			oldObj.Status.PublicIPs = []infrav1.MachinePublicIPStatus{
+				{Address: "51.15.0.1", Type: infrav1.PublicIPTypeRouted},
+			}
+			obj.Spec.PublicNetwork.IPType = infrav1.PublicIPTypeNAT
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should allow setting NAT IPs on a machine with NAT IPs", func() {
+			By("simulating an update of the public IP type from unset to NAT")
+			oldObj.Status.PublicIPs = []infrav1.MachinePublicIPStatus{
+				{Address: "51.15.0.1", Type: infrav1.PublicIPTypeNAT},
+			}
+			obj.Spec.PublicNetwork.IPType = infrav1.PublicIPTypeNAT
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).To(BeNil())
+		})
+	})
 })
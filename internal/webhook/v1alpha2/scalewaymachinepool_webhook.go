@@ -0,0 +1,89 @@
+package v1alpha2
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var scalewaymachinepoollog = logf.Log.WithName("scalewaymachinepool-resource")
+
+// ScalewayMachinePoolCustomValidator struct is responsible for validating the ScalewayMachinePool resource
+// when it is created, updated, or deleted.
+//
+// NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
+// as this struct is used only for temporary operations and does not need to be deeply copied.
+type ScalewayMachinePoolCustomValidator struct{}
+
+// SetupScalewayMachinePoolWebhookWithManager registers the webhook for ScalewayMachinePool in the manager.
+func SetupScalewayMachinePoolWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &infrav1.ScalewayMachinePool{}).
+		WithValidator(&ScalewayMachinePoolCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1alpha2-scalewaymachinepool,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=scalewaymachinepools,verbs=create;update,versions=v1alpha2,name=vscalewaymachinepool-v1alpha2.kb.io,admissionReviewVersions=v1
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ScalewayMachinePool.
+func (v *ScalewayMachinePoolCustomValidator) ValidateCreate(_ context.Context, obj *infrav1.ScalewayMachinePool) (admission.Warnings, error) {
+	scalewaymachinepoollog.Info("Validation for ScalewayMachinePool upon creation", "name", obj.GetName())
+	return nil, validateScalewayMachinePool(obj)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ScalewayMachinePool.
+func (v *ScalewayMachinePoolCustomValidator) ValidateUpdate(_ context.Context, _, newObj *infrav1.ScalewayMachinePool) (admission.Warnings, error) {
+	scalewaymachinepoollog.Info("Validation for ScalewayMachinePool upon update", "name", newObj.GetName())
+	// The template can be updated, the servers of the pool are then replaced.
+	return nil, validateScalewayMachinePool(newObj)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ScalewayMachinePool.
+func (v *ScalewayMachinePoolCustomValidator) ValidateDelete(_ context.Context, obj *infrav1.ScalewayMachinePool) (admission.Warnings, error) {
+	scalewaymachinepoollog.Info("Validation for ScalewayMachinePool upon deletion", "name", obj.GetName())
+	return nil, nil
+}
+
+func validateScalewayMachinePool(obj *infrav1.ScalewayMachinePool) error {
+	templatePath := field.NewPath("spec", "template")
+	allErrs := validateTemplateSpec(obj.Spec.Template, templatePath)
+
+	// The health of the servers of the pool is not persisted between reconciles.
+	if obj.Spec.Template.UnhealthyServerPolicy != "" {
+		allErrs = append(allErrs, field.Forbidden(templatePath.Child("unhealthyServerPolicy"), "unhealthyServerPolicy is not supported for servers of a ScalewayMachinePool"))
+	}
+
+	deletionStrategyPath := templatePath.Child("deletionStrategy")
+
+	// Servers of the pool are replaced and scaled down without a Machine, they cannot be kept.
+	if obj.Spec.Template.DeletionStrategy.Policy == infrav1.DeletionPolicyArchive {
+		allErrs = append(allErrs, field.Forbidden(deletionStrategyPath.Child("policy"), "servers of a ScalewayMachinePool cannot be archived"))
+	}
+
+	// The deletion progress of the servers of the pool is not persisted between reconciles.
+	if obj.Spec.Template.DeletionStrategy.GracefulShutdownTimeoutSeconds != 0 {
+		allErrs = append(allErrs, field.Forbidden(deletionStrategyPath.Child("gracefulShutdownTimeoutSeconds"), "servers of a ScalewayMachinePool cannot be shut down gracefully"))
+	}
+	if obj.Spec.Template.DeletionStrategy.SnapshotRootVolume != nil {
+		allErrs = append(allErrs, field.Forbidden(deletionStrategyPath.Child("snapshotRootVolume"), "root volumes of the servers of a ScalewayMachinePool cannot be snapshotted"))
+	}
+
+	// Protected servers are only deleted with their Machine, servers of the pool have none.
+	if ptr.Deref(obj.Spec.Template.Protected, false) {
+		allErrs = append(allErrs, field.Forbidden(templatePath.Child("protected"), "servers of a ScalewayMachinePool cannot be protected"))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(infrav1.GroupVersion.WithKind("ScalewayMachinePool").GroupKind(), obj.Name, allErrs)
+}
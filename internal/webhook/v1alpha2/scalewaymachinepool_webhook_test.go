@@ -0,0 +1,129 @@
+package v1alpha2
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrav1 "github.com/scaleway/cluster-api-provider-scaleway/api/v1alpha2"
+)
+
+var _ = Describe("ScalewayMachinePool Webhook", func() {
+	var (
+		obj       *infrav1.ScalewayMachinePool
+		validator ScalewayMachinePoolCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &infrav1.ScalewayMachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-machine-pool",
+			},
+			Spec: infrav1.ScalewayMachinePoolSpec{
+				Template: infrav1.ScalewayMachineSpec{
+					CommercialType: "DEV1-S",
+					Image: infrav1.Image{
+						IDOrName: infrav1.IDOrName{
+							Name: "scaleway-image",
+						},
+					},
+				},
+			},
+		}
+		validator = ScalewayMachinePoolCustomValidator{}
+		Expect(validator).NotTo(BeNil(), "Expected validator to be initialized")
+	})
+
+	Context("When creating or updating ScalewayMachinePool", func() {
+		It("Should pass with a private address range", func() {
+			obj.Spec.Template.PrivateNetwork = infrav1.MachinePrivateNetwork{
+				AddressRange: "10.0.0.0/24",
+			}
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("Should reject a static private address", func() {
+			obj.Spec.Template.PrivateNetwork = infrav1.MachinePrivateNetwork{
+				Address: "10.0.0.10",
+			}
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(HaveOccurred())
+		})
+		It("Should reject an IPAM IP on update", func() {
+			oldObj := obj.DeepCopy()
+			obj.Spec.Template.PrivateNetwork = infrav1.MachinePrivateNetwork{
+				IPAMIPID: "11111111-1111-1111-1111-111111111111",
+			}
+			By("calling the validateUpdate method")
+			_, err := validator.ValidateUpdate(context.Background(), oldObj, obj)
+			Expect(err).To(HaveOccurred())
+		})
+		It("Should reject a flexible IP selected by id", func() {
+			obj.Spec.Template.PublicNetwork.IPv4 = infrav1.FlexibleIPReference{
+				ID: "11111111-1111-1111-1111-111111111111",
+			}
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(HaveOccurred())
+		})
+		It("Should reject a flexible IP selected by address", func() {
+			obj.Spec.Template.PublicNetwork.IPv4 = infrav1.FlexibleIPReference{
+				Address: "51.15.0.1",
+			}
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(HaveOccurred())
+		})
+		It("Should reject an unhealthy server policy", func() {
+			obj.Spec.Template.UnhealthyServerPolicy = "Fail"
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(HaveOccurred())
+		})
+		It("Should reject the Archive deletion policy", func() {
+			obj.Spec.Template.DeletionStrategy = infrav1.DeletionStrategy{
+				Policy: infrav1.DeletionPolicyArchive,
+			}
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(HaveOccurred())
+		})
+		It("Should pass with the Delete deletion policy", func() {
+			obj.Spec.Template.DeletionStrategy = infrav1.DeletionStrategy{
+				Policy: infrav1.DeletionPolicyDelete,
+			}
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("Should reject a graceful shutdown timeout", func() {
+			obj.Spec.Template.DeletionStrategy = infrav1.DeletionStrategy{
+				GracefulShutdownTimeoutSeconds: 60,
+			}
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(HaveOccurred())
+		})
+		It("Should reject a root volume snapshot", func() {
+			oldObj := obj.DeepCopy()
+			obj.Spec.Template.DeletionStrategy = infrav1.DeletionStrategy{
+				SnapshotRootVolume: ptr.To(true),
+			}
+			By("calling the validateUpdate method")
+			_, err := validator.ValidateUpdate(context.Background(), oldObj, obj)
+			Expect(err).To(HaveOccurred())
+		})
+		It("Should reject protected servers", func() {
+			obj.Spec.Template.Protected = ptr.To(true)
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -40,6 +40,7 @@ func (v *ScalewayMachineTemplateCustomValidator) ValidateCreate(_ context.Contex
 	scalewaymachinetemplatelog.Info("Validation for ScalewayMachineTemplate upon creation", "name", obj.GetName())
 	// Validate the metadata of the template.
 	allErrs := obj.Spec.Template.ObjectMeta.Validate(field.NewPath("spec", "template", "metadata"))
+	allErrs = append(allErrs, validateTemplateSpec(obj.Spec.Template.Spec, field.NewPath("spec", "template", "spec"))...)
 	if len(allErrs) > 0 {
 		return nil, apierrors.NewInvalid(infrav1.GroupVersion.WithKind("ScalewayMachineTemplate").GroupKind(), obj.Name, allErrs)
 	}
@@ -72,6 +73,7 @@ func (v *ScalewayMachineTemplateCustomValidator) ValidateUpdate(ctx context.Cont
 
 	// Validate the metadata of the template.
 	allErrs = append(allErrs, newObj.Spec.Template.ObjectMeta.Validate(field.NewPath("spec", "template", "metadata"))...)
+	allErrs = append(allErrs, validateTemplateSpec(newObj.Spec.Template.Spec, field.NewPath("spec", "template", "spec"))...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
@@ -79,6 +81,38 @@ func (v *ScalewayMachineTemplateCustomValidator) ValidateUpdate(ctx context.Cont
 	return nil, apierrors.NewInvalid(infrav1.GroupVersion.WithKind("ScalewayMachineTemplate").GroupKind(), newObj.Name, allErrs)
 }
 
+// validateTemplateSpec validates the fields of a ScalewayMachineSpec that cannot be
+// set when the spec is a template for multiple machines.
+func validateTemplateSpec(spec infrav1.ScalewayMachineSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	privateNetworkPath := path.Child("privateNetwork")
+	if spec.PrivateNetwork.Address != "" {
+		allErrs = append(allErrs, field.Forbidden(privateNetworkPath.Child("address"), "a static private IP cannot be shared by multiple machines, use addressRange instead"))
+	}
+	if spec.PrivateNetwork.IPAMIPID != "" {
+		allErrs = append(allErrs, field.Forbidden(privateNetworkPath.Child("ipamIPID"), "an IPAM IP cannot be shared by multiple machines, use addressRange instead"))
+	}
+
+	publicNetworkPath := path.Child("publicNetwork")
+	for _, ip := range []struct {
+		name string
+		ref  infrav1.FlexibleIPReference
+	}{
+		{name: "ipv4", ref: spec.PublicNetwork.IPv4},
+		{name: "ipv6", ref: spec.PublicNetwork.IPv6},
+	} {
+		if ip.ref.ID != "" {
+			allErrs = append(allErrs, field.Forbidden(publicNetworkPath.Child(ip.name, "id"), "a flexible IP cannot be shared by multiple machines, use tags instead"))
+		}
+		if ip.ref.Address != "" {
+			allErrs = append(allErrs, field.Forbidden(publicNetworkPath.Child(ip.name, "address"), "a flexible IP cannot be shared by multiple machines, use tags instead"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ScalewayMachineTemplate.
 func (v *ScalewayMachineTemplateCustomValidator) ValidateDelete(_ context.Context, obj *infrav1.ScalewayMachineTemplate) (admission.Warnings, error) {
 	scalewaymachinetemplatelog.Info("Validation for ScalewayMachineTemplate upon deletion", "name", obj.GetName())
@@ -153,4 +153,61 @@ var _ = Describe("ScalewayMachineTemplate Webhook", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Context("When creating scalewayMachinetemplate", func() {
+		It("Should pass with a private address range", func() {
+			obj := v1alpha2ScalewayMachineTemplate.DeepCopy()
+			obj.Spec.Template.Spec.PrivateNetwork = infrav1.MachinePrivateNetwork{
+				AddressRange: "10.0.0.0/24",
+			}
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("Should reject a static private address", func() {
+			obj := v1alpha2ScalewayMachineTemplate.DeepCopy()
+			obj.Spec.Template.Spec.PrivateNetwork = infrav1.MachinePrivateNetwork{
+				Address: "10.0.0.10",
+			}
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(HaveOccurred())
+		})
+		It("Should reject an IPAM IP", func() {
+			obj := v1alpha2ScalewayMachineTemplate.DeepCopy()
+			obj.Spec.Template.Spec.PrivateNetwork = infrav1.MachinePrivateNetwork{
+				IPAMIPID: "11111111-1111-1111-1111-111111111111",
+			}
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(HaveOccurred())
+		})
+		It("Should pass with flexible IPs selected by tags", func() {
+			obj := v1alpha2ScalewayMachineTemplate.DeepCopy()
+			obj.Spec.Template.Spec.PublicNetwork.IPv4 = infrav1.FlexibleIPReference{
+				Tags: []string{"pool=ipv4"},
+			}
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("Should reject a flexible IP selected by id", func() {
+			obj := v1alpha2ScalewayMachineTemplate.DeepCopy()
+			obj.Spec.Template.Spec.PublicNetwork.IPv4 = infrav1.FlexibleIPReference{
+				ID: "11111111-1111-1111-1111-111111111111",
+			}
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(HaveOccurred())
+		})
+		It("Should reject a flexible IP selected by address", func() {
+			obj := v1alpha2ScalewayMachineTemplate.DeepCopy()
+			obj.Spec.Template.Spec.PublicNetwork.IPv6 = infrav1.FlexibleIPReference{
+				Address: "2001:bc8::1",
+			}
+			By("calling the validateCreate method")
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -104,6 +104,9 @@ var _ = BeforeSuite(func() {
 	err = SetupScalewayMachineTemplateWebhookWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = SetupScalewayMachinePoolWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
 	err = SetupScalewayManagedClusterWebhookWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
 
@@ -113,6 +116,12 @@ var _ = BeforeSuite(func() {
 	err = SetupScalewayManagedMachinePoolWebhookWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = SetupScalewayElasticMetalMachineWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = SetupScalewayElasticMetalMachineTemplateWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
 	// +kubebuilder:scaffold:webhook
 
 	go func() {
@@ -5,8 +5,12 @@ import "github.com/scaleway/cluster-api-provider-scaleway/internal/controller"
 // Following variables provide access to reconcilers implemented in internal/controller.
 var (
 	NewScalewayClusterReconciler             = controller.NewScalewayClusterReconciler
+	NewScalewayElasticMetalMachineReconciler = controller.NewScalewayElasticMetalMachineReconciler
 	NewScalewayMachineReconciler             = controller.NewScalewayMachineReconciler
+	NewScalewayMachinePoolReconciler         = controller.NewScalewayMachinePoolReconciler
+	NewScalewayMachineTemplateReconciler     = controller.NewScalewayMachineTemplateReconciler
 	NewScalewayManagedClusterReconciler      = controller.NewScalewayManagedClusterReconciler
 	NewScalewayManagedControlPlaneReconciler = controller.NewScalewayManagedControlPlaneReconciler
 	NewScalewayManagedMachinePoolReconciler  = controller.NewScalewayManagedMachinePoolReconciler
+	NewScalewayRemediationReconciler         = controller.NewScalewayRemediationReconciler
 )